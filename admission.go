@@ -0,0 +1,191 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// ErrQueryNotAdmitted is returned for queries rejected by the admission policy of the engine.
+var ErrQueryNotAdmitted = errors.NewKind("query rejected by admission policy: %s")
+
+// AdmissionPolicy limits the shape of the queries run by an engine, for platforms exposing SQL to end users. Queries
+// are checked after they're analyzed, and the ones not allowed fail with ErrQueryNotAdmitted before they run.
+type AdmissionPolicy struct {
+	// MaxEstimatedCost is the maximum estimated cost of a query, which is the number of rows it's estimated to read:
+	// all the rows of the tables it scans, once per row of the left side of a join for the right side, and one row
+	// per index lookup. Tables without statistics count as empty. Zero means no limit.
+	MaxEstimatedCost uint64
+	// DenyFullScans is the list of tables, either as "table" or "db.table", that queries can only read with index
+	// lookups.
+	DenyFullScans []string
+	// RequireLimit requires queries returning rows read from tables to have a LIMIT.
+	RequireLimit bool
+}
+
+// Check returns an error if the analyzed query given isn't allowed by the policy.
+func (p *AdmissionPolicy) Check(ctx *sql.Context, node sql.Node) error {
+	if plan.IsNoRowNode(unwrapQueryProcess(node)) {
+		return nil
+	}
+
+	shape := &queryShape{}
+	if err := shape.add(ctx, node); err != nil {
+		return err
+	}
+
+	for _, rt := range shape.fullScans {
+		if p.deniesFullScan(rt) {
+			return ErrQueryNotAdmitted.New(fmt.Sprintf("full scans of table %s are not allowed", rt.Name()))
+		}
+	}
+
+	if p.MaxEstimatedCost > 0 && shape.cost > p.MaxEstimatedCost {
+		return ErrQueryNotAdmitted.New(fmt.Sprintf("estimated cost %d exceeds the maximum of %d", shape.cost, p.MaxEstimatedCost))
+	}
+
+	if p.RequireLimit && shape.readsTables && !hasLimit(node) {
+		return ErrQueryNotAdmitted.New("queries must have a LIMIT")
+	}
+
+	return nil
+}
+
+func (p *AdmissionPolicy) deniesFullScan(rt *plan.ResolvedTable) bool {
+	for _, name := range p.DenyFullScans {
+		if strings.EqualFold(name, rt.Name()) ||
+			(rt.Database != nil && strings.EqualFold(name, rt.Database.Name()+"."+rt.Name())) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryShape is what the admission policy knows about a query: the tables it reads in full and its estimated cost.
+type queryShape struct {
+	fullScans   []*plan.ResolvedTable
+	readsTables bool
+	cost        uint64
+}
+
+func (s *queryShape) add(ctx *sql.Context, node sql.Node) error {
+	cost, err := s.nodeCost(ctx, node)
+	if err != nil {
+		return err
+	}
+	s.cost += cost
+	return nil
+}
+
+// nodeCost returns the estimated cost of the node given, recording the tables it reads along the way.
+func (s *queryShape) nodeCost(ctx *sql.Context, node sql.Node) (uint64, error) {
+	switch n := node.(type) {
+	case *plan.InsertInto:
+		// The destination of an insert isn't read
+		return s.nodeCost(ctx, n.Source)
+	case *plan.IndexedTableAccess:
+		s.readsTables = true
+		return 1, nil
+	case *plan.ResolvedTable:
+		// Tables without a database, such as dual, don't hold any data
+		if n.Database == nil {
+			return 0, nil
+		}
+		s.readsTables = true
+		s.fullScans = append(s.fullScans, n)
+		stats, ok, err := sql.GetTableStatistics(ctx, n.Table)
+		if err != nil || !ok {
+			return 0, err
+		}
+		return stats.RowCount, nil
+	case plan.JoinNode:
+		left, err := s.nodeCost(ctx, n.Left())
+		if err != nil {
+			return 0, err
+		}
+		right, err := s.nodeCost(ctx, n.Right())
+		if err != nil {
+			return 0, err
+		}
+		return left + left*right, nil
+	case *plan.IndexedInSubqueryFilter:
+		// The child is read once per row of the subquery
+		subquery, err := s.nodeCost(ctx, n.Subquery().Query)
+		if err != nil {
+			return 0, err
+		}
+		child, err := s.nodeCost(ctx, n.Children()[0])
+		if err != nil {
+			return 0, err
+		}
+		return subquery + subquery*child, nil
+	}
+
+	var cost uint64
+	for _, child := range node.Children() {
+		c, err := s.nodeCost(ctx, child)
+		if err != nil {
+			return 0, err
+		}
+		cost += c
+	}
+
+	if ne, ok := node.(sql.Expressioner); ok {
+		for _, e := range ne.Expressions() {
+			var err error
+			sql.Inspect(e, func(e sql.Expression) bool {
+				if sq, ok := e.(*plan.Subquery); ok && err == nil {
+					var c uint64
+					c, err = s.nodeCost(ctx, sq.Query)
+					cost += c
+				}
+				return err == nil
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return cost, nil
+}
+
+// hasLimit returns whether the rows returned by the analyzed query given are limited.
+func hasLimit(node sql.Node) bool {
+	for {
+		switch n := node.(type) {
+		case *plan.Limit, *plan.TopN:
+			return true
+		case *plan.QueryProcess, *plan.Project, *plan.Distinct, *plan.OrderedDistinct, *plan.Sort,
+			*plan.DecoratedNode, *plan.Offset:
+			node = n.Children()[0]
+		default:
+			return false
+		}
+	}
+}
+
+func unwrapQueryProcess(node sql.Node) sql.Node {
+	if qp, ok := node.(*plan.QueryProcess); ok {
+		return qp.Child
+	}
+	return node
+}
@@ -137,6 +137,10 @@ func auditInfo(ctx *sql.Context, err error) logrus.Fields {
 		"success":       true,
 	}
 
+	if tags := ctx.QueryTags(); len(tags) > 0 {
+		fields["tags"] = tags
+	}
+
 	if err != nil {
 		fields["success"] = false
 		fields["err"] = err
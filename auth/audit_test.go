@@ -242,4 +242,11 @@ func TestAuditLog(t *testing.T) {
 	m["success"] = false
 	m["err"] = err
 	require.Equal(m, e.Data)
+
+	ctx = ctx.WithQuery("query /* service:billing */")
+	l.Query(ctx, 808*time.Second, err)
+	e = hook.LastEntry()
+	m["query"] = "query /* service:billing */"
+	m["tags"] = map[string]string{"service": "billing"}
+	require.Equal(m, e.Data)
 }
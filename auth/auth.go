@@ -75,3 +75,11 @@ type Auth interface {
 	// Otherwise is an error using the authentication method.
 	Allowed(ctx *sql.Context, permission Permission) error
 }
+
+// Accounts is implemented by the Auth methods that know the account a client
+// was authenticated as, which CURRENT_USER() returns.
+type Accounts interface {
+	// Account returns the account of the client of the context given, in the
+	// user@host form, and whether it's known.
+	Account(ctx *sql.Context) (string, bool)
+}
@@ -167,3 +167,14 @@ func (s *Native) Allowed(ctx *sql.Context, permission Permission) error {
 
 	return u.Allowed(permission)
 }
+
+// Account implements Accounts interface. Native users can connect from any
+// host.
+func (s *Native) Account(ctx *sql.Context) (string, bool) {
+	u, ok := s.users[ctx.Client().User]
+	if !ok {
+		return "", false
+	}
+
+	return u.Name + "@%", true
+}
@@ -66,7 +66,8 @@ func (c *Conn) newContextWithQuery(ctx context.Context, query string) (*sql.Cont
 		sql.WithQuery(query),
 		sql.WithPid(c.dbConn.nextProcessID()),
 		sql.WithMemoryManager(c.dbConn.engine.MemoryManager),
-		sql.WithProcessList(c.dbConn.engine.ProcessList))
+		sql.WithProcessList(c.dbConn.engine.ProcessList),
+		sql.WithWorkerPool(c.dbConn.engine.WorkerPool))
 }
 
 type fakeTransaction struct{}
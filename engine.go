@@ -17,6 +17,7 @@ package sqle
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/memory"
 
@@ -32,10 +33,55 @@ import (
 type Config struct {
 	// VersionPostfix to display with the `VERSION()` UDF.
 	VersionPostfix string
+	// Version, if set, is the version reported by `VERSION()` and
+	// @@version instead of the default one followed by VersionPostfix.
+	// Since system variables are global, @@version is the one of the last
+	// engine created.
+	Version string
 	// Auth used for authentication and authorization.
 	Auth auth.Auth
+	// WorkerPoolSize is the maximum number of goroutines the engine runs at
+	// once for background work, such as parallel scans, index builds and
+	// background threads. Zero means no limit.
+	WorkerPoolSize int
+	// WorkerQuotas limits the number of workers each subsystem can use from
+	// the worker pool at once.
+	WorkerQuotas map[sql.WorkerSubsystem]int
+	// PreParseHooks are run, in order, on the text of every query before
+	// it's parsed.
+	PreParseHooks []PreParseHook
+	// PostParseHooks are run, in order, on every parsed query before it's
+	// analyzed.
+	PostParseHooks []PostParseHook
+	// QueryListeners are notified of every step of the execution of the
+	// statements run by the engine.
+	QueryListeners []QueryListener
+	// AdmissionPolicy, if set, limits the shape of the queries run by the
+	// engine.
+	AdmissionPolicy *AdmissionPolicy
+	// WorkloadClasses are the classes of sessions whose queries share
+	// concurrency and memory limits.
+	WorkloadClasses []WorkloadClass
+	// WorkloadClassifier assigns sessions to the workload classes. It's
+	// required for the workload classes to be enforced.
+	WorkloadClassifier WorkloadClassifier
+	// Clock, if set, is used instead of the system clock for the time of
+	// queries, as returned by NOW(), CURDATE() and the like, and by UUID().
+	Clock func() time.Time
+	// Random, if set, is the source of the random numbers returned by
+	// RAND() and UUID(). Together with Clock, it makes the results of these
+	// functions reproducible, e.g. in tests.
+	Random sql.Random
 }
 
+// PreParseHook rewrites the text of a query before it's parsed, e.g. to route queries or to interpret comment-based
+// directives. Returning an error rejects the query.
+type PreParseHook func(ctx *sql.Context, query string) (string, error)
+
+// PostParseHook rewrites a parsed query before it's analyzed, e.g. to enforce a blocklist of statements. Returning an
+// error rejects the query.
+type PostParseHook func(ctx *sql.Context, node sql.Node) (sql.Node, error)
+
 // Engine is a SQL engine.
 type Engine struct {
 	Analyzer          *analyzer.Analyzer
@@ -44,6 +90,18 @@ type Engine struct {
 	ProcessList       sql.ProcessList
 	MemoryManager     *sql.MemoryManager
 	BackgroundThreads *sql.BackgroundThreads
+	WorkerPool        *sql.WorkerPool
+	PreparedData      *PreparedDataCache
+	PreParseHooks     []PreParseHook
+	PostParseHooks    []PostParseHook
+	QueryListeners    []QueryListener
+	AdmissionPolicy   *AdmissionPolicy
+	Workloads         *Workloads
+	Clock             func() time.Time
+	Random            sql.Random
+	// Version is the value of the version system variable in the sessions the engine runs queries for, unless
+	// they were given another one, e.g. by the server they're connected to.
+	Version string
 }
 
 type ColumnWithRawDefault struct {
@@ -55,9 +113,12 @@ type ColumnWithRawDefault struct {
 // the default settings use `NewDefault`. Should call Engine.Close() to finalize
 // dependency lifecycles.
 func New(a *analyzer.Analyzer, cfg *Config) *Engine {
-	var versionPostfix string
+	version := function.ServerVersion("")
 	if cfg != nil {
-		versionPostfix = cfg.VersionPostfix
+		version = cfg.Version
+		if version == "" {
+			version = function.ServerVersion(cfg.VersionPostfix)
+		}
 	}
 
 	ls := sql.NewLockSubsystem()
@@ -65,7 +126,7 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	a.Catalog.RegisterFunction(
 		sql.FunctionN{
 			Name: "version",
-			Fn:   function.NewVersion(versionPostfix),
+			Fn:   function.NewVersionString(version),
 		})
 	a.Catalog.RegisterFunction(function.GetLockingFuncs(ls)...)
 
@@ -77,13 +138,52 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		au = cfg.Auth
 	}
 
+	var preParseHooks []PreParseHook
+	var postParseHooks []PostParseHook
+	var listeners []QueryListener
+	var admission *AdmissionPolicy
+	var workloads *Workloads
+	var clock func() time.Time
+	var random sql.Random
+	if cfg != nil {
+		preParseHooks = cfg.PreParseHooks
+		postParseHooks = cfg.PostParseHooks
+		listeners = cfg.QueryListeners
+		admission = cfg.AdmissionPolicy
+		if cfg.WorkloadClassifier != nil {
+			workloads = NewWorkloads(cfg.WorkloadClassifier, cfg.WorkloadClasses...)
+		}
+		clock = cfg.Clock
+		random = cfg.Random
+	}
+
+	var pool *sql.WorkerPool
+	if cfg == nil {
+		pool = sql.NewWorkerPool(0)
+	} else {
+		pool = sql.NewWorkerPool(cfg.WorkerPoolSize)
+		for subsystem, quota := range cfg.WorkerQuotas {
+			pool.SetQuota(subsystem, quota)
+		}
+	}
+
 	return &Engine{
 		Analyzer:          a,
 		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
 		ProcessList:       NewProcessList(),
 		Auth:              au,
 		LS:                ls,
-		BackgroundThreads: sql.NewBackgroundThreads(),
+		BackgroundThreads: sql.NewBackgroundThreadsWithPool(pool),
+		WorkerPool:        pool,
+		PreparedData:      NewPreparedDataCache(),
+		PreParseHooks:     preParseHooks,
+		PostParseHooks:    postParseHooks,
+		QueryListeners:    listeners,
+		AdmissionPolicy:   admission,
+		Workloads:         workloads,
+		Clock:             clock,
+		Random:            random,
+		Version:           version,
 	}
 }
 
@@ -98,11 +198,21 @@ func (e *Engine) AnalyzeQuery(
 	ctx *sql.Context,
 	query string,
 ) (sql.Schema, error) {
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
 	parsed, err := parse.Parse(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
+	parsed, err = e.rewriteNode(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
 	analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
 	if err != nil {
 		return nil, err
@@ -111,6 +221,86 @@ func (e *Engine) AnalyzeQuery(
 	return analyzed.Schema(), nil
 }
 
+// PrepareQuery analyzes a query and returns its Schema. The statement is kept for the session of the context given,
+// so that running the same query text later doesn't need to parse it again, until the schema of the catalog changes.
+func (e *Engine) PrepareQuery(
+	ctx *sql.Context,
+	query string,
+) (sql.Schema, error) {
+	version := e.Analyzer.Catalog.SchemaVersion()
+
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rewritten, err := e.rewriteNode(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzed, err := e.Analyzer.Analyze(ctx, rewritten, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	e.PreparedData.Put(ctx.ID(), query, &PreparedStatement{
+		Parsed:        parsed,
+		Schema:        analyzed.Schema(),
+		SchemaVersion: version,
+		ParamTypes:    plan.InferBindVarTypes(analyzed),
+	})
+
+	return analyzed.Schema(), nil
+}
+
+// inferParamTypes returns the types of the parameters of the statement analyzed given. When it was analyzed with its
+// parameters bound, the statement without its parameters bound is analyzed to tell where they're used.
+func (e *Engine) inferParamTypes(ctx *sql.Context, analyzed, unbound sql.Node, bound bool) (map[string]sql.Type, error) {
+	if bound {
+		var err error
+		analyzed, err = e.Analyzer.Analyze(ctx, unbound, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan.InferBindVarTypes(analyzed), nil
+}
+
+// RewriteQuery returns the query given as rewritten by the pre-parse hooks of the engine.
+func (e *Engine) RewriteQuery(ctx *sql.Context, query string) (string, error) {
+	var err error
+	for _, hook := range e.PreParseHooks {
+		query, err = hook(ctx, query)
+		if err != nil {
+			return "", err
+		}
+	}
+	return query, nil
+}
+
+// rewriteNode returns the parsed query given as rewritten by the post-parse hooks of the engine.
+func (e *Engine) rewriteNode(ctx *sql.Context, node sql.Node) (sql.Node, error) {
+	var err error
+	for _, hook := range e.PostParseHooks {
+		node, err = hook(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// CloseSession discards the statements prepared by the session with the id given.
+func (e *Engine) CloseSession(sessionID uint32) {
+	e.PreparedData.DeleteSession(sessionID)
+}
+
 // Query executes a query. If parsed is non-nil, it will be used instead of parsing the query from text.
 func (e *Engine) Query(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, error) {
 	return e.QueryWithBindings(ctx, query, nil)
@@ -122,16 +312,59 @@ func (e *Engine) QueryWithBindings(
 	query string,
 	bindings map[string]sql.Expression,
 ) (sql.Schema, sql.RowIter, error) {
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
 	return e.QueryNodeWithBindings(ctx, query, nil, bindings)
 }
 
 // QueryNodeWithBindings executes the query given with the bindings provided. If parsed is non-nil, it will be used
-// instead of parsing the query from text.
+// instead of parsing the query from text. The pre-parse hooks of the engine are expected to have been run on the query
+// text already, see RewriteQuery.
 func (e *Engine) QueryNodeWithBindings(
 	ctx *sql.Context,
 	query string,
 	parsed sql.Node,
 	bindings map[string]sql.Expression,
+) (sql.Schema, sql.RowIter, error) {
+	events := newQueryEvents(e.QueryListeners, query)
+	events.send(ctx, QueryStarted, 0, nil)
+
+	done := func() {}
+	if e.Workloads != nil {
+		var err error
+		done, err = e.Workloads.Admit(ctx)
+		if err != nil {
+			events.send(ctx, QueryFailed, 0, err)
+			return nil, nil, err
+		}
+	}
+
+	schema, iter, err := e.queryNodeWithBindings(ctx, query, parsed, bindings, events)
+	if err != nil {
+		done()
+		events.send(ctx, QueryFailed, 0, err)
+		return nil, nil, err
+	}
+
+	if e.Workloads != nil {
+		iter = &workloadIter{childIter: iter, done: done}
+	}
+
+	if len(e.QueryListeners) > 0 {
+		iter = &queryEventsIter{childIter: iter, events: events}
+	}
+
+	return schema, iter, nil
+}
+
+func (e *Engine) queryNodeWithBindings(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+	events *queryEvents,
 ) (sql.Schema, sql.RowIter, error) {
 	var (
 		analyzed sql.Node
@@ -139,6 +372,36 @@ func (e *Engine) QueryNodeWithBindings(
 		err      error
 	)
 
+	if ctx.Workers == nil {
+		ctx.Workers = e.WorkerPool
+	}
+	if e.Clock != nil {
+		ctx.ApplyOpts(sql.WithClock(e.Clock))
+	}
+	if e.Random != nil {
+		ctx.ApplyOpts(sql.WithRandom(e.Random))
+	}
+	if si, ok := ctx.Session.(sql.SessionVariableInitializer); ok && e.Version != "" {
+		if err := si.InitSessionVariable(ctx, "version", e.Version); err != nil {
+			return nil, nil, err
+		}
+	}
+	if ctx.Account == "" {
+		if accounts, ok := e.Auth.(auth.Accounts); ok {
+			ctx.Account, _ = accounts.Account(ctx)
+		}
+	}
+
+	version := e.Analyzer.Catalog.SchemaVersion()
+	stale := false
+	if stmt, ok := e.PreparedData.Get(ctx.ID(), query); ok {
+		if stmt.SchemaVersion != version {
+			stale = true
+		} else if parsed == nil {
+			parsed = stmt.Parsed
+		}
+	}
+
 	if parsed == nil {
 		parsed, err = parse.Parse(ctx, query)
 		if err != nil {
@@ -146,6 +409,12 @@ func (e *Engine) QueryNodeWithBindings(
 		}
 	}
 
+	unrewritten := parsed
+	parsed, err = e.rewriteNode(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = e.authCheck(ctx, parsed)
 	if err != nil {
 		return nil, nil, err
@@ -156,6 +425,7 @@ func (e *Engine) QueryNodeWithBindings(
 		return nil, nil, err
 	}
 
+	unbound := parsed
 	if len(bindings) > 0 {
 		parsed, err = plan.ApplyBindings(ctx, parsed, bindings)
 		if err != nil {
@@ -168,7 +438,35 @@ func (e *Engine) QueryNodeWithBindings(
 		return nil, nil, err
 	}
 
+	if e.AdmissionPolicy != nil {
+		if err := e.AdmissionPolicy.Check(ctx, analyzed); err != nil {
+			return nil, nil, err
+		}
+	}
+	events.analyzed(ctx, analyzed)
+
+	if stale {
+		// The schema changed since the statement was prepared, so it's prepared again against the current one
+		paramTypes, err := e.inferParamTypes(ctx, analyzed, unbound, len(bindings) > 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.PreparedData.Put(ctx.ID(), query, &PreparedStatement{
+			Parsed:        unrewritten,
+			Schema:        analyzed.Schema(),
+			SchemaVersion: version,
+			ParamTypes:    paramTypes,
+		})
+	}
+
 	iter, err = analyzed.RowIter(ctx, nil)
+	if plan.IsDDLNode(parsed) {
+		if err != nil {
+			e.Analyzer.Catalog.SchemaChanged()
+		} else {
+			iter = schemaChangingIter{iter, e.Analyzer.Catalog}
+		}
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -17,9 +17,12 @@ package enginetest_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
@@ -27,12 +30,14 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/auth"
 	"github.com/dolthub/go-mysql-server/enginetest"
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
@@ -80,6 +85,10 @@ func TestTracing(t *testing.T) {
 	enginetest.TestTracing(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestQueryMetrics(t *testing.T) {
+	enginetest.TestQueryMetrics(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestCurrentTimestamp(t *testing.T) {
 	enginetest.TestCurrentTimestamp(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -212,6 +221,7 @@ func TestShowProcessList(t *testing.T) {
 	p.UpdatePartitionProgress(1, "a", "a-2", 9)
 	p.UpdateTableProgress(1, "b", 2)
 	p.UpdateTableProgress(2, "foo", 1)
+	p.UpdateTableRowsEstimate(2, "foo", 100)
 
 	n := plan.NewShowProcessList()
 	n.Database = "foo"
@@ -224,18 +234,56 @@ func TestShowProcessList(t *testing.T) {
 	expected := []sql.Row{
 		{int64(1), "foo", addr, "foo", "Query", int64(0),
 			`
-a (4/5 partitions)
+a (4/5 partitions, 16/? rows)
  ├─ a-1 (7/? rows)
  └─ a-2 (9/? rows)
 
 b (2/6 partitions)
 `, "SELECT foo"},
-		{int64(1), "foo", addr, "foo", "Query", int64(0), "\nfoo (1/2 partitions)\n", "SELECT bar"},
+		{int64(1), "foo", addr, "foo", "Query", int64(0), "\nfoo (1/2 partitions, 0/100 rows)\n", "SELECT bar"},
 	}
 
 	require.ElementsMatch(expected, rows)
 }
 
+func TestQueryProgress(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	}), 2)
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(table.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+	}
+	db.AddTable("t", table)
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db, information_schema.NewInformationSchemaDatabase()))
+
+	p := sqle.NewProcessList()
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: "127.0.0.1:34567", User: "foo"}, 1)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p)).WithCurrentDB("db")
+	ctx, err := p.AddProcess(ctx, "SELECT * FROM t")
+	require.NoError(err)
+
+	_, iter, err := engine.Query(ctx, "SELECT * FROM t")
+	require.NoError(err)
+	_, err = iter.Next(ctx)
+	require.NoError(err)
+	queryCtx := ctx
+
+	ctx = sql.NewContext(context.Background(), sql.WithPid(2), sql.WithSession(sess), sql.WithProcessList(p)).WithCurrentDB("db")
+	ctx, err = p.AddProcess(ctx, "SELECT * FROM information_schema.query_progress WHERE table_name = 't'")
+	require.NoError(err)
+
+	_, progressIter, err := engine.Query(ctx, "SELECT * FROM information_schema.query_progress WHERE table_name = 't'")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, progressIter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{uint64(1), "t", int64(0), int64(2), int64(1), int64(3)}}, rows)
+
+	require.NoError(iter.Close(queryCtx))
+}
+
 // TODO: this was an analyzer test, but we don't have a mock process list for it to use, so it has to be here
 func TestTrackProcess(t *testing.T) {
 	require := require.New(t)
@@ -263,10 +311,12 @@ func TestTrackProcess(t *testing.T) {
 		map[string]sql.TableProgress{
 			"foo": sql.TableProgress{
 				Progress:           sql.Progress{Name: "foo", Done: 0, Total: 2},
-				PartitionsProgress: map[string]sql.PartitionProgress{}},
+				PartitionsProgress: map[string]sql.PartitionProgress{},
+				Rows:               sql.Progress{Name: "foo", Done: 0, Total: -1}},
 			"bar": sql.TableProgress{
 				Progress:           sql.Progress{Name: "bar", Done: 0, Total: 4},
-				PartitionsProgress: map[string]sql.PartitionProgress{}},
+				PartitionsProgress: map[string]sql.PartitionProgress{},
+				Rows:               sql.Progress{Name: "bar", Done: 0, Total: 0}},
 		},
 		processes[0].Progress)
 
@@ -349,8 +399,8 @@ func TestUnlockTables(t *testing.T) {
 	catalog := analyzer.NewCatalog(sql.NewDatabaseProvider(db))
 
 	ctx := sql.NewContext(context.Background()).WithCurrentDB("db").WithCurrentDB("db")
-	catalog.LockTable(ctx, "foo")
-	catalog.LockTable(ctx, "bar")
+	catalog.LockTable(ctx, "db", "foo")
+	catalog.LockTable(ctx, "db", "bar")
 
 	node := plan.NewUnlockTables()
 	node.Catalog = catalog
@@ -363,6 +413,1056 @@ func TestUnlockTables(t *testing.T) {
 	require.Equal(0, t3.unlocks)
 }
 
+func TestFlushTablesWithReadLock(t *testing.T) {
+	require := require.New(t)
+
+	db1 := memory.NewDatabase("db1")
+	db2 := memory.NewDatabase("db2")
+	t1 := newLockableTable(memory.NewTable("foo", sql.PrimaryKeySchema{}))
+	t2 := newLockableTable(memory.NewTable("bar", sql.PrimaryKeySchema{}))
+	db1.AddTable("foo", t1)
+	db2.AddTable("bar", t2)
+	db2.AddTable("baz", memory.NewTable("baz", sql.PrimaryKeySchema{}))
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db1, db2))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db1")
+
+	run := func(query string) {
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	run("FLUSH /*!40101 LOCAL */ TABLES")
+	require.Equal(0, t1.readLocks)
+	require.Equal(0, t2.readLocks)
+
+	run("FLUSH TABLES WITH READ LOCK")
+	require.Equal(1, t1.readLocks)
+	require.Equal(1, t2.readLocks)
+	require.Equal(0, t1.writeLocks+t2.writeLocks)
+
+	run("UNLOCK TABLES")
+	require.Equal(1, t1.unlocks)
+	require.Equal(1, t2.unlocks)
+
+	run("FLUSH TABLES db2.bar WITH READ LOCK")
+	require.Equal(1, t1.readLocks)
+	require.Equal(2, t2.readLocks)
+
+	run("UNLOCK TABLES")
+	require.Equal(1, t1.unlocks)
+	require.Equal(2, t2.unlocks)
+}
+
+func TestPreparedStatementSchemaChange(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})))
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	version := engine.Analyzer.Catalog.SchemaVersion()
+	schema, err := engine.PrepareQuery(ctx, "SELECT * FROM t")
+	require.NoError(err)
+	require.Len(schema, 1)
+
+	stmt, ok := engine.PreparedData.Get(ctx.ID(), "SELECT * FROM t")
+	require.True(ok)
+	require.Equal(version, stmt.SchemaVersion)
+
+	_, iter, err := engine.Query(ctx, "ALTER TABLE t ADD COLUMN b INT")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Greater(engine.Analyzer.Catalog.SchemaVersion(), version)
+
+	schema, iter, err = engine.Query(ctx, "SELECT * FROM t")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(schema, 2)
+
+	stmt, ok = engine.PreparedData.Get(ctx.ID(), "SELECT * FROM t")
+	require.True(ok)
+	require.Equal(engine.Analyzer.Catalog.SchemaVersion(), stmt.SchemaVersion)
+	require.Len(stmt.Schema, 2)
+
+	for _, query := range []string{
+		"CREATE TABLE t2 LIKE t",
+		"ALTER TABLE t ALTER COLUMN b SET DEFAULT 1",
+		"ALTER TABLE t ALTER COLUMN b DROP DEFAULT",
+	} {
+		version = engine.Analyzer.Catalog.SchemaVersion()
+		_, iter, err = engine.Query(ctx, query)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		require.Greater(engine.Analyzer.Catalog.SchemaVersion(), version, query)
+	}
+
+	engine.CloseSession(ctx.ID())
+	_, ok = engine.PreparedData.Get(ctx.ID(), "SELECT * FROM t")
+	require.False(ok)
+}
+
+func TestPreparedStatementLimit(t *testing.T) {
+	require := require.New(t)
+
+	_, limit, ok := sql.SystemVariables.GetGlobal("max_prepared_stmt_count")
+	require.True(ok)
+	defer sql.SystemVariables.SetGlobal("max_prepared_stmt_count", limit)
+	require.NoError(sql.SystemVariables.SetGlobal("max_prepared_stmt_count", int64(2)))
+
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db")))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	other := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("db")
+	require.NotEqual(ctx.ID(), other.ID())
+
+	prepare := func(ctx *sql.Context, query string) {
+		_, err := engine.PrepareQuery(ctx, query)
+		require.NoError(err)
+	}
+	prepared := func(ctx *sql.Context, query string) bool {
+		_, ok := engine.PreparedData.Get(ctx.ID(), query)
+		return ok
+	}
+
+	prepare(ctx, "SELECT 1")
+	prepare(ctx, "SELECT 2")
+	prepare(other, "SELECT 1")
+	// Preparing a statement again doesn't evict any
+	prepare(ctx, "SELECT 1")
+	require.True(prepared(ctx, "SELECT 1"))
+	require.True(prepared(ctx, "SELECT 2"))
+
+	// Past the limit, the statement prepared first is evicted, only from the session that prepared it
+	prepare(ctx, "SELECT 3")
+	require.False(prepared(ctx, "SELECT 1"))
+	require.True(prepared(ctx, "SELECT 2"))
+	require.True(prepared(ctx, "SELECT 3"))
+	require.True(prepared(other, "SELECT 1"))
+
+	// Statements evicted are parsed again when they're run
+	_, iter, err := engine.Query(ctx, "SELECT 1")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int8(1)}}, rows)
+
+	// With a limit of 0, no statements are held
+	require.NoError(sql.SystemVariables.SetGlobal("max_prepared_stmt_count", int64(0)))
+	prepare(ctx, "SELECT 4")
+	require.False(prepared(ctx, "SELECT 4"))
+	require.False(prepared(ctx, "SELECT 2"))
+
+	engine.CloseSession(other.ID())
+	require.False(prepared(other, "SELECT 1"))
+}
+
+func TestPreparedStatementParamTypes(t *testing.T) {
+	db := memory.NewDatabase("db")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "b", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 10), Source: "t", Nullable: true},
+		{Name: "c", Type: sql.Float64, Source: "t", Nullable: true},
+	})))
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+
+	for _, tt := range []struct {
+		query    string
+		expected map[string]sql.Type
+	}{
+		{
+			query:    "SELECT * FROM t WHERE a > ? AND b LIKE ? LIMIT ?",
+			expected: map[string]sql.Type{"v1": sql.Int64, "v2": sql.LongText, "v3": sql.Int64},
+		},
+		{
+			query:    "INSERT INTO t (b, a) VALUES (?, ?)",
+			expected: map[string]sql.Type{"v1": sql.MustCreateStringWithDefaults(sqltypes.VarChar, 10), "v2": sql.Int64},
+		},
+		{
+			query:    "UPDATE t SET c = ? WHERE a IN (?, ?)",
+			expected: map[string]sql.Type{"v1": sql.Float64, "v2": sql.Int64, "v3": sql.Int64},
+		},
+		{
+			query:    "SELECT a FROM t WHERE c BETWEEN ? AND ? OR a = (SELECT max(a) FROM t WHERE b = ?)",
+			expected: map[string]sql.Type{"v1": sql.Float64, "v2": sql.Float64, "v3": sql.MustCreateStringWithDefaults(sqltypes.VarChar, 10)},
+		},
+		{
+			// Nothing tells the type of parameters only used with each other
+			query:    "SELECT ? = ?",
+			expected: map[string]sql.Type{},
+		},
+	} {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := engine.PrepareQuery(ctx, tt.query)
+			require.NoError(t, err)
+			stmt, ok := engine.PreparedData.Get(ctx.ID(), tt.query)
+			require.True(t, ok)
+			require.Equal(t, tt.expected, stmt.ParamTypes)
+		})
+	}
+
+	t.Run("prepared again after a schema change", func(t *testing.T) {
+		query := "SELECT * FROM t WHERE a = ? OR d = ?"
+		_, iter, err := engine.Query(ctx, "ALTER TABLE t ADD COLUMN d DOUBLE")
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		_, err = engine.PrepareQuery(ctx, query)
+		require.NoError(t, err)
+
+		_, iter, err = engine.Query(ctx, "ALTER TABLE t DROP COLUMN d")
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		_, iter, err = engine.Query(ctx, "ALTER TABLE t ADD COLUMN d INT")
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+
+		_, iter, err = engine.QueryWithBindings(ctx, query, map[string]sql.Expression{
+			"v1": expression.NewLiteral(int64(1), sql.Int64),
+			"v2": expression.NewLiteral(int32(1), sql.Int32),
+		})
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+
+		stmt, ok := engine.PreparedData.Get(ctx.ID(), query)
+		require.True(t, ok)
+		require.Equal(t, engine.Analyzer.Catalog.SchemaVersion(), stmt.SchemaVersion)
+		require.Equal(t, map[string]sql.Type{"v1": sql.Int64, "v2": sql.Int32}, stmt.ParamTypes)
+	})
+}
+
+func TestEnginesWithSharedFunctions(t *testing.T) {
+	require := require.New(t)
+
+	functions := function.NewRegistry()
+	newEngine := func(db, postfix string) *sqle.Engine {
+		a := analyzer.NewBuilder(sql.NewDatabaseProvider(memory.NewDatabase(db))).WithFunctionRegistry(functions).Build()
+		return sqle.New(a, &sqle.Config{VersionPostfix: postfix})
+	}
+	e1 := newEngine("db1", "tenant1")
+	e2 := newEngine("db2", "tenant2")
+
+	for _, tt := range []struct {
+		engine   *sqle.Engine
+		db       string
+		expected string
+	}{
+		{e1, "db1", "8.0.11-tenant1"},
+		{e2, "db2", "8.0.11-tenant2"},
+	} {
+		ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB(tt.db)
+		_, iter, err := tt.engine.Query(ctx, "SELECT VERSION(), ABS(-1)")
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		require.Equal([]sql.Row{{tt.expected, int8(1)}}, rows)
+	}
+}
+
+func TestEngineVersionAndAccount(t *testing.T) {
+	require := require.New(t)
+
+	engine := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db"))), &sqle.Config{
+		Version:        "5.7.0-custom",
+		VersionPostfix: "ignored",
+		Auth:           auth.NewNativeSingle("user", "pass", auth.AllPermissions),
+	})
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	_, iter, err := engine.Query(ctx, "SELECT VERSION(), @@version, USER(), CURRENT_USER()")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"5.7.0-custom", "5.7.0-custom", "user@client", "user@%"}}, rows)
+
+	// The version is the engine's, not a global one that engines created later would overwrite
+	other := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db")))
+	_, iter, err = engine.Query(ctx, "SELECT @@version")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"5.7.0-custom"}}, rows)
+
+	otherCtx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	_, iter, err = other.Query(otherCtx, "SELECT @@version, @@global.version")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(otherCtx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{function.ServerVersion(""), function.ServerVersion("")}}, rows)
+}
+
+func TestEngineClockAndRandom(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 7, 4, 10, 30, 0, 0, time.UTC)
+	query := func() sql.Row {
+		engine := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db"))), &sqle.Config{
+			Clock:  func() time.Time { return now },
+			Random: sql.NewRandom(42),
+		})
+		ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+		_, iter, err := engine.Query(ctx, "SELECT NOW(), CURDATE(), RAND(), RAND(), UUID(), UUID()")
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		require.Len(rows, 1)
+		return rows[0]
+	}
+
+	row := query()
+	require.Equal(now, row[0])
+	require.Equal("2021-07-04", row[1])
+	require.NotEqual(row[2], row[3])
+	require.NotEqual(row[4], row[5])
+	require.Regexp("^[0-9a-f]{8}-[0-9a-f]{4}-1[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", row[4])
+	require.Equal(row, query())
+}
+
+func TestNowPrecision(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 7, 4, 10, 30, 0, 123456789, time.UTC)
+	engine := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db"))), &sqle.Config{
+		Clock: func() time.Time { return now },
+	})
+	query := func(query string) []sql.Row {
+		ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	require.Equal([]sql.Row{{"Project(NOW(6), SYSDATE(3))"}, {" └─ Table(dual)"}}, query("EXPLAIN SELECT NOW(6), SYSDATE(3)"))
+	require.Equal([]sql.Row{{"123456", "123000", "120000", "000000"}},
+		query("SELECT DATE_FORMAT(NOW(6), '%f'), DATE_FORMAT(SYSDATE(3), '%f'), DATE_FORMAT(UTC_TIMESTAMP(2), '%f'), DATE_FORMAT(NOW(), '%f')"))
+}
+
+func TestDivPrecisionIncrementType(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	query := func(query string) (sql.Schema, []sql.Row) {
+		sch, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return sch, rows
+	}
+
+	query("CREATE VIEW v AS SELECT 2 / 3 AS d")
+	query("SET SESSION div_precision_increment = 8")
+	for _, q := range []string{"SELECT 1 / 3", "SELECT * FROM (SELECT 1 / 3) sq", "SELECT (SELECT 1 / 3)"} {
+		sch, rows := query(q)
+		require.Equal(sql.MustCreateDecimalType(11, 8), sch[0].Type, q)
+		require.Equal([]sql.Row{{"0.33333333"}}, rows, q)
+	}
+	sch, rows := query("SELECT d FROM v")
+	require.Equal(sql.MustCreateDecimalType(11, 8), sch[0].Type)
+	require.Equal([]sql.Row{{"0.66666667"}}, rows)
+}
+
+func TestSessionTableOverride(t *testing.T) {
+	require := require.New(t)
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	db := memory.NewDatabase("db")
+	table := memory.NewTable("t", sch)
+	require.NoError(table.Insert(sql.NewEmptyContext(), sql.NewRow(int64(1))))
+	db.AddTable("t", table)
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+
+	fixture := memory.NewTable("t", sch)
+	require.NoError(fixture.Insert(sql.NewEmptyContext(), sql.NewRow(int64(42))))
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	ctx.Session.(sql.TableOverrideSession).SetTableOverride("db", fixture)
+
+	_, iter, err := engine.Query(ctx, "SELECT a FROM t")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(42)}}, rows)
+
+	otherCtx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	_, iter, err = engine.Query(otherCtx, "SELECT a FROM t")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(otherCtx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}}, rows)
+}
+
+func TestQueryRewriteHooks(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})))
+	errBlocked := errors.NewKind("statement not allowed: %T")
+
+	engine := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{
+		PreParseHooks: []sqle.PreParseHook{
+			func(ctx *sql.Context, query string) (string, error) {
+				return strings.Replace(query, "/*+ answer */", "42 AS answer,", 1), nil
+			},
+		},
+		PostParseHooks: []sqle.PostParseHook{
+			func(ctx *sql.Context, node sql.Node) (sql.Node, error) {
+				if _, ok := node.(*plan.DropTable); ok {
+					return nil, errBlocked.New(node)
+				}
+				return node, nil
+			},
+		},
+	})
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	sch, iter, err := engine.Query(ctx, "SELECT /*+ answer */ 1 AS one")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal("answer", sch[0].Name)
+	require.Equal([]sql.Row{{int8(42), int8(1)}}, rows)
+
+	_, _, err = engine.Query(ctx, "DROP TABLE t")
+	require.True(errBlocked.Is(err))
+
+	_, err = engine.AnalyzeQuery(ctx, "DROP TABLE t")
+	require.True(errBlocked.Is(err))
+}
+
+func TestQueryListeners(t *testing.T) {
+	require := require.New(t)
+
+	var events []sqle.QueryEvent
+	listener := func(ctx *sql.Context, event sqle.QueryEvent) {
+		events = append(events, event)
+	}
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	engine := enginetest.NewEngine(t, harness)
+	engine.QueryListeners = append(engine.QueryListeners, listener)
+
+	ctx := enginetest.NewContext(harness)
+	_, iter, err := engine.Query(ctx, "SELECT i FROM mytable")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	kinds := make([]sqle.QueryEventKind, len(events))
+	for i, event := range events {
+		kinds[i] = event.Kind
+		require.Equal("SELECT i FROM mytable", event.Query)
+	}
+	require.Equal([]sqle.QueryEventKind{sqle.QueryStarted, sqle.QueryAnalyzed, sqle.QueryFirstRow, sqle.QueryCompleted}, kinds)
+	require.Empty(events[0].Plan)
+	require.Contains(events[1].Plan, "mytable")
+	require.Equal(3, events[3].Rows)
+	require.True(events[3].Elapsed >= events[1].Elapsed)
+
+	events = nil
+	_, _, err = engine.Query(ctx, "SELECT i FROM nosuchtable")
+	require.Error(err)
+	require.Len(events, 2)
+	require.Equal(sqle.QueryStarted, events[0].Kind)
+	require.Equal(sqle.QueryFailed, events[1].Kind)
+	require.True(sql.ErrTableNotFound.Is(events[1].Err))
+}
+
+func TestAdmissionPolicy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  sqle.AdmissionPolicy
+		query   string
+		allowed bool
+	}{
+		{"limit required", sqle.AdmissionPolicy{RequireLimit: true}, "SELECT i FROM mytable", false},
+		{"limit given", sqle.AdmissionPolicy{RequireLimit: true}, "SELECT i FROM mytable LIMIT 1", true},
+		{"limit given with order", sqle.AdmissionPolicy{RequireLimit: true}, "SELECT i FROM mytable ORDER BY i LIMIT 1", true},
+		{"limit in subquery only", sqle.AdmissionPolicy{RequireLimit: true}, "SELECT * FROM (SELECT i FROM mytable LIMIT 1) sq JOIN othertable", false},
+		{"limit without tables", sqle.AdmissionPolicy{RequireLimit: true}, "SELECT 1", true},
+		{"full scan denied", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "SELECT * FROM mytable", false},
+		{"full scan denied by database", sqle.AdmissionPolicy{DenyFullScans: []string{"MYDB.mytable"}}, "SELECT * FROM mytable", false},
+		{"full scan in subquery denied", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "SELECT * FROM othertable WHERE i2 IN (SELECT i FROM mytable)", false},
+		{"full scan of other table", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "SELECT * FROM othertable", true},
+		{"index lookup", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "SELECT * FROM mytable WHERE i = 1", true},
+		{"insert destination", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "INSERT INTO mytable VALUES (10, 'ten')", true},
+		{"insert source", sqle.AdmissionPolicy{DenyFullScans: []string{"mytable"}}, "INSERT INTO othertable SELECT s, i FROM mytable", false},
+		{"cost under maximum", sqle.AdmissionPolicy{MaxEstimatedCost: 5}, "SELECT * FROM mytable", true},
+		{"cost over maximum", sqle.AdmissionPolicy{MaxEstimatedCost: 5}, "SELECT * FROM mytable a CROSS JOIN mytable b", false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			harness := enginetest.NewDefaultMemoryHarness()
+			engine := enginetest.NewEngine(t, harness)
+			policy := tt.policy
+			engine.AdmissionPolicy = &policy
+
+			ctx := enginetest.NewContext(harness)
+			_, iter, err := engine.Query(ctx, tt.query)
+			if !tt.allowed {
+				require.True(sqle.ErrQueryNotAdmitted.Is(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(err)
+			_, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+		})
+	}
+}
+
+func TestWorkloadClasses(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	engine := enginetest.NewEngine(t, harness)
+	classes := make(map[uint64]string)
+	engine.Workloads = sqle.NewWorkloads(func(ctx *sql.Context) string {
+		return classes[ctx.Pid()]
+	},
+		sqle.WorkloadClass{Name: "reporting", MaxConcurrentQueries: 1},
+		sqle.WorkloadClass{Name: "small", MaxMemory: 20},
+	)
+
+	newContext := func(class string) *sql.Context {
+		ctx := enginetest.NewContext(harness)
+		classes[ctx.Pid()] = class
+		return ctx
+	}
+
+	t.Run("concurrency", func(t *testing.T) {
+		require := require.New(t)
+
+		ctx := newContext("reporting")
+		_, running, err := engine.Query(ctx, "SELECT i FROM mytable")
+		require.NoError(err)
+
+		waiting := newContext("reporting")
+		timeout, cancel := context.WithTimeout(waiting, 50*time.Millisecond)
+		defer cancel()
+		_, _, err = engine.Query(waiting.WithContext(timeout), "SELECT i FROM mytable")
+		require.Equal(context.DeadlineExceeded, err)
+
+		// Sessions of other classes aren't limited
+		_, iter, err := engine.Query(newContext(""), "SELECT i FROM mytable")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+
+		_, err = sql.RowIterToRows(ctx, running)
+		require.NoError(err)
+		_, iter, err = engine.Query(newContext("reporting"), "SELECT i FROM mytable")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		require := require.New(t)
+
+		ctx := newContext("small")
+		memory := ctx.Memory
+		_, iter, err := engine.Query(ctx, "SELECT DISTINCT s FROM mytable")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.True(sql.ErrNoMemoryAvailable.Is(err), "unexpected error: %v", err)
+		require.Equal(memory, ctx.Memory)
+
+		ctx = newContext("")
+		_, iter, err = engine.Query(ctx, "SELECT DISTINCT s FROM mytable")
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		require.Len(rows, 3)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, _, err := engine.Query(newContext("nobody"), "SELECT 1")
+		require.True(t, sqle.ErrUnknownWorkloadClass.Is(err))
+	})
+}
+
+func TestCustomStatements(t *testing.T) {
+	require := require.New(t)
+
+	var backups []string
+	parse.RegisterStatement("BACKUP DATABASE", func(ctx *sql.Context, statement string) (sql.Node, error) {
+		fields := strings.Fields(statement)
+		if len(fields) != 3 {
+			return nil, sql.ErrSyntaxError.New(statement)
+		}
+		return &backupDatabase{name: fields[2], backups: &backups}, nil
+	})
+	defer parse.UnregisterStatement("BACKUP DATABASE")
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	engine := enginetest.NewEngine(t, harness)
+	ctx := enginetest.NewContext(harness)
+
+	sch, iter, err := engine.Query(ctx, "backup database mydb")
+	require.NoError(err)
+	require.Equal(sql.OkResultSchema, sch)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{sql.NewOkResult(0)}}, rows)
+	require.Equal([]string{"mydb"}, backups)
+
+	_, _, err = engine.Query(ctx, "BACKUP DATABASE")
+	require.True(sql.ErrSyntaxError.Is(err))
+}
+
+// backupDatabase is the node of the custom BACKUP DATABASE statement of TestCustomStatements.
+type backupDatabase struct {
+	name    string
+	backups *[]string
+}
+
+var _ sql.Node = (*backupDatabase)(nil)
+
+func (b *backupDatabase) Resolved() bool       { return true }
+func (b *backupDatabase) String() string       { return "BACKUP DATABASE " + b.name }
+func (b *backupDatabase) Schema() sql.Schema   { return sql.OkResultSchema }
+func (b *backupDatabase) Children() []sql.Node { return nil }
+func (b *backupDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(b, children...)
+}
+
+func (b *backupDatabase) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	*b.backups = append(*b.backups, b.name)
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+type maintainableTable struct {
+	sql.Table
+	optimized int
+	repairErr error
+}
+
+var _ sql.MaintainableTable = (*maintainableTable)(nil)
+
+func (m *maintainableTable) Optimize(ctx *sql.Context) error {
+	m.optimized++
+	return nil
+}
+
+func (m *maintainableTable) Repair(ctx *sql.Context) error {
+	return m.repairErr
+}
+
+func TestTableMaintenance(t *testing.T) {
+	require := require.New(t)
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	db := memory.NewDatabase("db")
+	table := &maintainableTable{Table: memory.NewTable("t", sch), repairErr: errors.NewKind("corrupted").New()}
+	db.AddTable("t", table)
+	db.AddTable("u", memory.NewTable("u", sch))
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+
+	sch2, iter, err := engine.Query(ctx, "OPTIMIZE TABLE t, u")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal("Msg_text", sch2[3].Name)
+	require.Equal([]sql.Row{
+		{"db.t", "optimize", "status", "OK"},
+		{"db.u", "optimize", "note", "The storage engine for the table doesn't support optimize"},
+	}, rows)
+	require.Equal(1, table.optimized)
+
+	_, iter, err = engine.Query(ctx, "REPAIR TABLE db.t QUICK")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"db.t", "repair", "error", "corrupted"},
+		{"db.t", "repair", "status", "Operation failed"},
+	}, rows)
+}
+
+type versionedTable struct {
+	sql.Table
+	revisions map[interface{}]sql.Table
+}
+
+var _ sql.VersionedTable = (*versionedTable)(nil)
+
+func (v *versionedTable) AsOf(ctx *sql.Context, asOf interface{}) (sql.Table, bool, error) {
+	t, ok := v.revisions[asOf]
+	return t, ok, nil
+}
+
+func TestVersionedTable(t *testing.T) {
+	require := require.New(t)
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+
+	v1 := memory.NewTable("t", sch)
+	require.NoError(v1.Insert(ctx, sql.NewRow(int64(1))))
+	v2 := memory.NewTable("t", sch)
+	require.NoError(v2.Insert(ctx, sql.NewRow(int64(1))))
+	require.NoError(v2.Insert(ctx, sql.NewRow(int64(2))))
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", &versionedTable{Table: v2, revisions: map[interface{}]sql.Table{"v1": v1, "v2": v2}})
+	db.AddTable("u", memory.NewTable("u", sch))
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+
+	_, iter, err := engine.Query(ctx, "SELECT a FROM t AS OF 'v1' ORDER BY a")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}}, rows)
+
+	_, iter, err = engine.Query(ctx, "SELECT a FROM t AS OF CONCAT('v', 2) ORDER BY a")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}}, rows)
+
+	_, _, err = engine.Query(ctx, "SELECT a FROM t AS OF 'v0'")
+	require.True(sql.ErrTableNotFound.Is(err), "unexpected error %v", err)
+
+	_, _, err = engine.Query(ctx, "SELECT a FROM u AS OF 'v1'")
+	require.True(sql.ErrAsOfNotSupported.Is(err), "unexpected error %v", err)
+}
+
+type statisticsTable struct {
+	*memory.Table
+	stats *sql.TableStatistics
+}
+
+var _ sql.TableStatisticsTable = (*statisticsTable)(nil)
+
+func (t *statisticsTable) Statistics(ctx *sql.Context) (*sql.TableStatistics, error) {
+	return t.stats, nil
+}
+
+func (t *statisticsTable) WithProjection(colNames []string) sql.Table {
+	return &statisticsTable{Table: t.Table.WithProjection(colNames).(*memory.Table), stats: t.stats}
+}
+
+func (t *statisticsTable) WithIndexLookup(lookup sql.IndexLookup) sql.Table {
+	return &statisticsTable{Table: t.Table.WithIndexLookup(lookup).(*memory.Table), stats: t.stats}
+}
+
+func TestTableStatistics(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	small := &statisticsTable{
+		Table: memory.NewTable("small", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "x", Type: sql.Int64, Source: "small", PrimaryKey: true},
+		})),
+		stats: &sql.TableStatistics{RowCount: 10, AvgRowSize: 8},
+	}
+	large := &statisticsTable{
+		Table: memory.NewTable("large", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "id", Type: sql.Int64, Source: "large", PrimaryKey: true},
+			{Name: "y", Type: sql.Int64, Source: "large"},
+		})),
+		stats: &sql.TableStatistics{RowCount: 1000, AvgRowSize: 16},
+	}
+	small.EnablePrimaryKeyIndexes()
+	db.AddTable("small", small)
+	db.AddTable("large", large)
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+
+	explain := func(query string) []sql.Row {
+		_, iter, err := engine.Query(ctx, "EXPLAIN "+query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	_, iter, err := engine.Query(ctx, "CREATE INDEX y ON large (y)")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	require.Equal([]sql.Row{
+		{"Projected table access on [x]"},
+		{" └─ Table(small) (estimated rows: 10)"},
+	}, explain("SELECT * FROM small"))
+
+	// Without distinct counts, each lookup into large is assumed to match a single row, so small is read first.
+	query := "SELECT * FROM small JOIN large ON small.x = large.y"
+	require.Equal([]sql.Row{
+		{"IndexedJoin(small.x = large.y) (estimated rows: 10)"},
+		{" ├─ Table(small) (estimated rows: 10)"},
+		{" └─ IndexedTableAccess(large on [large.y]) (estimated rows: 1000)"},
+	}, explain(query))
+
+	// With a single distinct value of large.y, every lookup matches the whole table, so large is read first.
+	large.stats.DistinctCount = map[string]uint64{"y": 1}
+	require.Equal([]sql.Row{
+		{"Project(small.x, large.id, large.y) (estimated rows: 10000)"},
+		{" └─ IndexedJoin(small.x = large.y) (estimated rows: 10000)"},
+		{"     ├─ Table(large) (estimated rows: 1000)"},
+		{"     └─ IndexedTableAccess(small on [small.x]) (estimated rows: 10)"},
+	}, explain(query))
+
+	large.stats.DistinctCount = map[string]uint64{"y": 50}
+	require.Equal([]sql.Row{
+		{"Limit(5) (estimated rows: 5)"},
+		{" └─ TopN(Limit: [5]; large.y ASC) (estimated rows: 5)"},
+		{"     └─ GroupBy (estimated rows: 50)"},
+		{"         ├─ SelectedExprs(large.y, COUNT(*))"},
+		{"         ├─ Grouping(large.y)"},
+		{"         └─ Filter((large.y = 3) OR (large.id > 10)) (estimated rows: 347)"},
+		{"             └─ Projected table access on [y id]"},
+		{"                 └─ Table(large) (estimated rows: 1000)"},
+	}, explain("SELECT y, COUNT(*) FROM large WHERE y = 3 OR id > 10 GROUP BY y ORDER BY y LIMIT 5"))
+
+	// EXPLAIN ANALYZE runs the query to also report the number of rows each node returns
+	_, iter, err = engine.Query(ctx, "INSERT INTO small VALUES (1), (2), (3)")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"Filter(small.x > 1) (estimated rows: 3, actual rows: 2)"},
+		{" └─ Projected table access on [x]"},
+		{"     └─ IndexedTableAccess(small on [small.x]) (estimated rows: 10, actual rows: 2)"},
+	}, explain("ANALYZE SELECT * FROM small WHERE x > 1"))
+}
+
+func TestExplainExtended(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("db")
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "s", Type: sql.Text, Source: "t"},
+		{Name: "u", Type: sql.Text, Source: "t"},
+	}))
+	db.AddTable("t", table)
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+
+	query := func(query string) []sql.Row {
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+	notes := func(query string) []string {
+		ctx.ClearWarnings()
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		var notes []string
+		for _, w := range ctx.Warnings() {
+			require.Equal(1739, w.Code)
+			notes = append(notes, w.Message)
+		}
+		return notes
+	}
+
+	query("CREATE INDEX su ON t (s, u)")
+
+	require.Equal(query("EXPLAIN SELECT * FROM t WHERE s = 1"), query("EXPLAIN EXTENDED SELECT * FROM t WHERE s = 1"))
+	require.Equal([]string{
+		"Cannot use index 'su' of table 't' due to type conversion on field 's'",
+	}, notes("EXPLAIN EXTENDED SELECT * FROM t WHERE s = 1"))
+	require.Equal([]string{
+		"Cannot use index 'su' of table 't' due to collation conversion on field 's'",
+	}, notes("EXPLAIN EXTENDED SELECT * FROM t WHERE s = BINARY 'a'"))
+	require.Equal([]string{
+		"Cannot use index 'su' of table 't' because field 'u' is not a prefix of the index",
+	}, notes("EXPLAIN EXTENDED SELECT * FROM t WHERE u = 'a'"))
+
+	// Indexes are only reported with EXTENDED, and not when they're used anyway
+	require.Empty(notes("EXPLAIN SELECT * FROM t WHERE s = 1"))
+	require.Empty(notes("EXPLAIN EXTENDED SELECT * FROM t WHERE s = 'a'"))
+	require.Empty(notes("EXPLAIN EXTENDED SELECT * FROM t WHERE s = 'a' AND s = 1"))
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	require := require.New(t)
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("db")
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(harness).WithCurrentDB("db")
+
+	query := func(query string) []sql.Row {
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	query("CREATE TABLE parent (id int PRIMARY KEY AUTO_INCREMENT, name varchar(20) DEFAULT 'x', doc json, d decimal(5,2), t datetime, KEY (name))")
+	query("CREATE TABLE child (id int PRIMARY KEY, parent_id int, CHECK (id > 0), FOREIGN KEY (parent_id) REFERENCES parent (id))")
+	query(`INSERT INTO parent (name, doc, d, t) VALUES ('a', '{"a": [1, null]}', 1.5, '2021-01-02 03:04:05'), (NULL, NULL, NULL, NULL)`)
+	query("INSERT INTO child VALUES (1, 1)")
+	query("CREATE VIEW names AS SELECT name FROM parent")
+
+	createParent, createChild := query("SHOW CREATE TABLE parent"), query("SHOW CREATE TABLE child")
+
+	path := filepath.Join(t.TempDir(), "db.backup")
+	query(fmt.Sprintf("BACKUP DATABASE db TO '%s'", path))
+
+	query("DROP VIEW names")
+	query("DROP TABLE child")
+	query("DELETE FROM parent")
+	query("CREATE TABLE other (i int)")
+
+	query(fmt.Sprintf("RESTORE DATABASE db FROM '%s'", path))
+	require.Equal([]sql.Row{
+		{int32(1), "a", sql.MustJSON(`{"a": [1, null]}`), "1.50", time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{int32(2), nil, nil, nil, nil},
+	}, query("SELECT * FROM parent ORDER BY id"))
+	require.Equal([]sql.Row{{"a"}, {nil}}, query("SELECT * FROM names ORDER BY name DESC"))
+	require.Equal([]sql.Row{{"child"}, {"names"}, {"parent"}}, query("SHOW TABLES"))
+
+	query("INSERT INTO parent (doc) VALUES (NULL)")
+	require.Equal([]sql.Row{{int32(3), "x"}}, query("SELECT id, name FROM parent WHERE id > 2"))
+	_, iter, err := engine.Query(ctx, "INSERT INTO child VALUES (0, 1)")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.EqualError(err, `Check constraint "child_chk_1" violated`)
+	require.Equal(createParent, query("SHOW CREATE TABLE parent"))
+	require.Equal(createChild, query("SHOW CREATE TABLE child"))
+
+	_, _, err = engine.Query(ctx, fmt.Sprintf("RESTORE DATABASE db FROM '%s'", filepath.Join(t.TempDir(), "missing")))
+	require.True(sql.ErrBackupCannotOpen.Is(err), "%v", err)
+}
+
+type sortedTable struct {
+	*memory.Table
+	order []string
+}
+
+var _ sql.SortedTable = (*sortedTable)(nil)
+
+func (t *sortedTable) SortOrder() []string {
+	return t.order
+}
+
+func (t *sortedTable) WithProjection(colNames []string) sql.Table {
+	return &sortedTable{Table: t.Table.WithProjection(colNames).(*memory.Table), order: t.order}
+}
+
+func TestSortedTable(t *testing.T) {
+	require := require.New(t)
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "b", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "v", Type: sql.Int64, Source: "t"},
+	}), 1)
+	for _, row := range []sql.Row{{int64(1), int64(1), int64(3)}, {int64(1), int64(2), int64(1)}, {int64(2), int64(1), int64(2)}} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", &sortedTable{Table: table, order: []string{"t.a", "t.b"}})
+	engine := sqle.NewDefault(sql.NewDatabaseProvider(db))
+
+	query := func(query string) []sql.Row {
+		_, iter, err := engine.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	require.Equal([]sql.Row{
+		{"Project(t.b, t.v)"},
+		{" └─ Filter(t.v > 0)"},
+		{"     └─ Projected table access on [b v a]"},
+		{"         └─ Table(t)"},
+	}, query("EXPLAIN SELECT b, v FROM t WHERE v > 0 ORDER BY a, b"))
+	require.Equal([]sql.Row{{int64(1), int64(3)}, {int64(2), int64(1)}, {int64(1), int64(2)}}, query("SELECT b, v FROM t WHERE v > 0 ORDER BY a, b"))
+
+	require.Equal([]sql.Row{
+		{"Sort(t.b ASC)"},
+		{" └─ Project(t.b)"},
+		{"     └─ Projected table access on [b]"},
+		{"         └─ Table(t)"},
+	}, query("EXPLAIN SELECT b FROM t ORDER BY b"))
+	require.Equal([]sql.Row{
+		{"Sort(t.a DESC)"},
+		{" └─ Project(t.a)"},
+		{"     └─ Projected table access on [a]"},
+		{"         └─ Table(t)"},
+	}, query("EXPLAIN SELECT a FROM t ORDER BY a DESC"))
+}
+
+func TestSortedTableParallel(t *testing.T) {
+	require := require.New(t)
+
+	const numPartitions, rowsPerPartition = 4, 1000
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness()).WithCurrentDB("db")
+	table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	}), numPartitions)
+	// Rows are inserted in the partitions in turn, so each partition holds a sorted range of the keys and their
+	// concatenation is sorted
+	for i := 0; i < rowsPerPartition; i++ {
+		for p := 0; p < numPartitions; p++ {
+			require.NoError(table.Insert(ctx, sql.Row{int64(p*rowsPerPartition + i)}))
+		}
+	}
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", &sortedTable{Table: table, order: []string{"t.a"}})
+	pro := sql.NewDatabaseProvider(db)
+	engine := sqle.New(analyzer.NewBuilder(pro).WithParallelism(numPartitions).Build(), nil)
+
+	_, iter, err := engine.Query(ctx, "EXPLAIN SELECT a FROM t ORDER BY a")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal(sql.Row{"Sort(t.a ASC)"}, rows[0])
+
+	_, iter, err = engine.Query(ctx, "SELECT a FROM t ORDER BY a")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, numPartitions*rowsPerPartition)
+	for i, row := range rows {
+		require.Equal(sql.Row{int64(i)}, row)
+	}
+
+	engine = sqle.New(analyzer.NewBuilder(pro).WithParallelism(1).Build(), nil)
+	_, iter, err = engine.Query(ctx, "EXPLAIN SELECT a FROM t ORDER BY a")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.NotEqual(sql.Row{"Sort(t.a ASC)"}, rows[0])
+}
+
 var _ sql.PartitionCounter = (*nonIndexableTable)(nil)
 
 func (t *nonIndexableTable) PartitionCount(ctx *sql.Context) (int64, error) {
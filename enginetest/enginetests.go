@@ -1411,10 +1411,10 @@ func TestCreateTable(t *testing.T, harness Harness) {
 	t.Run("CREATE TABLE (SELECT * )", func(t *testing.T) {
 		TestQuery(t, harness, e, "CREATE TABLE t10 (a INTEGER NOT NULL PRIMARY KEY, "+
 			"b VARCHAR(10))", []sql.Row(nil), nil, nil)
-		TestQuery(t, harness, e, `INSERT INTO t10 VALUES (1, "1"), (2, "2")`, []sql.Row{sql.Row{sql.OkResult{RowsAffected: 0x2, InsertID: 0x0, Info: fmt.Stringer(nil)}}}, nil, nil)
+		TestQuery(t, harness, e, `INSERT INTO t10 VALUES (1, "1"), (2, "2")`, []sql.Row{sql.Row{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}}, nil, nil)
 
 		// Create the table with the data from t10
-		TestQuery(t, harness, e, "CREATE TABLE t10a SELECT * from t10", []sql.Row{sql.Row{sql.OkResult{RowsAffected: 0x2, InsertID: 0x0, Info: fmt.Stringer(nil)}}}, nil, nil)
+		TestQuery(t, harness, e, "CREATE TABLE t10a SELECT * from t10", []sql.Row{sql.Row{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}}, nil, nil)
 
 		db, err := e.Analyzer.Catalog.Database("mydb")
 		require.NoError(t, err)
@@ -2647,6 +2647,62 @@ func TestWindowAgg(t *testing.T, harness Harness) {
 		{5, 0.0},
 	}, nil, nil)
 
+	// the partitions have different sizes, and the smaller one sorts first
+	TestQuery(t, harness, e, `SELECT a, percent_rank() over (partition by 1 - c order by b) FROM t1 order by a`, []sql.Row{
+		{0, 0.0},
+		{1, 0.0},
+		{2, 0.75},
+		{3, 0.0},
+		{4, 0.5},
+		{5, 1.0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, rank() over (order by b), dense_rank() over (order by b) FROM t1 order by a`, []sql.Row{
+		{0, 1, 1},
+		{1, 3, 2},
+		{2, 5, 3},
+		{3, 1, 1},
+		{4, 3, 2},
+		{5, 6, 4},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, rank() over (order by b desc), dense_rank() over (order by b desc) FROM t1 order by a`, []sql.Row{
+		{0, 5, 4},
+		{1, 3, 3},
+		{2, 2, 2},
+		{3, 5, 4},
+		{4, 3, 3},
+		{5, 1, 1},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, rank() over (partition by c order by b), dense_rank() over (partition by c order by b) FROM t1 order by a`, []sql.Row{
+		{0, 1, 1},
+		{1, 1, 1},
+		{2, 4, 3},
+		{3, 1, 1},
+		{4, 3, 2},
+		{5, 5, 4},
+	}, nil, nil)
+
+	// no order by clause -> all rows are peers
+	TestQuery(t, harness, e, `SELECT a, rank() over (partition by b), dense_rank() over () FROM t1 order by a`, []sql.Row{
+		{0, 1, 1},
+		{1, 1, 1},
+		{2, 1, 1},
+		{3, 1, 1},
+		{4, 1, 1},
+		{5, 1, 1},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, row_number() over (order by b, a), rank() over (order by b), percent_rank() over (order by b) FROM t1 order by a`, []sql.Row{
+		{0, 1, 1, 0.0},
+		{1, 3, 3, 0.4},
+		{2, 5, 5, 0.8},
+		{3, 2, 1, 0.0},
+		{4, 4, 3, 0.4},
+		{5, 6, 6, 1.0},
+	}, nil, nil)
+
 	TestQuery(t, harness, e, `SELECT a, first_value(b) over (partition by c order by b) FROM t1 order by a`, []sql.Row{
 		{0, 0},
 		{1, 1},
@@ -2682,6 +2738,119 @@ func TestWindowAgg(t *testing.T, harness Harness) {
 		{4, 1},
 		{5, 0},
 	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, lag(b) over (order by a), lead(b) over (order by a), lag(a, 0) over (order by a) FROM t1 order by a`, []sql.Row{
+		{0, nil, 1, 0},
+		{1, 0, 2, 1},
+		{2, 1, 0, 2},
+		{3, 2, 1, 3},
+		{4, 0, 3, 4},
+		{5, 1, nil, 5},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, lag(a, 2, -1) over (partition by c order by a), lead(a, 2, a * 10) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, -1, 3},
+		{1, -1, 10},
+		{2, -1, 4},
+		{3, 0, 5},
+		{4, 2, 40},
+		{5, 3, 50},
+	}, nil, nil)
+
+	// the frame of each row ends with its last peer
+	TestQuery(t, harness, e, `SELECT a, last_value(a) over (partition by c order by b, a), nth_value(b, 2) over (partition by c order by b) FROM t1 order by a`, []sql.Row{
+		{0, 0, 0},
+		{1, 1, nil},
+		{2, 2, 0},
+		{3, 3, 0},
+		{4, 4, 0},
+		{5, 5, 0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, nth_value(a, 2) over (partition by c order by b, a), nth_value(b, 3) over (order by a) FROM t1 order by a`, []sql.Row{
+		{0, nil, nil},
+		{1, nil, nil},
+		{2, 3, 2},
+		{3, 3, 2},
+		{4, 3, 2},
+		{5, 3, 2},
+	}, nil, nil)
+
+	RunQuery(t, e, harness, "CREATE TABLE t2 (a INTEGER PRIMARY KEY, b INTEGER)")
+	RunQuery(t, e, harness, "INSERT INTO t2 VALUES (1,NULL), (2,10), (3,NULL), (4,20), (5,NULL), (6,30)")
+
+	TestQuery(t, harness, e, `SELECT a, lag(b) over (order by a), lag(b) ignore nulls over (order by a), lead(b, 1, 0) IGNORE NULLS over (order by a) FROM t2 order by a`, []sql.Row{
+		{1, nil, nil, 10},
+		{2, nil, nil, 20},
+		{3, 10, 10, 20},
+		{4, nil, 10, 30},
+		{5, 20, 20, 30},
+		{6, nil, 20, 0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, first_value(b) ignore nulls over (order by a), last_value(b) respect nulls over (order by a), nth_value(b, 2) ignore nulls over (order by a) FROM t2 order by a`, []sql.Row{
+		{1, nil, nil, nil},
+		{2, 10, 10, nil},
+		{3, 10, nil, nil},
+		{4, 10, 20, 20},
+		{5, 10, nil, 20},
+		{6, 10, 30, 20},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, last_value(b) ignore nulls over (partition by a > 3 order by a) FROM t2 order by a`, []sql.Row{
+		{1, nil},
+		{2, 10},
+		{3, 10},
+		{4, 20},
+		{5, 20},
+		{6, 30},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, `SELECT a, lag(b, -1) over (order by a) FROM t2`, sql.ErrInvalidArgument)
+	AssertErr(t, e, harness, `SELECT a, lead(b, a) over (order by a) FROM t2`, sql.ErrInvalidArgument)
+	AssertErr(t, e, harness, `SELECT a, nth_value(b, 0) over (order by a) FROM t2`, sql.ErrInvalidArgument)
+	AssertErr(t, e, harness, `SELECT a, percent_rank() ignore nulls over (order by a) FROM t2`, sql.ErrUnsupportedFeature)
+
+	// aggregate functions over a window aggregate the rows of the frame of each row
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (order by a), count(*) over (partition by c), avg(b) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, 0.0, 5, 0.0},
+		{1, 1.0, 1, 1.0},
+		{2, 3.0, 5, 1.0},
+		{3, 3.0, 5, float64(2) / 3},
+		{4, 4.0, 5, 0.75},
+		{5, 7.0, 5, 1.2},
+	}, nil, nil)
+
+	// peers are in each other's frames
+	TestQuery(t, harness, e, `SELECT a, sum(a) over (order by b), min(a) over (partition by c order by b desc), max(b) over (partition by c) FROM t1 order by a`, []sql.Row{
+		{0, 3.0, 0, 3},
+		{1, 8.0, 1, 1},
+		{2, 10.0, 2, 3},
+		{3, 3.0, 0, 3},
+		{4, 8.0, 2, 3},
+		{5, 15.0, 5, 3},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (order by a) - count(*) over (order by a), row_number() over (order by a desc) FROM t1 order by a`, []sql.Row{
+		{0, -1.0, 6},
+		{1, -1.0, 5},
+		{2, 0.0, 4},
+		{3, -1.0, 3},
+		{4, -1.0, 2},
+		{5, 1.0, 1},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (order by a), count(b) over (order by a), count(b) over () FROM t2 order by a`, []sql.Row{
+		{1, nil, 0, 3},
+		{2, 10.0, 1, 3},
+		{3, 10.0, 1, 3},
+		{4, 30.0, 2, 3},
+		{5, 30.0, 2, 3},
+		{6, 60.0, 3, 3},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, `SELECT a, count(distinct b) over (order by a) FROM t2`, sql.ErrUnsupportedFeature)
+	AssertErr(t, e, harness, `SELECT a, sum(b) ignore nulls over (order by a) FROM t2`, sql.ErrUnsupportedFeature)
 }
 func TestNaturalJoin(t *testing.T, harness Harness) {
 	require := require.New(t)
@@ -3098,6 +3267,29 @@ func TestSessionSelectLimit(t *testing.T, harness Harness) {
 	}
 }
 
+// TestQueriesWithIndexedJoinLookupConcurrency runs the query tests with indexed joins looking up the rows of their
+// secondary tables concurrently, which must not change their results.
+func TestQueriesWithIndexedJoinLookupConcurrency(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	createIndexes(t, harness, e)
+	createForeignKeys(t, harness, e)
+
+	for _, tt := range QueryTests {
+		t.Run(tt.Query, func(t *testing.T) {
+			if sh, ok := harness.(SkippingHarness); ok && sh.SkipQueryTest(tt.Query) {
+				t.Skipf("Skipping query %s", tt.Query)
+			}
+
+			ctx := NewContextWithEngine(harness, e)
+			err := ctx.Session.SetSessionVariable(ctx, "indexed_join_lookup_concurrency", int64(4))
+			require.NoError(t, err)
+			TestQueryWithContext(t, ctx, e, tt.Query, tt.Expected, tt.ExpectedColumns, tt.Bindings)
+		})
+	}
+}
+
 func TestTracing(t *testing.T, harness Harness) {
 	require := require.New(t)
 	e := NewEngine(t, harness)
@@ -3142,6 +3334,54 @@ func TestTracing(t *testing.T, harness Harness) {
 	require.Equal(expectedSpans, spanOperations)
 }
 
+func TestQueryMetrics(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	metrics := sql.NewQueryMetrics()
+	ctx := NewContext(harness)
+	ctx.ApplyOpts(sql.WithQueryMetrics(metrics))
+
+	_, iter, err := e.Query(ctx, `SELECT DISTINCT i
+		FROM mytable
+		WHERE s <> 'first row'
+		ORDER BY i DESC
+		LIMIT 1`)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, 1)
+
+	type operator struct {
+		name            string
+		rowsIn, rowsOut int64
+	}
+	var expected = []operator{
+		{"plan.Limit", 1, 1},
+		{"plan.TopN", 2, 1},
+		{"plan.Distinct", 2, 2},
+		{"plan.Project", 2, 2},
+		{"plan.Filter", 3, 2},
+		{"plan.ResolvedTable", 0, 3},
+	}
+
+	var operators []operator
+	ops := metrics.Operators()
+	for len(ops) > 0 {
+		require.Len(ops, 1)
+		op := ops[0]
+		operators = append(operators, operator{op.Operator, op.RowsIn, op.RowsOut})
+		require.True(op.Time <= op.TotalTime, "time in %s is greater than its total time", op.Operator)
+		if op.Operator == "plan.Distinct" {
+			require.NotZero(op.MemoryHighWater)
+		}
+		ops = op.Children
+	}
+	require.Equal(expected, operators)
+}
+
 func TestCurrentTimestamp(t *testing.T, harness Harness) {
 	e := NewEngine(t, harness)
 	defer e.Close()
@@ -0,0 +1,178 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exprfuzz
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Failure is an evaluation of an expression that failed a check.
+type Failure struct {
+	Expression sql.Expression
+	Row        sql.Row
+	// SQL is the expression as given to the oracle, if any.
+	SQL string
+	// Result and Err are the result of the evaluation of the expression by the engine.
+	Result interface{}
+	Err    error
+	// Reason describes the check that failed.
+	Reason string
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("%s on row %v: %s (result: %#v, error: %v, SQL: %s)", f.Expression, f.Row, f.Reason, f.Result, f.Err, f.SQL)
+}
+
+// Check evaluates the expression given on the row given and checks the result, returning the first check failed, or
+// nil if there's none. Evaluations must not panic, their result must be a value of the type of the expression, and it
+// must match the result of the oracle given, unless it's nil. Evaluations that fail with an error pass if there's no
+// oracle, or if the oracle fails too.
+func Check(ctx *sql.Context, oracle Oracle, e sql.Expression, row sql.Row) (failure *Failure) {
+	failure = &Failure{Expression: e, Row: row}
+	defer func() {
+		if r := recover(); r != nil {
+			failure.Reason = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+
+	failure.Result, failure.Err = e.Eval(ctx, row)
+	if failure.Err == nil {
+		if reason := checkType(e.Type(), failure.Result); reason != "" {
+			failure.Reason = reason
+			return failure
+		}
+	}
+
+	if oracle == nil {
+		return nil
+	}
+
+	s, err := SQL(e, row)
+	if err != nil {
+		failure.Reason = fmt.Sprintf("can't render the expression as SQL: %s", err)
+		return failure
+	}
+	failure.SQL = s
+
+	expected, oracleErr := oracle.Eval(s)
+	switch {
+	case failure.Err != nil && oracleErr != nil:
+		return nil
+	case failure.Err != nil:
+		failure.Reason = fmt.Sprintf("evaluation failed, but the oracle returned %s", describe(expected))
+		return failure
+	case oracleErr != nil:
+		failure.Reason = fmt.Sprintf("the oracle failed: %s", oracleErr)
+		return failure
+	}
+
+	actual, err := text(e.Type(), failure.Result)
+	if err != nil {
+		failure.Reason = fmt.Sprintf("can't convert the result to text: %s", err)
+		return failure
+	}
+	if !matches(actual, expected) {
+		failure.Reason = fmt.Sprintf("expected %s, got %s", describe(expected), describe(actual))
+		return failure
+	}
+
+	return nil
+}
+
+// Run checks the given number of random expressions on random rows, and returns the failures found.
+func (f *Fuzzer) Run(ctx *sql.Context, oracle Oracle, n int) []Failure {
+	var failures []Failure
+	for i := 0; i < n; i++ {
+		if failure := Check(ctx, oracle, f.Expression(), f.Row()); failure != nil {
+			failures = append(failures, *failure)
+		}
+	}
+	return failures
+}
+
+// checkType returns why the value given isn't a value of the type given, or an empty string if it is. Values are of a
+// type when converting them to it doesn't fail and doesn't change their Go type. Booleans are also values of
+// sql.Boolean, since that's what boolean expressions return.
+func checkType(typ sql.Type, v interface{}) string {
+	if isNull(v) {
+		return ""
+	}
+	if _, ok := v.(bool); ok && typ == sql.Boolean {
+		return ""
+	}
+
+	converted, err := typ.Convert(v)
+	if err != nil {
+		return fmt.Sprintf("the result isn't a valid %s: %s", typ, err)
+	}
+	if reflect.TypeOf(converted) != reflect.TypeOf(v) {
+		return fmt.Sprintf("the result is a %T, but values of %s are %T", v, typ, converted)
+	}
+	return ""
+}
+
+// text returns the value given as sent to clients, or nil if it's NULL.
+func text(typ sql.Type, v interface{}) (*string, error) {
+	if isNull(v) {
+		return nil, nil
+	}
+	val, err := typ.SQL(nil, v)
+	if err != nil {
+		return nil, err
+	}
+	if val.IsNull() {
+		return nil, nil
+	}
+	s := val.ToString()
+	return &s, nil
+}
+
+// matches returns whether the results given are the same. Numbers only need to be close, since the engine and the
+// oracle may not format or round them the same way.
+func matches(actual, expected *string) bool {
+	if actual == nil || expected == nil {
+		return actual == expected
+	}
+	if *actual == *expected {
+		return true
+	}
+
+	a, err := strconv.ParseFloat(*actual, 64)
+	if err != nil {
+		return false
+	}
+	e, err := strconv.ParseFloat(*expected, 64)
+	if err != nil {
+		return false
+	}
+	return math.Abs(a-e) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(e)))
+}
+
+// isNull returns whether the value given is NULL. Some expressions return sql.Null rather than nil for NULL.
+func isNull(v interface{}) bool {
+	return v == nil || v == sql.Null
+}
+
+func describe(s *string) string {
+	if s == nil {
+		return "NULL"
+	}
+	return strconv.Quote(*s)
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exprfuzz
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// mysqlDSNKey is the environment variable with the DSN of the MySQL server to compare results with, such as
+// "root:root@tcp(127.0.0.1:3306)/". The comparison is skipped when it's not set.
+const mysqlDSNKey = "EXPRFUZZ_MYSQL_DSN"
+
+// oracleFunc is an Oracle evaluating expressions with a function.
+type oracleFunc func(expr string) (*string, error)
+
+func (f oracleFunc) Eval(expr string) (*string, error) {
+	return f(expr)
+}
+
+func TestFuzzerIsDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	a, b := NewFuzzer(42), NewFuzzer(42)
+	for i := 0; i < 100; i++ {
+		require.Equal(a.Row(), b.Row())
+		require.Equal(a.Expression().String(), b.Expression().String())
+	}
+}
+
+func TestFuzzerRows(t *testing.T) {
+	require := require.New(t)
+
+	f := NewFuzzer(1)
+	for i := 0; i < 100; i++ {
+		row := f.Row()
+		require.Len(row, len(DefaultSchema))
+		for j, v := range row {
+			if v != nil {
+				_, err := DefaultSchema[j].Type.Convert(v)
+				require.NoError(err)
+			}
+		}
+	}
+}
+
+func TestSQL(t *testing.T) {
+	row := sql.Row{int64(-3), uint64(7), 1.5, "2.50", "it's", time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC), nil}
+	field := func(i int) sql.Expression {
+		col := DefaultSchema[i]
+		return expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+	}
+
+	tests := []struct {
+		expr     sql.Expression
+		expected string
+	}{
+		{field(0), "CAST(-3 AS SIGNED)"},
+		{field(1), "CAST(7 AS UNSIGNED)"},
+		{field(2), "1.5e+00"},
+		{field(3), "CAST('2.50' AS DECIMAL(10,2))"},
+		{field(4), `'it\'s'`},
+		{field(5), "CAST('2021-02-03 04:05:06' AS DATETIME)"},
+		{field(6), "NULL"},
+		{expression.NewLiteral(time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC), sql.Date), "CAST('2021-02-03' AS DATE)"},
+		{expression.NewPlus(field(0), field(1)), "(CAST(-3 AS SIGNED) + CAST(7 AS UNSIGNED))"},
+		{expression.NewIsNull(field(6)), "(NULL IS NULL)"},
+		{expression.NewUnaryMinus(field(0)), "-(CAST(-3 AS SIGNED))"},
+		{expression.NewNot(expression.NewEquals(field(2), field(3))), "(NOT((1.5e+00 = CAST('2.50' AS DECIMAL(10,2)))))"},
+		{expression.NewConvert(field(4), expression.ConvertToDecimal), "convert('it\\'s', decimal(65,10))"},
+		{expression.NewConvert(field(0), expression.ConvertToUnsigned), "convert(CAST(-3 AS SIGNED), unsigned)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr.String(), func(t *testing.T) {
+			actual, err := SQL(tt.expr, row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	require.Nil(Check(ctx, nil, expression.NewLiteral(int8(1), sql.Int8), nil))
+	require.Nil(Check(ctx, nil, expression.NewLiteral(true, sql.Boolean), nil))
+	require.Nil(Check(ctx, nil, expression.NewLiteral(nil, sql.Int64), nil))
+
+	failure := Check(ctx, nil, expression.NewLiteral(1, sql.Int8), nil)
+	require.NotNil(failure)
+	require.Equal("the result is a int, but values of TINYINT are int8", failure.Reason)
+
+	failure = Check(ctx, nil, expression.NewLiteral("abc", sql.Int64), nil)
+	require.NotNil(failure)
+	require.True(strings.HasPrefix(failure.Reason, "the result isn't a valid BIGINT"))
+
+	two := oracleFunc(func(string) (*string, error) {
+		s := "2.0000000000001"
+		return &s, nil
+	})
+	sum := expression.NewPlus(expression.NewLiteral(int64(1), sql.Int64), expression.NewLiteral(int64(1), sql.Int64))
+	require.Nil(Check(ctx, two, sum, nil))
+
+	failure = Check(ctx, two, expression.NewLiteral(int64(3), sql.Int64), nil)
+	require.NotNil(failure)
+	require.Equal("CAST(3 AS SIGNED)", failure.SQL)
+	require.Equal(`expected "2.0000000000001", got "3"`, failure.Reason)
+
+	null := oracleFunc(func(string) (*string, error) {
+		return nil, nil
+	})
+	failure = Check(ctx, null, sum, nil)
+	require.NotNil(failure)
+	require.Equal(`expected NULL, got "2"`, failure.Reason)
+}
+
+func TestFuzz(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for _, failure := range NewFuzzer(1).Run(ctx, nil, 5000) {
+		if strings.HasPrefix(failure.Reason, "panic") {
+			t.Error(failure)
+		}
+	}
+}
+
+func TestFuzzWithMySQL(t *testing.T) {
+	dsn := os.Getenv(mysqlDSNKey)
+	if dsn == "" {
+		t.Skipf("%s isn't set", mysqlDSNKey)
+	}
+
+	oracle, err := NewMySQLOracle(dsn)
+	require.NoError(t, err)
+	defer oracle.Close()
+
+	for _, failure := range NewFuzzer(1).Run(sql.NewEmptyContext(), oracle, 1000) {
+		t.Error(failure)
+	}
+}
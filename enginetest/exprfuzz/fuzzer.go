@@ -0,0 +1,206 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exprfuzz evaluates randomly generated expression trees against randomly generated rows, to find values for
+// which the engine's expressions and type conversions misbehave. Every evaluation is checked against the type of the
+// expression, which the value returned must conform to, and optionally against an Oracle, which evaluates the same
+// expression as SQL on a reference implementation. MySQLOracle uses a real MySQL server for that, which is easiest to
+// run with docker:
+//
+//	docker run -d -p 3306:3306 -e MYSQL_ROOT_PASSWORD=root mysql:8.0
+//	EXPRFUZZ_MYSQL_DSN='root:root@tcp(127.0.0.1:3306)/' go test ./enginetest/exprfuzz/...
+//
+// Runs are reproducible: the same seed generates the same rows and expressions.
+package exprfuzz
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// DefaultSchema is the schema of the rows generated by fuzzers created with NewFuzzer. It has a column of each kind
+// of type whose conversions are most prone to edge cases.
+var DefaultSchema = sql.Schema{
+	{Name: "i", Type: sql.Int64, Source: "t", Nullable: true},
+	{Name: "u", Type: sql.Uint64, Source: "t", Nullable: true},
+	{Name: "f", Type: sql.Float64, Source: "t", Nullable: true},
+	{Name: "d", Type: sql.MustCreateDecimalType(10, 2), Source: "t", Nullable: true},
+	{Name: "s", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Source: "t", Nullable: true},
+	{Name: "dt", Type: sql.Datetime, Source: "t", Nullable: true},
+	{Name: "da", Type: sql.Date, Source: "t", Nullable: true},
+}
+
+// Fuzzer generates random rows of a schema, and random expressions over the columns of the schema.
+type Fuzzer struct {
+	// Rand is the source of the randomness of the fuzzer.
+	Rand *rand.Rand
+	// Schema is the schema of the rows generated.
+	Schema sql.Schema
+	// MaxDepth is the maximum depth of the expressions generated.
+	MaxDepth int
+	// NullRate is the probability of NULL values in nullable columns and literals.
+	NullRate float64
+}
+
+// NewFuzzer returns a Fuzzer for DefaultSchema seeded with the seed given.
+func NewFuzzer(seed int64) *Fuzzer {
+	return &Fuzzer{
+		Rand:     rand.New(rand.NewSource(seed)),
+		Schema:   DefaultSchema,
+		MaxDepth: 3,
+		NullRate: 0.1,
+	}
+}
+
+// Row returns a random row of the fuzzer's schema.
+func (f *Fuzzer) Row() sql.Row {
+	row := make(sql.Row, len(f.Schema))
+	for i, col := range f.Schema {
+		if col.Nullable && f.Rand.Float64() < f.NullRate {
+			continue
+		}
+		row[i] = f.Value(col.Type)
+	}
+	return row
+}
+
+// Value returns a random value of the type given. Values on the edges of the type's range are more likely than
+// others.
+func (f *Fuzzer) Value(typ sql.Type) interface{} {
+	var v interface{}
+	switch {
+	case typ == sql.Int64:
+		v = f.pick(int64(0), int64(1), int64(-1), int64(math.MaxInt64), int64(math.MinInt64), f.Rand.Int63n(2000)-1000)
+	case typ == sql.Uint64:
+		v = f.pick(uint64(0), uint64(1), uint64(math.MaxUint64), uint64(1)<<63, uint64(f.Rand.Int63n(2000)))
+	case typ == sql.Float64:
+		v = f.pick(0.0, 1.5, -2.25, 1e308, 5e-324, -1e-7, (f.Rand.Float64()-0.5)*1e6)
+	case sql.IsDecimal(typ):
+		v = f.pick("0", "0.01", "-0.01", "12345678.99", "-12345678.99", strconv.FormatFloat((f.Rand.Float64()-0.5)*1e4, 'f', 2, 64))
+	case sql.IsText(typ):
+		v = f.pick("", "abc", "12", " 3.5x", "-7", "1e3", "2021-01-01", "2021-02-30 10:00:00", "18446744073709551616", strconv.Itoa(f.Rand.Intn(100)))
+	case typ == sql.Datetime:
+		v = f.pick(
+			time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+			time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC),
+			time.Unix(f.Rand.Int63n(math.MaxInt32), 0).UTC(),
+		)
+	case typ == sql.Date:
+		v = f.pick(
+			time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC),
+			time.Unix(f.Rand.Int63n(math.MaxInt32), 0).UTC().Truncate(24*time.Hour),
+		)
+	default:
+		return nil
+	}
+
+	converted, err := typ.Convert(v)
+	if err != nil {
+		return nil
+	}
+	return converted
+}
+
+// Expression returns a random expression over the columns of the fuzzer's schema.
+func (f *Fuzzer) Expression() sql.Expression {
+	return f.expression(f.MaxDepth)
+}
+
+var castTypes = []string{
+	expression.ConvertToSigned,
+	expression.ConvertToUnsigned,
+	expression.ConvertToDouble,
+	expression.ConvertToDecimal,
+	expression.ConvertToChar,
+	expression.ConvertToDate,
+	expression.ConvertToDatetime,
+}
+
+func (f *Fuzzer) expression(depth int) sql.Expression {
+	if depth <= 0 || f.Rand.Intn(4) == 0 {
+		return f.leaf()
+	}
+
+	left := f.expression(depth - 1)
+	switch f.Rand.Intn(6) {
+	case 0:
+		right := f.expression(depth - 1)
+		ops := []func(l, r sql.Expression) *expression.Arithmetic{
+			expression.NewPlus,
+			expression.NewMinus,
+			expression.NewMult,
+			expression.NewDiv,
+			expression.NewIntDiv,
+			expression.NewMod,
+		}
+		return ops[f.Rand.Intn(len(ops))](left, right)
+	case 1:
+		right := f.expression(depth - 1)
+		switch f.Rand.Intn(6) {
+		case 0:
+			return expression.NewEquals(left, right)
+		case 1:
+			return expression.NewNullSafeEquals(left, right)
+		case 2:
+			return expression.NewLessThan(left, right)
+		case 3:
+			return expression.NewLessThanOrEqual(left, right)
+		case 4:
+			return expression.NewGreaterThan(left, right)
+		default:
+			return expression.NewGreaterThanOrEqual(left, right)
+		}
+	case 2:
+		right := f.expression(depth - 1)
+		if f.Rand.Intn(2) == 0 {
+			return expression.NewAnd(left, right)
+		}
+		return expression.NewOr(left, right)
+	case 3:
+		if f.Rand.Intn(2) == 0 {
+			return expression.NewNot(left)
+		}
+		return expression.NewIsNull(left)
+	case 4:
+		return expression.NewUnaryMinus(left)
+	default:
+		return expression.NewConvert(left, castTypes[f.Rand.Intn(len(castTypes))])
+	}
+}
+
+// leaf returns a column or a literal of the type of one of the columns.
+func (f *Fuzzer) leaf() sql.Expression {
+	col := f.Schema[f.Rand.Intn(len(f.Schema))]
+	if f.Rand.Intn(2) == 0 {
+		return expression.NewGetFieldWithTable(f.Schema.IndexOf(col.Name, col.Source), col.Type, col.Source, col.Name, col.Nullable)
+	}
+	if f.Rand.Float64() < f.NullRate {
+		return expression.NewLiteral(nil, sql.Null)
+	}
+	return expression.NewLiteral(f.Value(col.Type), col.Type)
+}
+
+func (f *Fuzzer) pick(values ...interface{}) interface{} {
+	return values[f.Rand.Intn(len(values))]
+}
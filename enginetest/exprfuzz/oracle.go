@@ -0,0 +1,77 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exprfuzz
+
+import (
+	"context"
+	dsql "database/sql"
+	"fmt"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// Oracle evaluates SQL expressions on a reference implementation.
+type Oracle interface {
+	// Eval returns the value of the SQL expression given as text, or nil if it's NULL.
+	Eval(expr string) (*string, error)
+}
+
+// MySQLOracle is an Oracle evaluating expressions on a MySQL server.
+type MySQLOracle struct {
+	db *dsql.DB
+	// conn is the connection all expressions are evaluated on, since the session variables set are per connection.
+	conn *dsql.Conn
+}
+
+var _ Oracle = (*MySQLOracle)(nil)
+
+// NewMySQLOracle returns an Oracle for the MySQL server with the DSN given, in the format of
+// github.com/go-sql-driver/mysql, such as "root:root@tcp(127.0.0.1:3306)/".
+func NewMySQLOracle(dsn string) (*MySQLOracle, error) {
+	db, err := dsql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	// Expressions are evaluated in UTC, like the engine's sessions do by default
+	if _, err := conn.ExecContext(context.Background(), "SET time_zone = '+00:00'"); err != nil {
+		conn.Close()
+		db.Close()
+		return nil, err
+	}
+	return &MySQLOracle{db: db, conn: conn}, nil
+}
+
+// Eval implements the Oracle interface.
+func (o *MySQLOracle) Eval(expr string) (*string, error) {
+	var v dsql.NullString
+	if err := o.conn.QueryRowContext(context.Background(), fmt.Sprintf("SELECT %s", expr)).Scan(&v); err != nil {
+		return nil, err
+	}
+	if !v.Valid {
+		return nil, nil
+	}
+	return &v.String, nil
+}
+
+// Close closes the connection to the server.
+func (o *MySQLOracle) Close() error {
+	o.conn.Close()
+	return o.db.Close()
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exprfuzz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// SQL returns the expression given as a SQL expression for a reference implementation to evaluate. Columns are
+// replaced with the values they have in the row given, and every value is written as a literal of its exact type,
+// so that the reference evaluates the same expression on the same values without needing a table.
+func SQL(e sql.Expression, row sql.Row) (string, error) {
+	switch e := e.(type) {
+	case *expression.Literal:
+		return Literal(e.Value(), e.Type())
+	case *expression.GetField:
+		if e.Index() >= len(row) {
+			return "", fmt.Errorf("column %s is out of the bounds of row %v", e, row)
+		}
+		return Literal(row[e.Index()], e.Type())
+	}
+
+	children := e.Children()
+	rendered := make([]sql.Expression, len(children))
+	for i, child := range children {
+		s, err := SQL(child, row)
+		if err != nil {
+			return "", err
+		}
+		rendered[i] = &rawSQL{sql: s, typ: child.Type()}
+	}
+
+	switch e := e.(type) {
+	case *expression.IsNull:
+		return fmt.Sprintf("(%s IS NULL)", rendered[0]), nil
+	case *expression.UnaryMinus:
+		return fmt.Sprintf("-(%s)", rendered[0]), nil
+	case *expression.Convert:
+		if dt, ok := e.Type().(sql.DecimalType); ok {
+			// The precision and scale of decimal conversions aren't part of the expression's string
+			return fmt.Sprintf("convert(%s, decimal(%d,%d))", rendered[0], dt.Precision(), dt.Scale()), nil
+		}
+	}
+
+	withRendered, err := e.WithChildren(rendered...)
+	if err != nil {
+		return "", err
+	}
+	return withRendered.String(), nil
+}
+
+// Literal returns the value given as a SQL literal of the type given.
+func Literal(v interface{}, typ sql.Type) (string, error) {
+	if v == nil {
+		return "NULL", nil
+	}
+
+	v, err := typ.Convert(v)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case sql.IsSigned(typ):
+		return fmt.Sprintf("CAST(%d AS SIGNED)", v), nil
+	case sql.IsUnsigned(typ):
+		return fmt.Sprintf("CAST(%d AS UNSIGNED)", v), nil
+	case sql.IsFloat(typ):
+		f, err := sql.Float64.Convert(v)
+		if err != nil {
+			return "", err
+		}
+		// Numbers in scientific notation are doubles in MySQL, while any other number with a fraction is a decimal
+		return strconv.FormatFloat(f.(float64), 'e', -1, 64), nil
+	case sql.IsDecimal(typ):
+		dt := typ.(sql.DecimalType)
+		return fmt.Sprintf("CAST('%s' AS DECIMAL(%d,%d))", v, dt.Precision(), dt.Scale()), nil
+	case sql.IsText(typ):
+		return quote(v.(string)), nil
+	case typ == sql.Date:
+		return fmt.Sprintf("CAST('%s' AS DATE)", v.(time.Time).Format(sql.DateLayout)), nil
+	case sql.IsTime(typ):
+		return fmt.Sprintf("CAST('%s' AS DATETIME)", v.(time.Time).Format(sql.TimestampDatetimeLayout)), nil
+	case typ == sql.Null:
+		return "NULL", nil
+	default:
+		return "", fmt.Errorf("unsupported type for SQL literals: %s", typ)
+	}
+}
+
+var quoter = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func quote(s string) string {
+	return "'" + quoter.Replace(s) + "'"
+}
+
+// rawSQL is an expression standing for a SQL expression that's already been rendered, used to render expressions
+// with their own String.
+type rawSQL struct {
+	sql string
+	typ sql.Type
+}
+
+var _ sql.Expression = (*rawSQL)(nil)
+
+func (r *rawSQL) Resolved() bool             { return true }
+func (r *rawSQL) String() string             { return r.sql }
+func (r *rawSQL) Type() sql.Type             { return r.typ }
+func (r *rawSQL) IsNullable() bool           { return true }
+func (r *rawSQL) Children() []sql.Expression { return nil }
+
+func (r *rawSQL) Eval(*sql.Context, sql.Row) (interface{}, error) {
+	return nil, fmt.Errorf("rawSQL expressions can't be evaluated: %s", r.sql)
+}
+
+func (r *rawSQL) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 0)
+	}
+	return r, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 var InsertQueries = []WriteQueryTest{
@@ -56,7 +57,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO niltable (i, f) VALUES (10, 10.0), (12, 12.0);",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 		SelectQuery:         "SELECT i,f FROM niltable WHERE f IN (10.0, 12.0) ORDER BY f;",
 		ExpectedSelect:      []sql.Row{{int64(10), 10.0}, {int64(12), 12.0}},
 	},
@@ -228,7 +229,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable SELECT i+100,s FROM mytable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -241,7 +242,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO emptytable SELECT * FROM mytable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -251,7 +252,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO emptytable SELECT * FROM mytable where mytable.i > 2",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i",
 		ExpectedSelect: []sql.Row{
 			{int64(3), "third row"},
@@ -259,7 +260,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO niltable (i,f) SELECT i+10, NULL FROM mytable where mytable.i > 2",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1}}}},
 		SelectQuery:         "SELECT * FROM niltable where i > 10 ORDER BY i",
 		ExpectedSelect: []sql.Row{
 			{13, nil, nil, nil},
@@ -267,7 +268,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT i+10, 'new' FROM mytable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -280,7 +281,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable SELECT i2+100, s2 FROM othertable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -293,7 +294,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO emptytable (s,i) SELECT * FROM othertable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "third"},
@@ -303,7 +304,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO emptytable (s,i) SELECT concat(m.s, o.s2), m.i FROM othertable o JOIN mytable m ON m.i=o.i2",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first rowthird"},
@@ -314,7 +315,7 @@ var InsertQueries = []WriteQueryTest{
 	{
 		WriteQuery: `INSERT INTO emptytable (s,i) SELECT s,i from mytable where i = 1 
 			union select s,i from mytable where i = 3`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -325,7 +326,7 @@ var InsertQueries = []WriteQueryTest{
 		WriteQuery: `INSERT INTO emptytable (s,i) SELECT s,i from mytable where i = 1 
 			union select s,i from mytable where i = 3 
 			union select s,i from mytable where i > 2`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -337,7 +338,7 @@ var InsertQueries = []WriteQueryTest{
 			SELECT s,i from mytable where i = 1 
 			union all select s,i+1 from mytable where i < 2 
 			union all select s,i+2 from mytable where i in (1)`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -347,7 +348,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO emptytable (s,i) SELECT distinct s,i from mytable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM emptytable ORDER BY i,s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -357,7 +358,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT (i + 10.0) / 10.0 + 10 + i, concat(s, ' new') FROM mytable",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{int64(1), "first row"},
@@ -370,7 +371,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT CHAR_LENGTH(s), concat('numrows: ', count(*)) from mytable group by 1",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{1, "first row"},
@@ -384,7 +385,7 @@ var InsertQueries = []WriteQueryTest{
 	//  but that causes an error in our engine. Needs work
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT CHAR_LENGTH(s), concat('numrows: ', count(*)) from mytable group by 1 HAVING CHAR_LENGTH(s)  > 9",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{1, "first row"},
@@ -395,7 +396,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT i * 2, concat(s,s) from mytable order by 1 desc limit 1",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{1, "first row"},
@@ -406,7 +407,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) SELECT i + 3, concat(s,s) from mytable order by 1 desc",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{1, "first row"},
@@ -422,7 +423,7 @@ var InsertQueries = []WriteQueryTest{
 				FROM othertable ot INNER JOIN 
 					(SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2) sub 
 				ON sub.i = ot.i2 order by 1`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable where i > 10 ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{11, "third"},
@@ -434,7 +435,7 @@ var InsertQueries = []WriteQueryTest{
 		WriteQuery: `INSERT INTO mytable (i,s) SELECT sub.i + 10, ot.s2 
 				FROM (SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2) sub
 				INNER JOIN othertable ot ON sub.i = ot.i2 order by 1`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(3)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}}},
 		SelectQuery:         "SELECT * FROM mytable where i > 10 ORDER BY i, s",
 		ExpectedSelect: []sql.Row{
 			{11, "third"},
@@ -468,7 +469,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) values (1, 'hello2'), (2, 'hello3'), (4, 'no conflict') ON DUPLICATE KEY UPDATE s='hello4'",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(5)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 5, Info: plan.InsertInfo{Records: 3, Duplicates: 2}}}},
 		SelectQuery:         "SELECT * FROM mytable ORDER BY 1",
 		ExpectedSelect: []sql.Row{
 			{1, "hello4"},
@@ -502,7 +503,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO mytable (i,s) values (1,'mar'), (2,'par') ON DUPLICATE KEY UPDATE s=CONCAT(VALUES(s), 'tial')",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(4)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 4, Info: plan.InsertInfo{Records: 2, Duplicates: 2}}}},
 		SelectQuery:         "SELECT * FROM mytable WHERE i IN (1,2) ORDER BY i",
 		ExpectedSelect:      []sql.Row{{int64(1), "martial"}, {int64(2), "partial"}},
 	},
@@ -525,7 +526,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "INSERT INTO auto_increment_tbl (c0) values (44),(55)",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 		SelectQuery:         "SELECT * FROM auto_increment_tbl ORDER BY pk",
 		ExpectedSelect: []sql.Row{
 			{1, 11},
@@ -571,7 +572,7 @@ var InsertQueries = []WriteQueryTest{
 	{
 		WriteQuery: "INSERT INTO auto_increment_tbl values " +
 			"(NULL, 44), (NULL, 55), (9, 99), (NULL, 110), (NULL, 121)",
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(5)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 5, Info: plan.InsertInfo{Records: 5}}}},
 		SelectQuery:         "SELECT * FROM auto_increment_tbl ORDER BY pk",
 		ExpectedSelect: []sql.Row{
 			{1, 11},
@@ -586,7 +587,7 @@ var InsertQueries = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          `INSERT INTO auto_increment_tbl (c0) SELECT 44 FROM dual`,
-		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1}}}},
 		SelectQuery:         "SELECT * FROM auto_increment_tbl",
 		ExpectedSelect: []sql.Row{
 			{1, 11},
@@ -921,7 +922,7 @@ var InsertScripts = []ScriptTest{
 			{
 				Query: "INSERT INTO mytable (id, v2)values (1, DEFAULT), (2, DEFAULT)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -949,49 +950,49 @@ var InsertScripts = []ScriptTest{
 			{
 				Query: "INSERT IGNORE INTO y VALUES (1, 2), (4,4)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 1}},
+					{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 2, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
 				Query: "INSERT IGNORE INTO y VALUES (5, NULL)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 1}},
+					{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERBadNullError,
 			},
 			{
 				Query: "INSERT IGNORE INTO y SELECT * FROM y WHERE pk=(SELECT pk FROM y WHERE pk > 1);",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 0}},
+					{sql.OkResult{RowsAffected: 0, Info: plan.InsertInfo{Records: 1, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERSubqueryNo1Row,
 			},
 			{
 				Query: "INSERT IGNORE INTO y SELECT 10, 0 FROM dual WHERE 1=(SELECT 1 FROM dual UNION SELECT 2 FROM dual);",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 0}},
+					{sql.OkResult{RowsAffected: 0, Info: plan.InsertInfo{Records: 1, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERSubqueryNo1Row,
 			},
 			{
 				Query: "INSERT IGNORE INTO y SELECT 11, 0 FROM dual WHERE 1=(SELECT 1 FROM dual UNION SELECT 2 FROM dual) UNION SELECT 12, 0 FROM dual;",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 1}},
+					{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 2, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERSubqueryNo1Row,
 			},
 			{
 				Query: "INSERT IGNORE INTO y SELECT 13, 0 FROM dual UNION SELECT 14, 0 FROM dual WHERE 1=(SELECT 1 FROM dual UNION SELECT 2 FROM dual);",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 1}},
+					{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 2, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERSubqueryNo1Row,
 			},
 			{
 				Query: "INSERT IGNORE INTO y VALUES (3, 8)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 0}},
+					{sql.OkResult{RowsAffected: 0, Info: plan.InsertInfo{Records: 1, Duplicates: 1, Warnings: 1}}},
 				},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
@@ -1032,6 +1033,30 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "insert into select fills in defaults, checks constraints and runs triggers",
+		SetUpScript: []string{
+			"create table src (pk int primary key)",
+			"insert into src values (1), (2)",
+			"create table dst (pk int primary key auto_increment, a int, b varchar(10) default 'dflt', c int default 42, check (a < 100))",
+			"create trigger dst_before before insert on dst for each row set new.c = new.c + new.a",
+			"insert into dst (a) select pk from src",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a, b, c from dst order by a",
+				Expected: []sql.Row{{1, "dflt", 43}, {2, "dflt", 44}},
+			},
+			{
+				Query:       "insert into dst (a) select pk * 100 from src",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "select count(*) from dst",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
 }
 
 var InsertErrorTests = []GenericErrorQueryTest{
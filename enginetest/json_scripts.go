@@ -369,4 +369,195 @@ var JsonScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "JSON_EXTRACT and the -> and ->> operators",
+		SetUpScript: []string{
+			"create table t (pk int primary key, doc json)",
+			`INSERT INTO t VALUES (1, '{"name": "a", "tags": ["x", "y"], "meta": {"n": 1, "ok": true}}'),
+				(2, '{"name": "b", "tags": [], "meta": {"n": 2, "note": "say \\"hi\\""}}'),
+				(3, NULL)`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, doc->'$.name', doc->>'$.name' FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, sql.MustJSON(`"a"`), "a"}, {2, sql.MustJSON(`"b"`), "b"}, {3, nil, nil}},
+			},
+			{
+				Query:    `SELECT pk, doc->>"$.meta.note" FROM t ORDER BY pk`,
+				Expected: []sql.Row{{1, nil}, {2, `say "hi"`}, {3, nil}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE doc->>'$.tags[last]' = 'y'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk, doc->'$.meta.n' + 1 FROM t WHERE doc->'$.meta.n' > 1",
+				Expected: []sql.Row{{2, float64(3)}},
+			},
+			{
+				Query:    "SELECT pk, JSON_EXTRACT(doc, '$.tags[*]'), JSON_EXTRACT(doc, '$.name', '$.meta.n') FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, sql.MustJSON(`["x", "y"]`), sql.MustJSON(`["a", 1]`)}, {2, nil, sql.MustJSON(`["b", 2]`)}, {3, nil, nil}},
+			},
+			{
+				Query:    "SELECT JSON_EXTRACT(doc, '$**.n') FROM t WHERE pk = 1",
+				Expected: []sql.Row{{sql.MustJSON(`[1]`)}},
+			},
+			{
+				Query:    "SELECT doc->>'$.meta.ok', doc->'$.missing' IS NULL FROM t WHERE pk = 1",
+				Expected: []sql.Row{{"true", true}},
+			},
+			{
+				Query:       "SELECT doc->'$.[0]' FROM t",
+				ExpectedErr: sql.ErrInvalidJSONPath,
+			},
+		},
+	},
+	{
+		Name: "JSON creation and merge functions",
+		SetUpScript: []string{
+			"create table t (pk int primary key, name varchar(20), doc json)",
+			`INSERT INTO t VALUES (1, 'a', '{"a": 1, "b": [1]}'), (2, NULL, '[1, 2]')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, JSON_ARRAY(pk, name, doc) FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, sql.MustJSON(`[1, "a", {"a": 1, "b": [1]}]`)}, {2, sql.MustJSON(`[2, null, [1, 2]]`)}},
+			},
+			{
+				Query:    "SELECT JSON_ARRAY(), JSON_OBJECT()",
+				Expected: []sql.Row{{sql.MustJSON(`[]`), sql.MustJSON(`{}`)}},
+			},
+			{
+				Query:    "SELECT pk, JSON_OBJECT('id', pk, 'name', name, 'doc', doc) FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, sql.MustJSON(`{"id": 1, "name": "a", "doc": {"a": 1, "b": [1]}}`)}, {2, sql.MustJSON(`{"id": 2, "name": null, "doc": [1, 2]}`)}},
+			},
+			{
+				Query:       "SELECT JSON_OBJECT(name, pk) FROM t",
+				ExpectedErr: sql.ErrJSONObjectAggNullKey,
+			},
+			{
+				Query:    `SELECT JSON_MERGE_PATCH(doc, '{"a": null, "b": {"c": 2}}', '{"d": 3}') FROM t ORDER BY pk`,
+				Expected: []sql.Row{{sql.MustJSON(`{"b": {"c": 2}, "d": 3}`)}, {sql.MustJSON(`{"b": {"c": 2}, "d": 3}`)}},
+			},
+			{
+				Query:    `SELECT JSON_MERGE_PATCH('{"a": 1}', '[2]'), JSON_MERGE_PATCH('{"a": 1}', NULL)`,
+				Expected: []sql.Row{{sql.MustJSON(`[2]`), nil}},
+			},
+			{
+				Query:    `SELECT JSON_MERGE_PRESERVE(doc, '{"a": 2, "b": 3}', '"x"') FROM t ORDER BY pk`,
+				Expected: []sql.Row{{sql.MustJSON(`[{"a": [1, 2], "b": [1, 3]}, "x"]`)}, {sql.MustJSON(`[1, 2, {"a": 2, "b": 3}, "x"]`)}},
+			},
+		},
+	},
+	{
+		Name: "JSON search and attribute functions in filters",
+		SetUpScript: []string{
+			"create table t (pk int primary key, doc json)",
+			`INSERT INTO t VALUES (1, '{"name": "apple", "tags": ["red", "fruit"], "n": 1}'), (2, '{"name": "carrot", "tags": ["orange"], "n": 2.5}'), (3, '[1, 2, 3]')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT pk FROM t WHERE JSON_CONTAINS(doc, '"fruit"', '$.tags')`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    `SELECT pk FROM t WHERE JSON_CONTAINS_PATH(doc, 'one', '$.tags', '$[0]') ORDER BY pk`,
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    `SELECT pk FROM t WHERE JSON_CONTAINS_PATH(doc, 'all', '$.name', '$.tags[1]')`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    `SELECT pk, JSON_SEARCH(doc, 'all', '%r%') FROM t WHERE JSON_SEARCH(doc, 'one', '%r%') IS NOT NULL ORDER BY pk`,
+				Expected: []sql.Row{{1, sql.MustJSON(`["$.tags[0]", "$.tags[1]"]`)}, {2, sql.MustJSON(`["$.name", "$.tags[0]"]`)}},
+			},
+			{
+				Query:    `SELECT pk FROM t WHERE JSON_OVERLAPS(doc->'$.tags', '["orange", "blue"]') OR JSON_OVERLAPS(doc, '3')`,
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    `SELECT pk, JSON_LENGTH(doc), JSON_LENGTH(doc, '$.tags') FROM t ORDER BY pk`,
+				Expected: []sql.Row{{1, int32(3), int32(2)}, {2, int32(3), int32(1)}, {3, int32(3), nil}},
+			},
+			{
+				Query:    `SELECT pk, JSON_TYPE(doc), JSON_TYPE(doc->'$.n') FROM t ORDER BY pk`,
+				Expected: []sql.Row{{1, "OBJECT", "INTEGER"}, {2, "OBJECT", "DOUBLE"}, {3, "ARRAY", nil}},
+			},
+			{
+				Query:    `SELECT pk FROM t WHERE JSON_TYPE(doc->'$.tags') = 'ARRAY' AND JSON_LENGTH(doc->'$.tags') > 1`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       `SELECT JSON_CONTAINS_PATH(doc, 'any', '$.a') FROM t`,
+				ExpectedErr: sql.ErrInvalidJSONOneOrAll,
+			},
+			{
+				Query:       `SELECT JSON_LENGTH(doc, '$.*') FROM t`,
+				ExpectedErr: sql.ErrJSONPathWildcard,
+			},
+		},
+	},
+	{
+		Name: "JSON path expressions",
+		SetUpScript: []string{
+			"create table t (pk int primary key, doc json)",
+			`INSERT INTO t VALUES (1, '{"a": [1, [2, 3], {"b": "x"}], "c": {"b": "y"}, "key with spaces": true}')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT doc->'$.a[1 to last]', doc->'$.a[last-1][0]', doc->'$."key with spaces"' FROM t`,
+				Expected: []sql.Row{{sql.MustJSON(`[[2, 3], {"b": "x"}]`), sql.MustJSON(`2`), sql.MustJSON(`true`)}},
+			},
+			{
+				Query:    `SELECT doc->'$**.b', doc->'$.*.b', doc->'$.a[*][1]' FROM t`,
+				Expected: []sql.Row{{sql.MustJSON(`["x", "y"]`), sql.MustJSON(`["y"]`), sql.MustJSON(`[3]`)}},
+			},
+			{
+				Query:    `SELECT JSON_CONTAINS_PATH(doc, 'one', '$**.z', '$.a[0 to 1]'), JSON_CONTAINS_PATH(doc, 'all', '$.a[5]', '$**.b') FROM t`,
+				Expected: []sql.Row{{true, false}},
+			},
+			{
+				Query:    `SELECT JSON_SEARCH(doc, 'all', 'x', NULL, '$.a[1 to 2]'), JSON_SEARCH(doc, 'all', '_', NULL, '$**.b') FROM t`,
+				Expected: []sql.Row{{sql.MustJSON(`"$.a[2].b"`), sql.MustJSON(`["$.a[2].b", "$.c.b"]`)}},
+			},
+			{
+				Query:    `SELECT JSON_LENGTH(doc, '$.a[last]'), JSON_CONTAINS(doc, '3', '$.a[1]'), JSON_CONTAINS(doc, 'true', '$."key with spaces"') FROM t`,
+				Expected: []sql.Row{{int32(1), true, true}},
+			},
+			{
+				Query:       `SELECT JSON_CONTAINS_PATH(doc, 'one', '$.a[1 to]') FROM t`,
+				ExpectedErr: sql.ErrInvalidJSONPath,
+			},
+			{
+				Query:       `SELECT JSON_SEARCH(doc, 'one', 'x', NULL, '$**') FROM t`,
+				ExpectedErr: sql.ErrInvalidJSONPath,
+			},
+			{
+				Query:       `SELECT JSON_CONTAINS(doc, '1', '$.a[*]') FROM t`,
+				ExpectedErr: sql.ErrJSONPathWildcard,
+			},
+		},
+	},
+	{
+		Name: "Arithmetic over numbers extracted from JSON documents",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk INT PRIMARY KEY, x DECIMAL(4,2));",
+			"INSERT INTO t VALUES (1, 2.25);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT JSON_EXTRACT(JSON_OBJECT('a', 2.5), '$.a') + 1, JSON_EXTRACT(JSON_ARRAY(1.5), '$[0]') + 1`,
+				Expected: []sql.Row{{3.5, 2.5}},
+			},
+			{
+				Query:    `SELECT JSON_EXTRACT(JSON_ARRAY(x), '$[0]') * 2, JSON_EXTRACT(JSON_OBJECT('x', x), '$.x') - 0.25 FROM t`,
+				Expected: []sql.Row{{4.5, float64(2)}},
+			},
+			{
+				Query:    `SELECT CAST(JSON_EXTRACT(JSON_ARRAY(2.0), '$[0]') AS SIGNED), CAST(JSON_EXTRACT(JSON_ARRAY(2.5), '$[0]') AS DECIMAL) = 2.5`,
+				Expected: []sql.Row{{int64(2), true}},
+			},
+		},
+	},
 }
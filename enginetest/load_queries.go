@@ -74,6 +74,64 @@ var LoadDataScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Load data fills in default values",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, s varchar(10) default 'dflt', n int default 7, c int default 42)",
+			"LOAD DATA INFILE './testdata/test6.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' (pk, s, n)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, "a", 5, 42}, {2, "", 0, 42}, {3, "dflt", 7, 42}},
+			},
+		},
+	},
+	{
+		Name: "Load data with a column list",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key auto_increment, c1 int, c2 varchar(10) default (concat('x', 'y')))",
+			"LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"' (c1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, 1, "xy"}, {2, 2, "xy"}, {3, 3, "xy"}, {4, 4, "xy"}},
+			},
+		},
+	},
+	{
+		Name: "Load data runs triggers",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 int)",
+			"create table audit(pk int primary key, c1 int)",
+			"create trigger before_load before insert on loadtable for each row set new.c1 = new.pk * 10",
+			"create trigger after_load after insert on loadtable for each row insert into audit values (new.pk, new.c1)",
+			"LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"' (pk)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {3, 30}, {4, 40}},
+			},
+			{
+				Query:    "select * from audit ORDER BY pk",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {3, 30}, {4, 40}},
+			},
+		},
+	},
+	{
+		Name: "Load data reports its records",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"'",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 4, Info: plan.LoadDataInfo{Records: 4}}}},
+			},
+		},
+	},
 }
 
 var LoadDataErrorScripts = []ScriptTest{
@@ -106,6 +164,34 @@ var LoadDataErrorScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Load data into a column without a default value throws an error",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 int not null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"' (pk)",
+				ExpectedErr: sql.ErrInsertIntoNonNullableDefaultNullColumn,
+			},
+		},
+	},
+	{
+		Name: "Load data checks constraints",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 int default 42, check (c1 < 10))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"' (pk)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "select count(*) from loadtable",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
 	{
 		Name: "Load data escaped by terms longer than 1 character throws an error",
 		SetUpScript: []string{
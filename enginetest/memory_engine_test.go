@@ -90,6 +90,11 @@ func TestQueriesSimple(t *testing.T) {
 	enginetest.TestQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
 }
 
+// TestQueriesWithIndexedJoinLookupConcurrency runs the canonical test queries with concurrent lookups in indexed joins.
+func TestQueriesWithIndexedJoinLookupConcurrency(t *testing.T) {
+	enginetest.TestQueriesWithIndexedJoinLookupConcurrency(t, enginetest.NewMemoryHarness("lookups", 1, testNumPartitions, true, nil))
+}
+
 // Convenience test for debugging a single query. Unskip and set to the desired query.
 func TestSingleQuery(t *testing.T) {
 	t.Skip()
@@ -611,3 +616,28 @@ func findTable(dbs []sql.Database, tableName string) (sql.Database, sql.Table) {
 	}
 	return nil, nil
 }
+
+// TestSuite runs the compatibility suite integrators run against their own implementations, to make sure it passes
+// against the in-memory one.
+func TestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Every test of the suite is run on its own as well")
+	}
+
+	// The same tests are skipped as when they're run on their own, see TestInsertIgnoreInto and TestScripts
+	var tests []enginetest.SuiteTest
+	for _, test := range enginetest.SuiteTests {
+		if test.Name != "InsertIgnoreInto" {
+			tests = append(tests, test)
+		}
+	}
+	harness := func() enginetest.Harness {
+		harness := enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver)
+		harness.QueriesToSkip(
+			"SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.i in (1, 2, 3, 4)",
+			"INSERT INTO test2 VALUES (4);",
+		)
+		return harness
+	}
+	enginetest.TestSuiteTests(t, tests, harness)
+}
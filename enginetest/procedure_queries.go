@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 var ProcedureLogicTests = []ScriptTest{
@@ -239,7 +240,7 @@ END;`,
 				Query: "CALL p1(2)",
 				Expected: []sql.Row{
 					{
-						sql.NewOkResult(2),
+						sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}},
 					},
 				},
 			},
@@ -477,7 +478,7 @@ END`,
 			{
 				Query: "CALL add_item(6);",
 				Expected: []sql.Row{
-					{sql.NewOkResult(3)},
+					{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}},
 				},
 			},
 			{
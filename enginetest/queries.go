@@ -15,6 +15,7 @@
 package enginetest
 
 import (
+	"math"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -121,6 +122,52 @@ var QueryTests = []QueryTest{
 			{int64(3), "third row"},
 		},
 	},
+	{
+		Query: "SELECT i % 2 AS x, count(*) FROM mytable GROUP BY 1 ORDER BY 2 DESC",
+		Expected: []sql.Row{
+			{int64(1), int64(2)},
+			{int64(0), int64(1)},
+		},
+	},
+	{
+		Query: "SELECT i % 2 AS x, count(*) FROM mytable GROUP BY x ORDER BY 1 DESC",
+		Expected: []sql.Row{
+			{int64(1), int64(2)},
+			{int64(0), int64(1)},
+		},
+	},
+	{
+		Query: "SELECT s, i FROM mytable GROUP BY 2, 1 ORDER BY 2 DESC",
+		Expected: []sql.Row{
+			{"third row", int64(3)},
+			{"second row", int64(2)},
+			{"first row", int64(1)},
+		},
+	},
+	{
+		Query: "SELECT x, count(*) FROM (SELECT i % 2 AS x FROM mytable) t GROUP BY 1 ORDER BY 2 DESC",
+		Expected: []sql.Row{
+			{int64(1), int64(2)},
+			{int64(0), int64(1)},
+		},
+	},
+	{
+		// Like MySQL, a name in the GROUP BY refers to a column of the table before an alias of the select list, but
+		// a position always refers to the select list
+		Query: "SELECT i % 2 AS i, count(*) FROM mytable GROUP BY i ORDER BY 1, 2",
+		Expected: []sql.Row{
+			{int64(0), int64(1)},
+			{int64(1), int64(1)},
+			{int64(1), int64(1)},
+		},
+	},
+	{
+		Query: "SELECT i % 2 AS i, count(*) FROM mytable GROUP BY 1 ORDER BY 1",
+		Expected: []sql.Row{
+			{int64(0), int64(1)},
+			{int64(1), int64(2)},
+		},
+	},
 	{
 		Query: "SELECT pk DIV 2, SUM(c3) FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
@@ -323,7 +370,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 		ExpectedColumns: sql.Schema{
@@ -340,7 +387,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a (c,d) order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 		ExpectedColumns: sql.Schema{
@@ -357,7 +404,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT column_0 FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
 		Expected: []sql.Row{
-			{1.0},
+			{"1"},
 			{2},
 		},
 	},
@@ -463,7 +510,7 @@ var QueryTests = []QueryTest{
 			join (values row(2,4), row(1.0,"ab")) b on a.column_0 = b.column_0 and a.column_0 = b.column_0
 			order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 	},
@@ -1040,6 +1087,55 @@ var QueryTests = []QueryTest{
 			"foo": expression.NewLiteral(int64(2), sql.Int64),
 		},
 	},
+	{
+		Query: "SELECT i from mytable order by i limit ?, ?",
+		Expected: []sql.Row{
+			{2},
+			{3},
+		},
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral(int64(1), sql.Int64),
+			"v2": expression.NewLiteral(int64(2), sql.Int64),
+		},
+	},
+	{
+		Query: "SELECT i from mytable where i not in (?, ?) order by i limit ?",
+		Expected: []sql.Row{
+			{3},
+		},
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral(int64(1), sql.Int64),
+			"v2": expression.NewLiteral(int64(2), sql.Int64),
+			"v3": expression.NewLiteral(int64(1), sql.Int64),
+		},
+	},
+	{
+		Query: "SHOW TABLES LIKE ?",
+		Expected: []sql.Row{
+			{"newlinetable"},
+		},
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral("new%", sql.LongText),
+		},
+	},
+	{
+		Query: "SHOW COLUMNS FROM mytable LIKE :pattern",
+		Expected: []sql.Row{
+			{"i", "bigint", "NO", "PRI", "", ""},
+		},
+		Bindings: map[string]sql.Expression{
+			"pattern": expression.NewLiteral("i", sql.LongText),
+		},
+	},
+	{
+		Query: "SHOW VARIABLES LIKE ?",
+		Expected: []sql.Row{
+			{"gtid_mode", "OFF"},
+		},
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral("gtid_mode", sql.LongText),
+		},
+	},
 	{
 		Query:    "SELECT timestamp FROM reservedWordsTable;",
 		Expected: []sql.Row{{"1"}},
@@ -1068,6 +1164,70 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT i DIV 2 FROM mytable order by 1;",
 		Expected: []sql.Row{{int64(0)}, {int64(1)}, {int64(1)}},
 	},
+	{
+		Query:    "SELECT i / 2 FROM mytable order by 1;",
+		Expected: []sql.Row{{"0.5000"}, {"1.0000"}, {"1.5000"}},
+	},
+	{
+		Query:    "SELECT 7 / 2, 1 / 3, 2 / 3, 1 / 0",
+		Expected: []sql.Row{{"3.5000", "0.3333", "0.6667", sql.Null}},
+	},
+	{
+		Query:    "SELECT 7.5 DIV 2, -7.5 DIV 2, 7 DIV 2.5, 7 DIV 0",
+		Expected: []sql.Row{{int64(3), int64(-3), int64(2), sql.Null}},
+	},
+	{
+		Query:    "SELECT i MOD 2, i % 0, 7.5 MOD 2 FROM mytable order by i;",
+		Expected: []sql.Row{{int64(1), sql.Null, "1.5"}, {int64(0), sql.Null, "1.5"}, {int64(1), sql.Null, "1.5"}},
+	},
+	{
+		Query: "SELECT i & -2, i | -4, i ^ 1, i << 62, -i >> 62, ~i FROM mytable order by i;",
+		Expected: []sql.Row{
+			{uint64(0), uint64(18446744073709551613), uint64(0), uint64(4611686018427387904), uint64(3), uint64(18446744073709551614)},
+			{uint64(2), uint64(18446744073709551614), uint64(3), uint64(9223372036854775808), uint64(3), uint64(18446744073709551613)},
+			{uint64(2), uint64(18446744073709551615), uint64(2), uint64(13835058055282163712), uint64(3), uint64(18446744073709551612)},
+		},
+	},
+	{
+		Query:    "SELECT 1 << 64, 3.7 & 7, ~NULL",
+		Expected: []sql.Row{{uint64(0), uint64(4), nil}},
+	},
+	{
+		Query:    "SELECT pk2, c1 FROM two_pk WHERE pk1 = 1 ORDER BY pk2",
+		Expected: []sql.Row{{int8(0), int8(20)}, {int8(1), int8(30)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE pk1 IN (1, 0) ORDER BY pk1, pk2",
+		Expected: []sql.Row{{int8(0), int8(0)}, {int8(0), int8(1)}, {int8(1), int8(0)}, {int8(1), int8(1)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (pk1, pk2) > (0, 1) ORDER BY pk1, pk2",
+		Expected: []sql.Row{{int8(1), int8(0)}, {int8(1), int8(1)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (pk1, pk2) >= (0, 1) ORDER BY pk1, pk2 LIMIT 2",
+		Expected: []sql.Row{{int8(0), int8(1)}, {int8(1), int8(0)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (pk1, pk2) < (1, 0) ORDER BY pk1, pk2",
+		Expected: []sql.Row{{int8(0), int8(0)}, {int8(0), int8(1)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (1, 1) >= (pk1, pk2) AND (pk1, pk2) <> (0, 0) ORDER BY pk1, pk2",
+		Expected: []sql.Row{{int8(0), int8(1)}, {int8(1), int8(0)}, {int8(1), int8(1)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (pk1, pk2) = (1, 0)",
+		Expected: []sql.Row{{int8(1), int8(0)}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE (pk1, c1) > (0, 10) ORDER BY pk1, pk2",
+		Expected: []sql.Row{{int8(1), int8(0)}, {int8(1), int8(1)}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE i > 1 ORDER BY i LIMIT 1",
+		Expected: []sql.Row{{int64(2)}},
+	},
 	{
 		Query:    "SELECT -i FROM mytable;",
 		Expected: []sql.Row{{int64(-1)}, {int64(-2)}, {int64(-3)}},
@@ -1456,6 +1616,42 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT (select 2, 3 from dual) in ((1, 2)) from dual",
 		Expected: []sql.Row{{false}},
 	},
+	{
+		Query:    "SELECT (1, 2) < (1, 3), (2, 1) > (1, 5), (1, 2) >= (1, 2), (1, null) < (2, 0), (null, 1) < (2, 2)",
+		Expected: []sql.Row{{true, true, true, true, nil}},
+	},
+	{
+		Query:    "SELECT (1, null) = (1, null), (null, 1) = (2, 2), (null, 2) = (2, 2), (null, 1) != (2, 2), (1, '2') = (1, 2)",
+		Expected: []sql.Row{{nil, false, nil, true, true}},
+	},
+	{
+		Query:    "SELECT (1, 2) in ((3, 4), (1, 2)), (1, null) in ((1, 2)), (1, null) in ((2, 2)), (1, 2) in ((1, null), (3, 4)), (1, 2) in ((1, null), (1, 2))",
+		Expected: []sql.Row{{true, nil, false, nil, true}},
+	},
+	{
+		Query:    "SELECT (1, 2) in (select 1, null), (1, 2) in (select 2, null), (1, 2) not in (select 2, null), (1, 2) not in (select 1, null), (1, '2') in (select 1, 2)",
+		Expected: []sql.Row{{nil, false, true, nil, true}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE (i, s) > (1, 'z') ORDER BY i",
+		Expected: []sql.Row{{2}, {3}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE (i, s) IN (SELECT i, s FROM mytable WHERE i > 1) ORDER BY i",
+		Expected: []sql.Row{{2}, {3}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE (i, s) NOT IN ((1, null)) ORDER BY i",
+		Expected: []sql.Row{{2}, {3}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE (i, null) NOT IN ((1, 2)) ORDER BY i",
+		Expected: []sql.Row{{2}, {3}},
+	},
+	{
+		Query:    "SELECT i FROM niltable WHERE (i, b) NOT IN ((1, true), (2, false)) ORDER BY i",
+		Expected: []sql.Row{{2}, {3}, {4}, {5}, {6}},
+	},
 	{
 		Query:    `SELECT 'a' NOT IN ('b','c',null,'d')`,
 		Expected: []sql.Row{{nil}},
@@ -1866,6 +2062,18 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT SUBSTRING_INDEX(mytable.s, "d", 1) AS s FROM mytable INNER JOIN othertable ON (SUBSTRING_INDEX(mytable.s, "d", 1) = SUBSTRING_INDEX(othertable.s2, "d", 1)) GROUP BY 1 HAVING s = 'secon'`,
 		Expected: []sql.Row{{"secon"}},
 	},
+	{
+		Query:    `SELECT STRCMP('text', 'text2'), STRCMP('text2', 'text'), STRCMP('text', 'text'), STRCMP('text', NULL)`,
+		Expected: []sql.Row{{int32(-1), int32(1), int32(0), nil}},
+	},
+	{
+		Query:    `SELECT s FROM mytable WHERE STRCMP(s, 'second row') < 0 ORDER BY s`,
+		Expected: []sql.Row{{"first row"}},
+	},
+	{
+		Query:    `SELECT SOUNDEX('Robert'), SOUNDEX('Robert') = SOUNDEX('Rupert')`,
+		Expected: []sql.Row{{"R163", true}},
+	},
 	{
 		Query:    `SELECT * FROM specialtable t WHERE t.name LIKE "%a_%" ESCAPE 'a'`,
 		Expected: []sql.Row{sql.Row{"first_row"}, sql.Row{"second_row"}, sql.Row{"third_row"}},
@@ -2055,6 +2263,10 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT INET_NTOA("spaghetti")`,
 		Expected: []sql.Row{{"0.0.0.0"}},
 	},
+	{
+		Query:    `SELECT HEX(2.5), HEX(10/4), HEX('2.5')`,
+		Expected: []sql.Row{{"3", "3", "322E35"}},
+	},
 	{
 		Query:    `SELECT HEX(INET6_ATON("10.0.5.9"))`,
 		Expected: []sql.Row{{"0A000509"}},
@@ -2223,6 +2435,20 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT YEARWEEK('1987-01-01', 20), YEARWEEK('1987-01-01', 1), YEARWEEK('1987-01-01', 2), YEARWEEK('1987-01-01', 3), YEARWEEK('1987-01-01', 4), YEARWEEK('1987-01-01', 5), YEARWEEK('1987-01-01', 6), YEARWEEK('1987-01-01', 7)",
 		Expected: []sql.Row{{int32(198653), int32(198701), int32(198652), int32(198701), int32(198653), int32(198652), int32(198653), int32(198652)}},
 	},
+	{
+		Query:    "SELECT EXTRACT(YEAR FROM '2019-07-02 03:04:05.123456'), extract(week from '2019-07-02'), EXTRACT( DAY_MINUTE FROM '2019-07-02 03:04:05'), EXTRACT(SECOND_MICROSECOND FROM '2019-07-02 03:04:05.123456')",
+		Expected: []sql.Row{{int64(2019), int64(26), int64(20304), int64(5123456)}},
+		ExpectedColumns: sql.Schema{
+			{Name: "EXTRACT(YEAR FROM '2019-07-02 03:04:05.123456')", Type: sql.Int64},
+			{Name: "extract(week from '2019-07-02')", Type: sql.Int64},
+			{Name: "EXTRACT( DAY_MINUTE FROM '2019-07-02 03:04:05')", Type: sql.Int64},
+			{Name: "EXTRACT(SECOND_MICROSECOND FROM '2019-07-02 03:04:05.123456')", Type: sql.Int64},
+		},
+	},
+	{
+		Query:    "SELECT i, EXTRACT(YEAR_MONTH FROM NULL) FROM mytable WHERE EXTRACT(DAY FROM '2019-07-02') = i + 1",
+		Expected: []sql.Row{{int64(1), nil}},
+	},
 	{
 		Query:    "SELECT i FROM mytable WHERE i BETWEEN 1 AND 2",
 		Expected: []sql.Row{{int64(1)}, {int64(2)}},
@@ -3101,14 +3327,36 @@ var QueryTests = []QueryTest{
 			{"7th"},
 		},
 	},
+	{
+		Query: "select date_format('2021-01-01 00:00:00.5', '%a %b %j %f %r %U %u %V %v %X %x %%')",
+		Expected: []sql.Row{
+			{"Fri Jan 001 500000 12:00:00 AM 00 00 52 53 2020 2020 %"},
+		},
+	},
 	{
 		Query: "select from_unixtime(i) from mytable order by 1",
 		Expected: []sql.Row{
-			{time.Unix(1, 0)},
-			{time.Unix(2, 0)},
-			{time.Unix(3, 0)},
+			{time.Unix(1, 0).UTC()},
+			{time.Unix(2, 0).UTC()},
+			{time.Unix(3, 0).UTC()},
 		},
 	},
+	{
+		Query: "select from_unixtime(i + 0.5, '%Y-%m-%d %H:%i:%s.%f') from mytable order by 1",
+		Expected: []sql.Row{
+			{"1970-01-01 00:00:01.500000"},
+			{"1970-01-01 00:00:02.500000"},
+			{"1970-01-01 00:00:03.500000"},
+		},
+	},
+	{
+		Query:    "select from_unixtime(-1), from_unixtime(32536771200), unix_timestamp('1969-12-31 23:59:59')",
+		Expected: []sql.Row{{nil, nil, float64(0)}},
+	},
+	{
+		Query:    "select unix_timestamp(from_unixtime(1447430881.123456))",
+		Expected: []sql.Row{{1447430881.123456}},
+	},
 	// TODO: add additional tests for other functions. Every function needs an engine test to ensure it works correctly
 	//  with the analyzer.
 	{
@@ -3194,6 +3442,26 @@ var QueryTests = []QueryTest{
 			{"third row"},
 		},
 	},
+	{
+		Query:    "SELECT 1 UNION SELECT 1.0;",
+		Expected: []sql.Row{{"1.0"}},
+	},
+	{
+		Query:    "SELECT 1.5 UNION SELECT 1.50;",
+		Expected: []sql.Row{{"1.50"}},
+	},
+	{
+		Query:    "SELECT 1 UNION SELECT 2.5 UNION SELECT 2.50;",
+		Expected: []sql.Row{{"1.00"}, {"2.50"}},
+	},
+	{
+		Query:    "SELECT 1 UNION SELECT 1e0;",
+		Expected: []sql.Row{{float64(1)}},
+	},
+	{
+		Query:    "SELECT i FROM mytable UNION SELECT i/1 FROM mytable;",
+		Expected: []sql.Row{{"1.0000"}, {"2.0000"}, {"3.0000"}},
+	},
 	{
 		Query:    "",
 		Expected: []sql.Row{},
@@ -3234,11 +3502,9 @@ var QueryTests = []QueryTest{
 		},
 	},
 	{
-		Query: `SELECT s FROM mytable WHERE s LIKE '%D ROW'`,
-		Expected: []sql.Row{
-			{"second row"},
-			{"third row"},
-		},
+		// mytable.s has a binary collation, so LIKE is case-sensitive
+		Query:    `SELECT s FROM mytable WHERE s LIKE '%D ROW'`,
+		Expected: []sql.Row{},
 	},
 	{
 		Query: `SELECT SUBSTRING(s, -3, 3) AS s FROM mytable WHERE s LIKE '%d row' GROUP BY 1`,
@@ -3266,6 +3532,56 @@ var QueryTests = []QueryTest{
 			{float64(23.222)},
 		},
 	},
+	{
+		Query: `SELECT VAR_POP(i), VARIANCE(i), VAR_SAMP(i) FROM mytable`,
+		Expected: []sql.Row{
+			{float64(2) / 3, float64(2) / 3, float64(1)},
+		},
+	},
+	{
+		Query: `SELECT STD(i), STDDEV(i), STDDEV_POP(i), STDDEV_SAMP(i) FROM mytable`,
+		Expected: []sql.Row{
+			{math.Sqrt(float64(2) / 3), math.Sqrt(float64(2) / 3), math.Sqrt(float64(2) / 3), float64(1)},
+		},
+	},
+	{
+		Query: `SELECT VAR_SAMP(DISTINCT i % 2), STDDEV_SAMP(i) FROM mytable WHERE i = 1`,
+		Expected: []sql.Row{
+			{nil, nil},
+		},
+	},
+	{
+		Query: `SELECT i % 2 AS odd, VAR_POP(i) FROM mytable GROUP BY 1 ORDER BY 1`,
+		Expected: []sql.Row{
+			{int64(0), float64(0)},
+			{int64(1), float64(1)},
+		},
+	},
+	{
+		Query: `SELECT BIT_AND(i), BIT_OR(i), BIT_XOR(i) FROM mytable`,
+		Expected: []sql.Row{
+			{uint64(0), uint64(3), uint64(0)},
+		},
+	},
+	{
+		Query: `SELECT BIT_AND(i), BIT_OR(i), BIT_XOR(i) FROM mytable WHERE i > 3`,
+		Expected: []sql.Row{
+			{uint64(math.MaxUint64), uint64(0), uint64(0)},
+		},
+	},
+	{
+		Query: `SELECT BIT_AND(-i), BIT_OR(i - 2) FROM mytable`,
+		Expected: []sql.Row{
+			{uint64(math.MaxUint64 - 3), uint64(math.MaxUint64)},
+		},
+	},
+	{
+		Query: `SELECT i % 2 AS odd, BIT_OR(i << 4) FROM mytable GROUP BY 1 ORDER BY 1`,
+		Expected: []sql.Row{
+			{int64(0), uint64(0x20)},
+			{int64(1), uint64(0x30)},
+		},
+	},
 	{
 		Query: `SELECT DATABASE()`,
 		Expected: []sql.Row{
@@ -3278,6 +3594,12 @@ var QueryTests = []QueryTest{
 			{"user@client"},
 		},
 	},
+	{
+		Query: `SELECT SESSION_USER(), SYSTEM_USER()`,
+		Expected: []sql.Row{
+			{"user@client", "user@client"},
+		},
+	},
 	{
 		Query: `SELECT CURRENT_USER()`,
 		Expected: []sql.Row{
@@ -3373,6 +3695,10 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT JSON_UNQUOTE(JSON_EXTRACT('{"xid":null}', '$.xid'))`,
 		Expected: []sql.Row{{"null"}},
 	},
+	{
+		Query:    `SELECT json_unquote(json_extract('{"hi":"there"}', '$.nope'))`,
+		Expected: []sql.Row{{nil}},
+	},
 	{
 		Query:    `select JSON_EXTRACT('{"id":234}', '$.id')-1;`,
 		Expected: []sql.Row{{233.0}},
@@ -3567,7 +3893,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT round(15728640/1024/1024)`,
 		Expected: []sql.Row{
-			{int64(15)},
+			{"15"},
 		},
 	},
 	{
@@ -3600,6 +3926,10 @@ var QueryTests = []QueryTest{
 			{nil},
 		},
 	},
+	{
+		Query:    `SELECT CASE WHEN true THEN 2.5 ELSE 1 END, CASE WHEN false THEN 2.5 ELSE 1 END, CASE i WHEN 1 THEN 0.25 ELSE 123.5 END FROM mytable WHERE i = 1`,
+		Expected: []sql.Row{{"2.5", "1.0", "0.25"}},
+	},
 	{
 		Query: "SHOW COLLATION WHERE `Collation` IN ('binary', 'utf8_general_ci', 'utf8mb4_0900_ai_ci')",
 		Expected: []sql.Row{
@@ -3632,6 +3962,47 @@ var QueryTests = []QueryTest{
 			},
 		},
 	},
+	{
+		Query: `SHOW COLLATION LIKE 'utf8mb4_0900_a%'`,
+		Expected: []sql.Row{
+			{
+				sql.Collation_utf8mb4_0900_ai_ci.String(),
+				"utf8mb4",
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].SortLen,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].PadSpace,
+			},
+			{
+				sql.Collation_utf8mb4_0900_as_ci.String(),
+				"utf8mb4",
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_ci.Name].SortLen,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_ci.Name].PadSpace,
+			},
+			{
+				sql.Collation_utf8mb4_0900_as_cs.String(),
+				"utf8mb4",
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_cs.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_cs.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_cs.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_cs.Name].SortLen,
+				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_as_cs.Name].PadSpace,
+			},
+		},
+		ExpectedColumns: sql.Schema{
+			{Name: "Collation", Type: sql.LongText},
+			{Name: "Charset", Type: sql.LongText},
+			{Name: "Id", Type: sql.LongText},
+			{Name: "Default", Type: sql.LongText},
+			{Name: "Compiled", Type: sql.LongText},
+			{Name: "Sortlen", Type: sql.LongText},
+			{Name: "Pad_attribute", Type: sql.LongText},
+		},
+	},
 	{
 		Query:    `SHOW COLLATION LIKE 'foo'`,
 		Expected: nil,
@@ -3875,6 +4246,18 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT DATETIME('9999-12-31 23:59:59')",
 		Expected: []sql.Row{{time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)}},
 	},
+	{
+		Query:    "SELECT CONVERT('1 02:03:04', TIME), CONVERT('-00:00:00', TIME)",
+		Expected: []sql.Row{{"26:03:04", "00:00:00"}},
+	},
+	{
+		Query:    "SELECT TIME_TO_SEC('-01:00:01'), TIME_TO_SEC('1 01:00:00'), TIME_TO_SEC('2020-12-31 10:00:00')",
+		Expected: []sql.Row{{int64(-3601), int64(90000), int64(36000)}},
+	},
+	{
+		Query:    "SELECT HEX(CAST('ab' AS BINARY(4))), CAST('abcdef' AS CHAR(3)), CONVERT('abcdef', BINARY(2))",
+		Expected: []sql.Row{{"61620000", "abc", "ab"}},
+	},
 	{
 		Query:    "SELECT TIMESTAMP('2020-12-31 23:59:59')",
 		Expected: []sql.Row{{time.Date(2020, time.December, 31, 23, 59, 59, 0, time.UTC)}},
@@ -3916,16 +4299,15 @@ var QueryTests = []QueryTest{
 		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
 	},
 	{
-		Query:    `SELECT JSON_EXTRACT('[1, 2, 3]', '$.[0]')`,
+		Query:    `SELECT JSON_EXTRACT('[1, 2, 3]', '$[0]')`,
 		Expected: []sql.Row{{sql.MustJSON(`1`)}},
 	},
-	// TODO(andy)
 	{
 		Query:    `SELECT ARRAY_LENGTH(JSON_EXTRACT('[1, 2, 3]', '$'))`,
 		Expected: []sql.Row{{int32(3)}},
 	},
 	{
-		Query:    `SELECT ARRAY_LENGTH(JSON_EXTRACT('[{"i":0}, {"i":1, "y":"yyy"}, {"i":2, "x":"xxx"}]', '$.i'))`,
+		Query:    `SELECT ARRAY_LENGTH(JSON_EXTRACT('[{"i":0}, {"i":1, "y":"yyy"}, {"i":2, "x":"xxx"}]', '$[*].i'))`,
 		Expected: []sql.Row{{int32(3)}},
 	},
 	{
@@ -3948,21 +4330,25 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT GREATEST(i, s) FROM mytable`,
 		Expected: []sql.Row{{float64(1)}, {float64(2)}, {float64(3)}},
 	},
+	{
+		Query:    `SELECT GREATEST(1, 2.5), LEAST(3, 2.5), GREATEST(1, 10/4), LEAST(i, 1.50) FROM mytable WHERE i = 2`,
+		Expected: []sql.Row{{"2.5", "2.5", "2.5000", "1.50"}},
+	},
 	{
 		Query:    "select abs(-i) from mytable order by 1",
 		Expected: []sql.Row{{1}, {2}, {3}},
 	},
 	{
 		Query:    "select ceil(i + 0.5) from mytable order by 1",
-		Expected: []sql.Row{{2.0}, {3.0}, {4.0}},
+		Expected: []sql.Row{{"2"}, {"3"}, {"4"}},
 	},
 	{
 		Query:    "select floor(i + 0.5) from mytable order by 1",
-		Expected: []sql.Row{{1.0}, {2.0}, {3.0}},
+		Expected: []sql.Row{{"1"}, {"2"}, {"3"}},
 	},
 	{
 		Query:    "select round(i + 0.55, 1) from mytable order by 1",
-		Expected: []sql.Row{{1.6}, {2.6}, {3.6}},
+		Expected: []sql.Row{{"1.6"}, {"2.6"}, {"3.6"}},
 	},
 	{
 		Query:    "select date_format(da, '%s') from typestable order by 1",
@@ -4158,6 +4544,14 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT CHAR_LENGTH('áé'), LENGTH('àè')`,
 		Expected: []sql.Row{{int32(2), int32(4)}},
 	},
+	{
+		Query:    `SELECT CONVERT('àè€' USING latin1), LENGTH(CONVERT('àè€' USING latin1)), CHAR_LENGTH(CONVERT('àè€' USING latin1))`,
+		Expected: []sql.Row{{"àè€", int32(3), int32(3)}},
+	},
+	{
+		Query:    `SELECT CONVERT('a日b' USING ascii), LENGTH(CONVERT('àè' USING utf16))`,
+		Expected: []sql.Row{{"a?b", int32(4)}},
+	},
 	{
 		Query:    "SELECT i, COUNT(i) AS `COUNT(i)` FROM (SELECT i FROM mytable) t GROUP BY i ORDER BY i, `COUNT(i)` DESC",
 		Expected: []sql.Row{{int64(1), int64(1)}, {int64(2), int64(1)}, {int64(3), int64(1)}},
@@ -4234,6 +4628,30 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT avg(i) as `AVG(i)` FROM mytable GROUP BY i HAVING AVG(i) > 1",
 		Expected: []sql.Row{{float64(2)}, {float64(3)}},
 	},
+	{
+		Query:    `SELECT 1 FROM mytable HAVING avg(i) > 1`,
+		Expected: []sql.Row{{int8(1)}},
+	},
+	{
+		Query:    `SELECT count(*) FROM mytable HAVING max(i) > 2`,
+		Expected: []sql.Row{{int64(3)}},
+	},
+	{
+		Query:    `SELECT count(*) FROM mytable HAVING max(i) > 3`,
+		Expected: nil,
+	},
+	{
+		Query:    `SELECT s, count(*) FROM mytable GROUP BY s HAVING max(i) > 2`,
+		Expected: []sql.Row{{"third row", int64(1)}},
+	},
+	{
+		Query:    `SELECT i AS x FROM mytable HAVING i > 1 ORDER BY x`,
+		Expected: []sql.Row{{int64(2)}, {int64(3)}},
+	},
+	{
+		Query:    `SELECT i AS x FROM mytable HAVING x > 1 ORDER BY x DESC`,
+		Expected: []sql.Row{{int64(3)}, {int64(2)}},
+	},
 	{
 		Query: `SELECT s AS s, COUNT(*) AS count,  AVG(i) AS ` + "`AVG(i)`" + `
 		FROM  (
@@ -5313,6 +5731,72 @@ var QueryTests = []QueryTest{
 			{"6"},
 		},
 	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2, i",
+		Expected: []sql.Row{
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+			{int64(2), int64(2)},
+			{int64(4), int64(4)},
+			{int64(6), int64(6)},
+		},
+	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2 DESC, i",
+		Expected: []sql.Row{
+			{int64(6), int64(6)},
+			{int64(4), int64(4)},
+			{int64(2), int64(2)},
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+		},
+	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2 IS NULL, i2, i",
+		Expected: []sql.Row{
+			{int64(2), int64(2)},
+			{int64(4), int64(4)},
+			{int64(6), int64(6)},
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+		},
+	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2 IS NULL DESC, i2 DESC, i",
+		Expected: []sql.Row{
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+			{int64(6), int64(6)},
+			{int64(4), int64(4)},
+			{int64(2), int64(2)},
+		},
+	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2 IS NOT NULL, i2 DESC, i",
+		Expected: []sql.Row{
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+			{int64(6), int64(6)},
+			{int64(4), int64(4)},
+			{int64(2), int64(2)},
+		},
+	},
+	{
+		Query: "SELECT i, i2 FROM niltable ORDER BY i2 IS NOT NULL DESC, i2, i",
+		Expected: []sql.Row{
+			{int64(2), int64(2)},
+			{int64(4), int64(4)},
+			{int64(6), int64(6)},
+			{int64(1), nil},
+			{int64(3), nil},
+			{int64(5), nil},
+		},
+	},
 	{
 		Query: "SELECT pk,pk1,pk2,one_pk.c1 AS foo, two_pk.c1 AS bar FROM one_pk JOIN two_pk ON one_pk.c1=two_pk.c1 ORDER BY 1,2,3",
 		Expected: []sql.Row{
@@ -5391,9 +5875,25 @@ var QueryTests = []QueryTest{
 			{3, 3},
 		},
 	},
+	{
+		Query:    "SELECT 0.1 + 0.2, 0.1 + 0.2 = 0.3, 1.10 * 2.5, 1 / 3, 1.5 - 2, -(0.1 + 0.2)",
+		Expected: []sql.Row{{"0.3", true, "2.750", "0.3333", "-0.5", "-0.3"}},
+		ExpectedColumns: sql.Schema{
+			{Name: "0.1 + 0.2", Type: sql.MustCreateDecimalType(2, 1)},
+			{Name: "0.1 + 0.2 = 0.3", Type: sql.Boolean},
+			{Name: "1.10 * 2.5", Type: sql.MustCreateDecimalType(5, 3)},
+			{Name: "1 / 3", Type: sql.MustCreateDecimalType(7, 4)},
+			{Name: "1.5 - 2", Type: sql.MustCreateDecimalType(5, 1)},
+			{Name: "-(0.1 + 0.2)", Type: sql.MustCreateDecimalType(2, 1)},
+		},
+	},
+	{
+		Query:    "SELECT 0.1 + 0.2e0",
+		Expected: []sql.Row{{0.30000000000000004}},
+	},
 	{
 		Query:    "SELECT 2.0 + CAST(5 AS DECIMAL)",
-		Expected: []sql.Row{{float64(7)}},
+		Expected: []sql.Row{{"7.0000000000"}},
 	},
 	{
 		Query:    "SELECT (CASE WHEN i THEN i ELSE 0 END) as cases_i from mytable",
@@ -5556,9 +6056,9 @@ var QueryTests = []QueryTest{
 			row_number() over (order by length(s),i) + 0.0 / row_number() over (order by length(s) desc,i desc) + 0.0  
 			from mytable order by 1;`,
 		Expected: []sql.Row{
-			{1, 6, 1.0},
-			{2, 5, 3.0},
-			{3, 4, 2.0},
+			{1, 6, "1.00000"},
+			{2, 5, "3.00000"},
+			{3, 4, "2.00000"},
 		},
 	},
 	{
@@ -5814,6 +6314,42 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT EXISTS (SELECT pk FROM one_pk WHERE pk > 4)`,
 		Expected: []sql.Row{{false}},
 	},
+	{
+		Query:    `SELECT pk, EXISTS (SELECT 1 FROM two_pk WHERE pk1 = pk), pk IN (SELECT pk1 FROM two_pk WHERE pk2 = pk) FROM one_pk ORDER BY pk`,
+		Expected: []sql.Row{{0, true, true}, {1, true, true}, {2, false, false}, {3, false, false}},
+	},
+	{
+		Query:    `SELECT pk1, EXISTS (SELECT 1 FROM one_pk WHERE pk = pk1 + 3) FROM two_pk GROUP BY pk1 ORDER BY pk1`,
+		Expected: []sql.Row{{0, true}, {1, false}},
+	},
+	{
+		Query:    `SELECT pk1, (SELECT count(*) FROM one_pk WHERE pk <= pk1) FROM two_pk GROUP BY pk1 ORDER BY pk1`,
+		Expected: []sql.Row{{0, 1}, {1, 2}},
+	},
+	{
+		Query:    `SELECT pk1, count(*) FROM two_pk GROUP BY pk1 HAVING EXISTS (SELECT 1 FROM one_pk WHERE pk = pk1 + 3)`,
+		Expected: []sql.Row{{0, 2}},
+	},
+	{
+		Query:    `SELECT pk1, count(*) c FROM two_pk GROUP BY pk1 HAVING pk1 NOT IN (SELECT pk FROM one_pk WHERE pk > c - 2) ORDER BY pk1`,
+		Expected: []sql.Row{{0, 2}},
+	},
+	{
+		Query:    `SELECT pk1, sum(c1) FROM two_pk GROUP BY pk1 HAVING sum(c1) > (SELECT max(c1) FROM one_pk WHERE pk = pk1 + 1)`,
+		Expected: []sql.Row{{1, float64(50)}},
+	},
+	{
+		Query:    `SELECT pk FROM one_pk HAVING EXISTS (SELECT 1 FROM two_pk WHERE pk1 = pk) ORDER BY pk`,
+		Expected: []sql.Row{{0}, {1}},
+	},
+	{
+		Query:    `SELECT pk FROM one_pk ORDER BY EXISTS (SELECT 1 FROM two_pk WHERE pk1 = pk), pk`,
+		Expected: []sql.Row{{2}, {3}, {0}, {1}},
+	},
+	{
+		Query:    `SELECT pk FROM one_pk ORDER BY pk IN (SELECT pk1 FROM two_pk) DESC, (SELECT max(pk2) FROM two_pk WHERE pk1 = pk), pk DESC`,
+		Expected: []sql.Row{{1}, {0}, {3}, {2}},
+	},
 	{
 		Query:    `START TRANSACTION READ ONLY`,
 		Expected: []sql.Row{},
@@ -6176,9 +6712,6 @@ var BrokenQueries = []QueryTest{
 			{2},
 		},
 	},
-	{
-		Query: "SELECT json_array() FROM dual;",
-	},
 	{
 		Query: "SELECT json_array_append() FROM dual;",
 	},
@@ -6209,9 +6742,6 @@ var BrokenQueries = []QueryTest{
 	{
 		Query: "SELECT json_merge_preserve() FROM dual;",
 	},
-	{
-		Query: "SELECT json_object() FROM dual;",
-	},
 	{
 		Query: "SELECT json_overlaps() FROM dual;",
 	},
@@ -6341,11 +6871,6 @@ var BrokenQueries = []QueryTest{
 			},
 		},
 	},
-	// https://github.com/dolthub/go-mysql-server/issues/600
-	{
-		Query:    `SELECT json_unquote(json_extract('{"hi":"there"}', '$.nope'))`,
-		Expected: []sql.Row{{nil}}, // currently returns string "null"
-	},
 	// Null-safe and type conversion tuple comparison is not correctly
 	// implemented yet.
 	{
@@ -6471,7 +6996,7 @@ var DateParseQueries = []QueryTest{
 	},
 	{
 		Query:    "SELECT STR_TO_DATE('01/02/99 314', '%m/%e/%y %f')",
-		Expected: []sql.Row{{time.Date(1999, time.January, 2, 0, 0, 0, 314000, time.Local)}},
+		Expected: []sql.Row{{time.Date(1999, time.January, 2, 0, 0, 0, 314000000, time.Local)}},
 	},
 	{
 		Query:    "SELECT STR_TO_DATE('01/02/99 05:14:12 PM', '%m/%e/%y %r')",
@@ -6479,7 +7004,31 @@ var DateParseQueries = []QueryTest{
 	},
 	{
 		Query:    "SELECT STR_TO_DATE('invalid', 'notvalid')",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('20210315 071530.5', '%Y%m%d %H%i%s.%f')",
+		Expected: []sql.Row{{time.Date(2021, time.March, 15, 7, 15, 30, 500000000, time.Local)}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('Monday, 15 March 2021 12:30 AM', '%W, %e %M %Y %h:%i %p')",
+		Expected: []sql.Row{{time.Date(2021, time.March, 15, 0, 30, 0, 0, time.Local)}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('200442 Monday', '%X%V %W')",
+		Expected: []sql.Row{{time.Date(2004, time.October, 18, 0, 0, 0, 0, time.Local)}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('2021-02-30', '%Y-%m-%d')",
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE(NULL, '%Y-%m-%d'), STR_TO_DATE('2021-02-01', NULL)",
+		Expected: []sql.Row{{nil, nil}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('junk', '%Y') IS NULL, COALESCE(STR_TO_DATE('junk', '%Y'), 'fallback'), IFNULL(STR_TO_DATE('junk', '%Y'), 'fallback')",
+		Expected: []sql.Row{{true, "fallback", "fallback"}},
 	},
 }
 
@@ -6805,6 +7354,14 @@ var InfoSchemaQueries = []QueryTest{
 		Expected: []sql.Row{
 			{"InnoDB", "DEFAULT", "Supports transactions, row-level locking, and foreign keys", "YES", "YES", "YES"},
 		},
+		ExpectedColumns: sql.Schema{
+			{Name: "Engine", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 64)},
+			{Name: "Support", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 8)},
+			{Name: "Comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 80)},
+			{Name: "Transactions", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 3)},
+			{Name: "XA", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 3)},
+			{Name: "Savepoints", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 3)},
+		},
 	},
 	{
 		Query: "SELECT * FROM information_schema.table_constraints ORDER BY table_name, constraint_type;",
@@ -7236,6 +7793,14 @@ var errorQueries = []QueryErrorTest{
 		Query:       `SELECT * FROM specialtable t WHERE t.name LIKE '$%' ESCAPE '$$'`,
 		ExpectedErr: sql.ErrInvalidArgument,
 	},
+	{
+		Query:       `SELECT i FROM mytable GROUP BY 2`,
+		ExpectedErr: sql.ErrGroupByColumnIndex,
+	},
+	{
+		Query:       `SELECT i, count(*) FROM mytable GROUP BY 2`,
+		ExpectedErr: sql.ErrGroupByAggregate,
+	},
 	{
 		Query:       `SELECT JSON_OBJECT("a","b","c") FROM dual`,
 		ExpectedErr: sql.ErrInvalidArgumentNumber,
@@ -7265,6 +7830,14 @@ var errorQueries = []QueryErrorTest{
 		Query:       "SELECT SUM(i) as sum, i FROM mytable GROUP BY i ORDER BY 1+SUM(i) ASC",
 		ExpectedErr: analyzer.ErrAggregationUnsupported,
 	},
+	{
+		Query:       "SELECT pk1, EXISTS (SELECT 1 FROM one_pk WHERE pk = pk2) FROM two_pk GROUP BY pk1",
+		ExpectedErr: analyzer.ErrValidationGroupBy,
+	},
+	{
+		Query:       "SELECT pk FROM one_pk WHERE pk = count(*)",
+		ExpectedErr: analyzer.ErrAggregationUnsupported,
+	},
 	{
 		Query:       "select ((1, 2)) from dual",
 		ExpectedErr: sql.ErrInvalidOperandColumns,
@@ -7313,6 +7886,22 @@ var errorQueries = []QueryErrorTest{
 		Query:       "select ((4,5),((1,2),3)) = ((1,2),(4,5)) from dual",
 		ExpectedErr: sql.ErrInvalidOperandColumns,
 	},
+	{
+		Query:       "select (1, 2) < (1, 2, 3) from dual",
+		ExpectedErr: sql.ErrInvalidOperandColumns,
+	},
+	{
+		Query:       "select (1, 2) in ((1, 2), (1, 2, 3)) from dual",
+		ExpectedErr: sql.ErrInvalidOperandColumns,
+	},
+	{
+		Query:       "select i from mytable where (i, s) in (select i from mytable)",
+		ExpectedErr: sql.ErrInvalidOperandColumns,
+	},
+	{
+		Query:       "select (1, 2) between (0, 0) and (5, 5) from dual",
+		ExpectedErr: sql.ErrInvalidOperandColumns,
+	},
 	{
 		Query:       "SELECT (2, 2)=1 FROM dual where exists (SELECT 1 FROM dual)",
 		ExpectedErr: sql.ErrInvalidOperandColumns,
@@ -7549,69 +8138,71 @@ var ShowTableStatusQueries = []QueryTest{
 	{
 		Query: `SHOW TABLE STATUS FROM mydb`,
 		Expected: []sql.Row{
-			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"myview", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "VIEW"},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS LIKE '%table'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS WHERE Name = 'mytable'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS`,
 		Expected: []sql.Row{
-			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"myview", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "VIEW"},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 }
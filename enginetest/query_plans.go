@@ -2034,6 +2034,74 @@ var PlanTests = []QueryPlanTest{
 			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
+	{
+		Query: `SELECT s, count(*) FROM mytable GROUP BY s ORDER BY s DESC`,
+		ExpectedPlan: "Project(mytable.s, COUNT(*) as count(*))\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(mytable.s, COUNT(*))\n" +
+			"     ├─ Grouping(mytable.s)\n" +
+			"     └─ Sort(mytable.s DESC)\n" +
+			"         └─ Projected table access on [s]\n" +
+			"             └─ Table(mytable)\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE pk1 = 1 ORDER BY pk2`,
+		ExpectedPlan: "Filter(two_pk.pk1 = 1)\n" +
+			" └─ Projected table access on [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk2, c1 FROM two_pk t WHERE t.pk1 = 1 ORDER BY t.pk1, t.pk2`,
+		ExpectedPlan: "Project(t.pk2, t.c1)\n" +
+			" └─ Filter(t.pk1 = 1)\n" +
+			"     └─ Projected table access on [pk2 c1 pk1]\n" +
+			"         └─ TableAlias(t)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM mytable WHERE i > 1 ORDER BY i LIMIT 1`,
+		ExpectedPlan: "Limit(1)\n" +
+			" └─ Filter(mytable.i > 1)\n" +
+			"     └─ Projected table access on [i s]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE pk1 IN (0, 1) ORDER BY pk2`,
+		ExpectedPlan: "Sort(two_pk.pk2 ASC)\n" +
+			" └─ Filter(two_pk.pk1 HASH IN (0, 1))\n" +
+			"     └─ Projected table access on [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE pk1 = 1 ORDER BY pk2 DESC`,
+		ExpectedPlan: "Sort(two_pk.pk2 DESC)\n" +
+			" └─ Filter(two_pk.pk1 = 1)\n" +
+			"     └─ Projected table access on [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk1, pk2 FROM two_pk WHERE (pk1, pk2) > (0, 1)`,
+		ExpectedPlan: "Project(two_pk.pk1, two_pk.pk2)\n" +
+			" └─ Filter((two_pk.pk1, two_pk.pk2) > (0, 1))\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk1, pk2 FROM two_pk t WHERE (pk1, c1) >= (0, 10)`,
+		ExpectedPlan: "Project(t.pk1, t.pk2)\n" +
+			" └─ Filter((t.pk1, t.c1) >= (0, 10))\n" +
+			"     └─ Projected table access on [pk1 pk2 c1]\n" +
+			"         └─ TableAlias(t)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
 }
 
 // Queries where the query planner produces a correct (results) but suboptimal plan.
@@ -18,6 +18,7 @@ import (
 	"math"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 // TODO: none of these tests insert into tables without primary key columns, which have different semantics for
@@ -47,6 +48,12 @@ var ReplaceQueries = []WriteQueryTest{
 		SelectQuery:         "SELECT s FROM mytable WHERE i = 1;",
 		ExpectedSelect:      []sql.Row{{"new row same i"}},
 	},
+	{
+		WriteQuery:          "REPLACE INTO mytable VALUES (1, 'first row'), (4, 'fourth row');",
+		ExpectedWriteResult: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 2, Duplicates: 1}}}},
+		SelectQuery:         "SELECT * FROM mytable WHERE i IN (1, 4) ORDER BY i;",
+		ExpectedSelect:      []sql.Row{{int64(1), "first row"}, {int64(4), "fourth row"}},
+	},
 	{
 		WriteQuery:          "REPLACE INTO mytable (s, i) VALUES ('x', 999);",
 		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
@@ -15,11 +15,15 @@
 package enginetest
 
 import (
+	"time"
+
 	"gopkg.in/src-d/go-errors.v1"
 
+	"github.com/dolthub/go-mysql-server/internal/regex"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -464,6 +468,59 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "CrossDB DDL",
+		SetUpScript: []string{
+			"CREATE DATABASE test",
+			"CREATE TABLE test.x (pk int primary key, v int)",
+			"CREATE INDEX idx_v ON test.x (v)",
+			"insert into test.x values (1, 10), (2, 20)",
+			"create table a (xa int primary key)",
+			"CREATE TABLE test.y LIKE test.x",
+			"CREATE VIEW test.vx AS SELECT pk FROM test.x",
+			"RENAME TABLE test.y TO test.z",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW TABLES FROM test",
+				Expected: []sql.Row{{"vx"}, {"x"}, {"z"}},
+			},
+			{
+				Query:    "SELECT * FROM test.vx ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT table_schema, table_name FROM information_schema.tables WHERE table_name IN ('x', 'z', 'vx', 'a') ORDER BY 2",
+				Expected: []sql.Row{{"mydb", "a"}, {"test", "vx"}, {"test", "x"}, {"test", "z"}},
+			},
+			{
+				Query: "SHOW INDEXES FROM test.x",
+				Expected: []sql.Row{
+					{"x", 1, "idx_v", 1, "v", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:       "RENAME TABLE test.z TO mydb.z",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+			{
+				Query:    "DROP VIEW test.vx",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "DROP TABLE test.z, a",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW TABLES FROM test",
+				Expected: []sql.Row{{"x"}},
+			},
+			{
+				Query:    "SELECT table_name FROM information_schema.tables WHERE table_schema = 'mydb' AND table_type = 'BASE TABLE'",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	{
 		// All DECLARE statements are only allowed under BEGIN/END blocks
 		Name: "Top-level DECLARE statements",
@@ -511,7 +568,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "insert into a (y) values (2), (3)",
-				Expected: []sql.Row{{sql.NewOkResult(2)}},
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 			},
 			{
 				Query:    "select last_insert_id()",
@@ -519,7 +576,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "insert into b (x) values (1), (2)",
-				Expected: []sql.Row{{sql.NewOkResult(2)}},
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}}},
 			},
 			{
 				Query:    "select last_insert_id()",
@@ -663,7 +720,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "insert into b values (10), (11), (12), (13)",
-				Expected: []sql.Row{{sql.NewOkResult(4)}},
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 4, Info: plan.InsertInfo{Records: 4}}}},
 			},
 			{
 				Query:    "select found_rows()",
@@ -1109,7 +1166,7 @@ var ScriptTests = []ScriptTest{
 				Expected: []sql.Row{sql.Row{sql.OkResult{
 					RowsAffected: 3,
 					InsertID:     0,
-					Info:         nil,
+					Info:         plan.InsertInfo{Records: 3},
 				}}},
 			},
 		},
@@ -1369,6 +1426,1036 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "division honors div_precision_increment",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk BIGINT PRIMARY KEY, d DECIMAL(10,2));",
+			"INSERT INTO t VALUES (1, 7.25), (2, 10.00);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT d / 3, d DIV 3, d MOD 3 FROM t ORDER BY pk;",
+				Expected: []sql.Row{{"2.416667", int64(2), "1.25"}, {"3.333333", int64(3), "1.00"}},
+			},
+			{
+				Query:    "SET div_precision_increment = 8;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT 1 / 3, d / 3 FROM t ORDER BY pk;",
+				Expected: []sql.Row{{"0.33333333", "2.4166666667"}, {"0.33333333", "3.3333333333"}},
+			},
+			{
+				Query:    "SET div_precision_increment = 0;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT 7 / 2, d / 2 FROM t ORDER BY pk;",
+				Expected: []sql.Row{{"4", "3.63"}, {"4", "5.00"}},
+			},
+		},
+	},
+	{
+		Name: "CHAR and VARCHAR lengths are enforced according to sql_mode",
+		SetUpScript: []string{
+			"CREATE TABLE strs (pk BIGINT PRIMARY KEY, c VARCHAR(3), d CHAR(2));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO strs VALUES (1, 'abcd', 'x');",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:    "INSERT INTO strs VALUES (1, 'äöü', 'xy');",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT INTO strs VALUES (2, 'abcdef', 'xyz'), (3, 'ab', 'xyz');",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2, Warnings: 3}}}},
+			},
+			{
+				Query: "SHOW WARNINGS;",
+				Expected: []sql.Row{
+					{"Warning", 1265, "Data truncated for column 'c' at row 1"},
+					{"Warning", 1265, "Data truncated for column 'd' at row 1"},
+					{"Warning", 1265, "Data truncated for column 'd' at row 2"},
+				},
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "INSERT INTO strs VALUES (4, 'abcdef', 'x');",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:    "INSERT IGNORE INTO strs VALUES (4, 'abcdef', 'x');",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1, Warnings: 1}}}},
+			},
+			{
+				Query:    "SELECT * FROM strs ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), "äöü", "xy"}, {int64(2), "abc", "xy"}, {int64(3), "ab", "xy"}, {int64(4), "abc", "x"}},
+			},
+		},
+	},
+	{
+		Name: "BINARY and VARBINARY columns",
+		SetUpScript: []string{
+			"CREATE TABLE bins (pk int primary key, b binary(3), vb varbinary(3))",
+			"INSERT INTO bins VALUES (1, 'a', 'a'), (2, 'B', 'B')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, HEX(b), HEX(vb) FROM bins ORDER BY pk",
+				Expected: []sql.Row{{1, "610000", "61"}, {2, "420000", "42"}},
+			},
+			{
+				Query:    "SELECT pk FROM bins ORDER BY vb",
+				Expected: []sql.Row{{2}, {1}},
+			},
+			{
+				Query:    "SELECT pk FROM bins WHERE b = 'a\\0\\0'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "INSERT INTO bins VALUES (3, 'abcd', 'a')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:       "INSERT INTO bins VALUES (3, 'a', 'abcd')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+		},
+	},
+	{
+		Name: "TEXT and BLOB size classes",
+		SetUpScript: []string{
+			"CREATE TABLE classes (pk int primary key, tt tinytext, t text, mt mediumtext, lt longtext, tb tinyblob, b blob, mb mediumblob, lb longblob)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE classes",
+				Expected: []sql.Row{{"classes", "CREATE TABLE `classes` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `tt` tinytext,\n" +
+					"  `t` text,\n" +
+					"  `mt` mediumtext,\n" +
+					"  `lt` longtext,\n" +
+					"  `tb` tinyblob,\n" +
+					"  `b` blob,\n" +
+					"  `mb` mediumblob,\n" +
+					"  `lb` longblob,\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SELECT column_name, character_maximum_length, character_octet_length FROM information_schema.columns WHERE table_name = 'classes' AND column_name <> 'pk' ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"tt", uint64(255), uint64(255)},
+					{"t", uint64(65535), uint64(65535)},
+					{"mt", uint64(16777215), uint64(16777215)},
+					{"lt", uint64(4294967295), uint64(4294967295)},
+					{"tb", uint64(255), uint64(255)},
+					{"b", uint64(65535), uint64(65535)},
+					{"mb", uint64(16777215), uint64(16777215)},
+					{"lb", uint64(4294967295), uint64(4294967295)},
+				},
+			},
+			{
+				Query:    "INSERT INTO classes (pk, tt, tb) VALUES (1, REPEAT('x', 255), REPEAT('x', 255))",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO classes (pk, tt) VALUES (2, REPEAT('x', 256))",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:       "INSERT INTO classes (pk, tb) VALUES (2, REPEAT('x', 256))",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:    "INSERT INTO classes (pk, t, b) VALUES (2, REPEAT('x', 256), REPEAT('x', 256))",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+		},
+	},
+	{
+		Name: "Case-insensitive collations",
+		SetUpScript: []string{
+			"CREATE TABLE fruits (pk int primary key, name varchar(20) COLLATE utf8mb4_general_ci, code varchar(20) COLLATE utf8mb4_bin)",
+			"INSERT INTO fruits VALUES (1, 'apple', 'a'), (2, 'Banana', 'B'), (3, 'APPLE', 'A'), (4, 'cherry', 'c')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM fruits WHERE name = 'Apple' ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM fruits WHERE name IN ('BANANA', 'Cherry') ORDER BY pk",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM fruits WHERE name > 'B' ORDER BY pk",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM fruits WHERE code = 'a'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM fruits ORDER BY name, pk",
+				Expected: []sql.Row{{1}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM fruits ORDER BY code",
+				Expected: []sql.Row{{3}, {2}, {1}, {4}},
+			},
+			{
+				Query:    "SELECT LOWER(name), COUNT(*) FROM fruits GROUP BY name ORDER BY 1",
+				Expected: []sql.Row{{"apple", 2}, {"banana", 1}, {"cherry", 1}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT name FROM fruits) t",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT name), COUNT(DISTINCT code) FROM fruits",
+				Expected: []sql.Row{{3, 4}},
+			},
+		},
+	},
+	{
+		Name: "Numeric ranges are enforced according to sql_mode",
+		SetUpScript: []string{
+			"CREATE TABLE nums (pk BIGINT PRIMARY KEY, t TINYINT, u INT UNSIGNED);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO nums VALUES (1, 128, 0);",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:       "INSERT INTO nums VALUES (1, 0, -1);",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:       "INSERT INTO nums VALUES (1, '1e30', 0);",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT INTO nums VALUES (1, 300, -5), (2, -300, '1e30');",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2, Warnings: 4}}}},
+			},
+			{
+				Query: "SHOW WARNINGS;",
+				Expected: []sql.Row{
+					{"Warning", 1264, "Out of range value for column 't' at row 1"},
+					{"Warning", 1264, "Out of range value for column 'u' at row 1"},
+					{"Warning", 1264, "Out of range value for column 't' at row 2"},
+					{"Warning", 1264, "Out of range value for column 'u' at row 2"},
+				},
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT IGNORE INTO nums VALUES (3, 1000, 1);",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1, Warnings: 1}}}},
+			},
+			{
+				Query:    "SELECT * FROM nums ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), int8(127), uint32(0)}, {int64(2), int8(-128), uint32(4294967295)}, {int64(3), int8(127), uint32(1)}},
+			},
+		},
+	},
+	{
+		Name: "Numeric ranges are enforced on UPDATE according to sql_mode",
+		SetUpScript: []string{
+			"CREATE TABLE n (pk BIGINT PRIMARY KEY, x TINYINT, u INT UNSIGNED);",
+			"INSERT INTO n VALUES (1, 1, 1), (2, 2, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "UPDATE n SET x = 1000;",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "UPDATE n SET x = 1000, u = -5 WHERE pk = 1;",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1, Warnings: 2}}}},
+			},
+			{
+				Query: "SHOW WARNINGS;",
+				Expected: []sql.Row{
+					{"Warning", 1264, "Out of range value for column 'x' at row 1"},
+					{"Warning", 1264, "Out of range value for column 'u' at row 1"},
+				},
+			},
+			{
+				Query:    "UPDATE n SET x = x - 1000;",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.UpdateInfo{Matched: 2, Updated: 2, Warnings: 2}}}},
+			},
+			{
+				Query:    "INSERT INTO n VALUES (1, 0, 0) ON DUPLICATE KEY UPDATE u = 1e30;",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 1, Duplicates: 1, Warnings: 1}}}},
+			},
+			{
+				Query:    "SELECT * FROM n ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), int8(-128), uint32(4294967295)}, {int64(2), int8(-128), uint32(2)}},
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "UPDATE n SET u = -1;",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+		},
+	},
+	{
+		Name: "Zero and invalid dates are handled according to sql_mode",
+		SetUpScript: []string{
+			"CREATE TABLE dates (pk BIGINT PRIMARY KEY, d DATE, dt DATETIME NOT NULL);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO dates VALUES (1, '0000-00-00', '0000-00-00 00:00:00');",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (2, '2020-02-30', '2020-01-01');",
+				ExpectedErr: sql.ErrInvalidDate,
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (2, '2020-02-03', 'not a date');",
+				ExpectedErr: sql.ErrConvertingToTime,
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ZERO_DATE';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (2, '0000-00-00', '2020-01-01');",
+				ExpectedErr: sql.ErrInvalidDate,
+			},
+			{
+				Query:    "INSERT IGNORE INTO dates VALUES (2, '0000-00-00', '2020-01-01');",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1, Warnings: 1}}}},
+			},
+			{
+				Query:    "SET sql_mode = 'NO_ZERO_DATE';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT INTO dates VALUES (3, '2020-02-30', '2020-02-30 10:00:00');",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.InsertInfo{Records: 1, Warnings: 2}}}},
+			},
+			{
+				Query: "SHOW WARNINGS;",
+				Expected: []sql.Row{
+					{"Warning", 1264, "Out of range value for column 'd' at row 1"},
+					{"Warning", 1264, "Out of range value for column 'dt' at row 1"},
+				},
+			},
+			{
+				Query:    "SET sql_mode = 'ALLOW_INVALID_DATES';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT INTO dates VALUES (4, '2020-02-30', '2021-04-31 10:00:00');",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query: "SELECT pk, d, dt FROM dates ORDER BY pk;",
+				Expected: []sql.Row{
+					{int64(1), sql.Date.Zero(), sql.Datetime.Zero()},
+					{int64(2), sql.Date.Zero(), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{int64(3), nil, sql.Datetime.Zero()},
+					{int64(4), time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+	},
+	{
+		Name: "HANDLER statements",
+		SetUpScript: []string{
+			"CREATE TABLE hnd (pk int PRIMARY KEY, v int, KEY v_idx (v));",
+			"INSERT INTO hnd VALUES (1, 30), (2, 10), (3, 20), (4, 40);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "HANDLER hnd OPEN AS h",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "HANDLER hnd OPEN AS h",
+				ExpectedErr: sql.ErrDuplicateAliasOrTable,
+			},
+			{
+				Query:    "HANDLER h READ FIRST LIMIT 10",
+				Expected: []sql.Row{{1, 30}, {2, 10}, {3, 20}, {4, 40}},
+			},
+			{
+				Query:    "HANDLER h READ NEXT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "HANDLER h READ FIRST WHERE v = 20",
+				Expected: []sql.Row{{3, 20}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx FIRST",
+				Expected: []sql.Row{{2, 10}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx NEXT",
+				Expected: []sql.Row{{3, 20}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx NEXT",
+				Expected: []sql.Row{{1, 30}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx NEXT LIMIT 5",
+				Expected: []sql.Row{{4, 40}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx NEXT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` = (2)",
+				Expected: []sql.Row{{2, 10}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx > (10)",
+				Expected: []sql.Row{{3, 20}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx NEXT",
+				Expected: []sql.Row{{1, 30}},
+			},
+			{
+				Query:    "HANDLER h READ v_idx >= (20) WHERE pk > 3",
+				Expected: []sql.Row{{4, 40}},
+			},
+			{
+				Query:       "HANDLER h READ LAST",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+			{
+				Query:    "HANDLER h CLOSE",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "HANDLER h READ FIRST",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+			{
+				Query:    "HANDLER mydb.hnd OPEN",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "HANDLER hnd READ `PRIMARY` >= (3)",
+				Expected: []sql.Row{{3, 20}},
+			},
+			{
+				Query:    "HANDLER hnd CLOSE",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "SHOW TABLE STATUS reports views and table collations",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk int primary key auto_increment, b varbinary(10), s varchar(10)) COLLATE utf8mb4_0900_ai_ci",
+			"INSERT INTO t1 (b, s) VALUES ('a', 'a'), ('b', 'b')",
+			"CREATE VIEW v1 AS SELECT pk FROM t1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW TABLE STATUS LIKE '%1'",
+				Expected: []sql.Row{
+					{"t1", "InnoDB", "10", "Fixed", uint64(2), uint64(58), uint64(116), uint64(0), int64(0), int64(0), int64(3), nil, nil, nil, "utf8mb4_0900_ai_ci", nil, nil, ""},
+					{"v1", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "VIEW"},
+				},
+			},
+			{
+				Query:    "SHOW TABLE STATUS WHERE `Comment` = 'VIEW' AND Name = 'v1'",
+				Expected: []sql.Row{{"v1", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "VIEW"}},
+			},
+		},
+	},
+	{
+		Name: "ON UPDATE CURRENT_TIMESTAMP columns",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int, ts timestamp DEFAULT '2000-01-01 00:00:00' ON UPDATE CURRENT_TIMESTAMP, dt datetime ON UPDATE LOCALTIMESTAMP)",
+			"INSERT INTO t (pk, v) VALUES (1, 1), (2, 2), (3, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "UPDATE t SET v = 10 WHERE pk = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "UPDATE t SET v = v WHERE pk = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 0)}},
+			},
+			{
+				Query:    "SELECT pk, v, ts > '2020-01-01', dt IS NOT NULL FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10, true, true}, {2, 2, false, false}, {3, 3, false, false}},
+			},
+			{
+				Query:    "UPDATE t SET v = 20, ts = '2001-01-01 00:00:00' WHERE pk = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "INSERT INTO t (pk, v) VALUES (3, 30) ON DUPLICATE KEY UPDATE v = VALUES(v)",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query: "SELECT pk, v, ts > '2020-01-01', dt IS NOT NULL FROM t ORDER BY pk",
+				Expected: []sql.Row{
+					{1, 10, true, true},
+					{2, 20, false, true},
+					{3, 30, true, true},
+				},
+			},
+			{
+				Query:    "SELECT ts FROM t WHERE pk = 2",
+				Expected: []sql.Row{{time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `v` int,\n" +
+					"  `ts` timestamp DEFAULT \"2000-01-01 00:00:00\" ON UPDATE CURRENT_TIMESTAMP(),\n" +
+					"  `dt` datetime ON UPDATE CURRENT_TIMESTAMP(),\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:    "SELECT column_name, extra FROM information_schema.columns WHERE table_name = 't' AND column_name IN ('ts', 'dt') ORDER BY 1",
+				Expected: []sql.Row{{"dt", "on update CURRENT_TIMESTAMP"}, {"ts", "on update CURRENT_TIMESTAMP"}},
+			},
+			{
+				Query:       "CREATE TABLE t2 (pk int primary key, v int ON UPDATE CURRENT_TIMESTAMP)",
+				ExpectedErr: sql.ErrInvalidOnUpdate,
+			},
+			{
+				Query:       "CREATE TABLE t2 (pk int primary key, d date ON UPDATE CURRENT_TIMESTAMP)",
+				ExpectedErr: sql.ErrInvalidOnUpdate,
+			},
+			{
+				Query:       "CREATE TABLE t2 (pk int primary key, d datetime ON UPDATE UTC_TIMESTAMP)",
+				ExpectedErr: sql.ErrInvalidOnUpdate,
+			},
+		},
+	},
+	{
+		Name: "HAVING without GROUP BY is only lenient outside of ONLY_FULL_GROUP_BY",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int)",
+			"INSERT INTO t VALUES (1, 1), (2, 2), (3, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT v FROM t GROUP BY v HAVING pk > 1",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk AS x FROM t HAVING pk > 1 ORDER BY x",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SET sql_mode = 'ONLY_FULL_GROUP_BY'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "SELECT pk FROM t HAVING count(*) > 1",
+				ExpectedErr: analyzer.ErrValidationAggregatedNoGroupBy,
+			},
+			{
+				Query:       "SELECT v FROM t GROUP BY v HAVING pk > 1",
+				ExpectedErr: analyzer.ErrHavingNonGroupedColumn,
+			},
+			{
+				Query:    "SELECT count(*) FROM t HAVING max(pk) > 2",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT v, count(*) FROM t GROUP BY v HAVING v > 1",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "SELECT pk AS x FROM t HAVING pk > 1 ORDER BY x",
+				Expected: []sql.Row{{2}, {3}},
+			},
+		},
+	},
+	{
+		Name: "column comments and collations round trip",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key auto_increment comment 'the key', s varchar(20) character set latin1 collate latin1_swedish_ci comment 'it''s latin', b text collate utf8mb4_bin, e enum('a', 'b') collate utf8mb4_bin)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL AUTO_INCREMENT COMMENT 'the key',\n  `s` varchar(20) CHARACTER SET latin1 COLLATE latin1_swedish_ci COMMENT 'it''s latin',\n  `b` text COLLATE utf8mb4_bin,\n  `e` enum('a','b') COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SHOW FULL COLUMNS FROM t",
+				Expected: []sql.Row{
+					{"pk", "int", nil, "NO", "PRI", "", "auto_increment", "", "the key"},
+					{"s", "varchar(20)", "latin1_swedish_ci", "YES", "", "", "", "", "it's latin"},
+					{"b", "text", "utf8mb4_bin", "YES", "", "", "", "", ""},
+					{"e", "enum('a','b')", "utf8mb4_bin", "YES", "", "", "", "", ""},
+				},
+			},
+			{
+				Query: "SELECT column_name, column_type, character_set_name, collation_name, column_comment, extra FROM information_schema.columns WHERE table_name = 't' ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"pk", "int", nil, nil, "the key", "auto_increment"},
+					{"s", "varchar(20)", "latin1", "latin1_swedish_ci", "it's latin", ""},
+					{"b", "text", "utf8mb4", "utf8mb4_bin", "", ""},
+					{"e", "enum('a','b')", "utf8mb4", "utf8mb4_bin", "", ""},
+				},
+			},
+			{
+				Query:    "DROP TABLE t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CREATE TABLE `t` (\n  `pk` int NOT NULL AUTO_INCREMENT COMMENT 'the key',\n  `s` varchar(20) CHARACTER SET latin1 COLLATE latin1_swedish_ci COMMENT 'it''s latin',\n  `b` text COLLATE utf8mb4_bin,\n  `e` enum('a','b') COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL AUTO_INCREMENT COMMENT 'the key',\n  `s` varchar(20) CHARACTER SET latin1 COLLATE latin1_swedish_ci COMMENT 'it''s latin',\n  `b` text COLLATE utf8mb4_bin,\n  `e` enum('a','b') COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+		},
+	},
+	{
+		Name: "TIMESTAMP values are stored in UTC and converted to the session time zone, DATETIME values aren't",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, ts timestamp, dt datetime, INDEX (ts))",
+			"INSERT INTO t VALUES (1, '2021-01-01 12:00:00', '2021-01-01 12:00:00')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SET time_zone = '+02:00'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT ts, dt FROM t",
+				Expected: []sql.Row{{time.Date(2021, 1, 1, 14, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE ts = '2021-01-01 14:00:00'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE ts > dt",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT UNIX_TIMESTAMP(ts), UNIX_TIMESTAMP(dt) FROM t",
+				Expected: []sql.Row{{float64(1609502400), float64(1609495200)}},
+			},
+			{
+				Query:    "SELECT FROM_UNIXTIME(1609502400), FROM_UNIXTIME(1609502400, '%H:%i')",
+				Expected: []sql.Row{{time.Date(2021, 1, 1, 14, 0, 0, 0, time.UTC), "14:00"}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (2, '2021-01-01 14:00:00', '2021-01-01 14:00:00')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "UPDATE t SET ts = '2021-06-01 02:00:00' WHERE pk = 1",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "SET time_zone = 'UTC'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query: "SELECT pk, ts, dt FROM t ORDER BY pk",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+					{2, time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 14, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    "SET time_zone = '-05:00'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "DELETE FROM t WHERE ts = '2021-01-01 07:00:00'",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk, FROM_UNIXTIME(0) FROM t",
+				Expected: []sql.Row{{1, time.Date(1969, 12, 31, 19, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:       "SET time_zone = 'Nowhere/Special'",
+				ExpectedErr: sql.ErrUnknownTimeZone,
+			},
+			{
+				Query:       "SET time_zone = '+14:30'",
+				ExpectedErr: sql.ErrUnknownTimeZone,
+			},
+			{
+				Query:    "SELECT @@time_zone, ts FROM t",
+				Expected: []sql.Row{{"-05:00", time.Date(2021, 5, 31, 19, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SET time_zone = 'SYSTEM'",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "EXTRACT in views and triggers",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, dt datetime(6), ym int)",
+			"CREATE TRIGGER trig BEFORE INSERT ON t FOR EACH ROW SET new.ym = EXTRACT(YEAR_MONTH FROM new.dt)",
+			"CREATE VIEW v AS SELECT pk, EXTRACT(DAY_MICROSECOND FROM dt) AS dm, extract(quarter from dt) FROM t",
+			"INSERT INTO t (pk, dt) VALUES (1, '2021-11-05 08:09:10.000011')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, time.Date(2021, 11, 5, 8, 9, 10, 11000, time.UTC), 202111}},
+			},
+			{
+				Query:    "SELECT * FROM v",
+				Expected: []sql.Row{{1, int64(5080910000011), int64(4)}},
+			},
+			{
+				Query:    "SHOW CREATE VIEW v",
+				Expected: []sql.Row{{"v", "CREATE VIEW `v` AS SELECT pk, EXTRACT(DAY_MICROSECOND FROM dt) AS dm, extract(quarter from dt) FROM t"}},
+			},
+		},
+	},
+	{
+		Name: "STRCMP uses the collation of its arguments",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, ci varchar(20) COLLATE utf8mb4_0900_ai_ci, cs varchar(20) COLLATE utf8mb4_0900_bin)",
+			"INSERT INTO t VALUES (1, 'Apple', 'Apple'), (2, 'banana', 'banana')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, STRCMP(ci, 'APPLE'), STRCMP(cs, 'APPLE'), STRCMP('APPLE', ci) FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, int32(0), int32(1), int32(0)}, {2, int32(1), int32(1), int32(-1)}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE STRCMP(ci, 'B') < 0",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE STRCMP(cs, 'B') < 0",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE STRCMP(cs, 'a') < 0",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "LIKE uses the collation of its arguments and supports ESCAPE",
+		SetUpScript: []string{
+			"CREATE TABLE t2 (pk int primary key, ci varchar(20) COLLATE utf8mb4_0900_ai_ci, cs varchar(20) COLLATE utf8mb4_0900_bin)",
+			`INSERT INTO t2 VALUES (1, 'Apple', 'Apple'), (2, '50% off', '50% off'), (3, 'a_b\\c', 'a_b\\c')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t2 WHERE ci LIKE 'apple'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE cs LIKE 'apple'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE cs LIKE 'A%'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE ci LIKE '%\\\\%%' ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE ci LIKE '%|%%' ESCAPE '|' ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE ci LIKE 'a|_b%' ESCAPE '|' ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE cs LIKE 'a_b\\\\\\\\c'",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE cs LIKE 'a_b\\\\c' ESCAPE ''",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:       "SELECT pk FROM t2 WHERE cs LIKE 'a' ESCAPE '||'",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+		},
+	},
+	{
+		Name: "joins on columns of different types",
+		SetUpScript: []string{
+			"CREATE TABLE ji (i int primary key)",
+			"CREATE TABLE js (pk int primary key, s varchar(10), b bigint)",
+			"INSERT INTO ji VALUES (1), (2), (3)",
+			"INSERT INTO js VALUES (1, '1', 1), (2, '2.0', 2), (3, '3.5', 4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT ji.i, js.s FROM ji JOIN js ON ji.i = js.s ORDER BY ji.i",
+				Expected: []sql.Row{{1, "1"}, {2, "2.0"}},
+			},
+			{
+				Query:    "SELECT ji.i, sq.s FROM (SELECT i FROM ji) ji JOIN (SELECT s FROM js) sq ON ji.i = sq.s ORDER BY ji.i",
+				Expected: []sql.Row{{1, "1"}, {2, "2.0"}},
+			},
+			{
+				Query:    "SELECT ji.i, sq.b FROM (SELECT i FROM ji) ji JOIN (SELECT b FROM js) sq ON ji.i = sq.b ORDER BY ji.i",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "SELECT ji.i, sq.s FROM (SELECT i FROM ji) ji JOIN (SELECT s, b FROM js) sq ON ji.i = sq.s AND ji.i = sq.b ORDER BY ji.i",
+				Expected: []sql.Row{{1, "1"}, {2, "2.0"}},
+			},
+		},
+	},
+	{
+		Name: "REGEXP with the ICU engine",
+		SetUpScript: []string{
+			"CREATE TABLE t3 (pk int primary key, s varchar(20))",
+			"INSERT INTO t3 VALUES (1, 'foobar'), (2, 'foobaz'), (3, 'abab'), (4, 'abcd')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "SELECT pk FROM t3 WHERE s REGEXP 'foo(?=bar)'",
+				ExpectedErr: expression.ErrInvalidRegexp,
+			},
+			{
+				Query:    "SET regexp_engine = 'icu'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s REGEXP 'foo(?=bar)'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s RLIKE 'foo(?!bar)'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s REGEXP '^(ab)\\\\1$'",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s REGEXP '^[[:alpha:]]{4}$' AND s NOT REGEXP '(?<=o)b' ORDER BY pk",
+				Expected: []sql.Row{{3}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s REGEXP '(?i)^ABC' ORDER BY pk",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:       "SELECT pk FROM t3 WHERE s REGEXP '(?<=a'",
+				ExpectedErr: expression.ErrInvalidRegexp,
+			},
+			{
+				Query:    "SELECT REPEAT('a', 8000000) REGEXP '^a*$'",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:       "SELECT REPEAT('a', 8000000) REGEXP '^(a)*$'",
+				ExpectedErr: regex.ErrICUStackOverflow,
+			},
+			{
+				Query:       "SELECT CONCAT(REPEAT('a', 40), 'b') REGEXP '^(a+)+$'",
+				ExpectedErr: regex.ErrICUTimeout,
+			},
+			{
+				Query:    "SET regexp_engine = 'go'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT pk FROM t3 WHERE s REGEXP 'c' ORDER BY pk",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "default collations are inherited from the database and the table",
+		SetUpScript: []string{
+			"CREATE DATABASE coll CHARACTER SET latin1",
+			"CREATE TABLE coll.t1 (pk int primary key, s varchar(20), b text collate utf8mb4_bin)",
+			"CREATE TABLE coll.t2 (pk int primary key, s varchar(20)) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			"ALTER TABLE coll.t2 ADD COLUMN c char(5)",
+			"ALTER TABLE coll.t2 ADD COLUMN d char(5) CHARACTER SET latin1",
+			"CREATE TABLE coll.t3 LIKE coll.t2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT schema_name, default_character_set_name, default_collation_name FROM information_schema.schemata WHERE schema_name = 'coll'",
+				Expected: []sql.Row{{"coll", "latin1", "latin1_swedish_ci"}},
+			},
+			{
+				Query:    "SHOW CREATE DATABASE coll",
+				Expected: []sql.Row{{"coll", "CREATE DATABASE `coll` /*!40100 DEFAULT CHARACTER SET latin1 COLLATE latin1_swedish_ci */"}},
+			},
+			{
+				Query: "SELECT table_name, column_name, collation_name FROM information_schema.columns WHERE table_schema = 'coll' ORDER BY 1, ordinal_position",
+				Expected: []sql.Row{
+					{"t1", "pk", nil},
+					{"t1", "s", "latin1_swedish_ci"},
+					{"t1", "b", "utf8mb4_bin"},
+					{"t2", "pk", nil},
+					{"t2", "s", "utf8mb4_unicode_ci"},
+					{"t2", "c", "utf8mb4_unicode_ci"},
+					{"t2", "d", "latin1_swedish_ci"},
+					{"t3", "pk", nil},
+					{"t3", "s", "utf8mb4_unicode_ci"},
+					{"t3", "c", "utf8mb4_unicode_ci"},
+					{"t3", "d", "latin1_swedish_ci"},
+				},
+			},
+			{
+				Query:    "SELECT table_name, table_collation FROM information_schema.tables WHERE table_schema = 'coll' ORDER BY 1",
+				Expected: []sql.Row{{"t1", "latin1_swedish_ci"}, {"t2", "utf8mb4_unicode_ci"}, {"t3", "utf8mb4_unicode_ci"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE coll.t1",
+				Expected: []sql.Row{{"t1", "CREATE TABLE `t1` (\n  `pk` int NOT NULL,\n  `s` varchar(20),\n  `b` text CHARACTER SET utf8mb4 COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=latin1"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE coll.t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL,\n  `s` varchar(20),\n  `c` char(5),\n  `d` char(5) CHARACTER SET latin1 COLLATE latin1_swedish_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"}},
+			},
+			{
+				Query:    "ALTER DATABASE coll COLLATE utf8mb4_bin",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "CREATE TABLE coll.t4 (s varchar(20))",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT column_name, collation_name FROM information_schema.columns WHERE table_schema = 'coll' AND table_name = 't4'",
+				Expected: []sql.Row{{"s", "utf8mb4_bin"}},
+			},
+			{
+				Query:    "SELECT default_collation_name FROM information_schema.schemata WHERE schema_name = 'coll'",
+				Expected: []sql.Row{{"utf8mb4_bin"}},
+			},
+			{
+				Query:    "SELECT default_collation_name FROM information_schema.schemata WHERE schema_name = 'mydb'",
+				Expected: []sql.Row{{"utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "IN subqueries over numbers of different types",
+		SetUpScript: []string{
+			"CREATE TABLE ints (a INT PRIMARY KEY);",
+			"CREATE TABLE doubles (d DOUBLE PRIMARY KEY);",
+			"CREATE TABLE decimals (x DECIMAL(10,2) PRIMARY KEY);",
+			"INSERT INTO ints VALUES (1), (2), (10);",
+			"INSERT INTO doubles VALUES (1.5), (2);",
+			"INSERT INTO decimals VALUES (0.1), (2.00);",
+			"CREATE TABLE nullables (k INT PRIMARY KEY, y DECIMAL(10,1));",
+			"INSERT INTO nullables VALUES (1, NULL), (2, 1.0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1 IN (SELECT 10/10), 1 IN (SELECT 1.0), 1 NOT IN (SELECT 1.0), 2 IN (SELECT 2.5)",
+				Expected: []sql.Row{{true, true, false, false}},
+			},
+			{
+				Query:    "SELECT a FROM ints WHERE a IN (SELECT a/10 FROM ints) ORDER BY a",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT a FROM ints WHERE a NOT IN (SELECT a/10 FROM ints) ORDER BY a",
+				Expected: []sql.Row{{2}, {10}},
+			},
+			{
+				Query:    "SELECT d FROM doubles WHERE d IN (SELECT 1.5)",
+				Expected: []sql.Row{{1.5}},
+			},
+			{
+				Query:    "SELECT d FROM doubles WHERE d IN (SELECT x FROM decimals) OR d IN (SELECT a FROM ints) ORDER BY d",
+				Expected: []sql.Row{{float64(2)}},
+			},
+			{
+				Query:    "SELECT x FROM decimals WHERE x IN (SELECT a FROM ints) OR x IN (SELECT 0.10) ORDER BY x",
+				Expected: []sql.Row{{"0.10"}, {"2.00"}},
+			},
+			{
+				Query:    "SELECT x FROM decimals WHERE x NOT IN (SELECT d FROM doubles) ORDER BY x",
+				Expected: []sql.Row{{"0.10"}},
+			},
+			{
+				Query:    "SELECT 1 IN (SELECT y FROM nullables), 3 IN (SELECT y FROM nullables)",
+				Expected: []sql.Row{{true, nil}},
+			},
+		},
+	},
+	{
+		Name: "Strings used as numbers are read by their leading number",
+		SetUpScript: []string{
+			"CREATE TABLE strs (pk INT PRIMARY KEY, s VARCHAR(10));",
+			"INSERT INTO strs VALUES (1, '1a'), (2, '2'), (3, 'abc');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1 + '1a'",
+				Expected: []sql.Row{{float64(2)}},
+			},
+			{
+				Query:    "SHOW WARNINGS",
+				Expected: []sql.Row{{"Warning", 1292, "Truncated incorrect DOUBLE value: '1a'"}},
+			},
+			{
+				Query:    "SELECT 1 + '1a', 1 + 'abc', '1a' = 1, ' 2 ' * 2",
+				Expected: []sql.Row{{float64(2), float64(1), true, float64(4)}},
+			},
+			{
+				Query:    "SELECT pk, s + 1 FROM strs ORDER BY pk",
+				Expected: []sql.Row{{1, float64(2)}, {2, float64(3)}, {3, float64(1)}},
+			},
+			{
+				Query:    "SELECT pk FROM strs WHERE s = 1 OR s = 0 ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+		},
+	},
 }
 
 var CreateCheckConstraintsScripts = []ScriptTest{
@@ -0,0 +1,145 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import "testing"
+
+// SuiteTest is one of the tests of the compatibility suite run by TestSuite.
+type SuiteTest struct {
+	// Name is the name of the subtest the test is run as.
+	Name string
+	// Run runs the test against the harness given.
+	Run func(t *testing.T, harness Harness)
+	// Supported returns whether the harness given supports the features the test needs. Tests with no Supported
+	// function are run for every harness.
+	Supported func(harness Harness) bool
+}
+
+// SuiteTests are the tests run by TestSuite, in the order they're run. Integrators that need finer control over the
+// suite than SkippingHarness gives them can run a subset of these with TestSuiteTests.
+var SuiteTests = []SuiteTest{
+	{Name: "Queries", Run: TestQueries},
+	{Name: "QueryPlans", Run: TestQueryPlans, Supported: supportsNativeIndexes},
+	{Name: "VersionedQueries", Run: TestVersionedQueries, Supported: supportsVersioning},
+	{Name: "QueryErrors", Run: TestQueryErrors},
+	{Name: "InfoSchema", Run: TestInfoSchema},
+	{Name: "ReadOnlyDatabases", Run: TestReadOnlyDatabases, Supported: supportsReadOnlyDatabases},
+	{Name: "ColumnAliases", Run: TestColumnAliases},
+	{Name: "OrderByGroupBy", Run: TestOrderByGroupBy},
+	{Name: "AmbiguousColumnResolution", Run: TestAmbiguousColumnResolution},
+	{Name: "InsertInto", Run: TestInsertInto},
+	{Name: "InsertIgnoreInto", Run: TestInsertIgnoreInto},
+	{Name: "InsertIntoErrors", Run: TestInsertIntoErrors},
+	{Name: "LoadData", Run: TestLoadData},
+	{Name: "LoadDataErrors", Run: TestLoadDataErrors},
+	{Name: "ReplaceInto", Run: TestReplaceInto},
+	{Name: "ReplaceIntoErrors", Run: TestReplaceIntoErrors},
+	{Name: "Update", Run: TestUpdate},
+	{Name: "UpdateErrors", Run: TestUpdateErrors},
+	{Name: "Delete", Run: TestDelete},
+	{Name: "DeleteErrors", Run: TestDeleteErrors},
+	{Name: "Truncate", Run: TestTruncate},
+	{Name: "Scripts", Run: TestScripts},
+	{Name: "ComplexIndexQueries", Run: TestComplexIndexQueries},
+	{Name: "Triggers", Run: TestTriggers},
+	{Name: "TriggerErrors", Run: TestTriggerErrors},
+	{Name: "StoredProcedures", Run: TestStoredProcedures},
+	{Name: "Views", Run: TestViews},
+	{Name: "VersionedViews", Run: TestVersionedViews, Supported: supportsVersioning},
+	{Name: "CreateTable", Run: TestCreateTable},
+	{Name: "DropTable", Run: TestDropTable},
+	{Name: "RenameTable", Run: TestRenameTable},
+	{Name: "RenameColumn", Run: TestRenameColumn},
+	{Name: "AddColumn", Run: TestAddColumn},
+	{Name: "ModifyColumn", Run: TestModifyColumn},
+	{Name: "DropColumn", Run: TestDropColumn},
+	{Name: "CreateDatabase", Run: TestCreateDatabase},
+	{Name: "DropDatabase", Run: TestDropDatabase},
+	{Name: "PkOrdinals", Run: TestPkOrdinals},
+	{Name: "CreateForeignKeys", Run: TestCreateForeignKeys, Supported: supportsForeignKeys},
+	{Name: "DropForeignKeys", Run: TestDropForeignKeys, Supported: supportsForeignKeys},
+	{Name: "CreateCheckConstraints", Run: TestCreateCheckConstraints},
+	{Name: "ChecksOnInsert", Run: TestChecksOnInsert},
+	{Name: "ChecksOnUpdate", Run: TestChecksOnUpdate},
+	{Name: "DisallowedCheckConstraints", Run: TestDisallowedCheckConstraints},
+	{Name: "DropCheckConstraints", Run: TestDropCheckConstraints},
+	{Name: "DropConstraints", Run: TestDropConstraints, Supported: supportsForeignKeys},
+	{Name: "Explode", Run: TestExplode},
+	{Name: "ReadOnly", Run: TestReadOnly},
+	{Name: "NaturalJoin", Run: TestNaturalJoin},
+	{Name: "NaturalJoinEqual", Run: TestNaturalJoinEqual},
+	{Name: "NaturalJoinDisjoint", Run: TestNaturalJoinDisjoint},
+	{Name: "InnerNestedInNaturalJoins", Run: TestInnerNestedInNaturalJoins},
+	{Name: "WindowAgg", Run: TestWindowAgg},
+	{Name: "ColumnDefaults", Run: TestColumnDefaults},
+	{Name: "AlterTable", Run: TestAlterTable},
+	{Name: "AddDropPks", Run: TestAddDropPks},
+	{Name: "DateParse", Run: TestDateParse},
+	{Name: "JsonScripts", Run: TestJsonScripts},
+	{Name: "ShowTableStatus", Run: TestShowTableStatus},
+	{Name: "Variables", Run: TestVariables},
+	{Name: "VariableErrors", Run: TestVariableErrors},
+	{Name: "Warnings", Run: TestWarnings},
+	{Name: "ClearWarnings", Run: TestClearWarnings},
+	{Name: "Use", Run: TestUse},
+	{Name: "SessionSelectLimit", Run: TestSessionSelectLimit},
+	{Name: "Tracing", Run: TestTracing},
+	{Name: "CurrentTimestamp", Run: TestCurrentTimestamp},
+}
+
+// TestSuite runs the whole compatibility suite against harnesses returned by the function given: the queries and
+// scripts used to develop the engine with their expected results and, for harnesses that support native indexes, the
+// expected plans of PlanTests. It's the single entry point for integrators that want to validate that their
+// sql.Database implementation behaves like the in-memory one the engine is developed against. Since tests leave
+// sessions and databases behind, every test is run with a new harness. Tests that need features the harness doesn't
+// support, according to the optional harness interfaces it implements, are skipped. Individual queries can be skipped
+// by implementing SkippingHarness.
+func TestSuite(t *testing.T, newHarness func() Harness) {
+	TestSuiteTests(t, SuiteTests, newHarness)
+}
+
+// TestSuiteTests runs the suite tests given like TestSuite does.
+func TestSuiteTests(t *testing.T, tests []SuiteTest, newHarness func() Harness) {
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			harness := newHarness()
+			if test.Supported != nil && !test.Supported(harness) {
+				t.Skipf("Skipping %s, the harness doesn't support it", test.Name)
+			}
+			test.Run(t, harness)
+		})
+	}
+}
+
+func supportsNativeIndexes(harness Harness) bool {
+	ih, ok := harness.(IndexHarness)
+	return ok && ih.SupportsNativeIndexCreation()
+}
+
+func supportsForeignKeys(harness Harness) bool {
+	fkh, ok := harness.(ForeignKeyHarness)
+	return ok && fkh.SupportsForeignKeys()
+}
+
+func supportsVersioning(harness Harness) bool {
+	_, ok := harness.(VersionedDBHarness)
+	return ok
+}
+
+func supportsReadOnlyDatabases(harness Harness) bool {
+	_, ok := harness.(ReadOnlyDatabaseHarness)
+	return ok
+}
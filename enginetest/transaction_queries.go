@@ -924,4 +924,49 @@ var TransactionTests = []TransactionTest{
 			},
 		},
 	},
+	{
+		Name: "consistent snapshot",
+		SetUpScript: []string{
+			"create table t (x int primary key, y int)",
+			"insert into t values (1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/* client a */ SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "/* client a */ START TRANSACTION /*!40100 WITH CONSISTENT SNAPSHOT */",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ insert into t values (2, 2)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "/* client a */ SELECT /*!40001 SQL_NO_CACHE */ * FROM t",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "/* client a */ COMMIT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ select * from t order by x",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:       "/* client a */ insert into t values (3, 3)",
+				ExpectedErr: sql.ErrReadOnlyTransaction,
+			},
+			{
+				Query:    "/* client a */ COMMIT",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 }
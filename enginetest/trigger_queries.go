@@ -47,7 +47,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (7), (9)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 		},
@@ -77,7 +77,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (7), (9)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 		},
@@ -130,7 +130,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (7), (9)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 		},
@@ -160,7 +160,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (7), (9)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 		},
@@ -238,7 +238,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a (y, x) values (5,7), (9,11)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -260,7 +260,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a (y, x) values (5,7), (9,11)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -316,7 +316,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into test.a values (7), (9)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 		},
@@ -817,7 +817,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (2), (3), (5)",
 				Expected: []sql.Row{
-					{sql.NewOkResult(3)},
+					{sql.OkResult{RowsAffected: 3, Info: plan.InsertInfo{Records: 3}}},
 				},
 			},
 			{
@@ -846,7 +846,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (1), (3)",
 				Expected: []sql.Row{
-					{sql.NewOkResult(2)},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -871,7 +871,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (1), (3)",
 				Expected: []sql.Row{
-					{sql.NewOkResult(2)},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -1035,7 +1035,7 @@ var TriggerTests = []ScriptTest{
 			{
 				Query: "insert into a values (1), (3)",
 				Expected: []sql.Row{
-					{sql.NewOkResult(2)},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
@@ -1176,7 +1176,7 @@ end;`,
 			{
 				Query: "insert into a values (1), (3)",
 				Expected: []sql.Row{
-					{sql.OkResult{RowsAffected: 2}},
+					{sql.OkResult{RowsAffected: 2, Info: plan.InsertInfo{Records: 2}}},
 				},
 			},
 			{
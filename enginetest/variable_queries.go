@@ -230,7 +230,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @myvar",
 		Expected: []sql.Row{
-			{123.4},
+			{"123.4"},
 		},
 	},
 	{
@@ -240,7 +240,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @myvar, @@auto_increment_increment",
 		Expected: []sql.Row{
-			{123.4, 1234},
+			{"123.4", 1234},
 		},
 	},
 	{
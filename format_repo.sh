@@ -16,7 +16,7 @@
 
 set -eo pipefail
 
-paths=`find . -maxdepth 1 -mindepth 1 \( -name gen -prune -o -type d -print -o -type f -name '*.go' -print \)`
+paths=`find . -maxdepth 1 -mindepth 1 \( -name gen -prune -o -name third_party -prune -o -type d -print -o -type f -name '*.go' -print \)`
 
 goimports -w -local github.com/dolthub/go-mysql-server $paths
 
@@ -15,6 +15,8 @@
 package regex
 
 import (
+	"context"
+
 	"github.com/go-kit/kit/metrics/discard"
 	errors "gopkg.in/src-d/go-errors.v1"
 )
@@ -38,6 +40,21 @@ type Matcher interface {
 	Match(text string) bool
 }
 
+// ContextMatcher is implemented by the matchers whose matches can be cancelled and limited.
+type ContextMatcher interface {
+	// MatchContext returns true if the text matches the regular expression. It returns an error if the context is
+	// done or the match exceeds the limits given before it ends.
+	MatchContext(ctx context.Context, text string, limits Limits) (bool, error)
+}
+
+// Limits bound the resources a match can use. Zero means no limit.
+type Limits struct {
+	// Stack is the maximum size in bytes of the backtracking stack, as in the regexp_stack_limit system variable.
+	Stack int64
+	// Time is the maximum number of steps of a match, in the units of the regexp_time_limit system variable.
+	Time int64
+}
+
 // Disposer interface is used to release resources.
 // The interface should be implemented by all go binding for native C libraries
 type Disposer interface {
@@ -111,6 +128,14 @@ func (dm *disposableMatcher) Match(s string) bool {
 	return dm.m.Match(s)
 }
 
+// MatchContext implements the ContextMatcher interface. Matchers that can't be limited ignore the context and limits.
+func (dm *disposableMatcher) MatchContext(ctx context.Context, s string, limits Limits) (bool, error) {
+	if cm, ok := dm.m.(ContextMatcher); ok {
+		return cm.MatchContext(ctx, s, limits)
+	}
+	return dm.m.Match(s), nil
+}
+
 func (dm *disposableMatcher) Dispose() {
 	dm.d.Dispose()
 }
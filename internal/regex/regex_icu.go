@@ -0,0 +1,1163 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regex
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrICUSyntax is returned when a regular expression can't be parsed by the ICU engine.
+	ErrICUSyntax = errors.NewKind("Syntax error in regular expression on line 1, character %d: %s")
+	// ErrICUStackOverflow is returned when a match needs a deeper backtracking stack than its limit allows.
+	ErrICUStackOverflow = errors.NewKind("Overflow in the regular expression backtrack stack.")
+	// ErrICUTimeout is returned when a match takes more steps than its limit allows.
+	ErrICUTimeout = errors.NewKind("Timeout exceeded in regular expression match.")
+)
+
+const (
+	// icuFrameSize is the number of bytes of the stack limit each nested match counts for.
+	icuFrameSize = 128
+	// icuMaxDepth is the deepest a match can nest whatever the stack limit. Nested matches use up to about 512 bytes
+	// of the goroutine stack each, so this keeps it well under Go's maximum stack size, whose overflow can't be
+	// recovered from.
+	icuMaxDepth = 1 << 19
+	// icuStepsPerTimeUnit is the number of steps each unit of the time limit stands for, as in ICU.
+	icuStepsPerTimeUnit = 10000
+	// icuCheckInterval is the number of steps between checks of the context.
+	icuCheckInterval = 1 << 12
+)
+
+// DefaultICULimits are the limits of matches that aren't given any, the defaults of regexp_stack_limit and
+// regexp_time_limit.
+var DefaultICULimits = Limits{Stack: 8000000, Time: 32}
+
+// ICU is a backtracking regular expression engine following the syntax of ICU, the library MySQL uses for regular
+// expressions. Besides what Go's regexp package supports, it supports the constructs that need backtracking:
+//   - lookaheads (?=...) and (?!...), and lookbehinds (?<=...) and (?<!...)
+//   - backreferences \1 and \k<name>
+//   - atomic groups (?>...) and possessive quantifiers *+, ++, ?+ and {n,m}+
+//
+// It also supports the usual ICU escapes such as \d, \w, \s, \p{L}, \b, \A, \z, \Z and \Q...\E, POSIX classes in
+// sets such as [[:alpha:]], and the i, m, s and x flags. As in ICU, matches are limited in the depth of their
+// backtracking stack and in the number of steps they take, and fail with an error when they exceed either.
+type ICU struct {
+	root    *icuNode
+	nGroups int
+}
+
+// Match implements the Matcher interface. It matches with the default limits, and doesn't match when the match
+// exceeds them.
+func (r *ICU) Match(s string) bool {
+	ok, err := r.MatchContext(context.Background(), s, DefaultICULimits)
+	return ok && err == nil
+}
+
+// MatchContext implements the ContextMatcher interface. As in MySQL, the text matches if the expression matches any
+// of its substrings. The step limit applies to the match at each starting position.
+func (r *ICU) MatchContext(ctx context.Context, s string, limits Limits) (bool, error) {
+	t := time.Now()
+	defer func() {
+		MatchHistogram.With("string", s, "duration", "seconds").Observe(time.Since(t).Seconds())
+	}()
+
+	m := &icuMatcher{
+		ctx:      ctx,
+		input:    []rune(s),
+		caps:     make([]int, 2*(r.nGroups+1)),
+		maxDepth: icuMaxDepth,
+	}
+	if limits.Stack > 0 && limits.Stack/icuFrameSize < icuMaxDepth {
+		m.maxDepth = int(limits.Stack / icuFrameSize)
+	}
+	if limits.Time > 0 {
+		m.maxSteps = limits.Time * icuStepsPerTimeUnit
+	}
+
+	for start := 0; start <= len(m.input); start++ {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+		for i := range m.caps {
+			m.caps[i] = -1
+		}
+		m.steps = 0
+		ok := m.match(r.root, start, func(int) bool { return true })
+		if m.err != nil {
+			return false, m.err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Dispose implements the Disposer interface.
+func (*ICU) Dispose() {}
+
+// NewICU compiles the ICU regular expression given.
+func NewICU(re string) (Matcher, Disposer, error) {
+	t := time.Now()
+	p := &icuParser{re: []rune(re), names: make(map[string]int)}
+	root, err := p.parse()
+	if err != nil {
+		return nil, nil, err
+	}
+	CompileHistogram.With("regex", re, "duration", "seconds").Observe(time.Since(t).Seconds())
+
+	r := ICU{root: root, nGroups: p.nGroups}
+	return &r, &r, nil
+}
+
+func init() {
+	err := Register("icu", NewICU)
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+type icuNodeKind byte
+
+const (
+	icuLiteral icuNodeKind = iota
+	icuAnyChar
+	icuCharSet
+	icuLineStart
+	icuLineEnd
+	icuTextStart
+	icuTextEnd
+	icuTextEndBeforeNewline
+	icuWordBoundary
+	icuNotWordBoundary
+	icuConcat
+	icuAlternate
+	icuCapture
+	icuRepeat
+	icuAtomic
+	icuLookahead
+	icuLookbehind
+	icuBackref
+)
+
+type icuNode struct {
+	kind icuNodeKind
+	subs []*icuNode
+	// r is the character of literals.
+	r rune
+	// set is the predicate of character sets.
+	set func(rune) bool
+	// group is the number of the group of captures and backreferences.
+	group int
+	// min and max are the bounds of repetitions, where a max of -1 means there's none.
+	min, max int
+	// lazy and possessive are set for repetitions that match as few times as possible, and as many times as possible
+	// without backtracking.
+	lazy, possessive bool
+	// negate is set for negative lookarounds.
+	negate bool
+	// foldCase, multiline and dotAll are the flags in effect where the node is.
+	foldCase, multiline, dotAll bool
+}
+
+type icuFlags struct {
+	foldCase, multiline, dotAll, extended bool
+}
+
+type icuParser struct {
+	re      []rune
+	pos     int
+	flags   icuFlags
+	nGroups int
+	names   map[string]int
+	// backrefs are checked to refer to existing groups once the whole expression is parsed.
+	backrefs []*icuNode
+}
+
+func (p *icuParser) parse() (*icuNode, error) {
+	root, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.re) {
+		return nil, p.error("unmatched closing parenthesis")
+	}
+	for _, b := range p.backrefs {
+		if b.group > p.nGroups {
+			return nil, ErrICUSyntax.New(len(p.re), "backreference to a missing group")
+		}
+	}
+	return root, nil
+}
+
+func (p *icuParser) parseAlternation() (*icuNode, error) {
+	var alts []*icuNode
+	for {
+		seq, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		alts = append(alts, seq)
+		if !p.consume('|') {
+			break
+		}
+	}
+	if len(alts) == 1 {
+		return alts[0], nil
+	}
+	return &icuNode{kind: icuAlternate, subs: alts}, nil
+}
+
+func (p *icuParser) parseConcat() (*icuNode, error) {
+	seq := &icuNode{kind: icuConcat}
+	for {
+		p.skipExtended()
+		if p.pos == len(p.re) || p.peek() == '|' || p.peek() == ')' {
+			return seq, nil
+		}
+		atom, err := p.parseAtom()
+		if err != nil {
+			return nil, err
+		}
+		if atom == nil {
+			// Flag settings don't match anything
+			continue
+		}
+		atom, err = p.parseQuantifier(atom)
+		if err != nil {
+			return nil, err
+		}
+		seq.subs = append(seq.subs, atom)
+	}
+}
+
+func (p *icuParser) parseAtom() (*icuNode, error) {
+	r := p.re[p.pos]
+	p.pos++
+	switch r {
+	case '(':
+		return p.parseGroup()
+	case '[':
+		set, err := p.parseSet()
+		if err != nil {
+			return nil, err
+		}
+		return p.newSet(set), nil
+	case '.':
+		return &icuNode{kind: icuAnyChar, dotAll: p.flags.dotAll}, nil
+	case '^':
+		return &icuNode{kind: icuLineStart, multiline: p.flags.multiline}, nil
+	case '$':
+		return &icuNode{kind: icuLineEnd, multiline: p.flags.multiline}, nil
+	case '*', '+', '?':
+		return nil, p.errorAt(p.pos-1, "nothing to repeat")
+	case '\\':
+		return p.parseEscapeAtom()
+	default:
+		return p.newLiteral(r), nil
+	}
+}
+
+func (p *icuParser) parseGroup() (*icuNode, error) {
+	start := p.pos - 1
+	saved := p.flags
+	defer func() { p.flags = saved }()
+
+	var node *icuNode
+	switch {
+	case p.consumeString("?:"):
+		node = &icuNode{kind: icuConcat}
+	case p.consumeString("?>"):
+		node = &icuNode{kind: icuAtomic}
+	case p.consumeString("?="):
+		node = &icuNode{kind: icuLookahead}
+	case p.consumeString("?!"):
+		node = &icuNode{kind: icuLookahead, negate: true}
+	case p.consumeString("?<="):
+		node = &icuNode{kind: icuLookbehind}
+	case p.consumeString("?<!"):
+		node = &icuNode{kind: icuLookbehind, negate: true}
+	case p.consumeString("?#"):
+		for p.pos < len(p.re) && p.re[p.pos] != ')' {
+			p.pos++
+		}
+		if !p.consume(')') {
+			return nil, p.errorAt(start, "unterminated comment")
+		}
+		return nil, nil
+	case p.consumeString("?<"):
+		name := p.parseName()
+		if name == "" || !p.consume('>') {
+			return nil, p.error("invalid group name")
+		}
+		if _, ok := p.names[name]; ok {
+			return nil, p.error("duplicate group name")
+		}
+		p.nGroups++
+		p.names[name] = p.nGroups
+		node = &icuNode{kind: icuCapture, group: p.nGroups}
+	case p.consume('?'):
+		flags, scoped, err := p.parseFlags()
+		if err != nil {
+			return nil, err
+		}
+		if !scoped {
+			// The flags apply to the rest of the enclosing group
+			saved = flags
+			return nil, nil
+		}
+		p.flags = flags
+		node = &icuNode{kind: icuConcat}
+	default:
+		p.nGroups++
+		node = &icuNode{kind: icuCapture, group: p.nGroups}
+	}
+
+	sub, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(')') {
+		return nil, p.errorAt(start, "unterminated group")
+	}
+	if node.kind == icuConcat {
+		return sub, nil
+	}
+	node.subs = []*icuNode{sub}
+	return node, nil
+}
+
+// parseFlags parses flag settings like (?i) and (?i-s:...) after the ?, returning whether they only apply to the
+// group they start.
+func (p *icuParser) parseFlags() (icuFlags, bool, error) {
+	flags := p.flags
+	on := true
+	for p.pos < len(p.re) {
+		r := p.re[p.pos]
+		p.pos++
+		switch r {
+		case 'i':
+			flags.foldCase = on
+		case 'm':
+			flags.multiline = on
+		case 's':
+			flags.dotAll = on
+		case 'x':
+			flags.extended = on
+		case '-':
+			if !on {
+				return flags, false, p.errorAt(p.pos-1, "invalid flag")
+			}
+			on = false
+		case ')':
+			return flags, false, nil
+		case ':':
+			return flags, true, nil
+		default:
+			return flags, false, p.errorAt(p.pos-1, "invalid flag")
+		}
+	}
+	return flags, false, p.error("unterminated flag setting")
+}
+
+func (p *icuParser) parseQuantifier(atom *icuNode) (*icuNode, error) {
+	p.skipExtended()
+	if p.pos == len(p.re) {
+		return atom, nil
+	}
+
+	start := p.pos
+	min, max := 0, -1
+	switch p.re[p.pos] {
+	case '*':
+		p.pos++
+	case '+':
+		p.pos++
+		min = 1
+	case '?':
+		p.pos++
+		max = 1
+	case '{':
+		p.pos++
+		var ok bool
+		if min, max, ok = p.parseBounds(); !ok {
+			// Braces that aren't bounds are literals
+			p.pos = start
+			return atom, nil
+		}
+		if max != -1 && min > max {
+			return nil, p.errorAt(start, "invalid repetition bounds")
+		}
+	default:
+		return atom, nil
+	}
+
+	node := &icuNode{kind: icuRepeat, subs: []*icuNode{atom}, min: min, max: max}
+	if p.consume('?') {
+		node.lazy = true
+	} else if p.consume('+') {
+		node.possessive = true
+	}
+	return node, nil
+}
+
+// parseBounds parses the bounds of a repetition after the opening brace: {n}, {n,} or {n,m}.
+func (p *icuParser) parseBounds() (min, max int, ok bool) {
+	min, ok = p.parseInt()
+	if !ok {
+		return 0, 0, false
+	}
+	max = min
+	if p.consume(',') {
+		max = -1
+		if p.pos < len(p.re) && p.re[p.pos] != '}' {
+			if max, ok = p.parseInt(); !ok {
+				return 0, 0, false
+			}
+		}
+	}
+	return min, max, p.consume('}')
+}
+
+func (p *icuParser) parseInt() (int, bool) {
+	start := p.pos
+	for p.pos < len(p.re) && p.re[p.pos] >= '0' && p.re[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.Atoi(string(p.re[start:p.pos]))
+	return n, err == nil
+}
+
+// parseEscapeAtom parses an escape sequence outside of a set, after the backslash.
+func (p *icuParser) parseEscapeAtom() (*icuNode, error) {
+	if p.pos == len(p.re) {
+		return nil, p.error("trailing backslash")
+	}
+
+	r := p.re[p.pos]
+	switch r {
+	case 'b':
+		p.pos++
+		return &icuNode{kind: icuWordBoundary}, nil
+	case 'B':
+		p.pos++
+		return &icuNode{kind: icuNotWordBoundary}, nil
+	case 'A':
+		p.pos++
+		return &icuNode{kind: icuTextStart}, nil
+	case 'z':
+		p.pos++
+		return &icuNode{kind: icuTextEnd}, nil
+	case 'Z':
+		p.pos++
+		return &icuNode{kind: icuTextEndBeforeNewline}, nil
+	case 'Q':
+		p.pos++
+		seq := &icuNode{kind: icuConcat}
+		for p.pos < len(p.re) && !p.consumeString(`\E`) {
+			seq.subs = append(seq.subs, p.newLiteral(p.re[p.pos]))
+			p.pos++
+		}
+		return seq, nil
+	case 'k':
+		p.pos++
+		if !p.consume('<') {
+			return nil, p.error("invalid named backreference")
+		}
+		name := p.parseName()
+		group, ok := p.names[name]
+		if !ok || !p.consume('>') {
+			return nil, p.error("invalid named backreference")
+		}
+		return p.newBackref(group), nil
+	}
+
+	if r >= '1' && r <= '9' {
+		// As in ICU, the group number is made of as many digits as there are groups
+		group := int(r - '0')
+		p.pos++
+		for p.pos < len(p.re) && p.re[p.pos] >= '0' && p.re[p.pos] <= '9' {
+			next := group*10 + int(p.re[p.pos]-'0')
+			if next > p.nGroups {
+				break
+			}
+			group = next
+			p.pos++
+		}
+		return p.newBackref(group), nil
+	}
+
+	c, set, err := p.parseEscape()
+	if err != nil {
+		return nil, err
+	}
+	if set != nil {
+		return p.newSet(set), nil
+	}
+	return p.newLiteral(c), nil
+}
+
+// parseEscape parses an escape sequence that can be used both in and out of sets, after the backslash. It returns
+// either the character the sequence stands for, or the predicate of the characters it stands for, like for \d.
+func (p *icuParser) parseEscape() (rune, func(rune) bool, error) {
+	start := p.pos - 1
+	if p.pos == len(p.re) {
+		return 0, nil, p.error("trailing backslash")
+	}
+
+	r := p.re[p.pos]
+	p.pos++
+	switch r {
+	case 'd':
+		return 0, unicode.IsDigit, nil
+	case 'D':
+		return 0, negate(unicode.IsDigit), nil
+	case 'w':
+		return 0, isWordChar, nil
+	case 'W':
+		return 0, negate(isWordChar), nil
+	case 's':
+		return 0, unicode.IsSpace, nil
+	case 'S':
+		return 0, negate(unicode.IsSpace), nil
+	case 'p', 'P':
+		if p.pos == len(p.re) {
+			return 0, nil, p.errorAt(start, "missing property name")
+		}
+		name := string(p.re[p.pos])
+		if p.consume('{') {
+			end := p.pos
+			for end < len(p.re) && p.re[end] != '}' {
+				end++
+			}
+			if end == len(p.re) {
+				return 0, nil, p.errorAt(start, "unterminated property name")
+			}
+			name = string(p.re[p.pos:end])
+			p.pos = end + 1
+		} else if p.pos < len(p.re) {
+			p.pos++
+		}
+		table, ok := unicodeTable(name)
+		if !ok {
+			return 0, nil, p.errorAt(start, "unknown property "+name)
+		}
+		set := func(r rune) bool { return unicode.Is(table, r) }
+		if r == 'P' {
+			set = negate(set)
+		}
+		return 0, set, nil
+	case 't':
+		return '\t', nil, nil
+	case 'n':
+		return '\n', nil, nil
+	case 'r':
+		return '\r', nil, nil
+	case 'f':
+		return '\f', nil, nil
+	case 'a':
+		return '\a', nil, nil
+	case 'e':
+		return '\x1b', nil, nil
+	case 'x':
+		if p.consume('{') {
+			c, ok := p.parseHex(8, '}')
+			if !ok || !p.consume('}') {
+				return 0, nil, p.errorAt(start, "invalid hexadecimal escape")
+			}
+			return c, nil, nil
+		}
+		return p.parseFixedHex(start, 2)
+	case 'u':
+		return p.parseFixedHex(start, 4)
+	case 'U':
+		return p.parseFixedHex(start, 8)
+	case '0':
+		c := rune(0)
+		for i := 0; i < 3 && p.pos < len(p.re) && p.re[p.pos] >= '0' && p.re[p.pos] <= '7'; i++ {
+			c = c*8 + p.re[p.pos] - '0'
+			p.pos++
+		}
+		return c, nil, nil
+	}
+
+	if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		return 0, nil, p.errorAt(start, "unsupported escape sequence")
+	}
+	// Escaped symbols stand for themselves
+	return r, nil, nil
+}
+
+func (p *icuParser) parseFixedHex(start, digits int) (rune, func(rune) bool, error) {
+	pos := p.pos
+	c, ok := p.parseHex(digits, 0)
+	if !ok || p.pos-pos != digits {
+		return 0, nil, p.errorAt(start, "invalid hexadecimal escape")
+	}
+	return c, nil, nil
+}
+
+// parseHex parses up to the number of hexadecimal digits given, stopping at the terminator given.
+func (p *icuParser) parseHex(maxDigits int, terminator rune) (rune, bool) {
+	start := p.pos
+	for p.pos < len(p.re) && p.pos-start < maxDigits && p.re[p.pos] != terminator {
+		p.pos++
+	}
+	n, err := strconv.ParseUint(string(p.re[start:p.pos]), 16, 32)
+	if err != nil || n > unicode.MaxRune {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+// parseSet parses a set of characters such as [a-z\d[:punct:]], after the opening bracket.
+func (p *icuParser) parseSet() (func(rune) bool, error) {
+	start := p.pos - 1
+	negated := p.consume('^')
+
+	var ranges []rune
+	var sets []func(rune) bool
+	for first := true; ; first = false {
+		if p.pos == len(p.re) {
+			return nil, p.errorAt(start, "unterminated set")
+		}
+		if p.re[p.pos] == ']' && !first {
+			p.pos++
+			break
+		}
+
+		if p.consumeString("[:") {
+			set, err := p.parsePOSIXClass()
+			if err != nil {
+				return nil, err
+			}
+			sets = append(sets, set)
+			continue
+		}
+		if p.consume('[') {
+			set, err := p.parseSet()
+			if err != nil {
+				return nil, err
+			}
+			sets = append(sets, set)
+			continue
+		}
+
+		lo, set, err := p.parseSetChar()
+		if err != nil {
+			return nil, err
+		}
+		if set != nil {
+			sets = append(sets, set)
+			continue
+		}
+		hi := lo
+		if p.pos+1 < len(p.re) && p.re[p.pos] == '-' && p.re[p.pos+1] != ']' {
+			p.pos++
+			if hi, set, err = p.parseSetChar(); err != nil {
+				return nil, err
+			}
+			if set != nil || hi < lo {
+				return nil, p.error("invalid range in set")
+			}
+		}
+		ranges = append(ranges, lo, hi)
+	}
+
+	set := func(r rune) bool {
+		for i := 0; i < len(ranges); i += 2 {
+			if r >= ranges[i] && r <= ranges[i+1] {
+				return true
+			}
+		}
+		for _, s := range sets {
+			if s(r) {
+				return true
+			}
+		}
+		return false
+	}
+	if p.flags.foldCase {
+		set = foldSet(set)
+	}
+	if negated {
+		set = negate(set)
+	}
+	return set, nil
+}
+
+func (p *icuParser) parseSetChar() (rune, func(rune) bool, error) {
+	r := p.re[p.pos]
+	p.pos++
+	if r != '\\' {
+		return r, nil, nil
+	}
+	if p.consume('b') {
+		// \b is a backspace in sets
+		return '\b', nil, nil
+	}
+	return p.parseEscape()
+}
+
+// parsePOSIXClass parses a POSIX class like [:alpha:] or [:^alpha:], after the [:.
+func (p *icuParser) parsePOSIXClass() (func(rune) bool, error) {
+	start := p.pos - 2
+	negated := p.consume('^')
+	name := p.parseName()
+	if !p.consumeString(":]") {
+		return nil, p.errorAt(start, "invalid POSIX class")
+	}
+
+	set, ok := posixClasses[strings.ToLower(name)]
+	if !ok {
+		return nil, p.errorAt(start, "unknown POSIX class "+name)
+	}
+	if negated {
+		set = negate(set)
+	}
+	return set, nil
+}
+
+func (p *icuParser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.re) && (isWordChar(p.re[p.pos]) && p.re[p.pos] < unicode.MaxASCII) {
+		p.pos++
+	}
+	return string(p.re[start:p.pos])
+}
+
+func (p *icuParser) newLiteral(r rune) *icuNode {
+	return &icuNode{kind: icuLiteral, r: r, foldCase: p.flags.foldCase}
+}
+
+func (p *icuParser) newSet(set func(rune) bool) *icuNode {
+	if p.flags.foldCase {
+		set = foldSet(set)
+	}
+	return &icuNode{kind: icuCharSet, set: set}
+}
+
+func (p *icuParser) newBackref(group int) *icuNode {
+	n := &icuNode{kind: icuBackref, group: group, foldCase: p.flags.foldCase}
+	p.backrefs = append(p.backrefs, n)
+	return n
+}
+
+// skipExtended skips the white space and comments of the expression if the x flag is set.
+func (p *icuParser) skipExtended() {
+	if !p.flags.extended {
+		return
+	}
+	for p.pos < len(p.re) {
+		switch {
+		case unicode.IsSpace(p.re[p.pos]):
+			p.pos++
+		case p.re[p.pos] == '#':
+			for p.pos < len(p.re) && p.re[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *icuParser) peek() rune {
+	return p.re[p.pos]
+}
+
+func (p *icuParser) consume(r rune) bool {
+	if p.pos < len(p.re) && p.re[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *icuParser) consumeString(s string) bool {
+	rs := []rune(s)
+	if p.pos+len(rs) > len(p.re) {
+		return false
+	}
+	for i, r := range rs {
+		if p.re[p.pos+i] != r {
+			return false
+		}
+	}
+	p.pos += len(rs)
+	return true
+}
+
+func (p *icuParser) error(msg string) error {
+	return p.errorAt(p.pos, msg)
+}
+
+// errorAt returns a syntax error at the position given, which is 1-based in the message as in MySQL.
+func (p *icuParser) errorAt(pos int, msg string) error {
+	return ErrICUSyntax.New(pos+1, msg)
+}
+
+var posixClasses = map[string]func(rune) bool{
+	"alpha":  unicode.IsLetter,
+	"digit":  unicode.IsDigit,
+	"alnum":  func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) },
+	"upper":  unicode.IsUpper,
+	"lower":  unicode.IsLower,
+	"space":  unicode.IsSpace,
+	"blank":  func(r rune) bool { return r == '\t' || unicode.Is(unicode.Zs, r) },
+	"punct":  unicode.IsPunct,
+	"cntrl":  unicode.IsControl,
+	"print":  unicode.IsPrint,
+	"graph":  func(r rune) bool { return unicode.IsGraphic(r) && !unicode.IsSpace(r) },
+	"xdigit": func(r rune) bool { return r < unicode.MaxASCII && strings.ContainsRune("0123456789abcdefABCDEF", r) },
+	"word":   isWordChar,
+}
+
+// unicodeTable returns the table of the Unicode general category, script or property with the name given.
+func unicodeTable(name string) (*unicode.RangeTable, bool) {
+	if t, ok := unicode.Categories[name]; ok {
+		return t, true
+	}
+	if t, ok := unicode.Scripts[name]; ok {
+		return t, true
+	}
+	t, ok := unicode.Properties[name]
+	return t, ok
+}
+
+// isWordChar returns whether the character is matched by \w, which in ICU includes letters, marks, decimal digits and
+// connector punctuation such as _.
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || unicode.Is(unicode.Pc, r)
+}
+
+func isLineTerminator(r rune) bool {
+	return r == '\n' || r == '\r' || r == '\u0085' || r == '\u2028' || r == '\u2029'
+}
+
+func negate(set func(rune) bool) func(rune) bool {
+	return func(r rune) bool { return !set(r) }
+}
+
+// foldSet returns a predicate matching the characters of the set given ignoring case.
+func foldSet(set func(rune) bool) func(rune) bool {
+	return func(r rune) bool {
+		if set(r) {
+			return true
+		}
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if set(f) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func equalFold(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+	return false
+}
+
+// icuMatcher matches a parsed expression against an input by backtracking. Each node is matched at a position and
+// calls a continuation with every position its match can end at, from the preferred one to the least preferred one,
+// until the continuation succeeds.
+type icuMatcher struct {
+	ctx   context.Context
+	input []rune
+	// caps holds the start and end positions of the groups, or -1 for the groups that didn't match.
+	caps []int
+	// depth is the number of nested matches, and steps the number of matches so far. A maxSteps of 0 means there's
+	// no limit.
+	depth, maxDepth int
+	steps, maxSteps int64
+	// err is set when the match is aborted, after which nothing matches.
+	err error
+}
+
+// match matches the node at the position given, and aborts the match with an error once it's too deep, takes too
+// many steps or its context is done.
+func (m *icuMatcher) match(n *icuNode, i int, k func(int) bool) bool {
+	if m.err != nil {
+		return false
+	}
+	m.steps++
+	switch {
+	case m.depth >= m.maxDepth:
+		m.err = ErrICUStackOverflow.New()
+		return false
+	case m.maxSteps > 0 && m.steps > m.maxSteps:
+		m.err = ErrICUTimeout.New()
+		return false
+	case m.steps%icuCheckInterval == 0:
+		select {
+		case <-m.ctx.Done():
+			m.err = m.ctx.Err()
+			return false
+		default:
+		}
+	}
+
+	m.depth++
+	ok := m.matchNode(n, i, k)
+	m.depth--
+	return ok
+}
+
+func (m *icuMatcher) matchNode(n *icuNode, i int, k func(int) bool) bool {
+	switch n.kind {
+	case icuLiteral:
+		if i < len(m.input) && (m.input[i] == n.r || n.foldCase && equalFold(m.input[i], n.r)) {
+			return k(i + 1)
+		}
+		return false
+	case icuAnyChar:
+		if i < len(m.input) && (n.dotAll || !isLineTerminator(m.input[i])) {
+			return k(i + 1)
+		}
+		return false
+	case icuCharSet:
+		if i < len(m.input) && n.set(m.input[i]) {
+			return k(i + 1)
+		}
+		return false
+	case icuLineStart:
+		if i == 0 || n.multiline && isLineTerminator(m.input[i-1]) {
+			return k(i)
+		}
+		return false
+	case icuLineEnd:
+		if n.multiline {
+			if i == len(m.input) || isLineTerminator(m.input[i]) {
+				return k(i)
+			}
+			return false
+		}
+		return m.atTextEndBeforeNewline(i) && k(i)
+	case icuTextStart:
+		return i == 0 && k(i)
+	case icuTextEnd:
+		return i == len(m.input) && k(i)
+	case icuTextEndBeforeNewline:
+		return m.atTextEndBeforeNewline(i) && k(i)
+	case icuWordBoundary, icuNotWordBoundary:
+		before := i > 0 && isWordChar(m.input[i-1])
+		after := i < len(m.input) && isWordChar(m.input[i])
+		if (before != after) == (n.kind == icuWordBoundary) {
+			return k(i)
+		}
+		return false
+	case icuConcat:
+		return m.matchSequence(n.subs, i, k)
+	case icuAlternate:
+		for _, sub := range n.subs {
+			if m.match(sub, i, k) {
+				return true
+			}
+		}
+		return false
+	case icuCapture:
+		start, end := m.caps[2*n.group], m.caps[2*n.group+1]
+		if m.match(n.subs[0], i, func(j int) bool {
+			m.caps[2*n.group], m.caps[2*n.group+1] = i, j
+			return k(j)
+		}) {
+			return true
+		}
+		m.caps[2*n.group], m.caps[2*n.group+1] = start, end
+		return false
+	case icuRepeat:
+		if n.possessive {
+			return m.matchAtomic(n, i, k)
+		}
+		return m.matchRepeat(n, i, 0, k)
+	case icuAtomic:
+		return m.matchAtomic(n.subs[0], i, k)
+	case icuLookahead:
+		return m.matchLookaround(n, i, k, func() bool {
+			return m.match(n.subs[0], i, func(int) bool { return true })
+		})
+	case icuLookbehind:
+		return m.matchLookaround(n, i, k, func() bool {
+			for start := i; start >= 0; start-- {
+				if m.match(n.subs[0], start, func(j int) bool { return j == i }) {
+					return true
+				}
+			}
+			return false
+		})
+	case icuBackref:
+		start, end := m.caps[2*n.group], m.caps[2*n.group+1]
+		if start < 0 {
+			return false
+		}
+		j := i
+		for _, r := range m.input[start:end] {
+			if j == len(m.input) || m.input[j] != r && !(n.foldCase && equalFold(m.input[j], r)) {
+				return false
+			}
+			j++
+		}
+		return k(j)
+	default:
+		panic("unknown regular expression node")
+	}
+}
+
+func (m *icuMatcher) matchSequence(nodes []*icuNode, i int, k func(int) bool) bool {
+	if len(nodes) == 0 {
+		return k(i)
+	}
+	return m.match(nodes[0], i, func(j int) bool {
+		return m.matchSequence(nodes[1:], j, k)
+	})
+}
+
+func (m *icuMatcher) matchRepeat(n *icuNode, i, count int, k func(int) bool) bool {
+	if count == 0 && n.subs[0].matchesOneChar() {
+		return m.matchCharRepeat(n, i, k)
+	}
+	if n.max != -1 && count == n.max {
+		return k(i)
+	}
+
+	more := func() bool {
+		return m.match(n.subs[0], i, func(j int) bool {
+			// Repeating empty matches once there are enough of them would never end
+			if j == i && count >= n.min {
+				return false
+			}
+			return m.matchRepeat(n, j, count+1, k)
+		})
+	}
+	switch {
+	case count < n.min:
+		return more()
+	case n.lazy:
+		return k(i) || more()
+	default:
+		return more() || k(i)
+	}
+}
+
+// matchesOneChar returns whether the node always matches a single character, without any captures.
+func (n *icuNode) matchesOneChar() bool {
+	switch n.kind {
+	case icuLiteral, icuAnyChar, icuCharSet:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchCharRepeat matches a repetition of a node matching a single character without nesting a match for each
+// repetition: it finds how many characters in a row the node matches, and tries the continuation with each count
+// allowed.
+func (m *icuMatcher) matchCharRepeat(n *icuNode, i int, k func(int) bool) bool {
+	sub := n.subs[0]
+	end := i
+	for (n.max == -1 || end-i < n.max) && end < len(m.input) && m.matchNode(sub, end, func(int) bool { return true }) {
+		end++
+		if (end-i)%icuCheckInterval == 0 && m.ctx.Err() != nil {
+			m.err = m.ctx.Err()
+			return false
+		}
+	}
+	if end-i < n.min {
+		return false
+	}
+
+	if n.lazy {
+		for j := i + n.min; j <= end; j++ {
+			if k(j) {
+				return true
+			}
+			if m.err != nil {
+				return false
+			}
+		}
+		return false
+	}
+	for j := end; j >= i+n.min; j-- {
+		if k(j) {
+			return true
+		}
+		if m.err != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// matchAtomic matches the node given without backtracking into it: only its preferred match is tried.
+func (m *icuMatcher) matchAtomic(n *icuNode, i int, k func(int) bool) bool {
+	if n.kind == icuRepeat {
+		// Possessive repetitions are atomic greedy repetitions
+		greedy := *n
+		greedy.possessive, greedy.lazy = false, false
+		n = &greedy
+	}
+
+	saved := append([]int(nil), m.caps...)
+	end := -1
+	if m.match(n, i, func(j int) bool {
+		end = j
+		return true
+	}) && k(end) {
+		return true
+	}
+	copy(m.caps, saved)
+	return false
+}
+
+// matchLookaround matches a lookaround node, whose subexpression is matched by find.
+func (m *icuMatcher) matchLookaround(n *icuNode, i int, k func(int) bool, find func() bool) bool {
+	saved := append([]int(nil), m.caps...)
+	if find() != n.negate && k(i) {
+		return true
+	}
+	copy(m.caps, saved)
+	return false
+}
+
+// atTextEndBeforeNewline returns whether the position is at the end of the input, or before a line terminator ending
+// it.
+func (m *icuMatcher) atTextEndBeforeNewline(i int) bool {
+	switch len(m.input) - i {
+	case 0:
+		return true
+	case 1:
+		return isLineTerminator(m.input[i])
+	case 2:
+		return m.input[i] == '\r' && m.input[i+1] == '\n'
+	default:
+		return false
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regex
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestICU(t *testing.T) {
+	testCases := []struct {
+		re, text string
+		match    bool
+	}{
+		{`abc`, `xxabcxx`, true},
+		{`^abc$`, `xxabcxx`, false},
+		{`a.c`, "a\nc", false},
+		{`(?s)a.c`, "a\nc", true},
+		{`^b`, "a\nb", false},
+		{`(?m)^b$`, "a\nb\nc", true},
+		{`c$`, "abc\n", true},
+		{`c\z`, "abc\n", false},
+		{`c\Z`, "abc\n", true},
+		{`\Aab`, "cab", false},
+		{`a|bc|d`, `xbcx`, true},
+		{`^(a|ab)c$`, `abc`, true},
+		{`^a{2,3}$`, `aaa`, true},
+		{`^a{2,3}$`, `aaaa`, false},
+		{`^a{2}$`, `aa`, true},
+		{`^a{2,}$`, `aaaaa`, true},
+		{`a{,2}`, `a{,2}`, true},
+		{`^a*?b`, `aaab`, true},
+		{`^(a+)+$`, `aaaa`, true},
+		{`^(a*)*$`, `aaaa`, true},
+		{`^a*+a`, `aaaa`, false},
+		{`^a++b`, `aaab`, true},
+		{`^(?>a*)a`, `aaa`, false},
+		{`^(?>a|ab)c`, `abc`, false},
+		{`foo(?=bar)`, `foobar`, true},
+		{`foo(?=bar)`, `foobaz`, false},
+		{`foo(?!bar)`, `foobar`, false},
+		{`foo(?!bar)`, `foobaz`, true},
+		{`^(?=.*\d)(?=.*[a-z]).{6,}$`, `abc123`, true},
+		{`^(?=.*\d)(?=.*[a-z]).{6,}$`, `abcdef`, false},
+		{`(?<=\$)\d+`, `costs $42`, true},
+		{`(?<=\$)\d+`, `costs 42`, false},
+		{`(?<!\$)\b\d+`, `costs $42`, false},
+		{`(?<!\$)\b\d+`, `costs 42`, true},
+		{`^(\w+) \1$`, `hello hello`, true},
+		{`^(\w+) \1$`, `hello world`, false},
+		{`(?i)^(\w+) \1$`, `hello HELLO`, true},
+		{`^(?<word>\w+)-\k<word>$`, `ab-ab`, true},
+		{`^(a)|\1b`, `b`, false},
+		{`\bcat\b`, `a cat!`, true},
+		{`\bcat\b`, `concat`, false},
+		{`\Bcat`, `concat`, true},
+		{`(?i)HeLLo`, `hello`, true},
+		{`(?i:H)ello`, `hello`, true},
+		{`(?i:H)ello`, `hELLO`, false},
+		{`H(?i)ello`, `HELLO`, true},
+		{`(?i)[a-c]+`, `ABC`, true},
+		{`(?x) a b c # comment`, `abc`, true},
+		{`^\d+$`, `123`, true},
+		{`^\D+$`, `12a`, false},
+		{`^\w+$`, `héllo_1`, true},
+		{`^\s$`, "\t", true},
+		{`^\p{Lu}\p{Ll}+$`, `Hello`, true},
+		{`^\P{L}+$`, `123`, true},
+		{`\p{Greek}`, `abc α`, true},
+		{`^[^abc]+$`, `def`, true},
+		{`^[^abc]+$`, `dbf`, false},
+		{`^[]a]+$`, `]a]`, true},
+		{`^[a\-z]+$`, `-az`, true},
+		{`^[\d.]+$`, `1.5`, true},
+		{`^[[:alpha:]]+$`, `abc`, true},
+		{`^[[:^alpha:][:digit:]]+$`, `1-2`, true},
+		{`^[a-c[x-z]]+$`, `axbz`, true},
+		{`^\x41\x{42}C$`, `ABC`, true},
+		{`^\Q.*\E$`, `.*`, true},
+		{`^\Q.*\E$`, `ab`, false},
+		{`a\.b`, `axb`, false},
+		{`(?#comment)ab`, `ab`, true},
+		{`(a)(b)(c)(d)(e)(f)(g)(h)(i)(j)\10`, `abcdefghijj`, true},
+		{``, `abc`, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.re+" "+tt.text, func(t *testing.T) {
+			m, d, err := NewICU(tt.re)
+			require.NoError(t, err)
+			defer d.Dispose()
+			require.Equal(t, tt.match, m.Match(tt.text))
+		})
+	}
+
+	for _, re := range []string{`(ab`, `ab)`, `*a`, `a**`, `[ab`, `a{3,2}`, `\2(a)`, `(?<n>a)\k<m>`, `(?z)`, `\p{Nope}`, `\y`, `a\`, `[z-a]`, `[[:nope:]]`} {
+		t.Run(re, func(t *testing.T) {
+			_, _, err := NewICU(re)
+			require.True(t, ErrICUSyntax.Is(err), "%v", err)
+		})
+	}
+}
+
+func TestICULimits(t *testing.T) {
+	match := func(re, text string, limits Limits) (bool, error) {
+		m, d, err := NewICU(re)
+		require.NoError(t, err)
+		defer d.Dispose()
+		return m.(ContextMatcher).MatchContext(context.Background(), text, limits)
+	}
+
+	long := strings.Repeat("a", 8000000)
+	t.Run("repeated characters don't nest", func(t *testing.T) {
+		ok, err := match(`^a*$`, long, Limits{})
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		ok, err = match(`^[a-z]+?$`, long, Limits{})
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("deep matches overflow the stack limit", func(t *testing.T) {
+		_, err := match(`^(a)*$`, long, DefaultICULimits)
+		require.True(t, ErrICUStackOverflow.Is(err), "%v", err)
+
+		_, err = match(`^(a)*$`, long, Limits{})
+		require.True(t, ErrICUStackOverflow.Is(err), "%v", err)
+
+		ok, err := match(`^(a)*$`, long[:1000], DefaultICULimits)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("catastrophic backtracking times out", func(t *testing.T) {
+		_, err := match(`^(a+)+$`, strings.Repeat("a", 40)+"b", DefaultICULimits)
+		require.True(t, ErrICUTimeout.Is(err), "%v", err)
+	})
+
+	t.Run("cancelled matches stop", func(t *testing.T) {
+		m, d, err := NewICU(`^(a+)+$`)
+		require.NoError(t, err)
+		defer d.Dispose()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = m.(ContextMatcher).MatchContext(ctx, strings.Repeat("a", 40)+"b", Limits{})
+		require.Equal(t, context.Canceled, err)
+
+		ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = m.(ContextMatcher).MatchContext(ctx, strings.Repeat("a", 40)+"b", Limits{})
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// QueryEventKind is the step of the execution of a statement a QueryEvent is about.
+type QueryEventKind byte
+
+const (
+	// QueryStarted is sent when the engine starts running a statement.
+	QueryStarted QueryEventKind = iota
+	// QueryAnalyzed is sent once the statement has been analyzed.
+	QueryAnalyzed
+	// QueryFirstRow is sent when the statement returns its first row.
+	QueryFirstRow
+	// QueryCompleted is sent when the rows of the statement are closed without errors.
+	QueryCompleted
+	// QueryFailed is sent when the statement fails at any step.
+	QueryFailed
+)
+
+func (k QueryEventKind) String() string {
+	switch k {
+	case QueryStarted:
+		return "started"
+	case QueryAnalyzed:
+		return "analyzed"
+	case QueryFirstRow:
+		return "first row"
+	case QueryCompleted:
+		return "completed"
+	case QueryFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// QueryEvent describes a step of the execution of a statement.
+type QueryEvent struct {
+	Kind  QueryEventKind
+	Query string
+	// Plan is the analyzed plan of the statement. It's empty until the statement is analyzed.
+	Plan string
+	// Elapsed is the time since the statement started.
+	Elapsed time.Duration
+	// Rows is the number of rows returned so far.
+	Rows int
+	// Err is the error the statement failed with, for QueryFailed events.
+	Err error
+}
+
+// QueryListener is called with every step of the execution of the statements run by an engine. Listeners are called
+// synchronously, so they should return quickly.
+type QueryListener func(ctx *sql.Context, event QueryEvent)
+
+// queryEvents sends the events of a single statement to the listeners of an engine.
+type queryEvents struct {
+	listeners []QueryListener
+	query     string
+	plan      string
+	start     time.Time
+}
+
+func newQueryEvents(listeners []QueryListener, query string) *queryEvents {
+	return &queryEvents{
+		listeners: listeners,
+		query:     query,
+		start:     time.Now(),
+	}
+}
+
+func (q *queryEvents) send(ctx *sql.Context, kind QueryEventKind, rows int, err error) {
+	if len(q.listeners) == 0 {
+		return
+	}
+
+	event := QueryEvent{
+		Kind:    kind,
+		Query:   q.query,
+		Plan:    q.plan,
+		Elapsed: time.Since(q.start),
+		Rows:    rows,
+		Err:     err,
+	}
+	for _, l := range q.listeners {
+		l(ctx, event)
+	}
+}
+
+func (q *queryEvents) analyzed(ctx *sql.Context, n sql.Node) {
+	if len(q.listeners) == 0 {
+		return
+	}
+	q.plan = n.String()
+	q.send(ctx, QueryAnalyzed, 0, nil)
+}
+
+// queryEventsIter is a RowIter wrapper that sends the events of the statement the rows belong to.
+type queryEventsIter struct {
+	childIter sql.RowIter
+	events    *queryEvents
+	rows      int
+	failed    bool
+}
+
+func (i *queryEventsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.childIter.Next(ctx)
+	if err == io.EOF {
+		return nil, err
+	} else if err != nil {
+		if !i.failed {
+			i.failed = true
+			i.events.send(ctx, QueryFailed, i.rows, err)
+		}
+		return nil, err
+	}
+
+	i.rows++
+	if i.rows == 1 {
+		i.events.send(ctx, QueryFirstRow, i.rows, nil)
+	}
+	return row, nil
+}
+
+func (i *queryEventsIter) Close(ctx *sql.Context) error {
+	err := i.childIter.Close(ctx)
+	if err != nil {
+		if !i.failed {
+			i.failed = true
+			i.events.send(ctx, QueryFailed, i.rows, err)
+		}
+		return err
+	}
+
+	if !i.failed {
+		i.events.send(ctx, QueryCompleted, i.rows, nil)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// backupFormat identifies the backups of memory databases, and the version of their format.
+const backupFormat = "go-mysql-server memory backup 1"
+
+func init() {
+	// The concrete types of the values stored in tables, other than the basic ones gob knows about
+	gob.Register(time.Time{})
+	gob.Register(sql.JSONDocument{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
+
+// databaseBackup is the snapshot of a database written by Backup.
+type databaseBackup struct {
+	Tables           []tableBackup
+	Views            map[string]string
+	Triggers         []sql.TriggerDefinition
+	StoredProcedures []sql.StoredProcedureDetails
+	Collation        string
+}
+
+type tableBackup struct {
+	Name          string
+	Columns       []columnBackup
+	PkOrdinals    []int
+	PkIndexes     bool
+	Indexes       []indexBackup
+	ForeignKeys   []sql.ForeignKeyConstraint
+	Checks        []sql.CheckDefinition
+	Partitions    [][]sql.Row
+	AutoIncrement interface{}
+	Collation     string
+}
+
+// columnBackup is a column of a table backup. Its type is stored as it's written in CREATE TABLE, and its default
+// value, which must be a literal, as its value.
+type columnBackup struct {
+	Name          string
+	Type          string
+	Default       interface{}
+	HasDefault    bool
+	AutoIncrement bool
+	Nullable      bool
+	PrimaryKey    bool
+	Comment       string
+	Extra         string
+}
+
+type indexBackup struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	Comment string
+}
+
+// Backup implements sql.BackupableDatabase. The tables of the database must be memory tables, and the defaults of
+// their columns must be literals. Columns updated with ON UPDATE expressions aren't supported either.
+func (d *Database) Backup(ctx *sql.Context, w io.Writer) error {
+	backup := databaseBackup{
+		Views:            d.views,
+		Triggers:         d.triggers,
+		StoredProcedures: d.storedProcedures,
+		Collation:        d.collation,
+	}
+	for _, table := range d.tables {
+		t, ok := table.(*Table)
+		if !ok {
+			return sql.ErrUnsupportedFeature.New(fmt.Sprintf("backup of table %s of type %T", table.Name(), table))
+		}
+		tb, err := t.backup(ctx)
+		if err != nil {
+			return err
+		}
+		backup.Tables = append(backup.Tables, tb)
+	}
+
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(backupFormat); err != nil {
+		return err
+	}
+	return enc.Encode(backup)
+}
+
+// Restore implements sql.BackupableDatabase.
+func (d *Database) Restore(ctx *sql.Context, r io.Reader) error {
+	var format string
+	var backup databaseBackup
+	dec := gob.NewDecoder(r)
+	if err := dec.Decode(&format); err != nil || format != backupFormat {
+		return sql.ErrInvalidBackup.New(d.name, "not a backup of a memory database")
+	}
+	if err := dec.Decode(&backup); err != nil {
+		return sql.ErrInvalidBackup.New(d.name, err.Error())
+	}
+
+	tables := make(map[string]sql.Table, len(backup.Tables))
+	for _, tb := range backup.Tables {
+		t, err := restoreTable(ctx, tb)
+		if err != nil {
+			return sql.ErrInvalidBackup.New(d.name, err.Error())
+		}
+		tables[t.name] = t
+	}
+
+	d.tables = tables
+	d.views = backup.Views
+	if d.views == nil {
+		d.views = make(map[string]string)
+	}
+	d.triggers = backup.Triggers
+	d.storedProcedures = backup.StoredProcedures
+	if _, ok := sql.Collations[backup.Collation]; ok {
+		d.collation = backup.Collation
+	}
+	return nil
+}
+
+func (t *Table) backup(ctx *sql.Context) (tableBackup, error) {
+	tb := tableBackup{
+		Name:          t.name,
+		PkOrdinals:    t.schema.PkOrdinals,
+		PkIndexes:     t.pkIndexesEnabled,
+		ForeignKeys:   t.foreignKeys,
+		Checks:        t.checks,
+		AutoIncrement: t.autoIncVal,
+		Collation:     t.collation,
+	}
+
+	for _, col := range t.schema.Schema {
+		cb := columnBackup{
+			Name:          col.Name,
+			Type:          col.Type.String(),
+			AutoIncrement: col.AutoIncrement,
+			Nullable:      col.Nullable,
+			PrimaryKey:    col.PrimaryKey,
+			Comment:       col.Comment,
+			Extra:         col.Extra,
+		}
+		if col.OnUpdate != nil {
+			return tableBackup{}, sql.ErrUnsupportedFeature.New(fmt.Sprintf("backup of the ON UPDATE expression of column %s.%s", t.name, col.Name))
+		}
+		if col.Default != nil {
+			if _, ok := col.Default.Expression.(*expression.Literal); !ok || !col.Default.IsLiteral() {
+				return tableBackup{}, sql.ErrUnsupportedFeature.New(fmt.Sprintf("backup of the default expression of column %s.%s", t.name, col.Name))
+			}
+			v, err := col.Default.Eval(ctx, nil)
+			if err != nil {
+				return tableBackup{}, err
+			}
+			cb.Default, cb.HasDefault = v, true
+		}
+		tb.Columns = append(tb.Columns, cb)
+	}
+
+	for _, idx := range t.indexes {
+		memIdx, ok := idx.(*Index)
+		if !ok {
+			return tableBackup{}, sql.ErrUnsupportedFeature.New(fmt.Sprintf("backup of index %s of type %T", idx.ID(), idx))
+		}
+		ib := indexBackup{Name: memIdx.Name, Unique: memIdx.Unique, Comment: memIdx.CommentStr}
+		for _, e := range memIdx.Exprs {
+			ib.Columns = append(ib.Columns, e.(*expression.GetField).Name())
+		}
+		tb.Indexes = append(tb.Indexes, ib)
+	}
+
+	for _, key := range t.partitionKeys {
+		tb.Partitions = append(tb.Partitions, t.partitions[string(key)])
+	}
+	return tb, nil
+}
+
+func restoreTable(ctx *sql.Context, tb tableBackup) (*Table, error) {
+	schema := make(sql.Schema, len(tb.Columns))
+	for i, cb := range tb.Columns {
+		typ, err := parseColumnType(cb.Type)
+		if err != nil {
+			return nil, err
+		}
+		col := &sql.Column{
+			Name:          cb.Name,
+			Type:          typ,
+			AutoIncrement: cb.AutoIncrement,
+			Nullable:      cb.Nullable,
+			Source:        tb.Name,
+			PrimaryKey:    cb.PrimaryKey,
+			Comment:       cb.Comment,
+			Extra:         cb.Extra,
+		}
+		if cb.HasDefault {
+			col.Default, err = sql.NewColumnDefaultValue(expression.NewLiteral(cb.Default, typ), typ, true, cb.Nullable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		schema[i] = col
+	}
+
+	t := NewPartitionedTable(tb.Name, sql.NewPrimaryKeySchema(schema, tb.PkOrdinals...), len(tb.Partitions))
+	t.pkIndexesEnabled = tb.PkIndexes
+	t.foreignKeys = tb.ForeignKeys
+	t.checks = tb.Checks
+	t.autoIncVal = tb.AutoIncrement
+	if _, ok := sql.Collations[tb.Collation]; ok {
+		t.collation = tb.Collation
+	}
+	for i, rows := range tb.Partitions {
+		t.partitions[string(t.partitionKeys[i])] = rows
+	}
+
+	for _, ib := range tb.Indexes {
+		columns := make([]sql.IndexColumn, len(ib.Columns))
+		for i, name := range ib.Columns {
+			columns[i] = sql.IndexColumn{Name: name}
+		}
+		constraint := sql.IndexConstraint_None
+		if ib.Unique {
+			constraint = sql.IndexConstraint_Unique
+		}
+		if err := t.CreateIndex(ctx, ib.Name, sql.IndexUsing_Default, constraint, columns, ib.Comment); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// parseColumnType returns the type written as in CREATE TABLE given, as returned by the String method of types.
+func parseColumnType(s string) (sql.Type, error) {
+	stmt, err := sqlparser.ParseStrictDDL(fmt.Sprintf("CREATE TABLE t (c %s)", s))
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil || len(ddl.TableSpec.Columns) != 1 {
+		return nil, fmt.Errorf("invalid column type: %s", s)
+	}
+	return sql.ColumnTypeToType(&ddl.TableSpec.Columns[0].Type)
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestDatabaseBackup(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	latin1 := sql.MustCreateString(sqltypes.VarChar, 10, sql.Collation_latin1_swedish_ci)
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "u", Type: sql.Uint8, Source: "t", Nullable: true},
+		{Name: "f", Type: sql.Float64, Source: "t", Nullable: true},
+		{Name: "d", Type: sql.MustCreateDecimalType(10, 2), Source: "t", Nullable: true},
+		{Name: "s", Type: latin1, Source: "t", Nullable: true, Comment: "a comment",
+			Default: mustLiteralDefault(expression.NewLiteral("x", latin1), latin1, true)},
+		{Name: "b", Type: sql.Blob, Source: "t", Nullable: true},
+		{Name: "e", Type: sql.MustCreateEnumType([]string{"a", "b"}, sql.Collation_Default), Source: "t", Nullable: true},
+		{Name: "st", Type: sql.MustCreateSetType([]string{"a", "b"}, sql.Collation_Default), Source: "t", Nullable: true},
+		{Name: "bt", Type: sql.MustCreateBitType(8), Source: "t", Nullable: true},
+		{Name: "dt", Type: sql.Datetime, Source: "t", Nullable: true},
+		{Name: "tm", Type: sql.Time, Source: "t", Nullable: true},
+		{Name: "j", Type: sql.JSON, Source: "t", Nullable: true},
+	}
+	rows := []sql.Row{
+		{int64(1), uint8(2), 3.5, "4.50", "x", []byte("b"), uint16(2), uint64(3), uint64(7),
+			time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC), "01:02:03", sql.MustJSON(`{"a": [1, null, "x"]}`)},
+		{int64(2), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil},
+	}
+
+	db := memory.NewDatabase("db")
+	require.NoError(db.CreateTable(ctx, "t", sql.NewPrimaryKeySchema(schema)))
+	table := db.Tables()["t"].(*memory.Table)
+	inserter := table.Inserter(ctx)
+	for _, row := range rows {
+		require.NoError(inserter.Insert(ctx, row))
+	}
+	require.NoError(inserter.Close(ctx))
+	require.NoError(table.CreateIndex(ctx, "idx", sql.IndexUsing_Default, sql.IndexConstraint_Unique, []sql.IndexColumn{{Name: "s"}, {Name: "u"}}, "unique"))
+	require.NoError(db.CreateView(ctx, "v", "SELECT i FROM t"))
+
+	var buf bytes.Buffer
+	require.NoError(db.Backup(ctx, &buf))
+
+	restored := memory.NewDatabase("restored")
+	require.NoError(restored.CreateTable(ctx, "other", sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Type: sql.Int64, Source: "other"}})))
+	require.NoError(restored.Restore(ctx, bytes.NewReader(buf.Bytes())))
+
+	names, err := restored.GetTableNames(ctx)
+	require.NoError(err)
+	require.Equal([]string{"t"}, names)
+	table = restored.Tables()["t"].(*memory.Table)
+	for i, col := range table.Schema() {
+		expected, actual := *schema[i], *col
+		require.Equal(expected.Type.String(), actual.Type.String())
+		expected.Type, actual.Type = nil, nil
+		if expected.Default != nil {
+			require.Equal(expected.Default.String(), actual.Default.String())
+			expected.Default, actual.Default = nil, nil
+		}
+		require.Equal(expected, actual)
+	}
+	require.ElementsMatch(rows, table.GetPartition("0"))
+
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	require.Len(indexes, 1)
+	require.Equal("idx", indexes[0].ID())
+	require.True(indexes[0].IsUnique())
+	require.Equal([]string{"t.s", "t.u"}, indexes[0].Expressions())
+
+	view, ok, err := restored.GetView(ctx, "v")
+	require.NoError(err)
+	require.True(ok)
+	require.Equal("SELECT i FROM t", view)
+
+	err = restored.Restore(ctx, strings.NewReader("not a backup"))
+	require.True(sql.ErrInvalidBackup.Is(err), "%v", err)
+
+	// Default expressions can't be backed up
+	schema = sql.Schema{{Name: "i", Type: sql.Int64, Source: "u",
+		Default: mustLiteralDefault(expression.NewLiteral(int64(1), sql.Int64), sql.Int64, false)}}
+	require.NoError(db.CreateTable(ctx, "u", sql.NewPrimaryKeySchema(schema)))
+	err = db.Backup(ctx, &buf)
+	require.True(sql.ErrUnsupportedFeature.Is(err), "%v", err)
+}
+
+func mustLiteralDefault(lit sql.Expression, typ sql.Type, literal bool) *sql.ColumnDefaultValue {
+	def, err := sql.NewColumnDefaultValue(lit, typ, literal, true)
+	if err != nil {
+		panic(err)
+	}
+	return def
+}
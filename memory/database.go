@@ -38,6 +38,8 @@ var _ sql.TableRenamer = (*Database)(nil)
 var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
+var _ sql.BackupableDatabase = (*Database)(nil)
+var _ sql.CollatedDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
@@ -46,6 +48,8 @@ type BaseDatabase struct {
 	triggers          []sql.TriggerDefinition
 	storedProcedures  []sql.StoredProcedureDetails
 	primaryKeyIndexes bool
+	// collation is the name of the default collation, kept instead of the collation so that databases can be compared
+	collation string
 }
 
 var _ MemoryDatabase = (*Database)(nil)
@@ -62,8 +66,9 @@ func NewDatabase(name string) *Database {
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:   name,
-		tables: map[string]sql.Table{},
+		name:      name,
+		tables:    map[string]sql.Table{},
+		collation: sql.Collation_Default.Name,
 	}
 }
 
@@ -77,6 +82,17 @@ func (d *BaseDatabase) Name() string {
 	return d.name
 }
 
+// GetCollation implements sql.CollatedDatabase.
+func (d *BaseDatabase) GetCollation(ctx *sql.Context) sql.Collation {
+	return sql.Collations[d.collation]
+}
+
+// SetCollation implements sql.CollatedDatabase.
+func (d *BaseDatabase) SetCollation(ctx *sql.Context, collation sql.Collation) error {
+	d.collation = collation.Name
+	return nil
+}
+
 // Tables returns all tables in the database.
 func (d *BaseDatabase) Tables() map[string]sql.Table {
 	return d.tables
@@ -37,6 +37,7 @@ type Index struct {
 }
 
 var _ sql.Index = (*Index)(nil)
+var _ sql.OrderedIndex = (*Index)(nil)
 
 func (idx *Index) Database() string                    { return idx.DB }
 func (idx *Index) Driver() string                      { return idx.DriverName }
@@ -60,6 +61,12 @@ func (idx *Index) Comment() string {
 	return idx.CommentStr
 }
 
+// Order implements the interface sql.OrderedIndex. Tables return the rows of a lookup on any index sorted by its
+// expressions.
+func (idx *Index) Order() sql.IndexOrder {
+	return sql.IndexOrderAsc
+}
+
 func (idx *Index) IndexType() string {
 	if len(idx.DriverName) > 0 {
 		return idx.DriverName
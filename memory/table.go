@@ -39,6 +39,8 @@ type Table struct {
 	foreignKeys      []sql.ForeignKeyConstraint
 	checks           []sql.CheckDefinition
 	pkIndexesEnabled bool
+	// collation is the name of the default collation, kept instead of the collation so that tables can be compared
+	collation string
 
 	// pushdown info
 	filters    []sql.Expression // currently unused, filter pushdown is significantly broken right now
@@ -79,6 +81,7 @@ var _ sql.StatisticsTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
+var _ sql.CollatedTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema.
 func NewTable(name string, schema sql.PrimaryKeySchema) *Table {
@@ -117,6 +120,7 @@ func NewPartitionedTable(name string, schema sql.PrimaryKeySchema, numPartitions
 		partitionKeys: keys,
 		autoIncVal:    autoIncVal,
 		autoColIdx:    autoIncIdx,
+		collation:     sql.SchemaCollation(schema.Schema).Name,
 	}
 }
 
@@ -130,6 +134,17 @@ func (t *Table) Schema() sql.Schema {
 	return t.schema.Schema
 }
 
+// Collation implements the sql.CollatedTable interface.
+func (t *Table) Collation() sql.Collation {
+	return sql.Collations[t.collation]
+}
+
+// SetCollation implements the sql.CollatedTable interface.
+func (t *Table) SetCollation(ctx *sql.Context, collation sql.Collation) error {
+	t.collation = collation.Name
+	return nil
+}
+
 func (t *Table) GetPartition(key string) []sql.Row {
 	rows, ok := t.partitions[string(key)]
 	if ok {
@@ -139,8 +154,16 @@ func (t *Table) GetPartition(key string) []sql.Row {
 	return nil
 }
 
+// orderedLookupPartitionKey is the key of the single partition returned for a lookup on an ordered index, whose rows
+// come from every partition of the table.
+const orderedLookupPartitionKey = "__ordered_lookup__"
+
 // Partitions implements the sql.Table interface.
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	if t.orderedLookupIndex() != nil {
+		return &partitionIter{keys: [][]byte{[]byte(orderedLookupPartitionKey)}}, nil
+	}
+
 	var keys [][]byte
 	for _, k := range t.partitionKeys {
 		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 {
@@ -157,6 +180,22 @@ func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
 
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if string(partition.Key()) == orderedLookupPartitionKey {
+		idx := t.orderedLookupIndex()
+		if idx == nil {
+			return nil, sql.ErrPartitionNotFound.New(partition.Key())
+		}
+		rows, err := t.orderedLookupRows(ctx, idx)
+		if err != nil {
+			return nil, err
+		}
+		return &tableIter{
+			rows:    rows,
+			columns: t.columns,
+			filters: t.filters,
+		}, nil
+	}
+
 	rows, ok := t.partitions[string(partition.Key())]
 	if !ok {
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
@@ -184,6 +223,68 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	}, nil
 }
 
+// orderedLookupIndex returns the index of the lookup of this table if its rows must be returned in index order, or
+// nil otherwise.
+func (t *Table) orderedLookupIndex() ExpressionsIndex {
+	if t.lookup == nil {
+		return nil
+	}
+	idx, ok := t.lookup.Index().(ExpressionsIndex)
+	if !ok {
+		return nil
+	}
+	if oi, ok := idx.(sql.OrderedIndex); !ok || oi.Order() != sql.IndexOrderAsc {
+		return nil
+	}
+	return idx
+}
+
+// orderedLookupRows returns the rows of every partition matching the lookup of this table, sorted by the expressions
+// of the index given.
+func (t *Table) orderedLookupRows(ctx *sql.Context, idx ExpressionsIndex) ([]sql.Row, error) {
+	var rows []sql.Row
+	for _, key := range t.partitionKeys {
+		values, err := t.lookup.(sql.DriverIndexLookup).Values(&Partition{key: key})
+		if err != nil {
+			return nil, err
+		}
+
+		partitionRows := make([]sql.Row, len(t.partitions[string(key)]))
+		copy(partitionRows, t.partitions[string(key)])
+		iter := &tableIter{rows: partitionRows, indexValues: values}
+		for {
+			row, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				iter.Close(ctx)
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+		if err := iter.Close(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sortFields := make([]sql.SortField, len(idx.ColumnExpressions()))
+	for i, e := range idx.ColumnExpressions() {
+		sortFields[i] = sql.SortField{Column: e, Order: sql.Ascending, NullOrdering: sql.NullsFirst}
+	}
+	sorter := &expression.Sorter{
+		SortFields: sortFields,
+		Rows:       rows,
+		Ctx:        ctx,
+	}
+	sort.Stable(sorter)
+	if sorter.LastError != nil {
+		return nil, sorter.LastError
+	}
+
+	return rows, nil
+}
+
 func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	var count uint64 = 0
 	for _, rows := range t.partitions {
@@ -1179,6 +1280,7 @@ func copyschema(sch sql.Schema) sql.Schema {
 
 func copyTable(t *Table, newSch sql.PrimaryKeySchema) (*Table, error) {
 	newTable := NewPartitionedTable(t.name, newSch, len(t.partitions))
+	newTable.collation = t.collation
 	for _, partition := range t.partitions {
 		for _, partitionRow := range partition {
 			err := newTable.Insert(sql.NewEmptyContext(), partitionRow)
@@ -0,0 +1,131 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// PreparedStatement is a statement prepared by a session.
+type PreparedStatement struct {
+	// Parsed is the statement as returned by the parser.
+	Parsed sql.Node
+	// Schema is the schema of the rows returned by the statement, as analyzed when it was prepared.
+	Schema sql.Schema
+	// SchemaVersion is the schema version of the catalog the statement was analyzed against. The statement is
+	// prepared again when the statement is run after the version changes.
+	SchemaVersion uint64
+	// ParamTypes are the types of the parameters of the statement, by bind variable name, as inferred from where
+	// they're used when the statement was analyzed. Parameters whose type can't be inferred aren't included.
+	ParamTypes map[string]sql.Type
+}
+
+// PreparedDataCache holds the statements prepared by each session, by query text. Every session holds at most as many
+// statements as the max_prepared_stmt_count system variable allows: past it, the statement prepared first is evicted,
+// and is parsed again the next time it's run.
+type PreparedDataCache struct {
+	mu   sync.Mutex
+	data map[uint32]*sessionPreparedData
+}
+
+// sessionPreparedData holds the statements prepared by a session.
+type sessionPreparedData struct {
+	stmts map[string]*PreparedStatement
+	// queries are the queries of the statements, in the order they were prepared in.
+	queries []string
+}
+
+// NewPreparedDataCache returns a new empty PreparedDataCache.
+func NewPreparedDataCache() *PreparedDataCache {
+	return &PreparedDataCache{
+		data: make(map[uint32]*sessionPreparedData),
+	}
+}
+
+// Get returns the statement prepared by the session given for the query given, if any.
+func (c *PreparedDataCache) Get(sessionID uint32, query string) (*PreparedStatement, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.data[sessionID]
+	if !ok {
+		return nil, false
+	}
+	stmt, ok := data.stmts[query]
+	return stmt, ok
+}
+
+// Put stores the statement prepared by the session given for the query given, replacing any previous one. If the
+// session holds as many statements as it's allowed to already, the one it prepared first is evicted.
+func (c *PreparedDataCache) Put(sessionID uint32, query string, stmt *PreparedStatement) {
+	limit := maxPreparedStmtCount()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.data[sessionID]
+	if !ok {
+		data = &sessionPreparedData{stmts: make(map[string]*PreparedStatement)}
+		c.data[sessionID] = data
+	}
+	if _, ok := data.stmts[query]; !ok {
+		for len(data.queries) > 0 && len(data.queries) >= limit {
+			delete(data.stmts, data.queries[0])
+			data.queries = data.queries[1:]
+		}
+		if limit == 0 {
+			return
+		}
+		data.queries = append(data.queries, query)
+	}
+	data.stmts[query] = stmt
+}
+
+// DeleteSession removes all the statements prepared by the session given.
+func (c *PreparedDataCache) DeleteSession(sessionID uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, sessionID)
+}
+
+// maxPreparedStmtCount returns the number of statements a session can hold, as given by the max_prepared_stmt_count
+// system variable.
+func maxPreparedStmtCount() int {
+	_, val, ok := sql.SystemVariables.GetGlobal("max_prepared_stmt_count")
+	if !ok {
+		return 0
+	}
+	limit, ok := val.(int64)
+	if !ok {
+		return 0
+	}
+	return int(limit)
+}
+
+// schemaChangingIter is a RowIter wrapper that increments the schema version of the catalog once a DDL statement has
+// been run.
+type schemaChangingIter struct {
+	childIter sql.RowIter
+	catalog   sql.Catalog
+}
+
+func (s schemaChangingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return s.childIter.Next(ctx)
+}
+
+func (s schemaChangingIter) Close(ctx *sql.Context) error {
+	defer s.catalog.SchemaChanged()
+	return s.childIter.Close(ctx)
+}
@@ -48,8 +48,9 @@ func (pl *ProcessList) Processes() []sql.Process {
 		p := *proc
 		var progress = make(map[string]sql.TableProgress, len(p.Progress))
 		for n, p := range p.Progress {
-			progress[n] = p
+			progress[n] = p.Copy()
 		}
+		p.Progress = progress
 		result = append(result, p)
 	}
 
@@ -75,6 +76,7 @@ func (pl *ProcessList) AddProcess(
 		Pid:        ctx.Pid(),
 		Connection: ctx.ID(),
 		Query:      query,
+		Tags:       sql.ParseQueryTags(query),
 		Progress:   make(map[string]sql.TableProgress),
 		User:       ctx.Session.Client().User,
 		StartedAt:  time.Now(),
@@ -127,6 +129,8 @@ func (pl *ProcessList) UpdatePartitionProgress(pid uint64, tableName, partitionN
 
 	partitionPg.Done += delta
 	tablePg.PartitionsProgress[partitionName] = partitionPg
+	tablePg.Rows.Done += delta
+	p.Progress[tableName] = tablePg
 }
 
 // AddTableProgress adds a new item to track progress from to the process with
@@ -206,6 +210,27 @@ func (pl *ProcessList) RemovePartitionProgress(pid uint64, tableName, partitionN
 	delete(tablePg.PartitionsProgress, partitionName)
 }
 
+// UpdateTableRowsEstimate sets the estimated number of rows to be read from the table
+// with the given name for the process with the given pid. If the pid or the table does
+// not exist, it will do nothing.
+func (pl *ProcessList) UpdateTableRowsEstimate(pid uint64, name string, rows int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	p, ok := pl.procs[pid]
+	if !ok {
+		return
+	}
+
+	tablePg, ok := p.Progress[name]
+	if !ok {
+		return
+	}
+
+	tablePg.Rows.Total = rows
+	p.Progress[name] = tablePg
+}
+
 // Kill terminates all queries for a given connection id.
 func (pl *ProcessList) Kill(connID uint32) {
 	pl.mu.Lock()
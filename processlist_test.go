@@ -43,8 +43,8 @@ func TestProcessList(t *testing.T) {
 		Pid:        1,
 		Connection: 1,
 		Progress: map[string]sql.TableProgress{
-			"a": {sql.Progress{Name: "a", Done: 0, Total: 5}, map[string]sql.PartitionProgress{}},
-			"b": {sql.Progress{Name: "b", Done: 0, Total: 6}, map[string]sql.PartitionProgress{}},
+			"a": {sql.Progress{Name: "a", Done: 0, Total: 5}, map[string]sql.PartitionProgress{}, sql.Progress{Name: "a", Total: -1}},
+			"b": {sql.Progress{Name: "b", Done: 0, Total: 6}, map[string]sql.PartitionProgress{}, sql.Progress{Name: "b", Total: -1}},
 		},
 		User:      "foo",
 		Query:     "SELECT foo",
@@ -63,17 +63,18 @@ func TestProcessList(t *testing.T) {
 	p.RemovePartitionProgress(ctx.Pid(), "b", "b-3")
 
 	expectedProgress := map[string]sql.TableProgress{
-		"a": {sql.Progress{Name: "a", Total: 5}, map[string]sql.PartitionProgress{}},
+		"a": {sql.Progress{Name: "a", Total: 5}, map[string]sql.PartitionProgress{}, sql.Progress{Name: "a", Total: -1}},
 		"b": {sql.Progress{Name: "b", Total: 6}, map[string]sql.PartitionProgress{
 			"b-1": {sql.Progress{Name: "b-1", Done: 0, Total: -1}},
 			"b-2": {sql.Progress{Name: "b-2", Done: 1, Total: -1}},
-		}},
+		}, sql.Progress{Name: "b", Done: 1, Total: -1}},
 	}
 	require.Equal(expectedProgress, p.procs[ctx.Pid()].Progress)
 
 	ctx = sql.NewContext(context.Background(), sql.WithPid(2), sql.WithSession(sess))
-	ctx, err = p.AddProcess(ctx, "SELECT bar")
+	ctx, err = p.AddProcess(ctx, "SELECT bar /* service:billing, route:invoices */")
 	require.NoError(err)
+	require.Equal(map[string]string{"service": "billing", "route": "invoices"}, p.procs[ctx.Pid()].Tags)
 
 	p.AddTableProgress(ctx.Pid(), "foo", 2)
 
@@ -84,10 +85,12 @@ func TestProcessList(t *testing.T) {
 	p.UpdateTableProgress(1, "a", 1)
 	p.UpdateTableProgress(1, "b", 2)
 	p.UpdateTableProgress(2, "foo", 1)
+	p.UpdateTableRowsEstimate(2, "foo", 10)
 
 	require.Equal(int64(4), p.procs[1].Progress["a"].Done)
 	require.Equal(int64(2), p.procs[1].Progress["b"].Done)
 	require.Equal(int64(1), p.procs[2].Progress["foo"].Done)
+	require.Equal(int64(10), p.procs[2].Progress["foo"].Rows.Total)
 
 	var expected []sql.Process
 	for _, p := range p.procs {
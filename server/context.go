@@ -58,6 +58,11 @@ type SessionManager struct {
 	builder     SessionBuilder
 	sessions    map[uint32]*managedSession
 	pid         uint64
+	// disableLocalInfile makes LOAD DATA LOCAL INFILE statements fail rather than ask clients for their files.
+	disableLocalInfile bool
+	// sessionVars are the values of system variables of the sessions of the server that differ from the global ones,
+	// such as its version.
+	sessionVars map[string]interface{}
 }
 
 // NewSessionManager creates a SessionManager with the given SessionBuilder.
@@ -96,6 +101,14 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 	if err != nil {
 		return err
 	}
+	if si, ok := session.(sql.SessionVariableInitializer); ok {
+		sqlCtx := sql.NewContext(ctx, sql.WithSession(session))
+		for name, val := range s.sessionVars {
+			if err := si.InitSessionVariable(sqlCtx, name, val); err != nil {
+				return err
+			}
+		}
+	}
 
 	s.sessions[conn.ConnectionID] = &managedSession{session, conn}
 
@@ -165,6 +178,11 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 		return nil, err
 	}
 
+	loadInfile := conn.LoadInfile
+	if s.disableLocalInfile {
+		loadInfile = nil
+	}
+
 	context := sql.NewContext(
 		ctx,
 		sql.WithSession(sess),
@@ -176,7 +194,7 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 		sql.WithRootSpan(s.tracer.StartSpan("query")),
 		sql.WithServices(sql.Services{
 			KillConnection: s.killConnection,
-			LoadInfile:     conn.LoadInfile,
+			LoadInfile:     loadInfile,
 		}),
 	)
 
@@ -16,9 +16,11 @@ package server
 
 import (
 	"io"
+	"math"
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -37,7 +39,6 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/parse"
-	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 var errConnectionNotFound = errors.NewKind("connection not found: %c")
@@ -50,9 +51,22 @@ var ErrConnectionWasClosed = errors.NewKind("connection was closed")
 
 var ErrUnsupportedOperation = errors.NewKind("unsupported operation")
 
+// ErrTooManyResultRows is returned when a result set has more rows than the server's MaxResultRows.
+var ErrTooManyResultRows = errors.NewKind("result set exceeds the maximum of %d rows, use a LIMIT to select fewer rows")
+
+// ErrResultSetTooLarge is returned when the rows of a result set take more bytes than the server's MaxResultBytes.
+var ErrResultSetTooLarge = errors.NewKind("result set exceeds the maximum size of %d bytes, use a LIMIT to select fewer rows")
+
 // TODO parametrize
 const rowsBatch = 100
 
+const (
+	// valueBufferSize is the size of the buffers the values of result rows are written into.
+	valueBufferSize = 16 * 1024
+	// minRowValueBufferSize is the free space left in a value buffer under which a new one is allocated for a row.
+	minRowValueBufferSize = 256
+)
+
 var tcpCheckerSleepDuration time.Duration = 1 * time.Second
 
 type MultiStmtMode int
@@ -70,6 +84,11 @@ type Handler struct {
 	readTimeout       time.Duration
 	disableMultiStmts bool
 	sel               ServerEventListener
+	// maxResultRows and maxResultBytes limit the size of result sets, unless they're zero.
+	maxResultRows  uint64
+	maxResultBytes uint64
+	// disabledCapabilities are the capability flags connections don't get even when their clients ask for them.
+	disabledCapabilities uint32
 }
 
 // NewHandler creates a new Handler given a SQLe engine.
@@ -89,11 +108,14 @@ func (h *Handler) NewConnection(c *mysql.Conn) {
 		h.sel.ClientConnected()
 	}
 
-	c.DisableClientMultiStatements = h.disableMultiStmts
+	c.DisableClientMultiStatements = h.disableMultiStmts || h.disabledCapabilities&mysql.CapabilityClientMultiStatements != 0
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).WithField("DisableClientMultiStatements", c.DisableClientMultiStatements).Infof("NewConnection")
 }
 
 func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
+	// This is the first call for a connection once its handshake is done, and before its session is created, so it's
+	// where the capabilities negotiated in the handshake are restricted
+	c.Capabilities &^= h.disabledCapabilities
 	return h.sm.SetDB(c, schemaName)
 }
 
@@ -102,14 +124,20 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	if err != nil {
 		return nil, err
 	}
-	schema, err := h.e.AnalyzeQuery(ctx, query)
+	schema, err := h.e.PrepareQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	// The parameters are sent with the types inferred from where they're used, rather than all as VARBINARY
+	if prepare, ok := c.PrepareData[c.StatementID]; ok {
+		if stmt, ok := h.e.PreparedData.Get(ctx.ID(), query); ok {
+			prepare.ParamFields = paramsToFields(ctx, prepare.ParamsCount, stmt.ParamTypes)
+		}
+	}
 	if sql.IsOkResultSchema(schema) {
 		return nil, nil
 	}
-	return schemaToFields(schema), nil
+	return schemaToFields(ctx, schema), nil
 }
 
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
@@ -134,6 +162,7 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 
 	ctx, _ := h.sm.NewContextWithQuery(c, "")
 	h.sm.CloseConn(c)
+	h.e.CloseSession(c.ConnectionID)
 
 	// If connection was closed, kill its associated queries.
 	ctx.ProcessList.Kill(c.ConnectionID)
@@ -227,6 +256,10 @@ func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Ex
 			res[k] = expression.NewLiteral(nil, sql.Null)
 		case v.Type() == sqltypes.Blob || v.Type() == sqltypes.VarBinary || v.Type() == sqltypes.Binary:
 			t, err := sql.CreateBinary(v.Type(), int64(len(v.ToBytes())))
+			if sql.ErrLengthTooLarge.Is(err) {
+				// Values too long for a VARBINARY are BLOBs
+				t, err = sql.CreateBinary(sqltypes.Blob, int64(len(v.ToBytes())))
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -237,6 +270,10 @@ func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Ex
 			res[k] = expression.NewLiteral(v, t)
 		case v.Type() == sqltypes.Text || v.Type() == sqltypes.VarChar || v.Type() == sqltypes.Char:
 			t, err := sql.CreateStringWithDefaults(v.Type(), int64(len(v.ToBytes())))
+			if sql.ErrLengthTooLarge.Is(err) {
+				// Values too long for a VARCHAR are TEXTs
+				t, err = sql.CreateStringWithDefaults(sqltypes.Text, int64(len(v.ToBytes())))
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -283,6 +320,14 @@ func (h *Handler) doQuery(
 		return "", err
 	}
 
+	maxPacket, err := maxAllowedPacket(ctx)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(query)) > maxPacket || bindingsSize(bindings) > maxPacket {
+		return "", sql.ErrPacketTooLarge.New()
+	}
+
 	var remainder string
 	var parsed sql.Node
 	if mode == MultiStmtModeOn {
@@ -293,6 +338,15 @@ func (h *Handler) doQuery(
 		}
 	}
 
+	rewritten, err := h.e.RewriteQuery(ctx, query)
+	if err != nil {
+		return remainder, err
+	}
+	if rewritten != query {
+		query = rewritten
+		parsed = nil
+	}
+
 	ctx = ctx.WithQuery(query)
 	more := remainder != ""
 
@@ -399,16 +453,20 @@ func (h *Handler) doQuery(
 	// Read rows off the row iterator and send them to the row channel.
 	eg.Go(func() error {
 		defer cancelF()
+		var valueBuf []byte
+		var resultRows, resultBytes uint64
+		var flush bool
 		for {
 			if r == nil {
-				r = &sqltypes.Result{Fields: schemaToFields(schema)}
+				r = &sqltypes.Result{Fields: schemaToFields(ctx, schema)}
 			}
 
-			if r.RowsAffected == rowsBatch {
+			if r.RowsAffected == rowsBatch || flush {
 				if err := callback(r, more); err != nil {
 					return err
 				}
 				r = nil
+				flush = false
 				proccesedAtLeastOneBatch = true
 				continue
 			}
@@ -428,10 +486,37 @@ func (h *Handler) doQuery(
 					continue
 				}
 
-				outputRow, err := rowToSQL(schema, row)
+				resultRows++
+				if h.maxResultRows > 0 && resultRows > h.maxResultRows {
+					return ErrTooManyResultRows.New(h.maxResultRows)
+				}
+
+				// Rows with chunked values are sent right away, so that only one of them is held in memory at a
+				// time, and their size is checked before they're read
+				chunkedSize, chunked := chunkedValuesSize(row)
+				if chunkedSize > maxPacket {
+					return sql.ErrPacketTooLarge.New()
+				}
+				flush = chunked
+
+				outputRow, buf, err := rowToSQL(schema, row, valueBuf)
 				if err != nil {
 					return err
 				}
+				valueBuf = buf
+
+				if rowPacketSize(outputRow) > maxPacket {
+					return sql.ErrPacketTooLarge.New()
+				}
+
+				if h.maxResultBytes > 0 {
+					for _, v := range outputRow {
+						resultBytes += uint64(len(v.Raw()))
+					}
+					if resultBytes > h.maxResultBytes {
+						return ErrResultSetTooLarge.New(h.maxResultBytes)
+					}
+				}
 
 				ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 				r.Rows = append(r.Rows, outputRow)
@@ -460,7 +545,7 @@ func (h *Handler) doQuery(
 	if err = setConnStatusFlags(ctx, c); err != nil {
 		return remainder, err
 	}
-	if err = setResultInfo(ctx, c, r, parsed); err != nil {
+	if err = setResultInfo(ctx, r); err != nil {
 		return remainder, err
 	}
 
@@ -507,33 +592,70 @@ func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
 	return nil
 }
 
-func setResultInfo(ctx *sql.Context, conn *mysql.Conn, r *sqltypes.Result, parsedQuery sql.Node) error {
+// setResultInfo sets the last insert id of the result given. The number of affected rows, which depends on the
+// CLIENT_FOUND_ROWS capabilities flag for UPDATE and INSERT ... ON DUPLICATE KEY UPDATE, is already in the OkResult.
+func setResultInfo(ctx *sql.Context, r *sqltypes.Result) error {
 	lastId := ctx.Session.GetLastQueryInfo(sql.LastInsertId)
 	r.InsertID = uint64(lastId)
+	return nil
+}
 
-	// cc. https://dev.mysql.com/doc/internals/en/capability-flags.html
-	// Check if the CLIENT_FOUND_ROWS Compatibility Flag is set
-	if shouldUseFoundRowsOutput(conn, parsedQuery) {
-		r.RowsAffected = uint64(ctx.GetLastQueryInfo(sql.FoundRows))
+// maxAllowedPacket returns the max_allowed_packet of the session, which is the largest query, set of prepared statement
+// parameters or result row it can send or receive. Larger packets are split by the protocol.
+func maxAllowedPacket(ctx *sql.Context) (uint64, error) {
+	val, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return 0, err
 	}
+	return uint64(val.(int64)), nil
+}
 
-	return nil
+// bindingsSize returns the number of bytes of the parameter values of a prepared statement.
+func bindingsSize(bindings map[string]*query.BindVariable) uint64 {
+	var size uint64
+	for _, b := range bindings {
+		size += uint64(len(b.Value))
+	}
+	return size
 }
 
-// When CLIENT_FOUND_ROWS is set we should return the number of rows MATCHED as the number of affected.
-// This should only happen on UPDATE and INSERT ON DUPLICATE queries
-func shouldUseFoundRowsOutput(conn *mysql.Conn, parsedQuery sql.Node) bool {
-	if (conn.Capabilities & mysql.CapabilityClientFoundRows) < 0 {
-		return false
+// chunkedValuesSize returns the size of the chunked values of the row given, and whether it has any.
+func chunkedValuesSize(row sql.Row) (uint64, bool) {
+	var size uint64
+	var chunked bool
+	for _, v := range row {
+		if cv, ok := v.(sql.ChunkedValue); ok {
+			size += uint64(cv.Size())
+			chunked = true
+		}
 	}
+	return size, chunked
+}
 
-	// TODO: Add support for INSERT ON DUPLICATE
-	switch parsedQuery.(type) {
-	case *plan.Update:
-		return true
-	default:
-		return false
+// rowPacketSize returns the size of the packet of the result row given, in which each value is prefixed by its
+// length-encoded size, and NULL values take a single byte.
+func rowPacketSize(row []sqltypes.Value) uint64 {
+	var size uint64
+	for _, v := range row {
+		if v.IsNull() {
+			size++
+			continue
+		}
+
+		l := uint64(len(v.Raw()))
+		switch {
+		case l < 251:
+			size += 1
+		case l < 1<<16:
+			size += 3
+		case l < 1<<24:
+			size += 4
+		default:
+			size += 9
+		}
+		size += l
 	}
+	return size
 }
 
 func isSessionAutocommit(ctx *sql.Context) (bool, error) {
@@ -661,7 +783,14 @@ func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
-func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
+// rowToSQL converts the row given to sqltypes.Values. Types that support it write the bytes of the values into buf,
+// so that the values of many rows share a few allocations. buf is returned extended past the bytes written, which
+// are never overwritten since the values sent reference them.
+func rowToSQL(s sql.Schema, row sql.Row, buf []byte) ([]sqltypes.Value, []byte, error) {
+	if cap(buf)-len(buf) < minRowValueBufferSize {
+		buf = make([]byte, 0, valueBufferSize)
+	}
+
 	o := make([]sqltypes.Value, len(row))
 	var err error
 	for i, v := range row {
@@ -670,33 +799,231 @@ func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
 			continue
 		}
 
-		o[i], err = s[i].Type.SQL(v)
+		o[i], err = s[i].Type.SQL(buf[len(buf):], v)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		// Values that fit were written in the free space of buf, unless their type doesn't use it, in which case the
+		// space skipped is simply left unused.
+		if n := len(o[i].Raw()); n <= cap(buf)-len(buf) {
+			buf = buf[:len(buf)+n]
 		}
 	}
 
-	return o, nil
+	return o, buf, nil
 }
 
-func schemaToFields(s sql.Schema) []*query.Field {
+func schemaToFields(ctx *sql.Context, s sql.Schema) []*query.Field {
+	results, convert := resultsCharacterSet(ctx)
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
-		var charset uint32 = mysql.CharacterSetUtf8
-		if sql.IsBlob(c.Type) {
-			charset = mysql.CharacterSetBinary
-		}
-
 		fields[i] = &query.Field{
-			Name:    c.Name,
-			Type:    c.Type.Type(),
-			Charset: charset,
+			Name:         c.Name,
+			Type:         c.Type.Type(),
+			ColumnLength: fieldColumnLength(c.Type),
+			Charset:      fieldCharset(c),
+			Decimals:     fieldDecimals(c.Type),
+			Flags:        fieldFlags(c),
+		}
+		if collation, ok := c.Collation(); ok && convert && collation.CharacterSet() != sql.CharacterSet_binary {
+			fields[i].Charset = uint32(results.DefaultCollation().ID())
+			fields[i].ColumnLength = convertedColumnLength(c.Type, collation.CharacterSet(), results)
+		}
+		// Columns of tables also carry the names of their table and of the column in it, which COM_FIELD_LIST
+		// responses are made of and which some clients use to find the columns of a table
+		if c.Source != "" {
+			fields[i].Table = c.Source
+			fields[i].OrgTable = c.Source
+			fields[i].OrgName = c.Name
 		}
 	}
 
 	return fields
 }
 
+// paramsToFields returns the definitions of the parameters of a prepared statement, by position, given the types of
+// its bind variables. Placeholders are named v1, v2 and so on by the parser. The definitions of parameters whose type
+// isn't known are nil.
+func paramsToFields(ctx *sql.Context, count uint16, types map[string]sql.Type) []*query.Field {
+	fields := make([]*query.Field, count)
+	for i := range fields {
+		if typ, ok := types["v"+strconv.Itoa(i+1)]; ok {
+			fields[i] = schemaToFields(ctx, sql.Schema{{Name: "?", Type: typ, Nullable: true}})[0]
+		}
+	}
+	return fields
+}
+
+// resultsCharacterSet returns the character set given by the character_set_results session variable, which strings
+// are reported in by result set fields, or false if they're reported in the character sets of their columns.
+func resultsCharacterSet(ctx *sql.Context) (sql.CharacterSet, bool) {
+	val, err := ctx.GetSessionVariable(ctx, "character_set_results")
+	if err != nil {
+		return "", false
+	}
+	name, _ := val.(string)
+	if name == "" {
+		return "", false
+	}
+	charset, err := sql.ParseCharacterSet(strings.ToLower(name))
+	if err != nil || charset == sql.CharacterSet_binary {
+		return "", false
+	}
+	return charset, true
+}
+
+// fieldCharset returns the id of the collation of the values of the column given, as sent in result set fields.
+// Anything but strings is binary.
+func fieldCharset(c *sql.Column) uint32 {
+	collation, ok := c.Collation()
+	if !ok {
+		return uint32(sql.Collation_binary.ID())
+	}
+	return uint32(collation.ID())
+}
+
+// convertedColumnLength returns the column length of a result set field of the string type given, when its values are
+// converted from the character set given to the character set of the results. As MySQL does, the length of TEXT types
+// allows for every byte to become a character.
+func convertedColumnLength(t sql.Type, from, to sql.CharacterSet) uint32 {
+	length := int64(fieldColumnLength(t))
+	if !sql.IsTextBlob(t) {
+		length /= from.MaxLength()
+	}
+	return columnLength(length * to.MaxLength())
+}
+
+// fieldColumnLength returns the maximum length of the values of the type given when displayed, as sent in result
+// set fields. It's the length in bytes for strings, and the number of characters for anything else.
+func fieldColumnLength(t sql.Type) uint32 {
+	switch t := t.(type) {
+	case sql.StringType:
+		return columnLength(t.MaxByteLength())
+	case sql.DecimalType:
+		// Room for the sign and, if there's any digit after it, the decimal point
+		length := int64(t.Precision()) + 1
+		if t.Scale() > 0 {
+			length++
+		}
+		return columnLength(length)
+	case sql.BitType:
+		return uint32(t.NumberOfBits())
+	case sql.EnumType:
+		var length int64
+		for _, v := range t.Values() {
+			if l := int64(len(v)); l > length {
+				length = l
+			}
+		}
+		return columnLength(length * t.CharacterSet().MaxLength())
+	case sql.SetType:
+		var length int64
+		for _, v := range t.Values() {
+			length += int64(len(v)) + 1
+		}
+		if length > 0 {
+			length--
+		}
+		return columnLength(length * t.CharacterSet().MaxLength())
+	}
+
+	switch t.Type() {
+	case sqltypes.Int8:
+		return 4
+	case sqltypes.Uint8:
+		return 3
+	case sqltypes.Int16:
+		return 6
+	case sqltypes.Uint16:
+		return 5
+	case sqltypes.Int24:
+		return 9
+	case sqltypes.Uint24:
+		return 8
+	case sqltypes.Int32:
+		return 11
+	case sqltypes.Uint32:
+		return 10
+	case sqltypes.Int64, sqltypes.Uint64:
+		return 20
+	case sqltypes.Float32:
+		return 12
+	case sqltypes.Float64:
+		return 22
+	case sqltypes.Year:
+		return 4
+	case sqltypes.Date, sqltypes.Time:
+		return 10
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return 19
+	case sqltypes.TypeJSON:
+		return math.MaxUint32
+	default:
+		return 0
+	}
+}
+
+// fieldDecimals returns the number of digits after the decimal point of the values of the type given, as sent in
+// result set fields. Floats don't have a fixed number of them, which is reported as 31.
+func fieldDecimals(t sql.Type) uint32 {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return uint32(dt.Scale())
+	}
+	if sql.IsFloat(t) {
+		return 31
+	}
+	return 0
+}
+
+// fieldFlags returns the flags of the column given, as sent in result set fields. They include the ones implied by
+// its type, such as UNSIGNED or BINARY, which are otherwise derived from the type by the protocol layer.
+func fieldFlags(c *sql.Column) uint32 {
+	_, flags := sqltypes.TypeToMySQL(c.Type.Type())
+	if !c.Nullable {
+		flags |= int64(query.MySqlFlag_NOT_NULL_FLAG)
+	}
+	if c.PrimaryKey {
+		flags |= int64(query.MySqlFlag_PRI_KEY_FLAG | query.MySqlFlag_PART_KEY_FLAG)
+	}
+	if c.AutoIncrement {
+		flags |= int64(query.MySqlFlag_AUTO_INCREMENT_FLAG)
+	}
+	if sql.IsNumber(c.Type) {
+		flags |= int64(query.MySqlFlag_NUM_FLAG)
+	}
+	if sql.IsTextBlob(c.Type) {
+		flags |= int64(query.MySqlFlag_BLOB_FLAG)
+	}
+	if sql.IsJSON(c.Type) {
+		flags |= int64(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)
+	}
+	switch c.Type.Type() {
+	case sqltypes.Timestamp:
+		flags |= int64(query.MySqlFlag_TIMESTAMP_FLAG | query.MySqlFlag_BINARY_FLAG)
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Time, sqltypes.Year:
+		// As in MySQL, all temporal types are binary
+		flags |= int64(query.MySqlFlag_BINARY_FLAG)
+	}
+	if c.OnUpdate != nil {
+		flags |= int64(query.MySqlFlag_ON_UPDATE_NOW_FLAG)
+	}
+	// Only the columns of tables have defaults
+	if c.Source != "" && !c.Nullable && c.Default == nil && !c.AutoIncrement {
+		flags |= int64(query.MySqlFlag_NO_DEFAULT_VALUE_FLAG)
+	}
+	return uint32(flags)
+}
+
+// columnLength returns the length in bytes given as the column length of a result set field, which can't be larger
+// than the maximum of a 32-bit unsigned integer.
+func columnLength(length int64) uint32 {
+	if length > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(length)
+}
+
 var (
 	// QueryCounter describes a metric that accumulates number of queries monotonically.
 	QueryCounter = discard.NewCounter()
@@ -706,6 +1033,10 @@ var (
 
 	// QueryHistogram describes a queries latency.
 	QueryHistogram = discard.NewHistogram()
+
+	// QueryTagLabels are the keys of the query tags added as labels to the query metrics, so that they can be broken
+	// down by the services sending the queries. The label of a tag missing from a query is empty.
+	QueryTagLabels []string
 )
 
 func observeQuery(ctx *sql.Context, query string) func(err error) {
@@ -714,12 +1045,21 @@ func observeQuery(ctx *sql.Context, query string) func(err error) {
 	t := time.Now()
 	return func(err error) {
 		if err != nil {
-			QueryErrorCounter.With("query", query, "error", err.Error()).Add(1)
+			QueryErrorCounter.With(queryLabels(ctx, "query", query, "error", err.Error())...).Add(1)
 		} else {
-			QueryCounter.With("query", query).Add(1)
-			QueryHistogram.With("query", query, "duration", "seconds").Observe(time.Since(t).Seconds())
+			QueryCounter.With(queryLabels(ctx, "query", query)...).Add(1)
+			QueryHistogram.With(queryLabels(ctx, "query", query, "duration", "seconds")...).Observe(time.Since(t).Seconds())
 		}
 
 		span.Finish()
 	}
 }
+
+// queryLabels returns the label values given followed by the labels of the QueryTagLabels of the query.
+func queryLabels(ctx *sql.Context, labelValues ...string) []string {
+	tags := ctx.QueryTags()
+	for _, label := range QueryTagLabels {
+		labelValues = append(labelValues, label, tags[label])
+	}
+	return labelValues
+}
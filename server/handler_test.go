@@ -16,18 +16,27 @@ package server
 
 import (
 	"context"
+	dsql "database/sql"
+	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
 	"net"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	_ "github.com/go-sql-driver/mysql"
 	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/require"
 
 	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/auth"
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -189,7 +198,7 @@ func TestHandlerComPrepare(t *testing.T) {
 			name:      "select statement returns nil schema",
 			statement: "select c1 from test where c1 > ?",
 			expected: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8},
+				{Name: "c1", Table: "test", OrgTable: "test", OrgName: "c1", Type: query.Type_INT32, ColumnLength: 11, Charset: mysql.CharacterSetBinary, Flags: 36865},
 			},
 		},
 	} {
@@ -198,8 +207,49 @@ func TestHandlerComPrepare(t *testing.T) {
 			schema, err := handler.ComPrepare(dummyConn, test.statement)
 			require.NoError(t, err)
 			require.Equal(t, test.expected, schema)
+			_, ok := e.PreparedData.Get(dummyConn.ConnectionID, test.statement)
+			require.True(t, ok)
 		})
 	}
+
+	// The statements prepared by a connection are discarded when it's closed
+	handler.ConnectionClosed(dummyConn)
+	_, ok := e.PreparedData.Get(dummyConn.ConnectionID, "select c1 from test where c1 > ?")
+	require.False(t, ok)
+}
+
+// COM_FIELD_LIST is answered with the fields of the result of a query the protocol layer makes for the table
+func TestHandlerComFieldList(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	dummyConn := &mysql.Conn{ConnectionID: 1}
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	handler.NewConnection(dummyConn)
+	require.NoError(handler.ComInitDB(dummyConn, "test"))
+
+	var fields []*query.Field
+	err := handler.ComQuery(dummyConn, "SELECT * FROM test LIMIT 0;", func(res *sqltypes.Result, more bool) error {
+		require.Empty(res.Rows)
+		fields = append(fields, res.Fields...)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]*query.Field{
+		{Name: "c1", Table: "test", OrgTable: "test", OrgName: "c1", Type: query.Type_INT32, ColumnLength: 11, Charset: mysql.CharacterSetBinary, Flags: 36865},
+	}, fields)
 }
 
 type TestListener struct {
@@ -370,20 +420,99 @@ func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
 func TestSchemaToFields(t *testing.T) {
 	require := require.New(t)
 
+	onUpdateNow, err := sql.NewColumnDefaultValue(expression.NewLiteral(nil, sql.Null), sql.Timestamp, true, true)
+	require.NoError(err)
+
 	schema := sql.Schema{
-		{Name: "foo", Type: sql.Blob},
-		{Name: "bar", Type: sql.Text},
-		{Name: "baz", Type: sql.Int64},
+		{Name: "foo", Type: sql.Blob, Nullable: true},
+		{Name: "bar", Type: sql.Text, Nullable: true},
+		{Name: "baz", Type: sql.Int64, PrimaryKey: true, AutoIncrement: true},
+		{Name: "qux", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Nullable: true},
+		{Name: "quux", Type: sql.LongText, Nullable: true},
+		{Name: "latin", Type: sql.MustCreateString(sqltypes.VarChar, 10, sql.Collation_latin1_swedish_ci), Nullable: true},
+		{Name: "bin", Type: sql.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_bin), Nullable: true},
+		{Name: "u", Type: sql.Uint32, Nullable: true},
+		{Name: "dec", Type: sql.MustCreateDecimalType(10, 2)},
+		{Name: "f", Type: sql.Float64, Nullable: true},
+		{Name: "dt", Type: sql.Datetime, Nullable: true},
+		{Name: "e", Type: sql.MustCreateEnumType([]string{"a", "bcd"}, sql.Collation_Default), Nullable: true},
+		{Name: "s", Type: sql.MustCreateSetType([]string{"a", "bcd"}, sql.Collation_Default), Nullable: true},
+		{Name: "j", Type: sql.JSON, Nullable: true},
+		{Name: "t", Type: sql.Int32, Source: "mytable", Nullable: true},
+		{Name: "n", Type: sql.Int32, Source: "mytable"},
+		{Name: "ts", Type: sql.Timestamp, Source: "mytable", Nullable: true, OnUpdate: onUpdateNow},
+		{Name: "d", Type: sql.Date, Nullable: true},
+		{Name: "tm", Type: sql.Time, Nullable: true},
+		{Name: "y", Type: sql.Year, Nullable: true},
 	}
 
+	binary := uint32(mysql.CharacterSetBinary)
+	utf8mb4 := uint32(sql.Collation_utf8mb4_0900_ai_ci.ID())
 	expected := []*query.Field{
-		{Name: "foo", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary},
-		{Name: "bar", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8},
-		{Name: "baz", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8},
+		{Name: "foo", Type: query.Type_BLOB, ColumnLength: 65535, Charset: binary, Flags: 144},
+		{Name: "bar", Type: query.Type_TEXT, ColumnLength: 262128, Charset: utf8mb4, Flags: 16},
+		{Name: "baz", Type: query.Type_INT64, ColumnLength: 20, Charset: binary, Flags: 49667},
+		{Name: "qux", Type: query.Type_VARCHAR, ColumnLength: 80, Charset: utf8mb4},
+		{Name: "quux", Type: query.Type_TEXT, ColumnLength: math.MaxUint32, Charset: utf8mb4, Flags: 16},
+		{Name: "latin", Type: query.Type_VARCHAR, ColumnLength: 40, Charset: utf8mb4},
+		{Name: "bin", Type: query.Type_VARCHAR, ColumnLength: 40, Charset: utf8mb4},
+		{Name: "u", Type: query.Type_UINT32, ColumnLength: 10, Charset: binary, Flags: 32800},
+		{Name: "dec", Type: query.Type_DECIMAL, ColumnLength: 12, Charset: binary, Decimals: 2, Flags: 32769},
+		{Name: "f", Type: query.Type_FLOAT64, ColumnLength: 22, Charset: binary, Decimals: 31, Flags: 32768},
+		{Name: "dt", Type: query.Type_DATETIME, ColumnLength: 19, Charset: binary, Flags: 128},
+		{Name: "e", Type: query.Type_ENUM, ColumnLength: 12, Charset: utf8mb4, Flags: 256},
+		{Name: "s", Type: query.Type_SET, ColumnLength: 20, Charset: utf8mb4, Flags: 2048},
+		{Name: "j", Type: query.Type_JSON, ColumnLength: math.MaxUint32, Charset: binary, Flags: 144},
+		{Name: "t", Table: "mytable", OrgTable: "mytable", OrgName: "t", Type: query.Type_INT32, ColumnLength: 11, Charset: binary, Flags: 32768},
+		{Name: "n", Table: "mytable", OrgTable: "mytable", OrgName: "n", Type: query.Type_INT32, ColumnLength: 11, Charset: binary, Flags: 36865},
+		{Name: "ts", Table: "mytable", OrgTable: "mytable", OrgName: "ts", Type: query.Type_TIMESTAMP, ColumnLength: 19, Charset: binary, Flags: 9344},
+		{Name: "d", Type: query.Type_DATE, ColumnLength: 10, Charset: binary, Flags: 128},
+		{Name: "tm", Type: query.Type_TIME, ColumnLength: 10, Charset: binary, Flags: 128},
+		{Name: "y", Type: query.Type_YEAR, ColumnLength: 4, Charset: binary, Flags: 160},
 	}
 
-	fields := schemaToFields(schema)
+	ctx := sql.NewEmptyContext()
+	fields := schemaToFields(ctx, schema)
 	require.Equal(expected, fields)
+
+	// Without a character set for the results, strings are reported in the ones of their columns
+	require.NoError(ctx.SetSessionVariable(ctx, "character_set_results", ""))
+	fields = schemaToFields(ctx, schema)
+	require.Equal(uint32(sql.Collation_Default.ID()), fields[1].Charset)
+	require.Equal(uint32(65532), fields[1].ColumnLength)
+	require.Equal(uint32(sql.Collation_latin1_swedish_ci.ID()), fields[5].Charset)
+	require.Equal(uint32(10), fields[5].ColumnLength)
+	require.Equal(uint32(sql.Collation_utf8mb4_bin.ID()), fields[6].Charset)
+}
+
+func TestRowToSQL(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64},
+		{Name: "b", Type: sql.LongText},
+		{Name: "c", Type: sql.Float64},
+		{Name: "d", Type: sql.Uint8},
+	}
+
+	var buf []byte
+	var rows [][]sqltypes.Value
+	for i := 0; i < 1000; i++ {
+		row, newBuf, err := rowToSQL(schema, sql.NewRow(int64(i), fmt.Sprintf("row %d", i), float64(i)/2, nil), buf)
+		require.NoError(err)
+		buf = newBuf
+		rows = append(rows, row)
+	}
+
+	// values of later rows don't overwrite the ones of earlier rows sharing their buffer
+	for i, row := range rows {
+		require.Equal([]sqltypes.Value{
+			sqltypes.MakeTrusted(sqltypes.Int64, []byte(strconv.Itoa(i))),
+			sqltypes.MakeTrusted(sqltypes.Text, []byte(fmt.Sprintf("row %d", i))),
+			sqltypes.MakeTrusted(sqltypes.Float64, []byte(strconv.FormatFloat(float64(i)/2, 'f', -1, 64))),
+			sqltypes.NULL,
+		}, row)
+	}
 }
 
 func TestHandlerTimeout(t *testing.T) {
@@ -442,6 +571,475 @@ func TestHandlerTimeout(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestHandlerResultLimits(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	query := func(query string) (int, error) {
+		var rows int
+		err := handler.ComQuery(conn, query, func(res *sqltypes.Result, more bool) error {
+			rows += len(res.Rows)
+			return nil
+		})
+		return rows, err
+	}
+
+	t.Run("max result rows", func(t *testing.T) {
+		require := require.New(t)
+
+		handler.maxResultRows = 150
+		defer func() {
+			handler.maxResultRows = 0
+		}()
+
+		_, err := query("SELECT * FROM test")
+		require.Error(err)
+		require.Contains(err.Error(), "result set exceeds the maximum of 150 rows")
+
+		rows, err := query("SELECT * FROM test LIMIT 150")
+		require.NoError(err)
+		require.Equal(150, rows)
+
+		// sql_select_limit truncates results before they get to the limit
+		_, err = query("SET sql_select_limit = 100")
+		require.NoError(err)
+		rows, err = query("SELECT * FROM test")
+		require.NoError(err)
+		require.Equal(100, rows)
+		_, err = query("SET sql_select_limit = DEFAULT")
+		require.NoError(err)
+
+		// The limit only applies to rows returned, not to rows affected
+		_, err = query("CREATE TABLE copied (c1 INT PRIMARY KEY)")
+		require.NoError(err)
+		_, err = query("INSERT INTO copied SELECT * FROM test")
+		require.NoError(err)
+		rows, err = query("SELECT COUNT(*) FROM copied")
+		require.NoError(err)
+		require.Equal(1, rows)
+	})
+
+	t.Run("max result bytes", func(t *testing.T) {
+		require := require.New(t)
+
+		handler.maxResultBytes = 1000
+		defer func() {
+			handler.maxResultBytes = 0
+		}()
+
+		_, err := query("SELECT * FROM test")
+		require.Error(err)
+		require.Contains(err.Error(), "result set exceeds the maximum size of 1000 bytes")
+
+		rows, err := query("SELECT * FROM test LIMIT 100")
+		require.NoError(err)
+		require.Equal(100, rows)
+	})
+}
+
+// chunkedValue is a sql.ChunkedValue of the chunks given, which counts the times it's read.
+type chunkedValue struct {
+	chunks [][]byte
+	reads  *int
+}
+
+func (c chunkedValue) Size() int64 {
+	var size int64
+	for _, chunk := range c.chunks {
+		size += int64(len(chunk))
+	}
+	return size
+}
+
+func (c chunkedValue) WriteTo(w io.Writer) (int64, error) {
+	*c.reads++
+	var n int64
+	for _, chunk := range c.chunks {
+		written, err := w.Write(chunk)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func TestHandlerChunkedValues(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("test")
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(db))
+	table := memory.NewTable("blobs", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "id", Type: sql.Int32, Source: "blobs", PrimaryKey: true},
+		{Name: "b", Type: sql.LongBlob, Source: "blobs", Nullable: true},
+	}))
+	db.AddTable("blobs", table)
+
+	var reads int
+	large := strings.Repeat("x", 20000)
+	rows := []sql.Row{
+		{int32(1), chunkedValue{[][]byte{[]byte("ab"), []byte("cd")}, &reads}},
+		{int32(2), chunkedValue{[][]byte{[]byte(large[:10000]), []byte(large[10000:])}, &reads}},
+		{int32(3), []byte("plain")},
+		{int32(4), []byte("values")},
+	}
+	for _, row := range rows {
+		require.NoError(table.Insert(sql.NewEmptyContext(), row))
+	}
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	// rows with chunked values are sent right away, in results of their own
+	reads = 0
+	var results [][][]sqltypes.Value
+	err := handler.ComQuery(conn, "SELECT id, b FROM blobs ORDER BY id", func(res *sqltypes.Result, more bool) error {
+		results = append(results, res.Rows)
+		return nil
+	})
+	require.NoError(err)
+	require.Len(results, 3)
+	require.Len(results[0], 1)
+	require.Equal("abcd", results[0][0][1].ToString())
+	require.Len(results[1], 1)
+	require.Equal(large, results[1][0][1].ToString())
+	require.Len(results[2], 2)
+	require.Equal("plain", results[2][0][1].ToString())
+	require.Equal(2, reads)
+
+	// the size of chunked values is checked before they're read
+	reads = 0
+	err = handler.ComQuery(conn, "SET max_allowed_packet = 16384", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.NoError(err)
+	err = handler.ComQuery(conn, "SELECT b FROM blobs WHERE id = 2", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.Error(err)
+	sqlErr, ok := err.(*mysql.SQLError)
+	require.True(ok, "unexpected error %v", err)
+	require.Equal(mysql.ERNetPacketTooLarge, sqlErr.Number())
+	require.Equal(0, reads)
+}
+
+func TestHandlerMaxAllowedPacket(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	run := func(query string) error {
+		return handler.ComQuery(conn, query, func(res *sqltypes.Result, more bool) error {
+			return nil
+		})
+	}
+	requirePacketTooLarge := func(t *testing.T, err error) {
+		require.Error(t, err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(t, ok, "unexpected error %v", err)
+		require.Equal(t, mysql.ERNetPacketTooLarge, sqlErr.Number())
+	}
+
+	require.NoError(t, run("SET max_allowed_packet = 1024"))
+
+	t.Run("query", func(t *testing.T) {
+		requirePacketTooLarge(t, run("SELECT '"+strings.Repeat("a", 1024)+"'"))
+		require.NoError(t, run("SELECT '"+strings.Repeat("a", 1000)+"'"))
+	})
+
+	t.Run("result row", func(t *testing.T) {
+		requirePacketTooLarge(t, run("SELECT REPEAT('a', 1024)"))
+		requirePacketTooLarge(t, run("SELECT REPEAT('a', 600), REPEAT('b', 600)"))
+		require.NoError(t, run("SELECT REPEAT('a', 1000)"))
+	})
+
+	t.Run("prepared statement parameters", func(t *testing.T) {
+		execute := func(value string) error {
+			prepare := &mysql.PrepareData{
+				PrepareStmt: "SELECT :v1",
+				BindVars: map[string]*query.BindVariable{
+					"v1": {Type: query.Type_VARCHAR, Value: []byte(value)},
+				},
+			}
+			return handler.ComStmtExecute(conn, prepare, func(res *sqltypes.Result) error {
+				return nil
+			})
+		}
+		requirePacketTooLarge(t, execute(strings.Repeat("a", 1025)))
+		require.NoError(t, execute(strings.Repeat("a", 500)))
+	})
+}
+
+// Tests values larger than the 16MB payload of a single packet, which the protocol splits across several packets
+func TestLargePackets(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{Protocol: "tcp", Address: "localhost:" + port, Auth: new(auth.None)}, e)
+	require.NoError(err)
+	go s.Start()
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	// maxAllowedPacket=0 makes the client use the max_allowed_packet of the server
+	db, err := dsql.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%s)/test?maxAllowedPacket=0", port))
+	require.NoError(err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE big (pk INT PRIMARY KEY, c LONGTEXT)")
+	require.NoError(err)
+
+	value := strings.Repeat("abcdefgh", (mysql.MaxPacketSize+1024)/8)
+	_, err = db.Exec("INSERT INTO big VALUES (1, ?)", value)
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO big VALUES (2, '" + value + "')")
+	require.NoError(err)
+
+	rows, err := db.Query("SELECT pk, c FROM big ORDER BY pk")
+	require.NoError(err)
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		var pk int
+		var c string
+		require.NoError(rows.Scan(&pk, &c))
+		require.Equal(value, c, "unexpected value for pk %d", pk)
+		count++
+	}
+	require.NoError(rows.Err())
+	require.Equal(2, count)
+}
+
+// Tests that the parameters of prepared statements are sent with the types inferred for them, which the protocol
+// doesn't let clients such as go-sql-driver see, so the response to COM_STMT_PREPARE is read from the wire as it is.
+func TestPrepareParamTypes(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{Protocol: "tcp", Address: "localhost:" + port, Auth: new(auth.None)}, e)
+	require.NoError(err)
+	go s.Start()
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	netConn, err := net.Dial("tcp", "localhost:"+port)
+	require.NoError(err)
+	conn := &packetConn{Conn: netConn}
+	defer conn.Close()
+
+	// The handshake, as a client of protocol 4.1 without authentication
+	_, err = conn.readPacket()
+	require.NoError(err)
+	handshake := make([]byte, 32)
+	binary.LittleEndian.PutUint32(handshake, mysql.CapabilityClientProtocol41|mysql.CapabilityClientSecureConnection|mysql.CapabilityClientConnectWithDB)
+	binary.LittleEndian.PutUint32(handshake[4:], mysql.MaxPacketSize)
+	handshake[8] = mysql.CharacterSetUtf8
+	handshake = append(handshake, "root\x00"...)
+	handshake = append(handshake, 0)
+	handshake = append(handshake, "test\x00"...)
+	require.NoError(conn.writePacket(handshake))
+	ok, err := conn.readPacket()
+	require.NoError(err)
+	require.Equal(byte(mysql.OKPacket), ok[0])
+
+	conn.seq = 0
+	require.NoError(conn.writePacket(append([]byte{mysql.ComPrepare}, "SELECT c1 FROM test WHERE c1 > ? AND ? = ? LIMIT ?"...)))
+	prepareOk, err := conn.readPacket()
+	require.NoError(err)
+	require.Equal(byte(mysql.OKPacket), prepareOk[0], string(prepareOk))
+	require.Equal(uint16(4), binary.LittleEndian.Uint16(prepareOk[7:]))
+
+	var types []int64
+	for i := 0; i < 4; i++ {
+		def, err := conn.readPacket()
+		require.NoError(err)
+		types = append(types, columnDefinitionType(def))
+	}
+
+	int32Type, _ := sqltypes.TypeToMySQL(sqltypes.Int32)
+	int64Type, _ := sqltypes.TypeToMySQL(sqltypes.Int64)
+	// Parameters whose type can't be inferred are sent as VARBINARY
+	varbinaryType, _ := sqltypes.TypeToMySQL(sqltypes.VarBinary)
+	require.Equal([]int64{int32Type, varbinaryType, varbinaryType, int64Type}, types)
+}
+
+// packetConn is a connection that reads and writes the packets of the protocol as they are.
+type packetConn struct {
+	net.Conn
+	seq byte
+}
+
+func (c *packetConn) readPacket() ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(c, header); err != nil {
+		return nil, err
+	}
+	c.seq = header[3] + 1
+	payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+	_, err := io.ReadFull(c, payload)
+	return payload, err
+}
+
+func (c *packetConn) writePacket(payload []byte) error {
+	header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), c.seq}
+	c.seq++
+	_, err := c.Write(append(header, payload...))
+	return err
+}
+
+// columnDefinitionType returns the type of the column definition packet given, which follows its six strings and
+// the length of its fixed-length fields, its character set and its column length.
+func columnDefinitionType(def []byte) int64 {
+	pos := 0
+	for i := 0; i < 6; i++ {
+		// The strings of the definitions of these tests are shorter than 251 bytes, so their lengths take a byte
+		pos += 1 + int(def[pos])
+	}
+	return int64(def[pos+1+2+4])
+}
+
+func TestServerVersionAndCapabilities(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	port, err := getFreePort()
+	require.NoError(err)
+
+	_, version, _ := sql.SystemVariables.GetGlobal("version")
+	_, versionComment, _ := sql.SystemVariables.GetGlobal("version_comment")
+
+	_, err = NewDefaultServer(Config{
+		Protocol:             "tcp",
+		Address:              "localhost:" + port,
+		Auth:                 new(auth.None),
+		DisabledCapabilities: mysql.CapabilityClientDeprecateEOF,
+	}, e)
+	require.True(ErrUnsupportedCapabilities.Is(err))
+
+	s, err := NewDefaultServer(Config{
+		Protocol:             "tcp",
+		Address:              "localhost:" + port,
+		Auth:                 new(auth.None),
+		Version:              "5.7.30-masquerade",
+		VersionComment:       "test server",
+		DisabledCapabilities: mysql.CapabilityClientFoundRows | mysql.CapabilityClientMultiStatements,
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	db, err := dsql.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%s)/test?clientFoundRows=true&multiStatements=true", port))
+	require.NoError(err)
+	defer db.Close()
+
+	var v, comment string
+	require.NoError(db.QueryRow("SELECT @@version, @@version_comment").Scan(&v, &comment))
+	require.Equal("5.7.30-masquerade", v)
+	require.Equal("test server", comment)
+
+	// The version is the server's, not a global one that servers created later would overwrite
+	otherPort, err := getFreePort()
+	require.NoError(err)
+	other, err := NewDefaultServer(Config{
+		Protocol:       "tcp",
+		Address:        "localhost:" + otherPort,
+		Auth:           new(auth.None),
+		Version:        "8.0.0-other",
+		VersionComment: "other server",
+	}, e)
+	require.NoError(err)
+	require.NoError(other.Close())
+	require.NoError(db.QueryRow("SELECT @@version, @@version_comment").Scan(&v, &comment))
+	require.Equal("5.7.30-masquerade", v)
+	require.Equal("test server", comment)
+	_, v2, _ := sql.SystemVariables.GetGlobal("version")
+	_, comment2, _ := sql.SystemVariables.GetGlobal("version_comment")
+	require.Equal(version, v2)
+	require.Equal(versionComment, comment2)
+
+	// Without CLIENT_FOUND_ROWS, rows that are matched but left unchanged aren't counted
+	res, err := db.Exec("UPDATE test SET c1 = c1 WHERE c1 = 1")
+	require.NoError(err)
+	affected, err := res.RowsAffected()
+	require.NoError(err)
+	require.Equal(int64(0), affected)
+
+	_, err = db.Exec("SELECT 1; SELECT 2")
+	require.Error(err)
+}
+
+func TestSessionManagerDisableLocalInfile(t *testing.T) {
+	require := require.New(t)
+	sm := NewSessionManager(
+		testSessionBuilder,
+		opentracing.NoopTracer{},
+		func(db string) bool { return db == "test" },
+		sql.NewMemoryManager(nil),
+		sqle.NewProcessList(),
+		"foo",
+	)
+	sm.disableLocalInfile = true
+	conn := newConn(1)
+
+	ctx, err := sm.NewContext(conn)
+	require.NoError(err)
+	_, err = ctx.LoadInfile("file.csv")
+	require.True(sql.ErrUnsupportedFeature.Is(err))
+}
+
 func TestOkClosedConnection(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -609,6 +1207,7 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 
 	// Set the capabilities to include found rows
 	dummyConn.Capabilities = mysql.CapabilityClientFoundRows
+	changedRowsConn := &mysql.Conn{ConnectionID: 2}
 
 	// Setup the handler
 	handler := NewHandler(
@@ -632,6 +1231,7 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		conn                 *mysql.Conn
 		query                string
 		expectedRowsAffected uint64
+		expectedInfo         string
 	}{
 		{
 			name:                 "Update query should return number of rows matched instead of rows affected",
@@ -639,6 +1239,15 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "UPDATE test set c1 = c1 where c1 < 10",
 			expectedRowsAffected: uint64(10),
+			expectedInfo:         "Rows matched: 10  Changed: 0  Warnings: 0",
+		},
+		{
+			name:                 "Update query without CLIENT_FOUND_ROWS returns the number of rows changed",
+			handler:              handler,
+			conn:                 changedRowsConn,
+			query:                "UPDATE test set c1 = c1 where c1 < 10",
+			expectedRowsAffected: uint64(0),
+			expectedInfo:         "Rows matched: 10  Changed: 0  Warnings: 0",
 		},
 		{
 			name:                 "INSERT ON UPDATE returns +1 for every row that already exists",
@@ -646,6 +1255,7 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "INSERT INTO test VALUES (1), (2), (3) ON DUPLICATE KEY UPDATE c1=c1",
 			expectedRowsAffected: uint64(3),
+			expectedInfo:         "Records: 3  Duplicates: 0  Warnings: 0",
 		},
 		{
 			name:                 "SQL_CALC_ROWS should not affect CLIENT_FOUND_ROWS output",
@@ -660,6 +1270,14 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "INSERT into test VALUES (10000),(10001),(10002)",
 			expectedRowsAffected: uint64(3),
+			expectedInfo:         "Records: 3  Duplicates: 0  Warnings: 0",
+		},
+		{
+			name:                 "INSERT of a single row has no info",
+			handler:              handler,
+			conn:                 dummyConn,
+			query:                "INSERT into test VALUES (10003)",
+			expectedRowsAffected: uint64(1),
 		},
 	}
 
@@ -667,13 +1285,58 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			handler.ComInitDB(test.conn, "test")
 			var rowsAffected uint64
+			var info string
 			err := handler.ComQuery(test.conn, test.query, func(res *sqltypes.Result, more bool) error {
 				rowsAffected = uint64(res.RowsAffected)
+				info = res.Info
 				return nil
 			})
 
 			require.NoError(t, err)
 			require.Equal(t, test.expectedRowsAffected, rowsAffected)
+			require.Equal(t, test.expectedInfo, info)
 		})
 	}
 }
+
+func TestHandlerPreParseHooks(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	e.PreParseHooks = append(e.PreParseHooks, func(ctx *sql.Context, query string) (string, error) {
+		return strings.Replace(query, "FROM routed", "FROM test", 1), nil
+	})
+	dummyConn := &mysql.Conn{ConnectionID: 1}
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	handler.NewConnection(dummyConn)
+	require.NoError(t, handler.ComInitDB(dummyConn, "test"))
+
+	var rows int
+	err := handler.ComQuery(dummyConn, "SELECT * FROM routed LIMIT 3", func(res *sqltypes.Result, more bool) error {
+		rows += len(res.Rows)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, rows)
+}
+
+func TestQueryLabels(t *testing.T) {
+	ctx := sql.NewContext(context.Background(), sql.WithQuery("SELECT 1 /* service:billing, user:x */"))
+	require.Equal(t, []string{"query", "SELECT 1"}, queryLabels(ctx, "query", "SELECT 1"))
+
+	defer func(labels []string) { QueryTagLabels = labels }(QueryTagLabels)
+	QueryTagLabels = []string{"service", "route"}
+	require.Equal(t, []string{"query", "SELECT 1", "service", "billing", "route", ""}, queryLabels(ctx, "query", "SELECT 1"))
+}
@@ -57,18 +57,35 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder, listener ServerEve
 		cfg.MaxConnections = 0
 	}
 
+	if unsupported := cfg.DisabledCapabilities &^ ConfigurableCapabilities; unsupported != 0 {
+		return nil, ErrUnsupportedCapabilities.New(unsupported)
+	}
+
+	sm := NewSessionManager(
+		sb,
+		tracer,
+		e.Analyzer.Catalog.HasDB,
+		e.MemoryManager,
+		e.ProcessList,
+		cfg.Address)
+	sm.disableLocalInfile = cfg.DisabledCapabilities&mysql.CapabilityClientLocalFiles != 0
+	sm.sessionVars = make(map[string]interface{})
+	if cfg.Version != "" {
+		sm.sessionVars["version"] = cfg.Version
+	}
+	if cfg.VersionComment != "" {
+		sm.sessionVars["version_comment"] = cfg.VersionComment
+	}
+
 	handler := NewHandler(e,
-		NewSessionManager(
-			sb,
-			tracer,
-			e.Analyzer.Catalog.HasDB,
-			e.MemoryManager,
-			e.ProcessList,
-			cfg.Address),
+		sm,
 		cfg.ConnReadTimeout,
 		cfg.DisableClientMultiStatements,
 		listener,
 	)
+	handler.maxResultRows = cfg.MaxResultRows
+	handler.maxResultBytes = cfg.MaxResultBytes
+	handler.disabledCapabilities = cfg.DisabledCapabilities
 	a := cfg.Auth.Mysql()
 	l, err := NewListener(cfg.Protocol, cfg.Address, handler)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/opentracing/opentracing-go"
+	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/auth"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -42,8 +43,11 @@ type Config struct {
 	// Tracer to use in the server. By default, a noop tracer will be used if
 	// no tracer is provided.
 	Tracer opentracing.Tracer
-	// Version string to advertise in running server
+	// Version string to advertise in running server. It's also the value of the version system variable.
 	Version string
+	// VersionComment is the value of the version_comment system variable, which some clients show next to the
+	// version of the server.
+	VersionComment string
 	// ConnReadTimeout is the server's read timeout
 	ConnReadTimeout time.Duration
 	// ConnWriteTimeout is the server's write timeout
@@ -64,8 +68,32 @@ type Config struct {
 	DisableClientMultiStatements bool
 	// NoDefaults prevents using persisted configuration for new server sessions
 	NoDefaults bool
+	// MaxResultRows is the maximum number of rows of the result set of a query. Queries returning more rows fail with
+	// ErrTooManyResultRows once they get past it. Zero means no maximum. Unlike the sql_select_limit system variable,
+	// which sessions can change and which silently truncates the result of SELECT statements, it can't be lifted by
+	// clients.
+	MaxResultRows uint64
+	// MaxResultBytes is the maximum size of the result set of a query, counting the bytes of the values of its rows
+	// as sent to the client. Queries with larger results fail with ErrResultSetTooLarge. Zero means no maximum.
+	MaxResultBytes uint64
+	// DisabledCapabilities are capability flags of the client protocol, such as mysql.CapabilityClientLocalFiles,
+	// that the server doesn't honor even when clients ask for them. Only the flags in ConfigurableCapabilities can be
+	// disabled. The flags are still advertised in the handshake, as the protocol layer doesn't let the server change
+	// them, but connections behave as if they had not been negotiated.
+	DisabledCapabilities uint32
 }
 
+// ConfigurableCapabilities are the capability flags that can be disabled with Config.DisabledCapabilities. Disabling
+// CapabilityClientFoundRows makes UPDATE statements report the number of rows changed rather than matched,
+// CapabilityClientMultiStatements works like DisableClientMultiStatements, and CapabilityClientLocalFiles makes LOAD
+// DATA LOCAL INFILE statements fail.
+const ConfigurableCapabilities = mysql.CapabilityClientFoundRows |
+	mysql.CapabilityClientMultiStatements |
+	mysql.CapabilityClientLocalFiles
+
+// ErrUnsupportedCapabilities is returned when creating a server with capability flags disabled which can't be.
+var ErrUnsupportedCapabilities = errors.NewKind("capability flags %#x can't be disabled")
+
 func (c Config) NewConfig() (Config, error) {
 	if _, val, ok := sql.SystemVariables.GetGlobal("max_connections"); ok {
 		mc, ok := val.(int64)
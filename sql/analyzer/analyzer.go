@@ -26,6 +26,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 )
 
 const debugAnalyzerKey = "DEBUG_ANALYZER"
@@ -53,6 +54,8 @@ type Builder struct {
 	validationRules     []Rule
 	afterAllRules       []Rule
 	provider            sql.DatabaseProvider
+	functions           function.Registry
+	definitionCache     DefinitionCache
 	debug               bool
 	parallelism         int
 }
@@ -77,12 +80,28 @@ func (ab *Builder) WithDebug() *Builder {
 	return ab
 }
 
-// WithParallelism sets the parallelism level on the analyzer.
+// WithParallelism sets the parallelism level on the analyzer. It's the number of goroutines used both to execute
+// parallelizable nodes and to analyze independent subqueries.
 func (ab *Builder) WithParallelism(parallelism int) *Builder {
 	ab.parallelism = parallelism
 	return ab
 }
 
+// WithFunctionRegistry sets the registry of built-in functions of the analyzer's catalog, which can be shared by
+// many analyzers. By default, every analyzer gets its own registry.
+func (ab *Builder) WithFunctionRegistry(functions function.Registry) *Builder {
+	ab.functions = functions
+	return ab
+}
+
+// WithDefinitionCache sets the cache of parsed view, trigger and stored procedure definitions of the analyzer, which
+// can be shared by many analyzers. By default, every analyzer gets its own cache of DefaultDefinitionCacheSize
+// definitions.
+func (ab *Builder) WithDefinitionCache(cache DefinitionCache) *Builder {
+	ab.definitionCache = cache
+	return ab
+}
+
 // AddPreAnalyzeRule adds a new rule to the analyze before the standard analyzer rules.
 func (ab *Builder) AddPreAnalyzeRule(name string, fn RuleFunc) *Builder {
 	ab.preAnalyzeRules = append(ab.preAnalyzeRules, Rule{name, fn})
@@ -241,13 +260,24 @@ func (ab *Builder) Build() *Analyzer {
 		},
 	}
 
+	catalog := NewCatalog(ab.provider)
+	if ab.functions != nil {
+		catalog = NewCatalogWithFunctions(ab.provider, ab.functions)
+	}
+
+	definitionCache := ab.definitionCache
+	if definitionCache == nil {
+		definitionCache = NewDefinitionCache(DefaultDefinitionCacheSize)
+	}
+
 	return &Analyzer{
-		Debug:          debug || ab.debug,
-		contextStack:   make([]string, 0),
-		Batches:        batches,
-		Catalog:        NewCatalog(ab.provider),
-		Parallelism:    ab.parallelism,
-		ProcedureCache: NewProcedureCache(),
+		Debug:           debug || ab.debug,
+		contextStack:    make([]string, 0),
+		Batches:         batches,
+		Catalog:         catalog,
+		Parallelism:     ab.parallelism,
+		ProcedureCache:  NewProcedureCache(),
+		DefinitionCache: definitionCache,
 	}
 }
 
@@ -267,6 +297,9 @@ type Analyzer struct {
 	Catalog sql.Catalog
 	// ProcedureCache is a cache of stored procedures.
 	ProcedureCache *ProcedureCache
+	// DefinitionCache is a cache of parsed view, trigger and stored procedure definitions. If nil, definitions are
+	// parsed every time they're used.
+	DefinitionCache DefinitionCache
 }
 
 // NewDefault creates a default Analyzer instance with all default Rules and configuration.
@@ -324,6 +357,13 @@ func (a *Analyzer) LogDiff(prev, next sql.Node) {
 	}
 }
 
+// fork returns a copy of the analyzer that can be used concurrently with it, with its own debug context stack.
+func (a *Analyzer) fork() *Analyzer {
+	forked := *a
+	forked.contextStack = append([]string(nil), a.contextStack...)
+	return &forked
+}
+
 // PushDebugContext pushes the given context string onto the context stack, to use when logging debug messages.
 func (a *Analyzer) PushDebugContext(msg string) {
 	if a != nil {
@@ -30,7 +30,9 @@ func applyHashIn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.N
 		e, err := expression.TransformUp(filter.Expression, func(expr sql.Expression) (sql.Expression, error) {
 			if e, ok := expr.(*expression.InTuple); ok &&
 				hasSingleOutput(e.Left()) &&
-				isStatic(e.Right()) {
+				isStatic(e.Right()) &&
+				!hasNullTupleElements(e.Left()) &&
+				!hasNullTupleElements(e.Right()) {
 				return expression.NewHashInTuple(e.Left(), e.Right())
 			}
 			return expr, nil
@@ -58,6 +60,21 @@ func hasSingleOutput(e sql.Expression) bool {
 	})
 }
 
+// hasNullTupleElements checks if an expression has tuples with NULL elements, which can't be hashed since row values
+// with NULL elements are neither equal nor unequal to row values that only differ in those elements.
+func hasNullTupleElements(e sql.Expression) bool {
+	return expression.InspectUp(e, func(expr sql.Expression) bool {
+		if t, ok := expr.(expression.Tuple); ok && len(t) > 1 {
+			for _, el := range t {
+				if el.Type() == sql.Null {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
 // isStatic checks if an expression is static
 func isStatic(e sql.Expression) bool {
 	return !expression.InspectUp(e, func(expr sql.Expression) bool {
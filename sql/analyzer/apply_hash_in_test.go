@@ -534,6 +534,35 @@ func TestApplyHashIn(t *testing.T) {
 				child,
 			),
 		},
+		{
+			name: "skip filter with null tuple elements",
+			node: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewTuple(
+						expression.NewGetField(0, sql.Int64, "a", false),
+						expression.NewGetField(1, sql.Int64, "b", false),
+					),
+					expression.NewTuple(
+						expression.NewTuple(expression.NewLiteral(int64(2), sql.Int64), expression.NewLiteral(nil, sql.Null)),
+						expression.NewTuple(expression.NewLiteral(int64(1), sql.Int64), expression.NewLiteral(int64(0), sql.Int64)),
+					),
+				),
+				child,
+			),
+			expected: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewTuple(
+						expression.NewGetField(0, sql.Int64, "a", false),
+						expression.NewGetField(1, sql.Int64, "b", false),
+					),
+					expression.NewTuple(
+						expression.NewTuple(expression.NewLiteral(int64(2), sql.Int64), expression.NewLiteral(nil, sql.Null)),
+						expression.NewTuple(expression.NewLiteral(int64(1), sql.Int64), expression.NewLiteral(int64(0), sql.Int64)),
+					),
+				),
+				child,
+			),
+		},
 	}
 
 	runTestCases(t, sql.NewEmptyContext(), tests, NewDefault(sql.NewDatabaseProvider()), getRule("apply_hash_in"))
@@ -80,6 +80,10 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, nil
+		case *plan.FlushTables:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
 		case *plan.ResolvedTable:
 			nc := *node
 			ct, ok := nc.Table.(CatalogTable)
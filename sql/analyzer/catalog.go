@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -25,10 +26,14 @@ import (
 )
 
 type Catalog struct {
-	provider         sql.DatabaseProvider
+	provider sql.DatabaseProvider
+	// builtInFunctions may be shared with other catalogs, so it's never modified by the catalog.
 	builtInFunctions function.Registry
-	mu               sync.RWMutex
-	locks            sessionLocks
+	// functions are the functions registered on this catalog only.
+	functions     function.Registry
+	mu            sync.RWMutex
+	locks         sessionLocks
+	schemaVersion uint64
 }
 
 type tableLocks map[string]struct{}
@@ -39,9 +44,17 @@ type sessionLocks map[uint32]dbLocks
 
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) sql.Catalog {
+	return NewCatalogWithFunctions(provider, function.NewRegistry())
+}
+
+// NewCatalogWithFunctions returns a new empty Catalog with the given provider and built-in functions. The registry of
+// built-in functions can be shared by the catalogs of many engines, e.g. one per tenant, as functions registered on a
+// catalog are only visible to it and never added to the shared registry.
+func NewCatalogWithFunctions(provider sql.DatabaseProvider, builtIns function.Registry) sql.Catalog {
 	return &Catalog{
 		provider:         provider,
-		builtInFunctions: function.NewRegistry(),
+		builtInFunctions: builtIns,
+		functions:        make(function.Registry),
 		locks:            make(sessionLocks),
 	}
 }
@@ -98,11 +111,10 @@ func (c *Catalog) Database(db string) (sql.Database, error) {
 	return c.provider.Database(db)
 }
 
-// LockTable adds a lock for the given table and session client. It is assumed
-// the database is the current database in use.
-func (c *Catalog) LockTable(ctx *sql.Context, table string) {
+// LockTable adds a lock for the given table of the given database and session
+// client.
+func (c *Catalog) LockTable(ctx *sql.Context, db, table string) {
 	id := ctx.ID()
-	db := ctx.GetCurrentDatabase()
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -153,7 +165,18 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	return nil
 }
 
-// Table returns the table in the given database with the given name.
+// SchemaVersion returns the version of the schema of the catalog.
+func (c *Catalog) SchemaVersion() uint64 {
+	return atomic.LoadUint64(&c.schemaVersion)
+}
+
+// SchemaChanged increments the schema version of the catalog.
+func (c *Catalog) SchemaChanged() {
+	atomic.AddUint64(&c.schemaVersion, 1)
+}
+
+// Table returns the table in the given database with the given name. Tables overridden by the session of the context
+// given take precedence over the ones in the database.
 func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table, sql.Database, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -163,6 +186,12 @@ func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table,
 		return nil, nil, err
 	}
 
+	if overrides, ok := ctx.Session.(sql.TableOverrideSession); ok {
+		if tbl, ok := overrides.TableOverride(db.Name(), tableName); ok {
+			return tbl, db, nil
+		}
+	}
+
 	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
 	if err != nil {
 		return nil, nil, err
@@ -174,7 +203,7 @@ func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table,
 }
 
 // TableAsOf returns the table in the given database with the given name, as it existed at the time given. The database
-// named must support timed queries.
+// named, or the table itself, must support timed queries.
 func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf interface{}) (sql.Table, sql.Database, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -184,27 +213,27 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 		return nil, nil, err
 	}
 
-	versionedDb, ok := db.(sql.VersionedDatabase)
-	if !ok {
-		return nil, nil, sql.ErrAsOfNotSupported.New(tableName)
-	}
-
-	tbl, ok, err := versionedDb.GetTableInsensitiveAsOf(ctx, tableName, asOf)
-
+	tbl, ok, err := sql.GetTableInsensitiveAsOf(ctx, db, tableName, asOf)
 	if err != nil {
 		return nil, nil, err
 	} else if !ok {
-		return nil, nil, suggestSimilarTablesAsOf(versionedDb, ctx, tableName, asOf)
+		if versionedDb, ok := db.(sql.VersionedDatabase); ok {
+			return nil, nil, suggestSimilarTablesAsOf(versionedDb, ctx, tableName, asOf)
+		}
+		return nil, nil, suggestSimilarTables(db, ctx, tableName)
 	}
 
-	return tbl, versionedDb, nil
+	return tbl, db, nil
 }
 
-// RegisterFunction registers the functions given, adding them to the built-in functions.
+// RegisterFunction registers the functions given on this catalog, alongside the built-in functions.
 // Integrators with custom functions should typically use the FunctionProvider interface instead.
 func (c *Catalog) RegisterFunction(fns ...sql.Function) {
 	for _, fn := range fns {
-		err := c.builtInFunctions.Register(fn)
+		if _, ok := c.builtInFunctions[fn.FunctionName()]; ok {
+			panic(function.ErrFunctionAlreadyRegistered.New(fn.FunctionName()))
+		}
+		err := c.functions.Register(fn)
 		if err != nil {
 			panic(err)
 		}
@@ -222,6 +251,9 @@ func (c *Catalog) Function(name string) (sql.Function, error) {
 		}
 	}
 
+	if f, ok := c.functions[name]; ok {
+		return f, nil
+	}
 	return c.builtInFunctions.Function(name)
 }
 
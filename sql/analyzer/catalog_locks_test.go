@@ -28,15 +28,12 @@ func TestCatalogLockTable(t *testing.T) {
 	c := NewCatalog(NewDatabaseProvider())
 
 	ctx1 := sql.NewContext(context.Background())
-	ctx1.SetCurrentDatabase("db1")
 	ctx2 := sql.NewContext(context.Background())
-	ctx2.SetCurrentDatabase("db1")
 
-	c.LockTable(ctx1, "foo")
-	c.LockTable(ctx2, "bar")
-	c.LockTable(ctx1, "baz")
-	ctx1.SetCurrentDatabase("db2")
-	c.LockTable(ctx1, "qux")
+	c.LockTable(ctx1, "db1", "foo")
+	c.LockTable(ctx2, "db1", "bar")
+	c.LockTable(ctx1, "db1", "baz")
+	c.LockTable(ctx1, "db2", "qux")
 
 	expected := sessionLocks{
 		ctx1.ID(): dbLocks{
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 )
 
 func TestAllDatabases(t *testing.T) {
@@ -79,6 +80,66 @@ func TestCatalogTable(t *testing.T) {
 	require.Equal(mytable, table)
 }
 
+func TestCatalogTableOverride(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("foo")
+	mytable := memory.NewTable("bar", sql.PrimaryKeySchema{})
+	db.AddTable("bar", mytable)
+	c := NewCatalog(sql.NewDatabaseProvider(db))
+
+	session := sql.NewBaseSession()
+	ctx := sql.NewContext(context.Background(), sql.WithSession(session))
+	otherCtx := sql.NewEmptyContext()
+
+	fixture := memory.NewTable("BAR", sql.PrimaryKeySchema{})
+	session.SetTableOverride("FOO", fixture)
+
+	table, _, err := c.Table(ctx, "foo", "bar")
+	require.NoError(err)
+	require.Equal(fixture, table)
+
+	table, _, err = c.Table(otherCtx, "foo", "bar")
+	require.NoError(err)
+	require.Equal(mytable, table)
+
+	missing := memory.NewTable("baz", sql.PrimaryKeySchema{})
+	session.SetTableOverride("foo", missing)
+
+	table, _, err = c.Table(ctx, "foo", "baz")
+	require.NoError(err)
+	require.Equal(missing, table)
+
+	session.RemoveTableOverride("foo", "bar")
+	table, _, err = c.Table(ctx, "foo", "bar")
+	require.NoError(err)
+	require.Equal(mytable, table)
+}
+
+func TestCatalogSharedFunctions(t *testing.T) {
+	require := require.New(t)
+
+	builtIns := function.NewRegistry()
+	c1 := NewCatalogWithFunctions(sql.NewDatabaseProvider(memory.NewDatabase("a")), builtIns)
+	c2 := NewCatalogWithFunctions(sql.NewDatabaseProvider(memory.NewDatabase("b")), builtIns)
+
+	c1.RegisterFunction(sql.Function0{Name: "tenant_fn", Fn: function.NewDatabase})
+
+	_, err := c1.Function("tenant_fn")
+	require.NoError(err)
+	_, err = c2.Function("tenant_fn")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	_, ok := builtIns["tenant_fn"]
+	require.False(ok)
+
+	_, err = c2.Function("abs")
+	require.NoError(err)
+
+	require.Panics(func() {
+		c2.RegisterFunction(sql.Function1{Name: "abs", Fn: function.NewAbsVal})
+	})
+}
+
 func TestCatalogUnlockTables(t *testing.T) {
 	require := require.New(t)
 
@@ -92,8 +153,8 @@ func TestCatalogUnlockTables(t *testing.T) {
 
 	ctx := sql.NewContext(context.Background())
 	ctx.SetCurrentDatabase(db.Name())
-	c.LockTable(ctx, "t1")
-	c.LockTable(ctx, "t2")
+	c.LockTable(ctx, db.Name(), "t1")
+	c.LockTable(ctx, db.Name(), "t2")
 
 	require.NoError(c.UnlockTables(ctx, ctx.ID()))
 
@@ -0,0 +1,143 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// DefaultDefinitionCacheSize is the number of definitions kept by the definition cache of an analyzer built without
+// one of its own.
+const DefaultDefinitionCacheSize = 512
+
+// DefinitionKey identifies a definition in a DefinitionCache. It's a hash of the text of the definition, along with the
+// session settings that it's parsed differently under.
+type DefinitionKey [sha256.Size]byte
+
+// DefinitionCache caches the parsed definitions of views, triggers and stored procedures, so that their bodies aren't
+// parsed again every time they're used. As definitions are keyed by a hash of their text, a definition that changes is
+// never served from the cache. Implementations must be safe for concurrent use, and must not modify the nodes cached,
+// which are shared by every query that uses the definition.
+type DefinitionCache interface {
+	// Get returns the node parsed from the definition with the key given, if it's cached.
+	Get(key DefinitionKey) (sql.Node, bool)
+	// Put caches the node parsed from the definition with the key given.
+	Put(key DefinitionKey, node sql.Node)
+	// Invalidate removes every definition from the cache. It's called whenever views, triggers or stored procedures
+	// are created or dropped.
+	Invalidate()
+}
+
+// lruDefinitionCache is a DefinitionCache that keeps the most recently used definitions.
+type lruDefinitionCache struct {
+	cache *lru.Cache
+}
+
+var _ DefinitionCache = (*lruDefinitionCache)(nil)
+
+// NewDefinitionCache returns a DefinitionCache that keeps the given number of most recently used definitions.
+func NewDefinitionCache(size int) DefinitionCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &lruDefinitionCache{cache: cache}
+}
+
+// Get implements the DefinitionCache interface.
+func (c *lruDefinitionCache) Get(key DefinitionKey) (sql.Node, bool) {
+	node, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return node.(sql.Node), true
+}
+
+// Put implements the DefinitionCache interface.
+func (c *lruDefinitionCache) Put(key DefinitionKey, node sql.Node) {
+	c.cache.Add(key, node)
+}
+
+// Invalidate implements the DefinitionCache interface.
+func (c *lruDefinitionCache) Invalidate() {
+	c.cache.Purge()
+}
+
+// definitionSessionVariables are the session variables that the parser reads, which change the node that a
+// definition is parsed to.
+var definitionSessionVariables = []string{"sql_select_limit", "group_concat_max_len"}
+
+// definitionKey returns the key of the definition given in the cache.
+func definitionKey(ctx *sql.Context, definition string) (DefinitionKey, error) {
+	h := sha256.New()
+	h.Write([]byte(definition))
+	for _, name := range definitionSessionVariables {
+		val, err := ctx.GetSessionVariable(ctx, name)
+		if err != nil {
+			return DefinitionKey{}, err
+		}
+		fmt.Fprintf(h, "\x00%v", val)
+	}
+
+	var key DefinitionKey
+	copy(key[:], h.Sum(nil))
+	return key, nil
+}
+
+// parseDefinition parses the definition of a view, trigger or stored procedure, going through the analyzer's
+// definition cache if it has one.
+func (a *Analyzer) parseDefinition(ctx *sql.Context, definition string) (sql.Node, error) {
+	if a.DefinitionCache == nil {
+		return parse.Parse(ctx, definition)
+	}
+
+	key, err := definitionKey(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	if node, ok := a.DefinitionCache.Get(key); ok {
+		return node, nil
+	}
+
+	node, err := parse.Parse(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	a.DefinitionCache.Put(key, node)
+	return node, nil
+}
+
+// invalidateDefinitionCache empties the analyzer's definition cache when a view, trigger or stored procedure is created
+// or dropped, which includes dropping the tables or databases they belong to. Since definitions are keyed by their
+// text this isn't needed for correctness, but it keeps definitions that no longer exist from lingering in the cache.
+func invalidateDefinitionCache(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if a.DefinitionCache == nil {
+		return n, nil
+	}
+
+	switch n.(type) {
+	case *plan.CreateView, *plan.DropView, *plan.CreateTrigger, *plan.DropTrigger, *plan.CreateProcedure,
+		*plan.DropProcedure, *plan.DropTable, *plan.DropDB:
+		a.DefinitionCache.Invalidate()
+	}
+	return n, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestParseDefinition(t *testing.T) {
+	require := require.New(t)
+
+	const definition = "select i from mytable"
+	a := NewDefault(nil)
+	ctx := sql.NewEmptyContext()
+
+	parsed, err := a.parseDefinition(ctx, definition)
+	require.NoError(err)
+	cached, err := a.parseDefinition(ctx, definition)
+	require.NoError(err)
+	require.True(parsed == cached, "expected the cached node to be returned")
+
+	other, err := a.parseDefinition(ctx, "select s from mytable")
+	require.NoError(err)
+	require.False(parsed == other)
+
+	// The parser applies sql_select_limit, so definitions parsed under another limit are cached apart
+	require.NoError(ctx.SetSessionVariable(ctx, "sql_select_limit", int64(10)))
+	limited, err := a.parseDefinition(ctx, definition)
+	require.NoError(err)
+	require.False(parsed == limited)
+	require.IsType(&plan.Limit{}, limited)
+
+	a.DefinitionCache = nil
+	uncached, err := a.parseDefinition(ctx, definition)
+	require.NoError(err)
+	require.False(limited == uncached)
+	require.Equal(limited, uncached)
+}
+
+func TestInvalidateDefinitionCache(t *testing.T) {
+	require := require.New(t)
+
+	a := NewDefault(nil)
+	ctx := sql.NewEmptyContext()
+	parsed, err := a.parseDefinition(ctx, "select 1")
+	require.NoError(err)
+
+	_, err = invalidateDefinitionCache(ctx, a, plan.NewShowTables(nil, false, nil), nil)
+	require.NoError(err)
+	cached, err := a.parseDefinition(ctx, "select 1")
+	require.NoError(err)
+	require.True(parsed == cached, "expected the cached node to be returned")
+
+	_, err = invalidateDefinitionCache(ctx, a, plan.NewDropView(nil, false), nil)
+	require.NoError(err)
+	reparsed, err := a.parseDefinition(ctx, "select 1")
+	require.NoError(err)
+	require.False(parsed == reparsed)
+	require.Equal(parsed, reparsed)
+}
@@ -26,10 +26,20 @@ func resolveDescribeQuery(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 		return n, nil
 	}
 
-	q, err := a.Analyze(ctx, d.Query(), scope)
+	if !d.Extended {
+		q, err := a.Analyze(ctx, d.Query(), scope)
+		if err != nil {
+			return nil, err
+		}
+		return d.WithQuery(StripQueryProcess(q)), nil
+	}
+
+	// EXPLAIN EXTENDED reports the indexes the analyzer considered but couldn't use
+	diagnosticsCtx, diagnostics := withIndexDiagnostics(ctx)
+	q, err := a.Analyze(diagnosticsCtx, d.Query(), scope)
 	if err != nil {
 		return nil, err
 	}
-
-	return d.WithQuery(StripQueryProcess(q)), nil
+	q = StripQueryProcess(q)
+	return d.WithQuery(q).(*plan.DescribeQuery).WithNotes(diagnostics.notes(q)), nil
 }
@@ -0,0 +1,44 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveDivPrecisionIncrement fixes the div_precision_increment of the session on the divisions of the node, so that
+// the type of their results, which is sent to clients, has the scale of the values they evaluate to.
+func resolveDivPrecisionIncrement(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("resolve_div_precision_increment")
+	defer span.Finish()
+
+	var increment *int32
+	return plan.TransformExpressionsUp(n, func(e sql.Expression) (sql.Expression, error) {
+		div, ok := e.(*expression.Arithmetic)
+		if !ok || !div.IsDivision() {
+			return e, nil
+		}
+		if increment == nil {
+			i, err := expression.DivPrecisionIncrement(ctx)
+			if err != nil {
+				return nil, err
+			}
+			increment = &i
+		}
+		return div.WithDivPrecisionIncrement(*increment), nil
+	})
+}
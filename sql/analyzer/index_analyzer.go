@@ -162,6 +162,8 @@ func (r *indexAnalyzer) MatchingIndexes(ctx *sql.Context, db string, table strin
 		indexExprs := idx.Expressions()
 		if ok, prefixCount := exprsAreIndexSubset(exprStrs, indexExprs); ok && prefixCount >= 1 {
 			indexes = append(indexes, idxWithLen{idx, len(indexExprs), prefixCount})
+		} else if ok {
+			rejectIndex(ctx, idx, indexColumnName(exprStrs[0]), indexNotPrefix)
 		}
 	}
 
@@ -0,0 +1,176 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// indexRejection is the reason why the analyzer couldn't use an index it considered.
+type indexRejection byte
+
+const (
+	// indexTypeConversion means the values of the column are converted to another type to be compared, as strings
+	// compared with numbers are, so they can't be looked up in the order of the index.
+	indexTypeConversion indexRejection = iota
+	// indexCollationConversion means the values of the column are compared with a collation other than the one of the
+	// column, so they can't be looked up in the order of the index.
+	indexCollationConversion
+	// indexNotPrefix means the column compared isn't a prefix of the index, so the index can't narrow the rows read.
+	indexNotPrefix
+)
+
+// rejectedIndex is an index the analyzer considered for a column but couldn't use.
+type rejectedIndex struct {
+	index  sql.Index
+	column string
+	reason indexRejection
+}
+
+func (r rejectedIndex) String() string {
+	switch r.reason {
+	case indexTypeConversion:
+		return fmt.Sprintf("Cannot use index '%s' of table '%s' due to type conversion on field '%s'", r.index.ID(), r.index.Table(), r.column)
+	case indexCollationConversion:
+		return fmt.Sprintf("Cannot use index '%s' of table '%s' due to collation conversion on field '%s'", r.index.ID(), r.index.Table(), r.column)
+	default:
+		return fmt.Sprintf("Cannot use index '%s' of table '%s' because field '%s' is not a prefix of the index", r.index.ID(), r.index.Table(), r.column)
+	}
+}
+
+// indexDiagnostics collects the indexes rejected while analyzing a query, for EXPLAIN EXTENDED. Subqueries may be
+// analyzed concurrently, so it's safe for concurrent use.
+type indexDiagnostics struct {
+	mu       sync.Mutex
+	rejected []rejectedIndex
+}
+
+type indexDiagnosticsKey struct{}
+
+// withIndexDiagnostics returns a context that collects the indexes rejected while analyzing a query with it.
+func withIndexDiagnostics(ctx *sql.Context) (*sql.Context, *indexDiagnostics) {
+	d := &indexDiagnostics{}
+	return ctx.WithContext(context.WithValue(ctx.Context, indexDiagnosticsKey{}, d)), d
+}
+
+// rejectIndex records that the index given couldn't be used for the column given, if the context collects rejected
+// indexes.
+func rejectIndex(ctx *sql.Context, idx sql.Index, column string, reason indexRejection) {
+	d, ok := ctx.Value(indexDiagnosticsKey{}).(*indexDiagnostics)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rejected = append(d.rejected, rejectedIndex{index: idx, column: column, reason: reason})
+}
+
+// notes returns the descriptions of the rejected indexes, without duplicates and without the indexes the query plan
+// given ended up using anyway, such as an index rejected for one of the expressions of a filter but used for another.
+func (d *indexDiagnostics) notes(node sql.Node) []string {
+	used := make(map[string]bool)
+	var inspect func(node sql.Node)
+	inspect = func(node sql.Node) {
+		plan.Inspect(node, func(node sql.Node) bool {
+			if ita, ok := node.(*plan.IndexedTableAccess); ok && ita.Index() != nil {
+				used[indexKey(ita.Index())] = true
+			}
+			return true
+		})
+		plan.InspectExpressions(node, func(e sql.Expression) bool {
+			if sq, ok := e.(*plan.Subquery); ok {
+				inspect(sq.Query)
+			}
+			return true
+		})
+	}
+	inspect(node)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var notes []string
+	seen := make(map[string]bool)
+	for _, r := range d.rejected {
+		note := r.String()
+		if used[indexKey(r.index)] || seen[note] {
+			continue
+		}
+		seen[note] = true
+		notes = append(notes, note)
+	}
+	return notes
+}
+
+func indexKey(idx sql.Index) string {
+	return strings.ToLower(idx.Database() + "." + idx.Table() + "." + idx.ID())
+}
+
+// indexConversion returns whether the values of the column given are converted to be compared with the comparand
+// given, and why. As in MySQL, strings compared with numbers are compared as numbers, and strings compared with a
+// collation other than their own, which is the collation given, are compared in the order of that collation. Either
+// way, the order of an index on the column doesn't apply to the compared values, so the index can't look them up.
+func indexConversion(column, comparand sql.Expression, collation sql.Collation) (indexRejection, bool) {
+	if _, ok := column.(*expression.GetField); !ok || comparand == nil {
+		return 0, false
+	}
+
+	st, ok := column.Type().(sql.StringType)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case sql.IsNumber(comparand.Type()):
+		return indexTypeConversion, true
+	case sql.IsText(comparand.Type()) && !st.Collation().Equals(collation):
+		return indexCollationConversion, true
+	default:
+		return 0, false
+	}
+}
+
+// betweenIndexConversion returns whether the values of the column of the BETWEEN expression given are converted to
+// be compared with either of its bounds, and why.
+func betweenIndexConversion(e *expression.Between) (indexRejection, bool) {
+	if reason, ok := indexConversion(e.Val, e.Lower, expression.ResolveCollation(e.Val, e.Lower)); ok {
+		return reason, ok
+	}
+	return indexConversion(e.Val, e.Upper, expression.ResolveCollation(e.Val, e.Upper))
+}
+
+// comparisonCollation returns the collation strings are compared with by the comparison given.
+func comparisonCollation(e sql.Expression) sql.Collation {
+	switch e := e.(type) {
+	case expression.Comparer:
+		return expression.ResolveCollation(e.Left(), e.Right())
+	case *expression.Not:
+		return comparisonCollation(e.Child)
+	default:
+		return sql.Collation_Default
+	}
+}
+
+// indexColumnName returns the name of the column of the index expression given, such as b for mytable.b.
+func indexColumnName(indexExpr string) string {
+	return indexExpr[strings.LastIndex(indexExpr, ".")+1:]
+}
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -216,6 +218,10 @@ func getIndexes(
 		*expression.GreaterThan,
 		*expression.LessThanOrEqual,
 		*expression.GreaterThanOrEqual:
+		if cmp := e.(expression.Comparer); sql.IsTuple(cmp.Left().Type()) && sql.IsTuple(cmp.Right().Type()) {
+			return getTupleComparisonIndexes(ctx, ia, cmp, tableAliases)
+		}
+
 		lookup, err := getComparisonIndexLookup(ctx, a, ia, e.(expression.Comparer), tableAliases)
 		if err != nil || lookup == nil {
 			return result, err
@@ -247,6 +253,10 @@ func getIndexes(
 
 			normalizedExpressions := normalizeExpressions(ctx, tableAliases, e.Val)
 			idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), gf.Table(), normalizedExpressions...)
+			if reason, ok := betweenIndexConversion(e); idx != nil && ok {
+				rejectIndex(ctx, idx, gf.Name(), reason)
+				return nil, nil
+			}
 			if idx != nil {
 
 				upper, err := e.Upper.Eval(ctx, nil)
@@ -338,6 +348,10 @@ func getComparisonIndexLookup(
 
 		normalizedExpressions := normalizeExpressions(ctx, tableAliases, left)
 		idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), gf.Table(), normalizedExpressions...)
+		if reason, ok := indexConversion(left, right, comparisonCollation(e)); idx != nil && ok {
+			rejectIndex(ctx, idx, gf.Name(), reason)
+			return nil, nil
+		}
 		if idx != nil {
 			value, err := right.Eval(ctx, nil)
 			if err != nil {
@@ -374,6 +388,138 @@ func getComparisonIndexLookup(
 	return nil, nil
 }
 
+// getTupleComparisonIndexes returns the index lookup for a comparison of row values such as (a, b) > (1, 2), where one
+// side holds columns of a table that lead one of its indexes and the other side is evaluable. The lookup covers the
+// union of the ranges of a > 1 and of a = 1 AND b > 2, which lets keyset pagination seek to the first row of a page.
+// When the index covers only the leading columns of the row, the ranges of those are used instead, since the filter
+// on the full comparison still applies to the rows of the lookup.
+func getTupleComparisonIndexes(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	e expression.Comparer,
+	tableAliases TableAliases,
+) (indexLookupsByTable, error) {
+	left, right := e.Left(), e.Right()
+	// if the form is SOMETHING OP {INDEXABLE EXPR}, swap it, so it's {INDEXABLE EXPR} OP SOMETHING
+	if !isEvaluable(right) {
+		left, right, e = swapTermsOfExpression(e)
+	}
+
+	cols, ok := left.(expression.Tuple)
+	if !ok || !isEvaluable(right) {
+		return nil, nil
+	}
+
+	var table string
+	for _, col := range cols {
+		gf, ok := col.(*expression.GetField)
+		if !ok || (table != "" && gf.Table() != table) {
+			return nil, nil
+		}
+		table = gf.Table()
+	}
+
+	// Only the columns of the row leading an index in the same order narrow down its ranges, so look for the index
+	// matching the most leading columns of the row
+	normalizedExpressions := normalizeExpressions(ctx, tableAliases, cols...)
+	var idx sql.Index
+	prefix := 0
+	for n := len(cols); n > 0 && idx == nil; n-- {
+		idx = ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), table, normalizedExpressions[:n]...)
+		if idx == nil {
+			continue
+		}
+		indexExprs := idx.Expressions()
+		for prefix < n && prefix < len(indexExprs) &&
+			strings.EqualFold(indexExprs[prefix], normalizedExpressions[prefix].String()) {
+			prefix++
+		}
+	}
+	if prefix == 0 {
+		return nil, nil
+	}
+
+	value, err := right.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := value.([]interface{})
+	if !ok || len(values) != len(cols) {
+		return nil, nil
+	}
+	for _, v := range values[:prefix] {
+		// Comparisons with NULL elements match no rows, or only depend on the columns before them
+		if v == nil {
+			return nil, nil
+		}
+	}
+
+	// rangesFor returns the ranges of the rows equal to the values of the columns before the one given, and for which
+	// the column given compares to its value as the comparison given does.
+	rangesFor := func(col int, cmp expression.Comparer) sql.RangeCollection {
+		b := sql.NewIndexBuilder(ctx, idx)
+		for i := 0; i < col; i++ {
+			b = b.Equals(ctx, normalizedExpressions[i].String(), values[i])
+		}
+		colExpr := normalizedExpressions[col].String()
+		switch cmp.(type) {
+		case *expression.Equals, *expression.NullSafeEquals:
+			b = b.Equals(ctx, colExpr, values[col])
+		case *expression.GreaterThan:
+			b = b.GreaterThan(ctx, colExpr, values[col])
+		case *expression.GreaterThanOrEqual:
+			b = b.GreaterOrEqual(ctx, colExpr, values[col])
+		case *expression.LessThan:
+			b = b.LessThan(ctx, colExpr, values[col])
+		case *expression.LessThanOrEqual:
+			b = b.LessOrEqual(ctx, colExpr, values[col])
+		}
+		return b.Ranges(ctx)
+	}
+
+	var ranges sql.RangeCollection
+	switch e.(type) {
+	case *expression.Equals, *expression.NullSafeEquals:
+		ranges = rangesFor(prefix-1, e)
+	default:
+		// The comparison is strict on every column but the last one of the row, or on all of them if it isn't strict
+		strict, last := e, e
+		switch e.(type) {
+		case *expression.GreaterThan, *expression.GreaterThanOrEqual:
+			strict, last = expression.NewGreaterThan(nil, nil), expression.NewGreaterThanOrEqual(nil, nil)
+		case *expression.LessThan, *expression.LessThanOrEqual:
+			strict, last = expression.NewLessThan(nil, nil), expression.NewLessThanOrEqual(nil, nil)
+		}
+		if prefix == len(cols) {
+			last = e
+		}
+		for i := 0; i < prefix-1; i++ {
+			ranges = append(ranges, rangesFor(i, strict)...)
+		}
+		ranges = append(ranges, rangesFor(prefix-1, last)...)
+	}
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+
+	ranges, err = sql.RemoveOverlappingRanges(ranges...)
+	if err != nil || len(ranges) == 0 {
+		return nil, nil
+	}
+	lookup, err := idx.NewLookup(ctx, ranges...)
+	if err != nil || lookup == nil {
+		return nil, err
+	}
+
+	return indexLookupsByTable{
+		table: {
+			exprs:   cols[:prefix],
+			indexes: []sql.Index{idx},
+			lookup:  lookup,
+		},
+	}, nil
+}
+
 // Returns an equivalent expression to the one given with the left and right terms reversed. The new left and right side
 // of the expression are returned as well.
 func swapTermsOfExpression(e expression.Comparer) (left sql.Expression, right sql.Expression, newExpr expression.Comparer) {
@@ -673,6 +819,15 @@ func getMultiColumnIndexForExpressions(
 		matchedExprs := findColumns(exprs, selectedExpr.String())
 
 		for _, expr := range matchedExprs {
+			reason, ok := indexConversion(expr.colExpr, expr.comparand, comparisonCollation(expr.comparison))
+			if between, isBetween := expr.comparison.(*expression.Between); isBetween {
+				reason, ok = betweenIndexConversion(between)
+			}
+			if ok {
+				rejectIndex(ctx, index, expr.col.Name(), reason)
+				return nil, nil
+			}
+
 			switch expr.comparison.(type) {
 			case *expression.Equals,
 				*expression.NullSafeEquals,
@@ -970,7 +1125,8 @@ func extractJoinColumnExpr(e sql.Expression) (leftCol *joinColExpr, rightCol *jo
 func containsColumns(e sql.Expression) bool {
 	var result bool
 	sql.Inspect(e, func(e sql.Expression) bool {
-		if _, ok := e.(*expression.GetField); ok {
+		switch e.(type) {
+		case *expression.GetField, *expression.Star:
 			result = true
 			return false
 		}
@@ -992,7 +1148,7 @@ func containsSubquery(e sql.Expression) bool {
 }
 
 func isEvaluable(e sql.Expression) bool {
-	return !containsColumns(e) && !containsSubquery(e) && !containsBindvars(e)
+	return !containsColumns(e) && !containsSubquery(e) && !containsBindvars(e) && !containsAggregation(e)
 }
 
 func containsBindvars(e sql.Expression) bool {
@@ -130,6 +130,8 @@ type joinOrderNode struct {
 	right    *joinOrderNode
 	order    []int
 	cost     uint64
+	// stats are the estimates of the table of a leaf node, if it provides any.
+	stats *sql.TableStatistics
 }
 
 func (jo *joinOrderNode) String() string {
@@ -284,12 +286,13 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 
 		rt := getResolvedTable(jo.node)
 		// TODO: also consider indexes which could be pushed down to this table, if it's the first one
-		if st, ok := rt.Table.(sql.StatisticsTable); ok {
-			numRows, err := st.NumRows(ctx)
-			if err != nil {
-				return err
-			}
-			jo.cost = numRows
+		stats, ok, err := sql.GetTableStatistics(ctx, rt.Table)
+		if err != nil {
+			return err
+		}
+		if ok {
+			jo.stats = stats
+			jo.cost = stats.RowCount
 		} else {
 			jo.cost = uint64(1000)
 		}
@@ -346,8 +349,14 @@ func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder [
 			indexes := joinIndexes[strings.ToLower(jo.commutes[idx].node.Name())]
 			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
 			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
-			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
+			var usableIndex *joinIndex
+			if i > 0 && !isSubquery && !isValuesTable {
+				usableIndex = indexes.getUsableIndex(availableSchemaForKeys)
+			}
+			if usableIndex == nil {
 				cost *= jo.commutes[idx].cost
+			} else if rowsPerKey, ok := jo.commutes[idx].rowsPerLookup(usableIndex); ok {
+				cost = cost*rowsPerKey + 1
 			} else {
 				cost += 1
 			}
@@ -358,6 +367,31 @@ func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder [
 	return cost, nil
 }
 
+// rowsPerLookup returns the estimated number of rows of this leaf node matched by each lookup into the join index
+// given, which is known when the table estimates the number of distinct values of the columns of the index.
+func (jo *joinOrderNode) rowsPerLookup(ji *joinIndex) (uint64, bool) {
+	if jo.stats == nil || ji.index == nil {
+		return 0, false
+	}
+
+	// The number of distinct keys is at least the number of distinct values of its most selective column.
+	var distinct uint64
+	for _, col := range ji.cols {
+		if n, ok := jo.stats.Distinct(col.Name()); ok && n > distinct {
+			distinct = n
+		}
+	}
+	if distinct == 0 {
+		return 0, false
+	}
+
+	rows := jo.stats.RowCount / distinct
+	if rows == 0 {
+		rows = 1
+	}
+	return rows, true
+}
+
 func (jo *joinOrderNode) schema() sql.Schema {
 	if jo.node != nil {
 		return jo.node.Schema()
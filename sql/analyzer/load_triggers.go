@@ -18,7 +18,6 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -32,7 +31,7 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 		switch node := n.(type) {
 		case *plan.ShowTriggers:
 			newShowTriggers := *node
-			loadedTriggers, err := loadTriggersFromDb(ctx, newShowTriggers.Database())
+			loadedTriggers, err := loadTriggersFromDb(ctx, a, newShowTriggers.Database())
 			if err != nil {
 				return nil, err
 			}
@@ -43,7 +42,7 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 			}
 			return &newShowTriggers, nil
 		case *plan.DropTrigger:
-			loadedTriggers, err := loadTriggersFromDb(ctx, node.Database())
+			loadedTriggers, err := loadTriggersFromDb(ctx, a, node.Database())
 			if err != nil {
 				return nil, err
 			}
@@ -58,7 +57,7 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 			}
 			return node, nil
 		case *plan.DropTable:
-			loadedTriggers, err := loadTriggersFromDb(ctx, node.Database())
+			loadedTriggers, err := loadTriggersFromDb(ctx, a, node.Database())
 			if err != nil {
 				return nil, err
 			}
@@ -79,7 +78,7 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 	})
 }
 
-func loadTriggersFromDb(ctx *sql.Context, db sql.Database) ([]*plan.CreateTrigger, error) {
+func loadTriggersFromDb(ctx *sql.Context, a *Analyzer, db sql.Database) ([]*plan.CreateTrigger, error) {
 	var loadedTriggers []*plan.CreateTrigger
 	if triggerDb, ok := db.(sql.TriggerDatabase); ok {
 		triggers, err := triggerDb.GetTriggers(ctx)
@@ -87,7 +86,7 @@ func loadTriggersFromDb(ctx *sql.Context, db sql.Database) ([]*plan.CreateTrigge
 			return nil, err
 		}
 		for _, trigger := range triggers {
-			parsedTrigger, err := parse.Parse(ctx, trigger.CreateStatement)
+			parsedTrigger, err := a.parseDefinition(ctx, trigger.CreateStatement)
 			if err != nil {
 				return nil, err
 			}
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"reflect"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -71,6 +73,115 @@ func optimizeDistinct(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope
 	return node, nil
 }
 
+// sortGroupByInput replaces a Sort on the output of a GroupBy with a Sort on its input when the sort fields are
+// exactly the grouping expressions. Rows then arrive grouped together, so the GroupBy can aggregate one group at a time
+// and emit groups already in the requested order, rather than hashing every group and sorting the result again.
+func sortGroupByInput(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("sort_group_by_input")
+	defer span.Finish()
+
+	if !node.Resolved() {
+		return node, nil
+	}
+
+	return plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
+		sort, ok := node.(*plan.Sort)
+		if !ok {
+			return node, nil
+		}
+
+		var project *plan.Project
+		var groupBy *plan.GroupBy
+		switch child := sort.Child.(type) {
+		case *plan.GroupBy:
+			groupBy = child
+		case *plan.Project:
+			project = child
+			if groupBy, ok = child.Child.(*plan.GroupBy); !ok {
+				return node, nil
+			}
+		default:
+			return node, nil
+		}
+
+		sortFields, ok := groupingSortFields(sort.SortFields, project, groupBy)
+		if !ok {
+			return node, nil
+		}
+
+		a.Log("sorting group by input on grouping expressions")
+		var newNode sql.Node = plan.NewGroupBy(
+			groupBy.SelectedExprs,
+			groupBy.GroupByExprs,
+			plan.NewSort(sortFields, groupBy.Child),
+		)
+		if project != nil {
+			newNode = plan.NewProject(project.Projections, newNode)
+		}
+
+		return newNode, nil
+	})
+}
+
+// groupingSortFields returns the sort fields given, rewritten in terms of the grouping expressions of the GroupBy
+// given, and whether every sort field refers to a distinct grouping expression and every grouping expression is
+// sorted on. The project given, if any, sits between the sort fields and the GroupBy.
+func groupingSortFields(sortFields sql.SortFields, project *plan.Project, groupBy *plan.GroupBy) (sql.SortFields, bool) {
+	if len(sortFields) != len(groupBy.GroupByExprs) {
+		return nil, false
+	}
+
+	used := make([]bool, len(groupBy.GroupByExprs))
+	result := make(sql.SortFields, len(sortFields))
+	for i, sf := range sortFields {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok {
+			return nil, false
+		}
+
+		if project != nil {
+			if gf.Index() >= len(project.Projections) {
+				return nil, false
+			}
+			gf, ok = unaliased(project.Projections[gf.Index()]).(*expression.GetField)
+			if !ok {
+				return nil, false
+			}
+		}
+
+		if gf.Index() >= len(groupBy.SelectedExprs) {
+			return nil, false
+		}
+		selected := unaliased(groupBy.SelectedExprs[gf.Index()])
+
+		found := false
+		for j, e := range groupBy.GroupByExprs {
+			if !used[j] && reflect.DeepEqual(selected, e) {
+				used[j] = true
+				found = true
+				result[i] = sql.SortField{
+					Column:       e,
+					Order:        sf.Order,
+					NullOrdering: sf.NullOrdering,
+				}
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	return result, true
+}
+
+func unaliased(e sql.Expression) sql.Expression {
+	if alias, ok := e.(*expression.Alias); ok {
+		return alias.Child
+	}
+	return e
+}
+
 // moveJoinConditionsToFilter looks for expressions in a join condition that reference only tables in the left or right
 // side of the join, and move those conditions to a new Filter node instead. If the join condition is empty after these
 // moves, the join is converted to a CrossJoin.
@@ -177,6 +288,76 @@ func removeUnnecessaryConverts(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	})
 }
 
+// mergeNullSortFields merges the sort fields of the ORDER BY x IS NULL, x idiom, used to control where NULLs are
+// sorted, into a single sort field on x with the equivalent NullOrdering. The sort then evaluates and compares x once
+// per row instead of twice. ORDER BY x IS NOT NULL, x is merged the same way.
+func mergeNullSortFields(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("merge_null_sort_fields")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(node sql.Node) (sql.Node, error) {
+		s, ok := node.(*plan.Sort)
+		if !ok {
+			return node, nil
+		}
+
+		fields, merged := mergeNullSortFieldsOf(s.SortFields)
+		if !merged {
+			return node, nil
+		}
+
+		a.Log("merged null sort fields: %s", s)
+		return plan.NewSort(fields, s.Child), nil
+	})
+}
+
+// mergeNullSortFieldsOf returns the sort fields given with every IS NULL or IS NOT NULL field on the expression of the
+// field that follows it merged into that field, and whether any was merged.
+func mergeNullSortFieldsOf(sortFields []sql.SortField) ([]sql.SortField, bool) {
+	var result []sql.SortField
+	merged := false
+	for i := 0; i < len(sortFields); i++ {
+		sf := sortFields[i]
+		if i+1 == len(sortFields) {
+			result = append(result, sf)
+			continue
+		}
+
+		// x IS NULL sorts NULLs last when ascending, and x IS NOT NULL when descending.
+		nullsFirst := sf.Order == sql.Descending
+		e := sf.Column
+		if not, ok := e.(*expression.Not); ok {
+			e = not.Child
+			nullsFirst = !nullsFirst
+		}
+		isNull, ok := e.(*expression.IsNull)
+		next := sortFields[i+1]
+		if !ok || !reflect.DeepEqual(isNull.Child, next.Column) {
+			result = append(result, sf)
+			continue
+		}
+
+		// NullOrdering applies to the ascending order, which descending sorts reverse.
+		nullOrdering := sql.NullsLast
+		if nullsFirst == (next.Order != sql.Descending) {
+			nullOrdering = sql.NullsFirst
+		}
+		result = append(result, sql.SortField{
+			Column:       next.Column,
+			Order:        next.Order,
+			NullOrdering: nullOrdering,
+		})
+		merged = true
+		i++
+	}
+
+	return result, merged
+}
+
 // containsSources checks that all `needle` sources are contained inside `haystack`.
 func containsSources(haystack, needle []string) bool {
 	for _, s := range needle {
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -128,6 +129,91 @@ func TestOptimizeDistinct(t *testing.T) {
 	}
 }
 
+func TestSortGroupByInput(t *testing.T) {
+	t1 := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "foo"},
+		{Name: "b", Type: sql.Int64, Source: "foo"},
+	}))
+	table := plan.NewResolvedTable(t1, nil, nil)
+
+	groupBy := plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false),
+			aggregation.NewCount(expression.NewGetFieldWithTable(1, sql.Int64, "foo", "b", false)),
+		},
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false),
+		},
+		table,
+	)
+	project := plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("x", expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false)),
+			expression.NewGetField(1, sql.Int64, "COUNT(foo.b)", false),
+		},
+		groupBy,
+	)
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		expected sql.Node
+	}{
+		{
+			"sort on grouping",
+			plan.NewSort(
+				[]sql.SortField{{Column: expression.NewGetField(0, sql.Int64, "x", false), Order: sql.Descending}},
+				project,
+			),
+			plan.NewProject(
+				project.Projections,
+				plan.NewGroupBy(
+					groupBy.SelectedExprs,
+					groupBy.GroupByExprs,
+					plan.NewSort(
+						[]sql.SortField{{Column: expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false), Order: sql.Descending}},
+						table,
+					),
+				),
+			),
+		},
+		{
+			"sort on aggregate",
+			plan.NewSort(
+				[]sql.SortField{{Column: expression.NewGetField(1, sql.Int64, "COUNT(foo.b)", false)}},
+				project,
+			),
+			nil,
+		},
+		{
+			"sort on grouping and aggregate",
+			plan.NewSort(
+				[]sql.SortField{
+					{Column: expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false)},
+					{Column: expression.NewGetField(1, sql.Int64, "COUNT(foo.b)", false)},
+				},
+				groupBy,
+			),
+			nil,
+		},
+	}
+
+	rule := getRuleFrom(OnceAfterAll, "sort_group_by_input")
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := rule.Apply(sql.NewEmptyContext(), NewDefault(nil), tt.node, nil)
+			require.NoError(t, err)
+
+			expected := tt.expected
+			if expected == nil {
+				expected = tt.node
+			}
+			require.Equal(t, expected, result)
+		})
+	}
+}
+
 func TestMoveJoinConditionsToFilter(t *testing.T) {
 	t1 := memory.NewTable("t1", sql.NewPrimaryKeySchema(sql.Schema{
 		{Name: "a", Source: "t1", Type: sql.Int64},
@@ -446,3 +532,92 @@ func TestRemoveUnnecessaryConverts(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeNullSortFields(t *testing.T) {
+	x := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "x", true)
+	y := expression.NewGetFieldWithTable(1, sql.Int64, "mytable", "y", true)
+
+	testCases := []struct {
+		name     string
+		fields   []sql.SortField
+		expected []sql.SortField
+	}{
+		{
+			"is null asc",
+			[]sql.SortField{
+				{Column: expression.NewIsNull(x), Order: sql.Ascending},
+				{Column: x, Order: sql.Ascending},
+			},
+			[]sql.SortField{{Column: x, Order: sql.Ascending, NullOrdering: sql.NullsLast}},
+		},
+		{
+			"is null desc",
+			[]sql.SortField{
+				{Column: expression.NewIsNull(x), Order: sql.Descending},
+				{Column: x, Order: sql.Ascending},
+			},
+			[]sql.SortField{{Column: x, Order: sql.Ascending, NullOrdering: sql.NullsFirst}},
+		},
+		{
+			"is null asc, column desc",
+			[]sql.SortField{
+				{Column: expression.NewIsNull(x), Order: sql.Ascending},
+				{Column: x, Order: sql.Descending},
+				{Column: y, Order: sql.Ascending},
+			},
+			[]sql.SortField{
+				{Column: x, Order: sql.Descending, NullOrdering: sql.NullsFirst},
+				{Column: y, Order: sql.Ascending},
+			},
+		},
+		{
+			"is not null asc, column desc",
+			[]sql.SortField{
+				{Column: y, Order: sql.Ascending},
+				{Column: expression.NewNot(expression.NewIsNull(x)), Order: sql.Ascending},
+				{Column: x, Order: sql.Descending},
+			},
+			[]sql.SortField{
+				{Column: y, Order: sql.Ascending},
+				{Column: x, Order: sql.Descending, NullOrdering: sql.NullsLast},
+			},
+		},
+		{
+			"different columns",
+			[]sql.SortField{
+				{Column: expression.NewIsNull(x), Order: sql.Ascending},
+				{Column: y, Order: sql.Ascending},
+			},
+			nil,
+		},
+		{
+			"is null last",
+			[]sql.SortField{
+				{Column: x, Order: sql.Ascending},
+				{Column: expression.NewIsNull(x), Order: sql.Ascending},
+			},
+			nil,
+		},
+	}
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "x", Source: "mytable", Type: sql.Int64, Nullable: true},
+		{Name: "y", Source: "mytable", Type: sql.Int64, Nullable: true},
+	}))
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			node := plan.NewSort(tt.fields, plan.NewResolvedTable(table, nil, nil))
+			result, err := mergeNullSortFields(sql.NewEmptyContext(), NewDefault(nil), node, nil)
+			require.NoError(err)
+
+			if tt.expected == nil {
+				require.Equal(node, result)
+			} else {
+				require.Equal(plan.NewSort(tt.expected, plan.NewResolvedTable(table, nil, nil)), result)
+			}
+		})
+	}
+}
@@ -56,6 +56,14 @@ func trackProcess(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 			}
 			processList.AddTableProgress(ctx.Pid(), name, total)
 
+			stats, ok, err := sql.GetTableStatistics(ctx, n.Table)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				processList.UpdateTableRowsEstimate(ctx.Pid(), name, int64(stats.RowCount))
+			}
+
 			seen[name] = struct{}{}
 
 			onPartitionDone := func(partitionName string) {
@@ -98,7 +98,7 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 		return deletePlan, nil
 	}
 
-	triggers, err := loadTriggersFromDb(ctx, currentDb)
+	triggers, err := loadTriggersFromDb(ctx, a, currentDb)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +422,11 @@ func pushdownFiltersToTable(
 
 	// Push any filters for this table onto the table itself if it's a sql.FilteredTable
 	if ft, ok := table.(sql.FilteredTable); ok && len(filters.availableFiltersForTable(ctx, tableNode.Name())) > 0 {
-		tableFilters := filters.availableFiltersForTable(ctx, tableNode.Name())
-		handled := ft.HandledFilters(normalizeExpressions(ctx, tableAliases, tableFilters...))
+		tableFilters := normalizeExpressions(ctx, tableAliases, filters.availableFiltersForTable(ctx, tableNode.Name())...)
+		if eft, ok := ft.(sql.ExpressionFilteredTable); ok {
+			tableFilters = supportedFilters(eft.FilterCapabilities(), tableFilters)
+		}
+		handled := ft.HandledFilters(tableFilters)
 		filters.markFiltersHandled(handled...)
 
 		handled, err := FixFieldIndexesOnExpressions(ctx, scope, a, tableNode.Schema(), handled...)
@@ -452,6 +455,17 @@ func pushdownFiltersToTable(
 	}
 }
 
+// supportedFilters returns the filters given that a table with the capabilities given can evaluate.
+func supportedFilters(caps sql.FilterCapabilities, filters []sql.Expression) []sql.Expression {
+	var supported []sql.Expression
+	for _, f := range filters {
+		if expression.FilterSupported(caps, f) {
+			supported = append(supported, f)
+		}
+	}
+	return supported
+}
+
 // pushdownFiltersToAboveTable introduces a filter node with the given predicate
 func pushdownFiltersToAboveTable(
 	ctx *sql.Context,
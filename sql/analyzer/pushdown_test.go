@@ -209,6 +209,81 @@ func TestPushdownFilterToTables(t *testing.T) {
 	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("pushdown_filters"))
 }
 
+type capableFilteredTable struct {
+	*memory.FilteredTable
+	caps sql.FilterCapabilities
+}
+
+var _ sql.ExpressionFilteredTable = (*capableFilteredTable)(nil)
+
+func (t *capableFilteredTable) FilterCapabilities() sql.FilterCapabilities {
+	return t.caps
+}
+
+func TestPushdownFilterToTablesWithCapabilities(t *testing.T) {
+	table := &capableFilteredTable{
+		FilteredTable: memory.NewFilteredTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "i", Type: sql.Int32, Source: "mytable"},
+			{Name: "f", Type: sql.Float64, Source: "mytable"},
+		})),
+		caps: sql.FilterComparison,
+	}
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("mytable", table)
+
+	a := NewDefault(sql.NewDatabaseProvider(db))
+
+	or := expression.NewOr(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", false),
+			expression.NewLiteral(int32(1), sql.Int32),
+		),
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", false),
+			expression.NewLiteral(int32(2), sql.Int32),
+		),
+	)
+	eq := expression.NewEquals(
+		expression.NewGetFieldWithTable(1, sql.Float64, "mytable", "f", false),
+		expression.NewLiteral(3.14, sql.Float64),
+	)
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "only filters with supported expressions are pushed down",
+			node: plan.NewFilter(
+				expression.NewAnd(eq, or),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: plan.NewDecoratedNode("Filtered table access on [(mytable.f = 3.14)]",
+				plan.NewFilter(
+					or,
+					plan.NewResolvedTable(table.WithFilters(sql.NewEmptyContext(), []sql.Expression{eq}), nil, nil),
+				),
+			),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("pushdown_filters"))
+
+	table.caps |= sql.FilterOr
+	tests = []analyzerFnTestCase{
+		{
+			name: "filters are pushed down as whole expression trees",
+			node: plan.NewFilter(
+				expression.NewAnd(eq, or),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: plan.NewDecoratedNode("Filtered table access on [(mytable.f = 3.14) ((mytable.i = 1) OR (mytable.i = 2))]",
+				plan.NewResolvedTable(table.WithFilters(sql.NewEmptyContext(), []sql.Expression{eq, or}), nil, nil),
+			),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("pushdown_filters"))
+}
+
 func TestPushdownFiltersAboveTables(t *testing.T) {
 	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
 		{Name: "i", Type: sql.Int32, Source: "mytable"},
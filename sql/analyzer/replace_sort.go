@@ -0,0 +1,269 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceSortWithIndex removes Sort nodes whose rows come from an IndexedTableAccess on an ordered index that already
+// returns them in the requested order. This is the case when the sort fields follow the index expressions, once the
+// leading expressions fixed to a single value by the lookup are skipped, e.g. WHERE a = 1 ORDER BY b on an index on
+// (a, b). Sorts of the rows of a full scan of a sql.SortedTable whose order starts with the sort fields are removed
+// as well, unless the scan may be parallelized, which interleaves the rows of its partitions.
+func replaceSortWithIndex(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("replace_sort_with_index")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(node sql.Node) (sql.Node, error) {
+		s, ok := node.(*plan.Sort)
+		if !ok {
+			return node, nil
+		}
+
+		ordered, err := sortedByIndex(ctx, a, s)
+		if err != nil {
+			return nil, err
+		}
+		if !ordered {
+			return node, nil
+		}
+
+		a.Log("removing sort, rows are already returned in index order: %s", s)
+		return s.Child, nil
+	})
+}
+
+// sortedByIndex returns whether the rows of the child of the sort given are already returned in its order by an index.
+func sortedByIndex(ctx *sql.Context, a *Analyzer, s *plan.Sort) (bool, error) {
+	fields := make([]*expression.GetField, len(s.SortFields))
+	for i, sf := range s.SortFields {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok || sf.Order != sql.Ascending || sf.NullOrdering != sql.NullsFirst {
+			return false, nil
+		}
+		fields[i] = gf
+	}
+
+	source, fields := tableAccessForFields(s.Child, fields)
+	switch source := source.(type) {
+	case *plan.IndexedTableAccess:
+		return sortedByIndexLookup(ctx, source, fields)
+	case *plan.ResolvedTable:
+		if !sortedByTable(source, fields) {
+			return false, nil
+		}
+		parallel, err := mayBeParallelized(ctx, a, source)
+		return !parallel, err
+	default:
+		return false, nil
+	}
+}
+
+// sortedByTable returns whether a full scan of the table given returns its rows sorted by the fields given.
+func sortedByTable(rt *plan.ResolvedTable, fields []*expression.GetField) bool {
+	st, ok := getSortedTable(rt.Table)
+	if !ok {
+		return false
+	}
+
+	order := st.SortOrder()
+	if len(fields) > len(order) {
+		return false
+	}
+	for i, gf := range fields {
+		column := strings.ToLower(order[i][strings.LastIndex(order[i], ".")+1:])
+		if column != strings.ToLower(gf.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+// mayBeParallelized returns whether the parallelize rule may scan the partitions of the table given concurrently,
+// which it can't do for tables with a single partition.
+func mayBeParallelized(ctx *sql.Context, a *Analyzer, rt *plan.ResolvedTable) (bool, error) {
+	if a.Parallelism <= 1 {
+		return false, nil
+	}
+
+	if pc, ok := rt.Table.(sql.PartitionCounter); ok {
+		count, err := pc.PartitionCount(ctx)
+		if err != nil {
+			return false, err
+		}
+		return count > 1, nil
+	}
+
+	iter, err := rt.Table.Partitions(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close(ctx)
+
+	count := 0
+	for count < 2 {
+		if _, err := iter.Next(ctx); err == io.EOF {
+			break
+		} else if err != nil {
+			return false, err
+		}
+		count++
+	}
+	return count > 1, nil
+}
+
+func getSortedTable(t sql.Table) (sql.SortedTable, bool) {
+	switch t := t.(type) {
+	case sql.SortedTable:
+		return t, true
+	case sql.TableWrapper:
+		return getSortedTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+// sortedByIndexLookup returns whether the lookup of the table access given returns its rows sorted by the fields
+// given.
+func sortedByIndexLookup(ctx *sql.Context, ita *plan.IndexedTableAccess, fields []*expression.GetField) (bool, error) {
+	idx, ok := ita.Index().(sql.OrderedIndex)
+	if !ok || idx.Order() != sql.IndexOrderAsc {
+		return false, nil
+	}
+
+	lookup := plan.GetIndexLookup(ita)
+	if lookup == nil {
+		return false, nil
+	}
+
+	columns := make([]string, len(idx.Expressions()))
+	for i, e := range idx.Expressions() {
+		columns[i] = strings.ToLower(e[strings.LastIndex(e, ".")+1:])
+	}
+
+	fixed, err := fixedIndexColumns(ctx, idx, lookup.Ranges())
+	if err != nil {
+		return false, err
+	}
+
+	pos := 0
+	for _, gf := range fields {
+		name := strings.ToLower(gf.Name())
+		for pos < len(columns) && columns[pos] != name && pos < fixed {
+			pos++
+		}
+		if pos < len(columns) && columns[pos] == name {
+			pos++
+			continue
+		}
+		if !isFixedColumn(columns, fixed, name) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// tableAccessForFields returns the IndexedTableAccess or ResolvedTable the node given reads its rows from, along with
+// the fields given translated to the schema of that table access. It returns nil if the rows are read from anything
+// but a single table access, or in a different order.
+func tableAccessForFields(n sql.Node, fields []*expression.GetField) (sql.Node, []*expression.GetField) {
+	for {
+		switch node := n.(type) {
+		case *plan.IndexedTableAccess, *plan.ResolvedTable:
+			return node, fields
+		case *plan.Filter:
+			n = node.Child
+		case *plan.DecoratedNode:
+			n = node.Child
+		case *plan.TableAlias:
+			n = node.Child
+		case *plan.Project:
+			translated := make([]*expression.GetField, len(fields))
+			for i, gf := range fields {
+				if gf.Index() >= len(node.Projections) {
+					return nil, nil
+				}
+				p := node.Projections[gf.Index()]
+				if alias, ok := p.(*expression.Alias); ok {
+					p = alias.Child
+				}
+				field, ok := p.(*expression.GetField)
+				if !ok {
+					return nil, nil
+				}
+				translated[i] = field
+			}
+			fields = translated
+			n = node.Child
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// fixedIndexColumns returns the number of leading index columns that have the same single value in every range
+// given.
+func fixedIndexColumns(ctx *sql.Context, idx sql.Index, ranges sql.RangeCollection) (int, error) {
+	if len(ranges) == 0 {
+		return 0, nil
+	}
+
+	types := idx.ColumnExpressionTypes(ctx)
+	fixed := 0
+	for i := range ranges[0] {
+		if i >= len(types) {
+			break
+		}
+		for _, rang := range ranges {
+			ok, err := rang[i].RepresentsEquals()
+			if err != nil {
+				return 0, err
+			}
+			if !ok {
+				return fixed, nil
+			}
+			cmp, err := types[i].Type.Compare(sql.GetRangeCutKey(rang[i].LowerBound), sql.GetRangeCutKey(ranges[0][i].LowerBound))
+			if err != nil {
+				return 0, err
+			}
+			if cmp != 0 {
+				return fixed, nil
+			}
+		}
+		fixed++
+	}
+
+	return fixed, nil
+}
+
+func isFixedColumn(columns []string, fixed int, name string) bool {
+	for i := 0; i < fixed; i++ {
+		if columns[i] == name {
+			return true
+		}
+	}
+	return false
+}
@@ -539,6 +539,15 @@ func resolveColumnDefaultsOnWrapper(ctx *sql.Context, col *sql.Column, e *expres
 			case float64:
 				newDefault.Expression = expression.NewLiteral(-val, sql.Float64)
 				isLiteral = true
+			case string:
+				if sql.IsDecimal(literalExpr.Type()) {
+					neg, err := unaryMinusExpr.Eval(ctx, nil)
+					if err != nil {
+						return nil, err
+					}
+					newDefault.Expression = expression.NewLiteral(neg, literalExpr.Type())
+					isLiteral = true
+				}
 			}
 		}
 	}
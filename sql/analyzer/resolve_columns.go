@@ -216,6 +216,10 @@ func getNodeAvailableNames(n sql.Node, scope *Scope) availableNames {
 				name := strings.ToLower(n.(sql.Nameable).Name())
 				names.indexTable(name, name, i)
 				return false
+			case *plan.HandlerRead:
+				name := strings.ToLower(n.Name)
+				names.indexTable(name, name, i)
+				return false
 			case *plan.TableAlias:
 				switch t := n.Child.(type) {
 				case *plan.ResolvedTable, *plan.UnresolvedTable, *plan.SubqueryAlias:
@@ -351,7 +355,7 @@ func getColumnsInNodes(nodes []sql.Node, names availableNames, nestingLevel int)
 
 	for _, node := range nodes {
 		switch n := node.(type) {
-		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable:
+		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.HandlerRead:
 			for _, col := range n.Schema() {
 				names.indexColumn(col.Source, col.Name, nestingLevel)
 			}
@@ -623,25 +627,45 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 
 		// The reason we have two sets of columns, one for grouping and
 		// one for aggregate is because an alias can redefine a column name
-		// of the child schema. In the grouping, if that column is referenced
-		// it refers to the alias, and not the one in the child. However,
-		// in the aggregate, aliases in that same aggregate cannot be used,
-		// so it refers to the column in the child node.
+		// of the child schema. In the aggregate, aliases in that same aggregate
+		// cannot be used, so it refers to the column in the child node. In the
+		// grouping, MySQL looks for the column in the child before looking for
+		// the alias, so only the aliases that don't shadow a column of the
+		// child are pushed down.
 		var groupingColumns = make(map[string]struct{})
+		var groupingExprs = make(map[string]struct{})
 		for _, g := range g.GroupByExprs {
 			for _, n := range findAllColumns(g) {
 				groupingColumns[strings.ToLower(n)] = struct{}{}
 			}
+			groupingExprs[g.String()] = struct{}{}
+		}
+
+		var childColumns = make(map[string]struct{})
+		if g.Child.Resolved() {
+			for _, col := range g.Child.Schema() {
+				childColumns[strings.ToLower(col.Name)] = struct{}{}
+			}
+		}
+
+		// Positions in the grouping have been replaced by the aliased
+		// expression they refer to, which are grouped on through the alias too.
+		groupsOnAlias := func(alias *expression.Alias) bool {
+			if _, ok := groupingExprs[alias.Child.String()]; ok {
+				return true
+			}
+			name := strings.ToLower(alias.Name())
+			_, grouped := groupingColumns[name]
+			_, shadowed := childColumns[name]
+			return grouped && !shadowed
 		}
 
 		var selectedColumns = make(map[string]struct{})
 		for _, agg := range g.SelectedExprs {
 			// This alias is going to be pushed down, so don't bother gathering
 			// its requirements.
-			if alias, ok := agg.(*expression.Alias); ok {
-				if _, ok := groupingColumns[strings.ToLower(alias.Name())]; ok {
-					continue
-				}
+			if alias, ok := agg.(*expression.Alias); ok && groupsOnAlias(alias) {
+				continue
 			}
 
 			for _, n := range findAllColumns(agg) {
@@ -669,11 +693,12 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 			// Only if the alias is required in the grouping set needsReorder
 			// to true. If it's not required, there's no need for a reorder if
 			// no other alias is required.
-			_, ok = groupingColumns[name]
-			if ok {
+			if groupsOnAlias(alias) {
 				aliases[name] = len(newSelectedExprs)
 				needsReorder = true
-				delete(groupingColumns, name)
+				if _, shadowed := childColumns[name]; !shadowed {
+					delete(groupingColumns, name)
+				}
 
 				projection = append(projection, expr)
 				replacedAliases[alias.Child.String()] = alias.Name()
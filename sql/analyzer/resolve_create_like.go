@@ -66,11 +66,14 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 	}
 	origSch := likeTable.Schema()
 	newSch := make(sql.Schema, len(origSch))
+	explicitCollationColumns := make([]string, len(origSch))
 	for i, col := range origSch {
 		tempCol := *col
 		tempCol.Source = ct.Name()
 		newSch[i] = &tempCol
+		explicitCollationColumns[i] = col.Name
 	}
+	collation := sql.TableCollation(likeTable)
 
 	var pkOrdinals []int
 	if pkTable, ok := likeTable.(sql.PrimaryKeyTable); ok {
@@ -78,8 +81,10 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 	}
 
 	tableSpec := &plan.TableSpec{
-		Schema:  sql.NewPrimaryKeySchema(newSch, pkOrdinals...),
-		IdxDefs: idxDefs,
+		Schema:                   sql.NewPrimaryKeySchema(newSch, pkOrdinals...),
+		IdxDefs:                  idxDefs,
+		Collation:                &collation,
+		ExplicitCollationColumns: explicitCollationColumns,
 	}
 
 	return plan.NewCreateTable(ct.Database(), ct.Name(), ct.IfNotExists(), ct.Temporary(), tableSpec), nil
@@ -1,6 +1,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
@@ -37,6 +39,17 @@ func resolveCreateSelect(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 
 	newSpec := inputSpec.WithSchema(sql.NewPrimaryKeySchema(newSch, pkOrdinals...))
 
+	// The columns that only come from the select query keep their collation
+	definedColumns := make(map[string]bool)
+	for _, col := range inputSpec.Schema.Schema {
+		definedColumns[strings.ToLower(col.Name)] = true
+	}
+	for _, col := range newSch {
+		if !definedColumns[strings.ToLower(col.Name)] {
+			newSpec.ExplicitCollationColumns = append(newSpec.ExplicitCollationColumns, col.Name)
+		}
+	}
+
 	newCreateTable := plan.NewCreateTable(ct.Database(), ct.Name(), ct.IfNotExists(), ct.Temporary(), newSpec)
 	analyzedCreate, err := a.Analyze(ctx, newCreateTable, scope)
 	if err != nil {
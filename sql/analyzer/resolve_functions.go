@@ -15,8 +15,11 @@
 package analyzer
 
 import (
+	"fmt"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -64,6 +67,17 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) sql.TransformExprFunc
 			if err != nil {
 				return nil, err
 			}
+		} else if agg, ok := rf.(sql.Aggregation); ok && uf.Window != nil {
+			// Aggregate functions with an OVER clause are window functions, computed over the frame of each row
+			rf = window.NewAggregation(agg, uf.Window)
+		}
+
+		if uf.IgnoreNulls {
+			nt, ok := rf.(sql.NullTreatmentWindowAggregation)
+			if !ok {
+				return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("IGNORE NULLS with %s", n))
+			}
+			rf = nt.WithIgnoreNulls(true)
 		}
 
 		a.Log("resolved function %q", n)
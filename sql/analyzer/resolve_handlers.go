@@ -0,0 +1,47 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveHandlers sets the handler read by HANDLER ... READ statements from the handlers opened by the session, so that
+// their schema is known to the rest of the analysis.
+func resolveHandlers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_handlers")
+	defer span.Finish()
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		read, ok := n.(*plan.HandlerRead)
+		if !ok || read.Handler() != nil {
+			return n, nil
+		}
+
+		session, ok := ctx.Session.(sql.HandlerSession)
+		if !ok {
+			return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+		}
+
+		handler, ok := session.Handler(read.Name)
+		if !ok {
+			return nil, sql.ErrUnknownHandler.New(read.Name)
+		}
+
+		a.Log("resolved handler %s", read.Name)
+		return read.WithHandler(handler), nil
+	})
+}
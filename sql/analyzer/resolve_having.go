@@ -52,9 +52,7 @@ func resolveHaving(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (
 		// If any columns required by the having aren't available, pull them up.
 		if len(missingCols) > 0 {
 			var err error
-			// TODO: this should be an error for most queries. having expressions must appear in the group-by clause (even
-			//  in non-strict mode)
-			having, err = pullMissingColumnsUp(having, missingCols)
+			having, err = pullMissingColumnsUp(ctx, having, missingCols)
 			if err != nil {
 				return nil, err
 			}
@@ -77,6 +75,10 @@ func findMissingColumns(node sql.Node, expr sql.Expression) []string {
 
 	var missingCols []string
 	for _, n := range findExprNameables(expr) {
+		// Resolved fields already index into the child, even if they reference it through an alias.
+		if gf, ok := n.(*expression.GetField); ok && gf.Resolved() {
+			continue
+		}
 		name := strings.ToLower(n.Name())
 		if !stringContains(schemaCols, name) {
 			missingCols = append(missingCols, n.Name())
@@ -100,14 +102,39 @@ func projectOriginalAggregation(having *plan.Having, schema sql.Schema) *plan.Pr
 
 var errHavingChildMissingRef = errors.NewKind("cannot find column %s referenced in HAVING clause in either GROUP BY or its child")
 
-func pullMissingColumnsUp(having *plan.Having, missingCols []string) (*plan.Having, error) {
+// ErrHavingNonGroupedColumn is returned when a HAVING clause references a column missing from the GROUP BY clause under
+// ONLY_FULL_GROUP_BY.
+var ErrHavingNonGroupedColumn = errors.NewKind("column '%s' referenced in HAVING clause is not in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by")
+
+func pullMissingColumnsUp(ctx *sql.Context, having *plan.Having, missingCols []string) (*plan.Having, error) {
+	var schema sql.Schema
 	groupBy, err := findGroupBy(having)
-	if err != nil {
+	if err == nil {
+		schema = groupBy.Child.Schema()
+	} else if project := findProject(having); project != nil {
+		// Without any aggregation, the having works like a filter over the projected rows, so the columns it
+		// references may come from anywhere in the projection's child.
+		schema = project.Child.Schema()
+	} else {
 		return nil, err
 	}
 
-	schema := groupBy.Child.Schema()
-	var newAggregate []sql.Expression
+	// Without ONLY_FULL_GROUP_BY, any column of the grouped rows may be referenced, as MySQL does.
+	if groupBy != nil && ctx.IsSQLModeEnabled("ONLY_FULL_GROUP_BY") {
+		var groupBys []string
+		for _, expr := range groupBy.GroupByExprs {
+			if n, ok := expr.(sql.Nameable); ok {
+				groupBys = append(groupBys, strings.ToLower(n.Name()))
+			}
+		}
+		for _, c := range missingCols {
+			if !stringContains(groupBys, strings.ToLower(c)) {
+				return nil, ErrHavingNonGroupedColumn.New(c)
+			}
+		}
+	}
+
+	var newColumns []sql.Expression
 	for _, c := range missingCols {
 		idx := -1
 		for i, col := range schema {
@@ -120,13 +147,18 @@ func pullMissingColumnsUp(having *plan.Having, missingCols []string) (*plan.Havi
 			return nil, errHavingChildMissingRef.New(c)
 		}
 		col := schema[idx]
-		newAggregate = append(
-			newAggregate,
+		newColumns = append(
+			newColumns,
 			expression.NewGetFieldWithTable(idx, col.Type, col.Source, col.Name, col.Nullable),
 		)
 	}
 
-	node, err := addColumnsToGroupBy(having, newAggregate)
+	var node sql.Node
+	if groupBy != nil {
+		node, err = addColumnsToGroupBy(having, newColumns)
+	} else {
+		node, err = addColumnsToProject(having, newColumns)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +166,45 @@ func pullMissingColumnsUp(having *plan.Having, missingCols []string) (*plan.Havi
 	return node.(*plan.Having), nil
 }
 
+// findProject returns the projection below the given node, if any, only looking through nodes that keep its schema.
+func findProject(n sql.Node) *plan.Project {
+	switch n := n.(type) {
+	case *plan.Project:
+		return n
+	case *plan.Filter,
+		*plan.Sort,
+		*plan.Limit,
+		*plan.Offset,
+		*plan.Distinct,
+		*plan.Having:
+		return findProject(n.Children()[0])
+	default:
+		return nil
+	}
+}
+
+// addColumnsToProject adds the given columns to the projection below the given node, which must be one found by
+// findProject.
+func addColumnsToProject(node sql.Node, columns []sql.Expression) (sql.Node, error) {
+	switch node := node.(type) {
+	case *plan.Project:
+		return plan.NewProject(append(node.Projections, columns...), node.Child), nil
+	case *plan.Filter,
+		*plan.Sort,
+		*plan.Limit,
+		*plan.Offset,
+		*plan.Distinct,
+		*plan.Having:
+		child, err := addColumnsToProject(node.Children()[0], columns)
+		if err != nil {
+			return nil, err
+		}
+		return node.WithChildren(child)
+	default:
+		return nil, errHavingNeedsGroupBy.New()
+	}
+}
+
 func findGroupBy(n sql.Node) (*plan.GroupBy, error) {
 	children := n.Children()
 	if len(children) != 1 {
@@ -333,6 +404,12 @@ func replaceAggregations(ctx *sql.Context, having *plan.Having) (*plan.Having, b
 			}
 		}
 
+		resolved, err := resolveAggregationColumns(agg, groupBy.Child.Schema())
+		if err != nil {
+			return nil, err
+		}
+		agg = resolved.(sql.Aggregation)
+
 		newAggregate = append(newAggregate, agg)
 		return expression.NewGetField(
 			len(having.Child.Schema())+len(newAggregate)-1,
@@ -388,6 +465,37 @@ func replaceAggregations(ctx *sql.Context, having *plan.Having) (*plan.Having, b
 	return plan.NewHaving(cond, having.Child), requiresProjection, nil
 }
 
+// resolveAggregationColumns resolves the columns referenced by an aggregation taken from a HAVING clause against the
+// schema of the child of the group by it's being pushed down to, since the having itself only sees the group by's
+// output.
+func resolveAggregationColumns(agg sql.Expression, schema sql.Schema) (sql.Expression, error) {
+	return expression.TransformUp(agg, func(e sql.Expression) (sql.Expression, error) {
+		var table, name string
+		switch e := e.(type) {
+		case column:
+			table = strings.ToLower(e.Table())
+			name = strings.ToLower(e.Name())
+		case *expression.GetField:
+			table = strings.ToLower(e.Table())
+			name = strings.ToLower(e.Name())
+		default:
+			return e, nil
+		}
+
+		for i, col := range schema {
+			if strings.ToLower(col.Name) != name {
+				continue
+			}
+			if table != "" && strings.ToLower(col.Source) != table {
+				continue
+			}
+			return expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable), nil
+		}
+
+		return nil, errHavingChildMissingRef.New(name)
+	})
+}
+
 func aggregationEquals(ctx *sql.Context, a, b sql.Expression) bool {
 	// First unwrap aliases
 	if alias, ok := b.(*expression.Alias); ok {
@@ -420,6 +528,20 @@ func aggregationEquals(ctx *sql.Context, a, b sql.Expression) bool {
 			return false
 		}
 
+		return aggregationChildEquals(ctx, a.Child, b.Child)
+	case *aggregation.BitAggregation:
+		b, ok := b.(*aggregation.BitAggregation)
+		if !ok || a.Op != b.Op {
+			return false
+		}
+
+		return aggregationChildEquals(ctx, a.Child, b.Child)
+	case *aggregation.Variance:
+		b, ok := b.(*aggregation.Variance)
+		if !ok || a.Sample != b.Sample || a.StdDev != b.StdDev {
+			return false
+		}
+
 		return aggregationChildEquals(ctx, a.Child, b.Child)
 	case *aggregation.Min:
 		b, ok := b.(*aggregation.Min)
@@ -210,7 +210,8 @@ func resolveOrderByLiterals(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 			fields = make([]sql.SortField, len(sort.SortFields))
 		)
 		for i, f := range sort.SortFields {
-			if lit, ok := f.Column.(*expression.Literal); ok && sql.IsNumber(f.Column.Type()) {
+			// Like MySQL, only integer literals are column positions, any other constant is sorted on as is
+			if lit, ok := f.Column.(*expression.Literal); ok && sql.IsInteger(f.Column.Type()) {
 				// it is safe to eval literals with no context and/or row
 				v, err := lit.Eval(nil, nil)
 				if err != nil {
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"sync"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -24,26 +26,8 @@ func resolveSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 	span, ctx := ctx.Span("resolve_subqueries")
 	defer span.Finish()
 
-	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
-		switch n := n.(type) {
-		case *plan.SubqueryAlias:
-			// subqueries do not have access to outer scope
-			child, err := a.analyzeThroughBatch(ctx, n.Child, nil, "default-rules")
-			if err != nil {
-				return nil, err
-			}
-
-			if len(n.Columns) > 0 {
-				schemaLen := schemaLength(n.Child)
-				if schemaLen != len(n.Columns) {
-					return nil, sql.ErrColumnCountMismatch.New()
-				}
-			}
-
-			return n.WithChildren(StripQueryProcess(child))
-		default:
-			return n, nil
-		}
+	return analyzeSubqueryAliases(ctx, a, n, func(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+		return a.analyzeThroughBatch(ctx, n, nil, "default-rules")
 	})
 }
 
@@ -51,27 +35,147 @@ func finalizeSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 	span, ctx := ctx.Span("finalize_subqueries")
 	defer span.Finish()
 
-	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
-		switch n := n.(type) {
-		case *plan.SubqueryAlias:
-			// subqueries do not have access to outer scope
-			child, err := a.analyzeStartingAtBatch(ctx, n.Child, nil, "default-rules")
-			if err != nil {
-				return nil, err
+	return analyzeSubqueryAliases(ctx, a, n, func(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+		return a.analyzeStartingAtBatch(ctx, n, nil, "default-rules")
+	})
+}
+
+// analyzeSubqueryAliases analyzes the child of every outermost SubqueryAlias of the node given with the function
+// given. Nested ones are analyzed along with their parents. Subqueries do not have access to the outer scope, so
+// they're independent from each other: when the analyzer's parallelism is greater than one, up to that many are
+// analyzed at once. As for parallel scans, every goroutine but the first needs a worker from the context's pool, so
+// that a full pool only reduces the parallelism of the analysis instead of blocking it.
+func analyzeSubqueryAliases(
+	ctx *sql.Context,
+	a *Analyzer,
+	n sql.Node,
+	analyze func(*sql.Context, *Analyzer, sql.Node) (sql.Node, error),
+) (sql.Node, error) {
+	var aliases []*plan.SubqueryAlias
+	seen := make(map[*plan.SubqueryAlias]bool)
+	plan.Inspect(n, func(n sql.Node) bool {
+		if sa, ok := n.(*plan.SubqueryAlias); ok {
+			if !seen[sa] {
+				seen[sa] = true
+				aliases = append(aliases, sa)
 			}
+			return false
+		}
+		o, ok := n.(sql.OpaqueNode)
+		return !ok || !o.Opaque()
+	})
+	if len(aliases) == 0 {
+		return n, nil
+	}
+
+	children := make([]sql.Node, len(aliases))
+	errs := make([]error, len(aliases))
+	analyzeAlias := func(a *Analyzer, i int) {
+		sa := aliases[i]
+		// subqueries do not have access to outer scope
+		child, err := analyze(ctx, a, sa.Child)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		if len(sa.Columns) > 0 {
+			schemaLen := schemaLength(sa.Child)
+			if schemaLen != len(sa.Columns) {
+				errs[i] = sql.ErrColumnCountMismatch.New()
+				return
+			}
+		}
 
-			if len(n.Columns) > 0 {
-				schemaLen := schemaLength(n.Child)
-				if schemaLen != len(n.Columns) {
-					return nil, sql.ErrColumnCountMismatch.New()
+		children[i] = StripQueryProcess(child)
+	}
+
+	workers := a.Parallelism
+	if workers > len(aliases) {
+		workers = len(aliases)
+	}
+	if workers <= 1 {
+		for i := range aliases {
+			analyzeAlias(a, i)
+			if errs[i] != nil {
+				return nil, errs[i]
+			}
+		}
+	} else {
+		next := make(chan int, len(aliases))
+		for i := range aliases {
+			next <- i
+		}
+		close(next)
+
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			release := func() {}
+			if w > 0 {
+				var ok bool
+				release, ok = ctx.Workers.TryAcquire(sql.WorkerAnalysis)
+				if !ok {
+					break
 				}
 			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer release()
+				worker := a.fork()
+				for i := range next {
+					analyzeAlias(worker, i)
+				}
+			}()
+		}
+		wg.Wait()
 
-			return n.WithChildren(StripQueryProcess(child))
-		default:
-			return n, nil
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
 		}
-	})
+	}
+
+	analyzed := make(map[*plan.SubqueryAlias]sql.Node, len(aliases))
+	for i, sa := range aliases {
+		analyzed[sa] = children[i]
+	}
+	n, _, err := withAnalyzedSubqueryAliases(n, analyzed)
+	return n, err
+}
+
+// withAnalyzedSubqueryAliases returns the node given with the children of the subquery aliases in the map given
+// replaced by their analyzed versions, and whether anything was replaced.
+func withAnalyzedSubqueryAliases(n sql.Node, analyzed map[*plan.SubqueryAlias]sql.Node) (sql.Node, bool, error) {
+	if sa, ok := n.(*plan.SubqueryAlias); ok {
+		child, ok := analyzed[sa]
+		if !ok {
+			return n, false, nil
+		}
+		n, err := sa.WithChildren(child)
+		return n, true, err
+	}
+	if o, ok := n.(sql.OpaqueNode); ok && o.Opaque() {
+		return n, false, nil
+	}
+
+	children := n.Children()
+	newChildren := make([]sql.Node, len(children))
+	changed := false
+	for i, child := range children {
+		newChild, childChanged, err := withAnalyzedSubqueryAliases(child, analyzed)
+		if err != nil {
+			return nil, false, err
+		}
+		newChildren[i] = newChild
+		changed = changed || childChanged
+	}
+	if !changed {
+		return n, false, nil
+	}
+	n, err := n.WithChildren(newChildren...)
+	return n, true, err
 }
 
 func flattenTableAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
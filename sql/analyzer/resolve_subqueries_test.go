@@ -18,6 +18,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -108,7 +110,7 @@ func TestResolveSubqueries(t *testing.T) {
 	ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
 	resolveSubqueries := getRule("resolve_subqueries")
 	finalizeSubqueries := getRule("finalize_subqueries")
-	runTestCases(t, ctx, testCases, a, Rule{
+	subqueries := Rule{
 		Name: "subqueries",
 		Apply: func(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 			n, err := resolveSubqueries.Apply(ctx, a, n, scope)
@@ -117,7 +119,21 @@ func TestResolveSubqueries(t *testing.T) {
 			}
 			return finalizeSubqueries.Apply(ctx, a, n, scope)
 		},
-	})
+	}
+	runTestCases(t, ctx, testCases, a, subqueries)
+
+	// Subquery aliases are analyzed concurrently with a parallel analyzer, with the same results, including when
+	// the worker pool can't spare any goroutine
+	parallel := withoutProcessTracking(NewBuilder(sql.NewDatabaseProvider(db)).WithParallelism(4).RemoveAfterAllRule("parallelize").Build())
+	runTestCases(t, ctx, testCases, parallel, subqueries)
+
+	pool := sql.NewWorkerPool(1)
+	release, ok := pool.TryAcquire(sql.WorkerBackground)
+	require.True(t, ok)
+	defer release()
+	ctx.Workers = pool
+	runTestCases(t, ctx, testCases, parallel, subqueries)
+	require.Equal(t, uint64(2), pool.Stats(sql.WorkerAnalysis).Rejected)
 }
 
 func TestResolveSubqueryExpressions(t *testing.T) {
@@ -116,9 +116,11 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 				}
 				hasdiff = true
 
-				// TODO: Principled type coercion...
-				les[i] = expression.NewConvert(les[i], expression.ConvertToChar)
-				res[i] = expression.NewConvert(res[i], expression.ConvertToChar)
+				// Numbers are converted to a common numeric type, so that equal numbers of different types are
+				// distinct from each other only once.
+				// TODO: Principled type coercion for other types...
+				les[i] = convertUnionColumn(les[i], ls[i].Type, rs[i].Type)
+				res[i] = convertUnionColumn(res[i], ls[i].Type, rs[i].Type)
 
 				// Preserve schema names across the conversion.
 				les[i] = expression.NewAlias(ls[i].Name, les[i])
@@ -136,3 +138,24 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 		return n, nil
 	})
 }
+
+// convertUnionColumn returns the expression given converted to the type of the column of a union of the types given.
+func convertUnionColumn(e sql.Expression, left, right sql.Type) sql.Expression {
+	if !sql.IsNumber(left) || !sql.IsNumber(right) {
+		return expression.NewConvert(e, expression.ConvertToChar)
+	}
+	if sql.IsMixedSignInteger(left, right) {
+		return expression.NewConvertWithLengthAndScale(e, expression.ConvertToDecimal, sql.DecimalTypeMaxPrecision, 0)
+	}
+	switch t := sql.NumericCoercionType(left, right); {
+	case sql.IsDecimal(t):
+		dt := t.(sql.DecimalType)
+		return expression.NewConvertWithLengthAndScale(e, expression.ConvertToDecimal, int(dt.Precision()), int(dt.Scale()))
+	case t == sql.Int64:
+		return expression.NewConvert(e, expression.ConvertToSigned)
+	case t == sql.Uint64:
+		return expression.NewConvert(e, expression.ConvertToUnsigned)
+	default:
+		return expression.NewConvert(e, expression.ConvertToDouble)
+	}
+}
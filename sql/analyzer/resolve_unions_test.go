@@ -94,7 +94,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 					plan.NewResolvedTable(dualTable, nil, nil),
 				),
 				plan.NewProject(
-					[]sql.Expression{expression.NewLiteral(int32(3), sql.Int32)},
+					[]sql.Expression{expression.NewLiteral("3", sql.LongText)},
 					plan.NewResolvedTable(dualTable, nil, nil),
 				),
 			),
@@ -109,10 +109,46 @@ func TestMergeUnionSchemas(t *testing.T) {
 						plan.NewResolvedTable(dualTable, nil, nil),
 					),
 				),
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias("\"3\"", expression.NewConvert(
+							expression.NewGetField(0, sql.LongText, "\"3\"", false), "char")),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral("3", sql.LongText)},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
+			),
+			nil,
+		},
+		{
+			"Mismatched Integer Types Coerced to BIGINT",
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int32(3), sql.Int32)},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+			),
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias("1", expression.NewConvert(
+							expression.NewGetField(0, sql.Int64, "1", false), "signed")),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
 				plan.NewProject(
 					[]sql.Expression{
 						expression.NewAlias("3", expression.NewConvert(
-							expression.NewGetField(0, sql.Int32, "3", false), "char")),
+							expression.NewGetField(0, sql.Int32, "3", false), "signed")),
 					},
 					plan.NewProject(
 						[]sql.Expression{expression.NewLiteral(int32(3), sql.Int32)},
@@ -122,6 +158,42 @@ func TestMergeUnionSchemas(t *testing.T) {
 			),
 			nil,
 		},
+		{
+			"Integers and Decimals Coerced to Decimals",
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1))},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+			),
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias("1", expression.NewConvertWithLengthAndScale(
+							expression.NewGetField(0, sql.Int64, "1", false), "decimal", 65, 1)),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias("2.5", expression.NewConvertWithLengthAndScale(
+							expression.NewGetField(0, sql.MustCreateDecimalType(2, 1), "2.5", false), "decimal", 65, 1)),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1))},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
+			),
+			nil,
+		},
 	}
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
@@ -18,7 +18,6 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -53,7 +52,7 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 				}
 
 				if ok {
-					query, err := parse.Parse(ctx, viewDef)
+					query, err := a.parseDefinition(ctx, viewDef)
 					if err != nil {
 						return nil, err
 					}
@@ -23,13 +23,16 @@ import (
 var OnceBeforeDefault = []Rule{
 	{"validate_offset_and_limit", validateLimitAndOffset},
 	{"validate_create_table", validateCreateTable},
+	{"invalidate_definition_cache", invalidateDefinitionCache},
 	{"load_stored_procedures", loadStoredProcedures},
 	{"resolve_variables", resolveVariables},
 	{"resolve_set_variables", resolveSetVariables},
+	{"resolve_div_precision_increment", resolveDivPrecisionIncrement},
 	{"resolve_views", resolveViews},
 	{"lift_common_table_expressions", liftCommonTableExpressions},
 	{"resolve_common_table_expressions", resolveCommonTableExpressions},
 	{"resolve_tables", resolveTables},
+	{"resolve_handlers", resolveHandlers},
 	{"resolve_drop_constraint", resolveDropConstraint},
 	{"validate_drop_constraint", validateDropConstraint},
 	{"load_check_constraints", loadChecks},
@@ -93,6 +96,8 @@ var OnceAfterDefault = []Rule{
 	{"pushdown_projections", pushdownProjections},
 	{"set_join_scope_len", setJoinScopeLen},
 	{"erase_projection", eraseProjection},
+	{"merge_null_sort_fields", mergeNullSortFields},
+	{"replace_sort_with_index", replaceSortWithIndex},
 	{"insert_topn", insertTopNNodes},
 	// One final pass at analyzing subqueries to handle rewriting field indexes after changes to outer scope by
 	// previous rules.
@@ -112,6 +117,9 @@ var OnceAfterDefault = []Rule{
 // rules have been applied.
 var OnceAfterAll = []Rule{
 	{"track_process", trackProcess},
+	// Runs after validation, since it moves Sort nodes below the GroupBy nodes that validation rules expect to find
+	// them above.
+	{"sort_group_by_input", sortGroupByInput},
 	{"parallelize", parallelize},
 	//	{"begin_transaction", beginTransaction}, // Disabled for now, implicit transactions are handled before analysis in handler.go
 	{"clear_warnings", clearWarnings},
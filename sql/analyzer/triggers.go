@@ -22,7 +22,6 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
-	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -168,7 +167,7 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 		}
 
 		for _, trigger := range triggers {
-			parsedTrigger, err := parse.Parse(ctx, trigger.CreateStatement)
+			parsedTrigger, err := a.parseDefinition(ctx, trigger.CreateStatement)
 			if err != nil {
 				return nil, err
 			}
@@ -180,10 +179,12 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 
 			triggerTable := getTableName(ct.Table)
 			if stringContains(affectedTables, triggerTable) && triggerEventsMatch(triggerEvent, ct.TriggerEvent) {
-				if block, ok := ct.Body.(*plan.BeginEndBlock); ok {
-					ct.Body = plan.NewTriggerBeginEndBlock(block)
+				// The parsed trigger may be shared through the definition cache, so it's copied rather than modified
+				nct := *ct
+				if block, ok := nct.Body.(*plan.BeginEndBlock); ok {
+					nct.Body = plan.NewTriggerBeginEndBlock(block)
 				}
-				affectedTriggers = append(affectedTriggers, ct)
+				affectedTriggers = append(affectedTriggers, &nct)
 			}
 		}
 	}
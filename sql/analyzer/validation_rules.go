@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -52,6 +53,9 @@ var (
 	// ErrValidationGroupBy is returned when the aggregation expression does not
 	// appear in the grouping columns.
 	ErrValidationGroupBy = errors.NewKind("expression '%v' doesn't appear in the group by expressions")
+	// ErrValidationAggregatedNoGroupBy is returned when an aggregated query
+	// without GROUP BY selects a nonaggregated column under ONLY_FULL_GROUP_BY.
+	ErrValidationAggregatedNoGroupBy = errors.NewKind("in aggregated query without GROUP BY, expression #%d of SELECT list contains nonaggregated column '%v'; this is incompatible with sql_mode=only_full_group_by")
 	// ErrValidationSchemaSource is returned when there is any column source
 	// that does not match the table name.
 	ErrValidationSchemaSource = errors.NewKind("one or more schema sources are empty")
@@ -233,6 +237,12 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 	span, _ := ctx.Span("validate_group_by")
 	defer span.Finish()
 
+	if ctx.IsSQLModeEnabled("ONLY_FULL_GROUP_BY") {
+		if err := validateAggregatedWithoutGroupBy(n); err != nil {
+			return nil, err
+		}
+	}
+
 	switch n := n.(type) {
 	case *plan.GroupBy:
 		// Allow the parser use the GroupBy node to eval the aggregation functions
@@ -246,9 +256,12 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 			groupBys = append(groupBys, expr.String())
 		}
 
+		// Subqueries reference the columns of the grouped rows after the columns of the outer scopes
+		scopeLen := len(scope.Schema())
+		childLen := len(n.Child.Schema())
 		for _, expr := range n.SelectedExprs {
 			if _, ok := expr.(sql.Aggregation); !ok {
-				if !expressionReferencesOnlyGroupBys(groupBys, expr) {
+				if !expressionReferencesOnlyGroupBys(groupBys, expr, scopeLen, childLen) {
 					return nil, ErrValidationGroupBy.New(expr.String())
 				}
 			}
@@ -260,12 +273,42 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 	return n, nil
 }
 
-func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bool {
+// validateAggregatedWithoutGroupBy checks that aggregated queries without a GROUP BY clause, such as the ones
+// aggregated only through their HAVING clause, don't select nonaggregated columns.
+func validateAggregatedWithoutGroupBy(n sql.Node) error {
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) > 0 || err != nil {
+			return err == nil
+		}
+
+		childLen := len(gb.Child.Schema())
+		for i, expr := range gb.SelectedExprs {
+			if _, ok := expr.(sql.Aggregation); ok {
+				continue
+			}
+			if !expressionReferencesOnlyGroupBys(nil, expr, 0, childLen) {
+				err = ErrValidationAggregatedNoGroupBy.New(i+1, expr.String())
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
+func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression, scopeLen, childLen int) bool {
 	valid := true
 	sql.Inspect(expr, func(expr sql.Expression) bool {
 		switch expr := expr.(type) {
 		case nil, sql.Aggregation, *expression.Literal:
 			return false
+		case *plan.Subquery:
+			if !subqueryReferencesOnlyGroupBys(groupBys, expr, scopeLen, childLen) {
+				valid = false
+			}
+			return false
 		case *expression.Alias, sql.FunctionExpression:
 			if stringContains(groupBys, expr.String()) {
 				return false
@@ -291,6 +334,27 @@ func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bo
 	return valid
 }
 
+// subqueryReferencesOnlyGroupBys returns whether the columns of the grouped rows that the subquery given references,
+// which are those following the columns of the outer scopes in its rows, are all grouped by.
+func subqueryReferencesOnlyGroupBys(groupBys []string, subquery *plan.Subquery, scopeLen, childLen int) bool {
+	valid := true
+	plan.InspectExpressions(subquery.Query, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery:
+			if !subqueryReferencesOnlyGroupBys(groupBys, e, scopeLen, childLen) {
+				valid = false
+			}
+			return false
+		case *expression.GetField:
+			if e.Index() >= scopeLen && e.Index() < scopeLen+childLen && !stringContains(groupBys, e.String()) {
+				valid = false
+			}
+		}
+		return valid
+	})
+	return valid
+}
+
 func validateSchemaSource(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	span, _ := ctx.Span("validate_schema_source")
 	defer span.Finish()
@@ -527,6 +591,18 @@ func validateOperands(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 								err = sql.ErrInvalidOperandColumns.New(1, nc)
 							}
 						}
+					case *window.Aggregation:
+						// The arguments of an aggregate function used as a window function, such as the star of
+						// COUNT(*), are children of the window function itself
+						for _, e := range e.Children() {
+							if _, s := e.(*expression.Star); s {
+								continue
+							}
+							nc := sql.NumColumns(e.Type())
+							if nc != 1 {
+								err = sql.ErrInvalidOperandColumns.New(1, nc)
+							}
+						}
 					case expression.Tuple:
 						// Tuple expressions can contain tuples...
 					default:
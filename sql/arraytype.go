@@ -119,7 +119,7 @@ func (t arrayType) Promote() Type {
 	return t
 }
 
-func (t arrayType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t arrayType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -29,11 +29,19 @@ type BackgroundThreads struct {
 	parentCancel context.CancelFunc
 	nameToCancel map[string]context.CancelFunc
 	nameToCtx    map[string]context.Context
+	pool         *WorkerPool
 }
 
 func NewBackgroundThreads() *BackgroundThreads {
+	return NewBackgroundThreadsWithPool(nil)
+}
+
+// NewBackgroundThreadsWithPool returns a BackgroundThreads instance whose
+// threads each take a worker of the given pool for as long as they run.
+func NewBackgroundThreadsWithPool(pool *WorkerPool) *BackgroundThreads {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &BackgroundThreads{
+		pool:         pool,
 		wg:           &sync.WaitGroup{},
 		parentCtx:    ctx,
 		parentCancel: cancel,
@@ -45,7 +53,8 @@ func NewBackgroundThreads() *BackgroundThreads {
 
 // Add starts a background goroutine wrapped by a top-level sync.WaitGroup.
 // [f] must return when its [ctx] argument is cancelled, otherwise
-// Shutdown will hang.
+// Shutdown will hang. If the worker pool has no room for another
+// background thread, ErrWorkerPoolExhausted is returned.
 func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error {
 	select {
 	case <-bt.parentCtx.Done():
@@ -53,6 +62,11 @@ func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error
 	default:
 	}
 
+	release, ok := bt.pool.TryAcquire(WorkerBackground)
+	if !ok {
+		return ErrWorkerPoolExhausted.New(WorkerBackground)
+	}
+
 	threadCtx, threadCancel := context.WithCancel(bt.parentCtx)
 
 	bt.mu.Lock()
@@ -64,6 +78,7 @@ func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error
 
 	go func() {
 		defer bt.wg.Done()
+		defer release()
 		f(threadCtx)
 	}()
 
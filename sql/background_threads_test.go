@@ -96,4 +96,21 @@ func TestBackgroundThreads(t *testing.T) {
 		sort.Ints(b)
 		assert.Equal(t, []int{}, b)
 	})
+	t.Run("worker pool", func(t *testing.T) {
+		b = make([]int, 0)
+		pool := NewWorkerPool(1)
+		bThreads = NewBackgroundThreadsWithPool(pool)
+
+		err = bThreads.Add("first", f(1))
+		assert.NoError(t, err)
+
+		err = bThreads.Add("second", f(2))
+		assert.True(t, ErrWorkerPoolExhausted.Is(err))
+
+		err = bThreads.Shutdown()
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		assert.Equal(t, []int{1}, b)
+		assert.Equal(t, WorkerPoolStats{Completed: 1, Rejected: 1}, pool.Stats(WorkerBackground))
+	})
 }
@@ -174,7 +174,7 @@ func (t bitType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t bitType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t bitType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
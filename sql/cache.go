@@ -36,6 +36,50 @@ func HashOf(v Row) (uint64, error) {
 	return hash.Sum64(), nil
 }
 
+// HashOfSchema returns a hash of the given row like HashOf, except that rows whose values compare as equal with the
+// types of the schema given hash the same, like strings differing only in case in a case-insensitive collation.
+func HashOfSchema(sch Schema, v Row) (uint64, error) {
+	return HashOf(CollationKeys(sch, v))
+}
+
+// CollationKeys returns the values of the row given replaced by their CollationKey with the types of the schema
+// given. The row itself is returned if it has no values to replace.
+func CollationKeys(sch Schema, v Row) Row {
+	var keys Row
+	for i, x := range v {
+		if i >= len(sch) {
+			break
+		}
+		s, ok := x.(string)
+		if !ok {
+			continue
+		}
+		k := CollationKey(sch[i].Type, s)
+		if k == s {
+			continue
+		}
+		if keys == nil {
+			keys = v.Copy()
+		}
+		keys[i] = k
+	}
+	if keys == nil {
+		return v
+	}
+	return keys
+}
+
+// CollationKey returns the value given as it must be hashed to group or deduplicate values of the type given: strings
+// of a case-insensitive collation are folded, so that the values comparing as equal have the same key.
+func CollationKey(t Type, v interface{}) interface{} {
+	if st, ok := t.(StringType); ok {
+		if s, ok := v.(string); ok {
+			return st.Collation().Key(s)
+		}
+	}
+	return v
+}
+
 // ErrKeyNotFound is returned when the key could not be found in the cache.
 var ErrKeyNotFound = errors.NewKind("memory: key %d not found in cache")
 
@@ -84,10 +128,11 @@ type rowsCache struct {
 	memory   Freeable
 	reporter Reporter
 	rows     []Row
+	reserved uint64
 }
 
 func newRowsCache(memory Freeable, r Reporter) *rowsCache {
-	return &rowsCache{memory, r, nil}
+	return &rowsCache{memory: memory, reporter: r}
 }
 
 func (c *rowsCache) Add(row Row) error {
@@ -96,12 +141,15 @@ func (c *rowsCache) Add(row Row) error {
 	}
 
 	c.rows = append(c.rows, row)
+	c.reserved += reserveMemory(c.reporter, row)
 	return nil
 }
 
 func (c *rowsCache) Get() []Row { return c.rows }
 
 func (c *rowsCache) Dispose() {
+	releaseMemory(c.reporter, c.reserved)
+	c.reserved = 0
 	c.memory = nil
 	c.rows = nil
 }
@@ -134,6 +182,7 @@ type historyCache struct {
 	memory   Freeable
 	reporter Reporter
 	cache    map[uint64]interface{}
+	reserved uint64
 }
 
 func (h *historyCache) Size() int {
@@ -141,13 +190,16 @@ func (h *historyCache) Size() int {
 }
 
 func newHistoryCache(memory Freeable, r Reporter) *historyCache {
-	return &historyCache{memory, r, make(map[uint64]interface{})}
+	return &historyCache{memory: memory, reporter: r, cache: make(map[uint64]interface{})}
 }
 
 func (h *historyCache) Put(k uint64, v interface{}) error {
 	if !releaseMemoryIfNeeded(h.reporter, h.memory.Free) {
 		return ErrNoMemoryAvailable.New()
 	}
+	if _, ok := h.cache[k]; !ok {
+		h.reserved += reserveMemory(h.reporter, v)
+	}
 	h.cache[k] = v
 	return nil
 }
@@ -161,6 +213,8 @@ func (h *historyCache) Get(k uint64) (interface{}, error) {
 }
 
 func (h *historyCache) Dispose() {
+	releaseMemory(h.reporter, h.reserved)
+	h.reserved = 0
 	h.memory = nil
 	h.cache = nil
 }
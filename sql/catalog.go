@@ -43,9 +43,16 @@ type Catalog interface {
 	// Integrators with custom functions should typically use the FunctionProvider interface to register their functions.
 	RegisterFunction(fns ...Function)
 
-	// LockTable locks the table named
-	LockTable(ctx *Context, table string)
+	// LockTable locks the table named in the database named
+	LockTable(ctx *Context, db, table string)
 
 	// UnlockTables unlocks all tables locked by the session id given
 	UnlockTables(ctx *Context, id uint32) error
+
+	// SchemaVersion returns the version of the schema of the catalog, which changes every time a DDL statement is
+	// run. Anything cached from an analyzed query must be discarded when it changes.
+	SchemaVersion() uint64
+
+	// SchemaChanged increments the schema version of the catalog
+	SchemaChanged()
 }
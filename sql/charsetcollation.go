@@ -17,6 +17,8 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -50,10 +52,23 @@ func sensitiveLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
 	return regex.NewDisposableMatcher("go", likeStr)
 }
 
+// insensitiveCompare compares two strings ignoring case, rune by rune, so that it doesn't need to allocate lowercase
+// copies of them.
 func insensitiveCompare(a, b string) int {
-	lowerA := strings.ToLower(a)
-	lowerB := strings.ToLower(b)
-	return strings.Compare(lowerA, lowerB)
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			la, lb := unicode.ToLower(ra), unicode.ToLower(rb)
+			if la < lb {
+				return -1
+			} else if la > lb {
+				return 1
+			}
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return strings.Compare(a, b)
 }
 
 // Collation represents the collation of a string.
@@ -66,8 +81,14 @@ type Collation struct {
 
 var Collations = map[string]Collation{}
 
+// newCollation creates a collation comparing and LIKE matching strings ignoring case if its name says so, like
+// utf8mb4_general_ci and utf8mb4_0900_ai_ci, and by their bytes otherwise.
 func newCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: insensitiveCompare, LikeMatcher: insensitiveLikeMatcher}
+	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher}
+	if c.IsCaseInsensitive() {
+		c.Compare = insensitiveCompare
+		c.LikeMatcher = insensitiveLikeMatcher
+	}
 	Collations[name] = c
 	return c
 }
@@ -1033,6 +1054,79 @@ func ParseCollation(characterSetStr *string, collationStr *string, binaryAttribu
 	}
 }
 
+// ServerCollation returns the default collation of the server, given by the collation_server system variable, which
+// the databases created without a character set or collation of their own inherit.
+func ServerCollation(ctx *Context) (Collation, error) {
+	val, err := ctx.GetSessionVariable(ctx, "collation_server")
+	if err != nil {
+		return Collation_Default, err
+	}
+	name, _ := val.(string)
+	collation, ok := Collations[strings.ToLower(name)]
+	if !ok {
+		return Collation_Default, ErrCollationNotSupported.New(name)
+	}
+	return collation, nil
+}
+
+// DatabaseCollation returns the default collation of the database given, or the default collation if it isn't a
+// CollatedDatabase.
+func DatabaseCollation(ctx *Context, db Database) Collation {
+	if cdb, ok := db.(CollatedDatabase); ok {
+		return cdb.GetCollation(ctx)
+	}
+	return Collation_Default
+}
+
+// TableCollation returns the default collation of the table given, or the collation of its schema if neither it nor
+// the tables it wraps are a CollatedTable.
+func TableCollation(table Table) Collation {
+	for t := table; t != nil; {
+		switch tt := t.(type) {
+		case CollatedTable:
+			return tt.Collation()
+		case TableWrapper:
+			t = tt.Underlying()
+		default:
+			t = nil
+		}
+	}
+	return SchemaCollation(table.Schema())
+}
+
+// SchemaCollation returns the collation of the first non-binary string column of the schema given, or the default
+// collation if it has none.
+func SchemaCollation(schema Schema) Collation {
+	for _, col := range schema {
+		if st, ok := col.Type.(StringType); ok && !st.Collation().Equals(Collation_binary) {
+			return st.Collation()
+		}
+	}
+	return Collation_Default
+}
+
+// TypeWithCollation returns the type given with the collation given, if it's a non-binary string, enum or set type.
+// CHAR and VARCHAR types keep their length in characters, while TEXT types keep their size class. Other types are
+// returned as they are.
+func TypeWithCollation(typ Type, collation Collation) (Type, error) {
+	switch t := typ.(type) {
+	case StringType:
+		if t.CharacterSet() == CharacterSet_binary {
+			return t, nil
+		}
+		if IsTextBlob(t) {
+			return CreateString(t.Type(), MaxClassByteLength(t)/collation.CharacterSet().MaxLength(), collation)
+		}
+		return CreateString(t.Type(), t.MaxCharacterLength(), collation)
+	case EnumType:
+		return CreateEnumType(t.Values(), collation)
+	case SetType:
+		return CreateSetType(t.Values(), collation)
+	default:
+		return typ, nil
+	}
+}
+
 // DefaultCollation returns the default Collation for this CharacterSet.
 func (cs CharacterSet) DefaultCollation() Collation {
 	collation, ok := characterSetDefaults[cs]
@@ -1134,6 +1228,20 @@ func (c Collation) PadSpace() string {
 	return s.PadSpace
 }
 
+// IsCaseInsensitive returns whether the collation compares strings ignoring case.
+func (c Collation) IsCaseInsensitive() bool {
+	return strings.HasSuffix(c.Name, "_ci")
+}
+
+// Key returns the string given in a form that is the same for all the strings the collation compares as equal, so
+// that it can be hashed for grouping and deduplication.
+func (c Collation) Key(s string) string {
+	if c.IsCaseInsensitive() {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
 // Equals returns true if two collations are equal, false otherwise
 func (c Collation) Equals(other Collation) bool {
 	return c.Name == other.Name
@@ -69,3 +69,31 @@ func testParseCollation(t *testing.T, charset *string, collation *string, binary
 		}
 	})
 }
+
+func TestCollationCaseInsensitivity(t *testing.T) {
+	tests := []struct {
+		collation       Collation
+		caseInsensitive bool
+	}{
+		{Collation_binary, false},
+		{Collation_utf8mb4_0900_bin, false},
+		{Collation_utf8mb4_bin, false},
+		{Collation_utf8mb4_0900_as_cs, false},
+		{Collation_utf8mb4_general_ci, true},
+		{Collation_utf8mb4_0900_ai_ci, true},
+		{Collation_latin1_swedish_ci, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.collation.Name, func(t *testing.T) {
+			assert.Equal(t, test.caseInsensitive, test.collation.IsCaseInsensitive())
+			if test.caseInsensitive {
+				assert.Equal(t, 0, test.collation.Compare("Straße", "STRAßE"))
+				assert.Equal(t, "straße", test.collation.Key("Straße"))
+			} else {
+				assert.Equal(t, 1, test.collation.Compare("Straße", "STRAßE"))
+				assert.Equal(t, "Straße", test.collation.Key("Straße"))
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// characterSetEncodings are the encodings of the character sets that don't encode Unicode, used to tell which
+// characters they can represent and how many bytes those take. Character sets missing from here and from the Unicode
+// ones are assumed to represent every character with the number of bytes of their maximum length.
+var characterSetEncodings = map[CharacterSet]encoding.Encoding{
+	CharacterSet_big5:     traditionalchinese.Big5,
+	CharacterSet_cp1250:   charmap.Windows1250,
+	CharacterSet_cp1251:   charmap.Windows1251,
+	CharacterSet_cp1256:   charmap.Windows1256,
+	CharacterSet_cp1257:   charmap.Windows1257,
+	CharacterSet_cp850:    charmap.CodePage850,
+	CharacterSet_cp852:    charmap.CodePage852,
+	CharacterSet_cp866:    charmap.CodePage866,
+	CharacterSet_cp932:    japanese.ShiftJIS,
+	CharacterSet_eucjpms:  japanese.EUCJP,
+	CharacterSet_euckr:    korean.EUCKR,
+	CharacterSet_gb18030:  simplifiedchinese.GB18030,
+	CharacterSet_gb2312:   simplifiedchinese.GBK,
+	CharacterSet_gbk:      simplifiedchinese.GBK,
+	CharacterSet_greek:    charmap.ISO8859_7,
+	CharacterSet_hebrew:   charmap.ISO8859_8,
+	CharacterSet_koi8r:    charmap.KOI8R,
+	CharacterSet_koi8u:    charmap.KOI8U,
+	CharacterSet_latin1:   charmap.Windows1252,
+	CharacterSet_latin2:   charmap.ISO8859_2,
+	CharacterSet_latin5:   charmap.ISO8859_9,
+	CharacterSet_latin7:   charmap.ISO8859_13,
+	CharacterSet_macroman: charmap.Macintosh,
+	CharacterSet_sjis:     japanese.ShiftJIS,
+	CharacterSet_tis620:   charmap.Windows874,
+	CharacterSet_ujis:     japanese.EUCJP,
+}
+
+// EncodedLength returns the number of bytes the string given takes once encoded in the CharacterSet, as returned by
+// LENGTH(). Characters the CharacterSet can't represent count as the single byte of the '?' replacing them.
+func (cs CharacterSet) EncodedLength(s string) int {
+	if cs == CharacterSet_binary {
+		return len(s)
+	}
+
+	enc := cs.encoder()
+	length := 0
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		if n := cs.runeLength(r, size, enc); n > 0 {
+			length += n
+		} else {
+			length++
+		}
+	}
+	return length
+}
+
+// Transcode returns the string given converted to the CharacterSet, as CONVERT(... USING charset) does, with the
+// characters it can't represent replaced by '?'. Strings are always held as UTF-8, so the ones it can represent are
+// kept as they are.
+func (cs CharacterSet) Transcode(s string) string {
+	if cs == CharacterSet_binary {
+		return s
+	}
+
+	enc := cs.encoder()
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		if cs.runeLength(r, size, enc) > 0 {
+			sb.WriteString(s[:size])
+		} else {
+			sb.WriteByte('?')
+		}
+		s = s[size:]
+	}
+	return sb.String()
+}
+
+func (cs CharacterSet) encoder() *encoding.Encoder {
+	if enc, ok := characterSetEncodings[cs]; ok {
+		return enc.NewEncoder()
+	}
+	return nil
+}
+
+// runeLength returns the number of bytes the rune given, decoded from size bytes of UTF-8, takes in the CharacterSet,
+// or 0 if the CharacterSet can't represent it.
+func (cs CharacterSet) runeLength(r rune, size int, enc *encoding.Encoder) int {
+	if r == utf8.RuneError && size <= 1 {
+		return 0
+	}
+
+	switch cs {
+	case CharacterSet_utf8mb4:
+		return size
+	case CharacterSet_utf8mb3:
+		if size > 3 {
+			return 0
+		}
+		return size
+	case CharacterSet_ascii:
+		if r >= utf8.RuneSelf {
+			return 0
+		}
+		return 1
+	case CharacterSet_ucs2:
+		if r > 0xFFFF {
+			return 0
+		}
+		return 2
+	case CharacterSet_utf16, CharacterSet_utf16le:
+		if r > 0xFFFF {
+			return 4
+		}
+		return 2
+	case CharacterSet_utf32:
+		return 4
+	}
+
+	if enc == nil {
+		return int(cs.MaxLength())
+	}
+	encoded, err := enc.String(string(r))
+	if err != nil {
+		return 0
+	}
+	return len(encoded)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+)
+
+// ChunkedValue is a BLOB or TEXT value that a storage backend reads in chunks, rather than materializing it in a
+// single byte slice. Tables can return ChunkedValues in their rows for large values, which are only materialized when
+// an expression needs them. Otherwise, they are written straight into the result rows sent to the client, and rows
+// with chunked values are sent as soon as they're written, so that only one of them is held in memory at a time.
+type ChunkedValue interface {
+	// Size returns the size of the value in bytes.
+	Size() int64
+	// WriteTo writes the chunks of the value, in order, to the writer given.
+	io.WriterTo
+}
+
+// ReadChunkedValue appends the chunks of the value given to the byte slice given, and returns the extended slice.
+// The slice is grown at most once, to fit the size of the value.
+func ReadChunkedValue(dest []byte, v ChunkedValue) ([]byte, error) {
+	size := int(v.Size())
+	if cap(dest)-len(dest) < size {
+		grown := make([]byte, len(dest), len(dest)+size)
+		copy(grown, dest)
+		dest = grown
+	}
+
+	w := &appendWriter{dest}
+	if _, err := v.WriteTo(w); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+type appendWriter struct {
+	buf []byte
+}
+
+// Write implements io.Writer.
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
@@ -31,6 +31,9 @@ type Column struct {
 	Type Type
 	// Default contains the default value of the column or nil if it was not explicitly defined. A nil instance is valid, thus calls do not error.
 	Default *ColumnDefaultValue
+	// OnUpdate contains the value the column is set to when any other column of its row is updated, or nil if it was
+	// not defined.
+	OnUpdate *ColumnDefaultValue
 	// AutoIncrement is true if the column auto-increments.
 	AutoIncrement bool
 	// Nullable is true if the column can contain NULL values, or false
@@ -62,9 +65,58 @@ func (c *Column) Equals(c2 *Column) bool {
 		c.Source == c2.Source &&
 		c.Nullable == c2.Nullable &&
 		reflect.DeepEqual(c.Default, c2.Default) &&
+		reflect.DeepEqual(c.OnUpdate, c2.OnUpdate) &&
 		reflect.DeepEqual(c.Type, c2.Type)
 }
 
+// Collation returns the collation of the character strings held by the column, or false if the column holds any other
+// kind of values, including binary strings.
+func (c *Column) Collation() (Collation, bool) {
+	switch t := c.Type.(type) {
+	case StringType:
+		if t.CharacterSet() == CharacterSet_binary {
+			return Collation_binary, false
+		}
+		return t.Collation(), true
+	case EnumType:
+		return t.Collation(), true
+	case SetType:
+		return t.Collation(), true
+	default:
+		return Collation_Default, false
+	}
+}
+
+// TypeDefinition returns the definition of the column's type as shown by SHOW COLUMNS and information_schema.columns,
+// which is lowercased and doesn't include the CHARACTER SET and COLLATE clauses of the type.
+func (c *Column) TypeDefinition() string {
+	return strings.ToLower(strings.TrimSuffix(c.Type.String(), c.CollationClause()))
+}
+
+// CollationClause returns the CHARACTER SET and COLLATE clauses of the column's type, which are only present when it
+// doesn't use the default collation. The clauses are prefixed with a space when present.
+func (c *Column) CollationClause() string {
+	return c.TableCollationClause(Collation_Default)
+}
+
+// TableCollationClause returns the CHARACTER SET and COLLATE clauses of the column's type as shown in the definition of
+// a table with the default collation given, which are only present when the column doesn't use that collation.
+func (c *Column) TableCollationClause(tableCollation Collation) string {
+	collation, ok := c.Collation()
+	if !ok {
+		return ""
+	}
+
+	var clause string
+	if collation.CharacterSet() != tableCollation.CharacterSet() {
+		clause += " CHARACTER SET " + collation.CharacterSet().String()
+	}
+	if !collation.Equals(tableCollation) {
+		clause += " COLLATE " + collation.String()
+	}
+	return clause
+}
+
 func (c *Column) DebugString() string {
 	sb := strings.Builder{}
 	sb.WriteString("Name: ")
@@ -88,6 +140,9 @@ func (c *Column) DebugString() string {
 	sb.WriteString("Default: ")
 	sb.WriteString(c.Default.String())
 	sb.WriteString(", ")
+	sb.WriteString("OnUpdate: ")
+	sb.WriteString(c.OnUpdate.String())
+	sb.WriteString(", ")
 	sb.WriteString("AutoIncrement: ")
 	sb.WriteString(fmt.Sprintf("%v", c.AutoIncrement))
 	sb.WriteString(", ")
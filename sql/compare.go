@@ -0,0 +1,171 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+)
+
+// ComparisonType returns the type values of the types given are converted to before being compared with each other,
+// following MySQL's rules: values of the same type are compared as they are, numbers are compared as integers when
+// both are integers of the same signedness, as decimals when both are exact, and as doubles otherwise, temporal
+// values are compared as datetimes, and anything else as strings.
+func ComparisonType(left, right Type) Type {
+	if TypesEqual(left, right) {
+		return left
+	}
+
+	if IsTuple(left) && IsTuple(right) {
+		return left
+	}
+
+	if IsNumber(left) || IsNumber(right) {
+		if IsMixedSignInteger(left, right) {
+			return InternalDecimalType
+		}
+
+		switch t := NumericCoercionType(left, right); {
+		case IsDecimal(t):
+			return InternalDecimalType
+		case t == Int64, t == Uint64:
+			return t
+		default:
+			return Float64
+		}
+	}
+
+	if IsTime(left) || IsTime(right) {
+		return Datetime
+	}
+
+	return LongText
+}
+
+// ConvertForComparison converts the value given to the type given, which is usually a ComparisonType, so that it can
+// be compared with the type's Compare. As in MySQL, strings compare as numbers by their NumericPrefix, values that
+// can't be converted to a number compare as zero, and values that can't be converted to a string or a temporal value
+// compare as NULL.
+func ConvertForComparison(v interface{}, t Type) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if s, ok := v.(string); ok && (t == Int64 || t == Uint64 || t == Float64 || IsDecimal(t)) {
+		v, _ = NumericPrefix(s)
+	}
+
+	switch {
+	case t == Int64 || t == Float64 || IsDecimal(t):
+		c, err := t.Convert(v)
+		if err != nil {
+			return t.Zero(), nil
+		}
+		return c, nil
+	case t == Uint64:
+		c, err := Uint64.Convert(v)
+		if err != nil {
+			// Negative values wrap around, as when casting them to UNSIGNED
+			i, err := Int64.Convert(v)
+			if err != nil {
+				return Uint64.Zero(), nil
+			}
+			return uint64(i.(int64)), nil
+		}
+		return c, nil
+	case IsTime(t):
+		switch v.(type) {
+		case time.Time, string:
+		default:
+			return nil, nil
+		}
+		c, err := t.Convert(v)
+		if err != nil {
+			return nil, nil
+		}
+		return c, nil
+	case IsText(t):
+		c, err := t.Convert(v)
+		if err != nil {
+			return nil, nil
+		}
+		return c, nil
+	default:
+		return t.Convert(v)
+	}
+}
+
+// Compare compares the values given after converting them to the type given with ConvertForComparison. It's meant
+// for values of different types, which most types' Compare doesn't handle, and should be given the ComparisonType of
+// their types. Like Type.Compare, it returns -1, 0 or 1, and sorts NULLs after anything else.
+func Compare(a, b interface{}, t Type) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	a, err := ConvertForComparison(a, t)
+	if err != nil {
+		return 0, err
+	}
+	b, err = ConvertForComparison(b, t)
+	if err != nil {
+		return 0, err
+	}
+
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+	return t.Compare(a, b)
+}
+
+// TupleCompareFuncs returns the functions comparing the elements of values of the tuple types given, each with the
+// ComparisonType of the types of the elements.
+func TupleCompareFuncs(left, right TupleType) []CompareFunc {
+	fns := make([]CompareFunc, len(left))
+	for i := range left {
+		t := left[i]
+		if i < len(right) {
+			t = ComparisonType(left[i], right[i])
+		}
+		fns[i] = func(a, b interface{}) (int, error) {
+			return Compare(a, b, t)
+		}
+	}
+	return fns
+}
+
+// EqualTuples returns whether the row values given are equal, comparing their elements with the functions given. As
+// in MySQL, row values are equal when all of their elements are, so they aren't when any pair of elements isn't, even
+// if other elements are NULL, and the result is NULL otherwise if any element is NULL: (NULL, 1) = (2, 2) is false,
+// while (NULL, 1) = (2, 1) is NULL.
+func EqualTuples(left, right []interface{}, fns []CompareFunc) (equal bool, isNull bool, err error) {
+	if len(left) != len(right) {
+		return false, false, ErrInvalidOperandColumns.New(len(left), len(right))
+	}
+
+	for i := range left {
+		if left[i] == nil || right[i] == nil {
+			isNull = true
+			continue
+		}
+		cmp, err := fns[i](left[i], right[i])
+		if err != nil {
+			return false, false, err
+		}
+		if cmp != 0 {
+			return false, false, nil
+		}
+	}
+	return !isNull, isNull, nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+)
+
+// CompareFunc compares two values, returning -1, 0 or 1 in the same manner as Type.Compare.
+type CompareFunc func(a, b interface{}) (int, error)
+
+// CompareFuncForType returns a CompareFunc for values of the type given. Type.Compare has to determine how to compare
+// its arguments on every call, which is costly when comparing many values, like when sorting. For the common types
+// whose values have a single native representation, the function returned compares such values directly, falling
+// back to Type.Compare for anything else. Callers should resolve the function once and reuse it for every comparison.
+func CompareFuncForType(t Type) CompareFunc {
+	switch t := t.(type) {
+	case numberTypeImpl:
+		return numberCompareFunc(t)
+	case stringType:
+		compare := t.Collation().Compare
+		return func(a, b interface{}) (int, error) {
+			if as, ok := a.(string); ok {
+				if bs, ok := b.(string); ok {
+					return compare(as, bs), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case datetimeType:
+		if t.baseType == sqltypes.Date {
+			return t.Compare
+		}
+		return func(a, b interface{}) (int, error) {
+			if at, ok := a.(time.Time); ok {
+				if bt, ok := b.(time.Time); ok {
+					if at.Before(bt) {
+						return -1, nil
+					} else if at.After(bt) {
+						return 1, nil
+					}
+					return 0, nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	default:
+		return t.Compare
+	}
+}
+
+func numberCompareFunc(t numberTypeImpl) CompareFunc {
+	switch t.baseType {
+	case sqltypes.Int8:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(int8); ok {
+				if bi, ok := b.(int8); ok {
+					return compareInt64(int64(ai), int64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Int16:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(int16); ok {
+				if bi, ok := b.(int16); ok {
+					return compareInt64(int64(ai), int64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Int24, sqltypes.Int32:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(int32); ok {
+				if bi, ok := b.(int32); ok {
+					return compareInt64(int64(ai), int64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Int64:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(int64); ok {
+				if bi, ok := b.(int64); ok {
+					return compareInt64(ai, bi), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Uint8:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(uint8); ok {
+				if bi, ok := b.(uint8); ok {
+					return compareUint64(uint64(ai), uint64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Uint16:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(uint16); ok {
+				if bi, ok := b.(uint16); ok {
+					return compareUint64(uint64(ai), uint64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Uint24, sqltypes.Uint32:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(uint32); ok {
+				if bi, ok := b.(uint32); ok {
+					return compareUint64(uint64(ai), uint64(bi)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Uint64:
+		return func(a, b interface{}) (int, error) {
+			if ai, ok := a.(uint64); ok {
+				if bi, ok := b.(uint64); ok {
+					return compareUint64(ai, bi), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Float32:
+		return func(a, b interface{}) (int, error) {
+			if af, ok := a.(float32); ok {
+				if bf, ok := b.(float32); ok {
+					return compareFloat64(float64(af), float64(bf)), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	case sqltypes.Float64:
+		return func(a, b interface{}) (int, error) {
+			if af, ok := a.(float64); ok {
+				if bf, ok := b.(float64); ok {
+					return compareFloat64(af, bf), nil
+				}
+			}
+			return t.Compare(a, b)
+		}
+	default:
+		return t.Compare
+	}
+}
+
+func compareInt64(a, b int64) int {
+	if a == b {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+	return 1
+}
+
+func compareUint64(a, b uint64) int {
+	if a == b {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+	return 1
+}
+
+func compareFloat64(a, b float64) int {
+	if a == b {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+	return 1
+}
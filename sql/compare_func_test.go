@@ -0,0 +1,71 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareFuncForType(t *testing.T) {
+	now := time.Date(2021, 12, 1, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		typ Type
+		a   interface{}
+		b   interface{}
+	}{
+		{Int8, int8(-1), int8(1)},
+		{Int16, int16(3), int16(3)},
+		{Int24, int32(7), int32(-7)},
+		{Int32, int32(7), int64(8)},
+		{Int64, int64(1), int64(2)},
+		{Int64, int64(1), "1"},
+		{Uint8, uint8(1), uint8(2)},
+		{Uint16, uint16(2), uint16(1)},
+		{Uint32, uint32(5), uint32(5)},
+		{Uint64, uint64(1<<63 + 1), uint64(1)},
+		{Float32, float32(1.5), float32(1.25)},
+		{Float64, float64(-1.5), float64(1.25)},
+		{Float64, float64(2), int64(2)},
+		{LongText, "abc", "abd"},
+		{LongText, "b", "a"},
+		{LongText, "1", int64(1)},
+		{Datetime, now, now.Add(time.Second)},
+		{Datetime, now, "2021-12-01 10:30:00"},
+		{Date, now, now.Add(time.Hour)},
+		{Int64, nil, int64(1)},
+		{LongText, "a", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %v %v", tt.typ, tt.a, tt.b), func(t *testing.T) {
+			expected, err := tt.typ.Compare(tt.a, tt.b)
+			require.NoError(t, err)
+			cmp, err := CompareFuncForType(tt.typ)(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, expected, cmp)
+
+			expected, err = tt.typ.Compare(tt.b, tt.a)
+			require.NoError(t, err)
+			cmp, err = CompareFuncForType(tt.typ)(tt.b, tt.a)
+			require.NoError(t, err)
+			assert.Equal(t, expected, cmp)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparisonType(t *testing.T) {
+	tests := []struct {
+		left     Type
+		right    Type
+		expected Type
+	}{
+		{Int32, Int32, Int32},
+		{Int8, Int64, Int64},
+		{Uint8, Uint64, Uint64},
+		{Int64, Uint64, InternalDecimalType},
+		{Int64, MustCreateDecimalType(10, 2), InternalDecimalType},
+		{Int64, Float32, Float64},
+		{Int64, LongText, Float64},
+		{Datetime, Date, Datetime},
+		{Timestamp, LongText, Datetime},
+		{Text, Blob, LongText},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.left, test.right), func(t *testing.T) {
+			assert.Equal(t, test.expected, ComparisonType(test.left, test.right))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a        interface{}
+		b        interface{}
+		expected int
+	}{
+		{int64(1), "1", 0},
+		{int64(2), "10", -1},
+		{int8(0), "abc", 0},
+		{int64(-1), uint64(1), -1},
+		{uint64(18446744073709551615), int64(-1), 1},
+		{float64(1.5), "1.5", 0},
+		{"1.10", int64(1), 1},
+		{"2021-01-01", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), "2021-01-01 10:00:00", 1},
+		{"abc", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 1},
+		{nil, int64(1), 1},
+		{"a", "b", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.a, test.b), func(t *testing.T) {
+			typ := ComparisonType(ApproximateTypeFromValue(test.a), ApproximateTypeFromValue(test.b))
+			cmp, err := Compare(test.a, test.b, typ)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cmp)
+		})
+	}
+}
+
+func TestEqualTuples(t *testing.T) {
+	tests := []struct {
+		left   []interface{}
+		right  []interface{}
+		equal  bool
+		isNull bool
+	}{
+		{[]interface{}{int64(1), "a"}, []interface{}{int64(1), "a"}, true, false},
+		{[]interface{}{int64(1), "a"}, []interface{}{int64(1), "b"}, false, false},
+		{[]interface{}{int64(1), "2"}, []interface{}{int64(1), int64(2)}, true, false},
+		{[]interface{}{nil, int64(1)}, []interface{}{int64(2), int64(2)}, false, false},
+		{[]interface{}{nil, int64(2)}, []interface{}{int64(2), int64(2)}, false, true},
+		{[]interface{}{int64(1), int64(2)}, []interface{}{int64(1), nil}, false, true},
+	}
+
+	typesOf := func(vals []interface{}) TupleType {
+		types := make(TupleType, len(vals))
+		for i, v := range vals {
+			types[i] = Null
+			if v != nil {
+				types[i] = ApproximateTypeFromValue(v)
+			}
+		}
+		return types
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.left, test.right), func(t *testing.T) {
+			fns := TupleCompareFuncs(typesOf(test.left), typesOf(test.right))
+			equal, isNull, err := EqualTuples(test.left, test.right, fns)
+			require.NoError(t, err)
+			assert.Equal(t, test.equal, equal)
+			assert.Equal(t, test.isNull, isNull)
+		})
+	}
+
+	_, _, err := EqualTuples([]interface{}{int64(1)}, []interface{}{int64(1), int64(2)}, nil)
+	require.True(t, ErrInvalidOperandColumns.Is(err))
+}
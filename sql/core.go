@@ -16,6 +16,7 @@ package sql
 
 import (
 	"fmt"
+	"io"
 	"math"
 	"strconv"
 	"strings"
@@ -137,6 +138,24 @@ type WindowAggregation interface {
 	EvalRow(i int, buffer Row) (interface{}, error)
 }
 
+// OrderedWindowAggregation is a WindowAggregation that can compute its results from an ordering of its input rows that
+// was computed elsewhere. Window nodes sort their input once for every distinct window and share the resulting
+// ordering among all OrderedWindowAggregations over that window, rather than having each one sort its own buffer.
+type OrderedWindowAggregation interface {
+	WindowAggregation
+	// FinishOrdered is like Finish, but is given the indexes of the rows added, in the order given by this
+	// expression's window's partition and order by fields.
+	FinishOrdered(ctx *Context, buffer Row, order []int) error
+}
+
+// NullTreatmentWindowAggregation is a WindowAggregation that can skip the null values of its argument, as given by
+// the IGNORE NULLS clause of LAG, LEAD, FIRST_VALUE, LAST_VALUE and NTH_VALUE.
+type NullTreatmentWindowAggregation interface {
+	WindowAggregation
+	// WithIgnoreNulls returns a version of this window aggregation that skips null values when ignoreNulls is true
+	WithIgnoreNulls(ignoreNulls bool) WindowAggregation
+}
+
 // Node is a node in the execution plan tree.
 type Node interface {
 	Resolvable
@@ -267,6 +286,40 @@ type FilteredTable interface {
 	WithFilters(ctx *Context, filters []Expression) Table
 }
 
+// FilterCapabilities is a set of the kinds of expressions a table can evaluate in the filters pushed down to it.
+type FilterCapabilities uint16
+
+const (
+	// FilterComparison is the capability to evaluate comparisons: =, <=>, <, <=, > and >=.
+	FilterComparison FilterCapabilities = 1 << iota
+	// FilterIsNull is the capability to evaluate IS NULL.
+	FilterIsNull
+	// FilterAnd is the capability to evaluate AND.
+	FilterAnd
+	// FilterOr is the capability to evaluate OR.
+	FilterOr
+	// FilterNot is the capability to evaluate NOT.
+	FilterNot
+	// FilterIn is the capability to evaluate IN with a list of values.
+	FilterIn
+	// FilterLike is the capability to evaluate LIKE.
+	FilterLike
+)
+
+// Has returns whether the set contains all the capabilities given.
+func (c FilterCapabilities) Has(other FilterCapabilities) bool {
+	return c&other == other
+}
+
+// ExpressionFilteredTable is a FilteredTable that declares the kinds of expressions it can evaluate. Only the filters
+// made entirely of those expressions, columns of the table and literals are offered to HandledFilters, so a table that
+// translates filters to another query language receives whole expression trees it knows how to translate.
+type ExpressionFilteredTable interface {
+	FilteredTable
+	// FilterCapabilities returns the kinds of expressions the table can evaluate.
+	FilterCapabilities() FilterCapabilities
+}
+
 // ProjectedTable is a table that can produce a specific RowIter
 // that's more optimized given the columns that are projected.
 type ProjectedTable interface {
@@ -453,6 +506,16 @@ type TruncateableTable interface {
 	Truncate(*Context) (int, error)
 }
 
+// MaintainableTable is a table that can maintain its storage on OPTIMIZE TABLE and REPAIR TABLE.
+type MaintainableTable interface {
+	Table
+	// Optimize reorganizes the storage of the table to reduce its size and speed up its access, e.g. compacting it and
+	// refreshing its statistics.
+	Optimize(*Context) error
+	// Repair checks the storage of the table and fixes the problems found.
+	Repair(*Context) error
+}
+
 // AutoIncrementTable is a table that supports AUTO_INCREMENT.
 // Getter and Setter methods access the table's AUTO_INCREMENT
 // sequence. These methods should only be used for tables with
@@ -593,6 +656,16 @@ type VersionedDatabase interface {
 	GetTableNamesAsOf(ctx *Context, asOf interface{}) ([]string, error)
 }
 
+// VersionedTable is a Table that keeps its own history, so that it can be queried with AS OF in a database that isn't
+// a VersionedDatabase. VersionedDatabase takes precedence when a database implements it.
+type VersionedTable interface {
+	Table
+
+	// AsOf returns the table as it existed at the revision given, or false if the table didn't exist at that revision.
+	// Implementors must choose which types of expressions to accept as revision names.
+	AsOf(ctx *Context, asOf interface{}) (Table, bool, error)
+}
+
 type TransactionCharacteristic int
 
 const (
@@ -663,6 +736,16 @@ type TemporaryTableDatabase interface {
 	GetAllTemporaryTables(ctx *Context) ([]Table, error)
 }
 
+// BackupableDatabase is a database that can be backed up with BACKUP DATABASE and restored with RESTORE DATABASE
+// while it keeps serving queries.
+type BackupableDatabase interface {
+	Database
+	// Backup writes a snapshot of the contents of the database to the writer given, in a format of its choice.
+	Backup(ctx *Context, w io.Writer) error
+	// Restore replaces the contents of the database with the snapshot given, as written by Backup.
+	Restore(ctx *Context, r io.Reader) error
+}
+
 // TableCopierDatabase is a database that can copy a source table's data (without preserving indexed, fks, etc.) into
 // another destination table.
 type TableCopierDatabase interface {
@@ -711,6 +794,37 @@ func GetTableNameInsensitive(tblName string, tableNames []string) (string, bool)
 	return "", false
 }
 
+// GetTableInsensitiveAsOf returns the table of the database given by its case-insensitive name, as it existed at the
+// revision given. The revision is served by the database if it's a VersionedDatabase, and by the table otherwise if
+// it's a VersionedTable. Returns ErrAsOfNotSupported if neither keeps history.
+func GetTableInsensitiveAsOf(ctx *Context, db Database, tblName string, asOf interface{}) (Table, bool, error) {
+	if vdb, ok := db.(VersionedDatabase); ok {
+		return vdb.GetTableInsensitiveAsOf(ctx, tblName, asOf)
+	}
+
+	tbl, ok, err := db.GetTableInsensitive(ctx, tblName)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	vt, ok := getVersionedTable(tbl)
+	if !ok {
+		return nil, false, ErrAsOfNotSupported.New(db.Name())
+	}
+	return vt.AsOf(ctx, asOf)
+}
+
+func getVersionedTable(t Table) (VersionedTable, bool) {
+	switch t := t.(type) {
+	case VersionedTable:
+		return t, true
+	case TableWrapper:
+		return getVersionedTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
 // DBTableIter iterates over all tables returned by db.GetTableNames() calling cb for each one until all tables have
 // been processed, or an error is returned from the callback, or the cont flag is false when returned from the callback.
 func DBTableIter(ctx *Context, db Database, cb func(Table) (cont bool, err error)) error {
@@ -759,6 +873,26 @@ type TemporaryTableCreator interface {
 	CreateTemporaryTable(ctx *Context, name string, schema PrimaryKeySchema) error
 }
 
+// CollatedDatabase is a database with a default collation, which the tables created in it without a character set or
+// collation of their own inherit.
+type CollatedDatabase interface {
+	Database
+	// GetCollation returns the default collation of the database.
+	GetCollation(ctx *Context) Collation
+	// SetCollation sets the default collation of the database. Existing tables keep their collation.
+	SetCollation(ctx *Context, collation Collation) error
+}
+
+// CollatedTable is a table with a default collation, which the columns created in it without a character set or
+// collation of their own inherit.
+type CollatedTable interface {
+	Table
+	// Collation returns the default collation of the table.
+	Collation() Collation
+	// SetCollation sets the default collation of the table. Existing columns keep their collation.
+	SetCollation(ctx *Context, collation Collation) error
+}
+
 // ViewDefinition is the named textual definition of a view
 type ViewDefinition struct {
 	Name           string
@@ -16,6 +16,8 @@ package sql
 
 import (
 	"math"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -42,6 +44,12 @@ var (
 
 	ErrConvertingToTimeOutOfRange = errors.NewKind("value %q is outside of %v range")
 
+	// ErrInvalidDate is thrown when a value has the format of a date but isn't a valid one, such as '2020-02-30'
+	ErrInvalidDate = errors.NewKind("incorrect date value: '%s'")
+
+	// dateRegex matches the values in the year-month-day format, with an optional time, capturing the date parts
+	dateRegex = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})(?:[ T](\d{1,2}):(\d{1,2}):(\d{1,2})(\.\d{1,6})?)?$`)
+
 	// datetimeTypeMaxDatetime is the maximum representable Datetime/Date value.
 	datetimeTypeMaxDatetime = time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC)
 
@@ -203,6 +211,9 @@ func (t datetimeType) ConvertWithoutRangeCheck(v interface{}) (time.Time, error)
 			}
 		}
 		if !parsed {
+			if dateRegex.MatchString(value) {
+				return zeroTime, ErrInvalidDate.New(value)
+			}
 			return zeroTime, ErrConvertingToTime.New(v)
 		}
 	case time.Time:
@@ -217,6 +228,48 @@ func (t datetimeType) ConvertWithoutRangeCheck(v interface{}) (time.Time, error)
 	return res, nil
 }
 
+// ConvertInvalidDate converts the date given, which Convert rejected with ErrInvalidDate, the way MySQL stores it when
+// ALLOW_INVALID_DATES is enabled: only the month must be between 1 and 12 and the day between 1 and 31. As the days
+// past the end of the month can't be represented, they're carried into the next month. TIMESTAMP values always need
+// to be valid dates, so it returns false for them, as well as for the values that aren't dates at all.
+func ConvertInvalidDate(t DatetimeType, v interface{}) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok || t.Type() == sqltypes.Timestamp {
+		return zeroTime, false
+	}
+	match := dateRegex.FindStringSubmatch(s)
+	if match == nil {
+		return zeroTime, false
+	}
+
+	parts := make([]int, 6)
+	for i := range parts {
+		if match[i+1] != "" {
+			parts[i], _ = strconv.Atoi(match[i+1])
+		}
+	}
+	if parts[1] < 1 || parts[1] > 12 || parts[2] < 1 || parts[2] > 31 || parts[3] > 23 || parts[4] > 59 || parts[5] > 59 {
+		return zeroTime, false
+	}
+
+	var nsec int
+	if match[7] != "" {
+		frac, _ := strconv.ParseFloat(match[7], 64)
+		nsec = int(math.Round(frac*1e6)) * 1000
+	}
+	res := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], nsec, time.UTC)
+	if t.Type() == sqltypes.Date {
+		res = res.Truncate(24 * time.Hour)
+	}
+	return res, true
+}
+
+// IsZeroTime returns whether the value given is the zero date, '0000-00-00', of the DATE, DATETIME and TIMESTAMP types.
+func IsZeroTime(v interface{}) bool {
+	t, ok := v.(time.Time)
+	return ok && t.Equal(zeroTime)
+}
+
 func (t datetimeType) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
 	if err != nil {
@@ -234,7 +287,7 @@ func (t datetimeType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t datetimeType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t datetimeType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -259,6 +259,39 @@ func TestDatetimeConvert(t *testing.T) {
 	}
 }
 
+func TestConvertInvalidDate(t *testing.T) {
+	tests := []struct {
+		typ         DatetimeType
+		val         interface{}
+		expectedVal time.Time
+		expectedOk  bool
+	}{
+		{Date, "2020-02-30", time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), true},
+		{Date, "2021-04-31 10:11:12", time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC), true},
+		{Datetime, "2021-02-29 10:11:12.5", time.Date(2021, 3, 1, 10, 11, 12, 500000000, time.UTC), true},
+		{Datetime, "2021-02-32", zeroTime, false},
+		{Datetime, "2021-13-01", zeroTime, false},
+		{Datetime, "2021-00-10", zeroTime, false},
+		{Datetime, "2021-02-29 25:00:00", zeroTime, false},
+		{Datetime, "not a date", zeroTime, false},
+		{Timestamp, "2021-02-29", zeroTime, false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			_, err := test.typ.Convert(test.val)
+			require.Error(t, err)
+			if test.expectedOk {
+				assert.True(t, ErrInvalidDate.Is(err))
+			}
+
+			val, ok := ConvertInvalidDate(test.typ, test.val)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedVal, val)
+		})
+	}
+}
+
 func TestDatetimeString(t *testing.T) {
 	tests := []struct {
 		typ         Type
@@ -15,7 +15,9 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -157,8 +159,14 @@ func (t decimalType) ConvertToDecimal(v interface{}) (decimal.NullDecimal, error
 	case uint64:
 		res = decimal.NewFromBigInt(new(big.Int).SetUint64(value), 0)
 	case float32:
+		if err := checkDecimalFloat(float64(value)); err != nil {
+			return decimal.NullDecimal{}, err
+		}
 		res = decimal.NewFromFloat32(value)
 	case float64:
+		if err := checkDecimalFloat(value); err != nil {
+			return decimal.NullDecimal{}, err
+		}
 		res = decimal.NewFromFloat(value)
 	case string:
 		var err error
@@ -174,6 +182,15 @@ func (t decimalType) ConvertToDecimal(v interface{}) (decimal.NullDecimal, error
 				return decimal.NullDecimal{}, err
 			}
 		}
+	case json.Number:
+		// Numbers of JSON documents, like the decimals in them
+		return t.ConvertToDecimal(string(value))
+	case JSONValue:
+		doc, err := value.Unmarshall(nil)
+		if err != nil {
+			return decimal.NullDecimal{}, err
+		}
+		return t.ConvertToDecimal(doc.Val)
 	case *big.Float:
 		return t.ConvertToDecimal(value.Text('f', -1))
 	case *big.Int:
@@ -200,6 +217,18 @@ func (t decimalType) ConvertToDecimal(v interface{}) (decimal.NullDecimal, error
 	return decimal.NullDecimal{Decimal: res, Valid: true}, nil
 }
 
+// checkDecimalFloat returns an error for the floats that have no decimal representation, infinities and NaN, which
+// make the decimal library panic.
+func checkDecimalFloat(f float64) error {
+	if math.IsInf(f, 0) {
+		return ErrConvertToDecimalLimit.New()
+	}
+	if math.IsNaN(f) {
+		return ErrConvertingToDecimal.New(f)
+	}
+	return nil
+}
+
 // MustConvert implements the Type interface.
 func (t decimalType) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
@@ -215,7 +244,7 @@ func (t decimalType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t decimalType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t decimalType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -15,7 +15,9 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 	"testing"
@@ -222,6 +224,9 @@ func TestDecimalConvert(t *testing.T) {
 		{1, 0, float64(-9.4), "-9", false},
 		{1, 0, float32(9.5), "", true},
 		{1, 0, int32(-10), "", true},
+		{1, 0, math.Inf(1), "", true},
+		{1, 0, math.Inf(-1), "", true},
+		{1, 0, math.NaN(), "", true},
 
 		{1, 1, 0, "0.0", false},
 		{1, 1, .01, "0.0", false},
@@ -230,6 +235,8 @@ func TestDecimalConvert(t *testing.T) {
 		{1, 1, .55, "0.6", false},
 		{1, 1, "-.7863294659345624", "-0.8", false},
 		{1, 1, "2634193746329327479.32030573792e-19", "0.3", false},
+		{1, 1, json.Number("0.25"), "0.3", false},
+		{1, 1, JSONDocument{Val: json.Number("0.25")}, "0.3", false},
 		{1, 1, 1, "", true},
 		{1, 1, new(big.Rat).SetInt64(2), "", true},
 
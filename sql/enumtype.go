@@ -223,7 +223,7 @@ func (t enumType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t enumType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t enumType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -60,6 +60,12 @@ var (
 	// ErrAmbiguousColumnInOrderBy is returned when an order by column is ambiguous
 	ErrAmbiguousColumnInOrderBy = errors.NewKind("Column %q in order clause is ambiguous")
 
+	// ErrGroupByColumnIndex is returned when a GROUP BY position doesn't refer to an expression of the select list
+	ErrGroupByColumnIndex = errors.NewKind("unknown column %d in group by clause")
+
+	// ErrGroupByAggregate is returned when a GROUP BY position refers to an aggregate expression of the select list
+	ErrGroupByAggregate = errors.NewKind("Can't group on '%s'")
+
 	// ErrColumnExists is returned when an ALTER TABLE statement would create a duplicate column
 	ErrColumnExists = errors.NewKind("Column %q already exists")
 
@@ -110,6 +116,10 @@ var (
 	// ErrColumnDefaultDatetimeOnlyFunc is returned when a non datetime/timestamp column attempts to declare now/current_timestamp as a default value literal.
 	ErrColumnDefaultDatetimeOnlyFunc = errors.NewKind("only datetime/timestamp may declare default values of now()/current_timestamp() without surrounding parentheses")
 
+	// ErrInvalidOnUpdate is returned when a column that isn't a datetime/timestamp declares an ON UPDATE value, or when
+	// the value isn't the current timestamp.
+	ErrInvalidOnUpdate = errors.NewKind("Invalid ON UPDATE clause for '%s' column")
+
 	// ErrColumnDefaultSubquery is returned when a default value contains a subquery.
 	ErrColumnDefaultSubquery = errors.NewKind("default value on column `%s` may not contain subqueries")
 
@@ -224,6 +234,9 @@ var (
 	// ErrJSONObjectAggNullKey is returned when JSON_OBJECTAGG is run on a table with NULL keys
 	ErrJSONObjectAggNullKey = errors.NewKind("JSON documents may not contain NULL member names")
 
+	// ErrInvalidJSONOneOrAll is returned when the one_or_all argument of a JSON function is neither 'one' nor 'all'.
+	ErrInvalidJSONOneOrAll = errors.NewKind("The oneOrAll argument to %s may take these values: 'one' or 'all'.")
+
 	// ErrDeclareOrderInvalid is returned when a DECLARE statement is at an invalid location.
 	ErrDeclareOrderInvalid = errors.NewKind("DECLARE may only exist at the beginning of a BEGIN/END block")
 
@@ -376,6 +389,23 @@ var (
 
 	// ErrInvalidCheckConstraint is returned when a  check constraint is defined incorrectly
 	ErrInvalidCheckConstraint = errors.NewKind("invalid constraint definition: %s")
+
+	// ErrUnknownHandler is returned when a HANDLER statement refers to a handler that is not open
+	ErrUnknownHandler = errors.NewKind("Unknown table '%s' in HANDLER")
+
+	// ErrBackupNotSupported is returned when BACKUP DATABASE or RESTORE DATABASE is run on a database that doesn't
+	// implement BackupableDatabase.
+	ErrBackupNotSupported = errors.NewKind("database %s doesn't support BACKUP and RESTORE")
+
+	// ErrBackupCannotOpen is returned when BACKUP DATABASE or RESTORE DATABASE is unable to open the file specified.
+	ErrBackupCannotOpen = errors.NewKind("unable to open backup file: %s")
+
+	// ErrInvalidBackup is returned when RESTORE DATABASE is given a file that isn't a backup of the database.
+	ErrInvalidBackup = errors.NewKind("invalid backup of database %s: %s")
+
+	// ErrPacketTooLarge is returned when a query, the parameters of a prepared statement or a row of a result take
+	// more bytes than the max_allowed_packet system variable.
+	ErrPacketTooLarge = errors.NewKind("Got a packet bigger than 'max_allowed_packet' bytes")
 )
 
 func CastSQLError(err error) (*mysql.SQLError, error, bool) {
@@ -416,8 +446,16 @@ func CastSQLError(err error) (*mysql.SQLError, error, bool) {
 		code = mysql.ERRowIsReferenced2 // test with mysql returns 1451 vs 1215
 	case ErrDuplicateEntry.Is(err):
 		code = mysql.ERDupEntry
+	case ErrLengthBeyondLimit.Is(err):
+		code = mysql.ERDataTooLong
 	case ErrInvalidJSONText.Is(err):
 		code = 3141 // TODO: Needs to be added to vitess
+	case ErrInvalidJSONPath.Is(err):
+		code = 3143 // TODO: Needs to be added to vitess
+	case ErrJSONPathWildcard.Is(err):
+		code = 3149 // TODO: Needs to be added to vitess
+	case ErrInvalidJSONOneOrAll.Is(err):
+		code = 3154 // TODO: Needs to be added to vitess
 	case ErrMultiplePrimaryKeysDefined.Is(err):
 		code = mysql.ERMultiplePriKey
 	case ErrWrongAutoKey.Is(err):
@@ -430,6 +468,15 @@ func CastSQLError(err error) (*mysql.SQLError, error, bool) {
 		code = 1792 // TODO: Needs to be added to vitess
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
+	case ErrGroupByColumnIndex.Is(err):
+		code = mysql.ERBadFieldError
+	case ErrGroupByAggregate.Is(err):
+		code = mysql.ERWrongGroupField
+	case ErrUnknownTimeZone.Is(err):
+		code = mysql.ERUnknownTimeZone
+	case ErrPacketTooLarge.Is(err):
+		code = mysql.ERNetPacketTooLarge
+		sqlState = mysql.SSUnknownComError
 	default:
 		code = mysql.ERUnknownError
 	}
@@ -16,11 +16,16 @@ package expression
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,11 +43,27 @@ var (
 type Arithmetic struct {
 	BinaryExpression
 	Op string
+	// divPrecisionIncrement is the div_precision_increment of the divisions of the session they're analyzed in. Until
+	// then, their type follows the global value.
+	divPrecisionIncrement *int32
 }
 
 // NewArithmetic creates a new Arithmetic sql.Expression.
 func NewArithmetic(left, right sql.Expression, op string) *Arithmetic {
-	return &Arithmetic{BinaryExpression{Left: left, Right: right}, op}
+	return &Arithmetic{BinaryExpression: BinaryExpression{Left: left, Right: right}, Op: op}
+}
+
+// WithDivPrecisionIncrement returns a copy of the expression whose type and value, if it's a division, follow the
+// div_precision_increment given rather than the one of the session it's evaluated in.
+func (a *Arithmetic) WithDivPrecisionIncrement(increment int32) *Arithmetic {
+	na := *a
+	na.divPrecisionIncrement = &increment
+	return &na
+}
+
+// IsDivision returns whether the expression is a division with the / operator.
+func (a *Arithmetic) IsDivision() bool {
+	return strings.ToLower(a.Op) == sqlparser.DivStr
 }
 
 // NewPlus creates a new Arithmetic + sql.Expression.
@@ -134,19 +155,45 @@ func (a *Arithmetic) Type() sql.Type {
 			return sql.Int64
 		}
 
-		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) {
-			if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
-				return sql.Uint64
+		if a.IsDivision() {
+			increment := globalDivPrecisionIncrement()
+			if a.divPrecisionIncrement != nil {
+				increment = *a.divPrecisionIncrement
 			}
-			return sql.Int64
+			return divType(a.Left.Type(), a.Right.Type(), increment)
 		}
 
-		return sql.Float64
+		typ := sql.NumericCoercionType(a.Left.Type(), a.Right.Type())
+		if !sql.IsDecimal(typ) {
+			return typ
+		}
+		lt, rt := a.Left.Type(), a.Right.Type()
+		if strings.ToLower(a.Op) == sqlparser.MultStr {
+			// The precision and scale of a product are the sums of those of its factors
+			return decimalResultType(decimalPrecision(lt)+decimalPrecision(rt), decimalScale(lt)+decimalScale(rt))
+		}
+		// Sums and differences keep the larger scale, with room for the larger integral part plus a carry
+		scale := maxInt32(decimalScale(lt), decimalScale(rt))
+		integral := maxInt32(decimalPrecision(lt)-decimalScale(lt), decimalPrecision(rt)-decimalScale(rt))
+		return decimalResultType(integral+scale+1, scale)
 
-	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
+	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr, sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr:
 		return sql.Uint64
 
-	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr, sqlparser.ModStr:
+	case sqlparser.ModStr:
+		if isExactNumber(a.Left.Type()) && isExactNumber(a.Right.Type()) {
+			if sql.IsDecimal(a.Left.Type()) || sql.IsDecimal(a.Right.Type()) {
+				lt, rt := a.Left.Type(), a.Right.Type()
+				scale := maxInt32(decimalScale(lt), decimalScale(rt))
+				integral := maxInt32(decimalPrecision(lt)-decimalScale(lt), decimalPrecision(rt)-decimalScale(rt))
+				return decimalResultType(integral+scale, scale)
+			}
+		} else {
+			return sql.Float64
+		}
+		fallthrough
+
+	case sqlparser.IntDivStr:
 		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
 			return sql.Uint64
 		}
@@ -161,12 +208,111 @@ func isInterval(expr sql.Expression) bool {
 	return ok
 }
 
+// isExactNumber returns whether values of the type given are integers or decimals, as opposed to approximate values
+// such as floats or strings converted to numbers.
+func isExactNumber(t sql.Type) bool {
+	return sql.IsInteger(t) || sql.IsDecimal(t)
+}
+
+// decimalScale returns the number of digits after the decimal point of the type given, which is 0 for anything but
+// decimals.
+func decimalScale(t sql.Type) int32 {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return int32(dt.Scale())
+	}
+	return 0
+}
+
+// divType returns the type of the result of the division of values of the types given. As in MySQL, dividing exact
+// numbers results in a decimal with the scale of the dividend plus the given div_precision_increment, and anything
+// else in a double.
+func divType(left, right sql.Type, increment int32) sql.Type {
+	if !isExactNumber(left) || !isExactNumber(right) {
+		return sql.Float64
+	}
+	return decimalResultType(decimalPrecision(left)+decimalScale(right)+increment, decimalScale(left)+increment)
+}
+
+// decimalPrecision returns the number of digits needed to hold any value of the exact number type given.
+func decimalPrecision(t sql.Type) int32 {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return int32(dt.Precision())
+	}
+	switch t {
+	case sql.Int8, sql.Uint8:
+		return 3
+	case sql.Int16, sql.Uint16:
+		return 5
+	case sql.Int24, sql.Uint24:
+		return 8
+	case sql.Int32, sql.Uint32:
+		return 10
+	case sql.Int64:
+		return 19
+	default:
+		return 20
+	}
+}
+
+// decimalResultType returns the decimal type with the precision and scale given, clamped to the largest ones a
+// decimal supports, as MySQL does for the results of arithmetic.
+func decimalResultType(precision, scale int32) sql.Type {
+	if scale > sql.DecimalTypeMaxScale {
+		scale = sql.DecimalTypeMaxScale
+	}
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	if precision < scale {
+		precision = scale
+	}
+	if precision == 0 {
+		precision = 1
+	}
+	return sql.MustCreateDecimalType(uint8(precision), uint8(scale))
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// globalDivPrecisionIncrement returns the global value of div_precision_increment.
+func globalDivPrecisionIncrement() int32 {
+	_, val, ok := sql.SystemVariables.GetGlobal("div_precision_increment")
+	if !ok {
+		return 4
+	}
+	increment, err := sql.Int32.Convert(val)
+	if err != nil {
+		return 4
+	}
+	return increment.(int32)
+}
+
+// DivPrecisionIncrement returns the value of div_precision_increment for the session of the context given.
+func DivPrecisionIncrement(ctx *sql.Context) (int32, error) {
+	val, err := ctx.GetSessionVariable(ctx, "div_precision_increment")
+	if err != nil {
+		return 0, err
+	}
+	increment, err := sql.Int32.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+	return increment.(int32), nil
+}
+
 // WithChildren implements the Expression interface.
 func (a *Arithmetic) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
 		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
 	}
-	return NewArithmetic(children[0], children[1], a.Op), nil
+	na := *a
+	na.Left, na.Right = children[0], children[1]
+	return &na, nil
 }
 
 // Eval implements the Expression interface.
@@ -180,6 +326,41 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if sql.IsNumber(a.Type()) {
+		lval = numericOperand(ctx, a.Left.Type(), lval)
+		rval = numericOperand(ctx, a.Right.Type(), rval)
+	}
+
+	switch strings.ToLower(a.Op) {
+	case sqlparser.DivStr:
+		if sql.IsDecimal(a.Type()) {
+			var increment int32
+			if a.divPrecisionIncrement != nil {
+				increment = *a.divPrecisionIncrement
+			} else if increment, err = DivPrecisionIncrement(ctx); err != nil {
+				return nil, err
+			}
+			return decimalDiv(lval, rval, decimalScale(a.Left.Type())+increment)
+		}
+	case sqlparser.IntDivStr:
+		if !sql.IsInteger(a.Left.Type()) || !sql.IsInteger(a.Right.Type()) {
+			return decimalIntDiv(lval, rval)
+		}
+	case sqlparser.ModStr:
+		if typ := a.Type(); sql.IsDecimal(typ) {
+			return decimalMod(lval, rval, decimalScale(typ))
+		}
+	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
+		lval, rval = BitOperand(lval), BitOperand(rval)
+		return bitOp(a.Op, lval.(uint64), rval.(uint64)), nil
+	case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr:
+		if typ := a.Type(); sql.IsDecimal(typ) {
+			return decimalArithmetic(a.Op, lval, rval, decimalScale(typ))
+		} else if sql.IsMixedSignInteger(a.Left.Type(), a.Right.Type()) {
+			return mixedSignArithmetic(a.Op, lval, rval)
+		}
+	}
+
 	lval, rval, err = a.convertLeftRight(lval, rval)
 	if err != nil {
 		return nil, err
@@ -194,16 +375,6 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return mult(lval, rval)
 	case sqlparser.DivStr:
 		return div(lval, rval)
-	case sqlparser.BitAndStr:
-		return bitAnd(lval, rval)
-	case sqlparser.BitOrStr:
-		return bitOr(lval, rval)
-	case sqlparser.BitXorStr:
-		return bitXor(lval, rval)
-	case sqlparser.ShiftLeftStr:
-		return shiftLeft(lval, rval)
-	case sqlparser.ShiftRightStr:
-		return shiftRight(lval, rval)
 	case sqlparser.IntDivStr:
 		return intDiv(lval, rval)
 	case sqlparser.ModStr:
@@ -213,6 +384,20 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return nil, errUnableToEval.New(lval, a.Op, rval)
 }
 
+// numericOperand returns the value given of an operand of the type given that's used as a number. Strings are read
+// as their sql.NumericPrefix, with a warning if anything besides the number is skipped, as MySQL does.
+func numericOperand(ctx *sql.Context, typ sql.Type, v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok || !sql.IsText(typ) {
+		return v
+	}
+	n, truncated := sql.NumericPrefix(s)
+	if truncated {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect DOUBLE value: '%s'", s)
+	}
+	return n
+}
+
 func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	var lval, rval interface{}
 	var err error
@@ -229,6 +414,11 @@ func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{},
 		}
 	}
 
+	// The result is NULL regardless of the right operand, so don't bother evaluating it
+	if lval == nil {
+		return nil, nil, nil
+	}
+
 	if i, ok := a.Right.(*Interval); ok {
 		rval, err = i.EvalDelta(ctx, row)
 		if err != nil {
@@ -393,82 +583,170 @@ func div(lval, rval interface{}) (interface{}, error) {
 	return nil, errUnableToCast.New(lval, rval)
 }
 
-func bitAnd(lval, rval interface{}) (interface{}, error) {
-	switch l := lval.(type) {
+// BitOperand converts the value given to the unsigned 64-bit integer bit operators work on. As in MySQL, negative
+// numbers keep their two's complement representation, approximate values are rounded to the nearest integer and
+// anything that is not a number is 0.
+func BitOperand(v interface{}) uint64 {
+	switch n := v.(type) {
 	case uint64:
-		switch r := rval.(type) {
-		case uint64:
-			return l & r, nil
-		}
-
+		return n
+	case uint32:
+		return uint64(n)
+	case uint16:
+		return uint64(n)
+	case uint8:
+		return uint64(n)
+	case uint:
+		return uint64(n)
 	case int64:
-		switch r := rval.(type) {
-		case int64:
-			return l & r, nil
+		return uint64(n)
+	case int32:
+		return uint64(n)
+	case int16:
+		return uint64(n)
+	case int8:
+		return uint64(n)
+	case int:
+		return uint64(n)
+	case bool:
+		if n {
+			return 1
+		}
+		return 0
+	case float64:
+		return floatBitOperand(math.Round(n))
+	case float32:
+		return floatBitOperand(math.Round(float64(n)))
+	case string:
+		if u, err := strconv.ParseUint(strings.TrimSpace(n), 10, 64); err == nil {
+			return u
 		}
 	}
 
-	return nil, errUnableToCast.New(lval, rval)
+	f, err := sql.Float64.Convert(v)
+	if err != nil {
+		return 0
+	}
+	return floatBitOperand(math.Round(f.(float64)))
+}
+
+// floatBitOperand converts the integral float given to an unsigned 64-bit integer, saturating values out of range.
+func floatBitOperand(f float64) uint64 {
+	switch {
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	case f >= math.MaxInt64:
+		return uint64(f)
+	case f <= math.MinInt64:
+		return 1 << 63
+	default:
+		return uint64(int64(f))
+	}
 }
 
-func bitOr(lval, rval interface{}) (interface{}, error) {
-	switch l := lval.(type) {
-	case uint64:
-		switch r := rval.(type) {
-		case uint64:
-			return l | r, nil
-		}
-
-	case int64:
-		switch r := rval.(type) {
-		case int64:
-			return l | r, nil
-		}
+// bitOp applies the bit operator given to the values given. Shifting by 64 bits or more results in 0.
+func bitOp(op string, l, r uint64) uint64 {
+	switch strings.ToLower(op) {
+	case sqlparser.BitAndStr:
+		return l & r
+	case sqlparser.BitOrStr:
+		return l | r
+	case sqlparser.BitXorStr:
+		return l ^ r
+	case sqlparser.ShiftLeftStr:
+		return l << r
+	default:
+		return l >> r
 	}
-
-	return nil, errUnableToCast.New(lval, rval)
 }
 
-func bitXor(lval, rval interface{}) (interface{}, error) {
-	switch l := lval.(type) {
-	case uint64:
-		switch r := rval.(type) {
-		case uint64:
-			return l ^ r, nil
-		}
+// decimalDiv divides the values given as decimals, rounding the result to the scale given.
+func decimalDiv(lval, rval interface{}, scale int32) (interface{}, error) {
+	l, r, err := convertToDecimals(lval, rval)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsZero() {
+		return sql.Null, nil
+	}
+	if scale > sql.DecimalTypeMaxScale {
+		scale = sql.DecimalTypeMaxScale
+	}
+	return l.DivRound(r, scale).StringFixed(scale), nil
+}
 
-	case int64:
-		switch r := rval.(type) {
-		case int64:
-			return l ^ r, nil
-		}
+// decimalArithmetic applies the +, - or * operator given to the values given as decimals, with the scale given.
+func decimalArithmetic(op string, lval, rval interface{}, scale int32) (interface{}, error) {
+	l, r, err := convertToDecimals(lval, rval)
+	if err != nil {
+		return nil, err
 	}
+	return decimalOp(op, l, r).StringFixed(scale), nil
+}
 
-	return nil, errUnableToCast.New(lval, rval)
+// mixedSignArithmetic applies the +, - or * operator given to a signed and an unsigned integer. As in MySQL, the
+// result is unsigned, and an error is returned when it is out of range, e.g. for 1 - CAST(2 AS UNSIGNED).
+func mixedSignArithmetic(op string, lval, rval interface{}) (interface{}, error) {
+	l, r, err := convertToDecimals(lval, rval)
+	if err != nil {
+		return nil, err
+	}
+	res := decimalOp(op, l, r)
+	if res.Sign() < 0 || res.GreaterThan(maxUint64Decimal) {
+		return nil, sql.ErrOutOfRange.New(res, sql.Uint64)
+	}
+	return res.BigInt().Uint64(), nil
 }
 
-func shiftLeft(lval, rval interface{}) (interface{}, error) {
-	switch l := lval.(type) {
-	case uint64:
-		switch r := rval.(type) {
-		case uint64:
-			return l << r, nil
-		}
+var maxUint64Decimal = decimal.NewFromBigInt(new(big.Int).SetUint64(math.MaxUint64), 0)
+
+// decimalOp applies the +, - or * operator given to the decimals given.
+func decimalOp(op string, l, r decimal.Decimal) decimal.Decimal {
+	switch strings.ToLower(op) {
+	case sqlparser.PlusStr:
+		return l.Add(r)
+	case sqlparser.MinusStr:
+		return l.Sub(r)
+	default:
+		return l.Mul(r)
 	}
+}
 
-	return nil, errUnableToCast.New(lval, rval)
+// decimalIntDiv divides the values given as decimals, discarding the fractional part of the result.
+func decimalIntDiv(lval, rval interface{}) (interface{}, error) {
+	l, r, err := convertToDecimals(lval, rval)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsZero() {
+		return sql.Null, nil
+	}
+	q, _ := l.QuoRem(r, 0)
+	return q.IntPart(), nil
 }
 
-func shiftRight(lval, rval interface{}) (interface{}, error) {
-	switch l := lval.(type) {
-	case uint64:
-		switch r := rval.(type) {
-		case uint64:
-			return l >> r, nil
-		}
+// decimalMod returns the remainder of the division of the values given as decimals, with the scale given.
+func decimalMod(lval, rval interface{}, scale int32) (interface{}, error) {
+	l, r, err := convertToDecimals(lval, rval)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsZero() {
+		return sql.Null, nil
 	}
+	return l.Mod(r).StringFixed(scale), nil
+}
 
-	return nil, errUnableToCast.New(lval, rval)
+func convertToDecimals(lval, rval interface{}) (decimal.Decimal, decimal.Decimal, error) {
+	l, err := sql.InternalDecimalType.ConvertToDecimal(lval)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	r, err := sql.InternalDecimalType.ConvertToDecimal(rval)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	return l.Decimal, r.Decimal, nil
 }
 
 func intDiv(lval, rval interface{}) (interface{}, error) {
@@ -500,14 +778,29 @@ func mod(lval, rval interface{}) (interface{}, error) {
 	case uint64:
 		switch r := rval.(type) {
 		case uint64:
+			if r == 0 {
+				return sql.Null, nil
+			}
 			return l % r, nil
 		}
 
 	case int64:
 		switch r := rval.(type) {
 		case int64:
+			if r == 0 {
+				return sql.Null, nil
+			}
 			return l % r, nil
 		}
+
+	case float64:
+		switch r := rval.(type) {
+		case float64:
+			if r == 0 {
+				return sql.Null, nil
+			}
+			return math.Mod(l, r), nil
+		}
 	}
 
 	return nil, errUnableToCast.New(lval, rval)
@@ -534,6 +827,14 @@ func (e *UnaryMinus) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if dt, ok := e.Child.Type().(sql.DecimalType); ok {
+		dec, err := dt.ConvertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return dec.Decimal.Neg().StringFixed(int32(dt.Scale())), nil
+	}
+
 	if !sql.IsNumber(e.Child.Type()) {
 		child, err = sql.Float64.Convert(child)
 		if err != nil {
@@ -600,3 +901,44 @@ func (e *UnaryMinus) WithChildren(children ...sql.Expression) (sql.Expression, e
 	}
 	return NewUnaryMinus(children[0]), nil
 }
+
+// BitNot is the bitwise inversion operator ~.
+type BitNot struct {
+	UnaryExpression
+}
+
+// NewBitNot creates a new BitNot expression node.
+func NewBitNot(child sql.Expression) *BitNot {
+	return &BitNot{UnaryExpression{Child: child}}
+}
+
+// Eval implements the sql.Expression interface.
+func (e *BitNot) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	child, err := e.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if child == nil {
+		return nil, nil
+	}
+
+	return ^BitOperand(child), nil
+}
+
+// Type implements the sql.Expression interface.
+func (e *BitNot) Type() sql.Type {
+	return sql.Uint64
+}
+
+func (e *BitNot) String() string {
+	return fmt.Sprintf("~%s", e.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (e *BitNot) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return NewBitNot(children[0]), nil
+}
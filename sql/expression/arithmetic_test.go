@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -55,6 +56,104 @@ func TestPlus(t *testing.T) {
 	require.Equal(float64(5), result)
 }
 
+func TestArithmeticNumericCoercion(t *testing.T) {
+	dec1 := sql.MustCreateDecimalType(10, 1)
+	dec2 := sql.MustCreateDecimalType(10, 2)
+	testCases := []struct {
+		name         string
+		expr         sql.Expression
+		expectedType sql.Type
+		expected     interface{}
+	}{
+		{
+			"int + uint",
+			NewPlus(NewLiteral(int64(-5), sql.Int64), NewLiteral(uint64(10), sql.Uint64)),
+			sql.Uint64,
+			uint64(5),
+		},
+		{
+			"uint * int",
+			NewMult(NewLiteral(uint64(math.MaxUint32), sql.Uint64), NewLiteral(int32(2), sql.Int32)),
+			sql.Uint64,
+			uint64(2 * math.MaxUint32),
+		},
+		{
+			"int + decimal",
+			NewPlus(NewLiteral(int64(1), sql.Int64), NewLiteral("0.25", dec2)),
+			sql.MustCreateDecimalType(22, 2),
+			"1.25",
+		},
+		{
+			"decimal - decimal",
+			NewMinus(NewLiteral("1.5", dec1), NewLiteral("0.25", dec2)),
+			sql.MustCreateDecimalType(12, 2),
+			"1.25",
+		},
+		{
+			"decimal * decimal",
+			NewMult(NewLiteral("1.5", dec1), NewLiteral("0.25", dec2)),
+			sql.MustCreateDecimalType(20, 3),
+			"0.375",
+		},
+		{
+			"decimal + decimal is exact",
+			NewPlus(NewLiteral("0.1", sql.MustCreateDecimalType(1, 1)), NewLiteral("0.2", sql.MustCreateDecimalType(1, 1))),
+			sql.MustCreateDecimalType(2, 1),
+			"0.3",
+		},
+		{
+			"decimal + double",
+			NewPlus(NewLiteral("1.5", dec1), NewLiteral(float64(0.25), sql.Float64)),
+			sql.Float64,
+			float64(1.75),
+		},
+		{
+			"int + string",
+			NewPlus(NewLiteral(int64(1), sql.Int64), NewLiteral("0.5", sql.LongText)),
+			sql.Float64,
+			float64(1.5),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.expectedType, tt.expr.Type())
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+
+	_, err := NewMinus(NewLiteral(int64(1), sql.Int64), NewLiteral(uint64(2), sql.Uint64)).
+		Eval(sql.NewEmptyContext(), sql.NewRow())
+	require.True(t, sql.ErrOutOfRange.Is(err))
+}
+
+func TestArithmeticStringPrefix(t *testing.T) {
+	testCases := []struct {
+		expr     sql.Expression
+		expected interface{}
+		warnings int
+	}{
+		{NewPlus(NewLiteral(int64(1), sql.Int64), NewLiteral("1a", sql.LongText)), float64(2), 1},
+		{NewPlus(NewLiteral(int64(1), sql.Int64), NewLiteral("abc", sql.LongText)), float64(1), 1},
+		{NewMult(NewLiteral(" 2.5 ", sql.LongText), NewLiteral(int64(2), sql.Int64)), float64(5), 0},
+		{NewMinus(NewLiteral("3e1x", sql.LongText), NewLiteral("0.5y", sql.LongText)), float64(29.5), 2},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.expr.String(), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			result, err := tt.expr.Eval(ctx, sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+			require.Equal(uint16(tt.warnings), ctx.WarningCount())
+		})
+	}
+}
+
 func TestPlusInterval(t *testing.T) {
 	require := require.New(t)
 
@@ -188,14 +287,16 @@ func TestDiv(t *testing.T) {
 	var intTestCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    string
 		null        bool
 	}{
-		{"1 / 1", 1, 1, 1, false},
-		{"-1 / 1", -1, 1, -1, false},
-		{"0 / 1234567890", 0, 12345677890, 0, false},
-		{"1/0", 1, 0, 0, true},
-		{"0/0", 1, 0, 0, true},
+		{"1 / 1", 1, 1, "1.0000", false},
+		{"-1 / 1", -1, 1, "-1.0000", false},
+		{"7 / 2", 7, 2, "3.5000", false},
+		{"2 / 3", 2, 3, "0.6667", false},
+		{"0 / 1234567890", 0, 12345677890, "0.0000", false},
+		{"1/0", 1, 0, "", true},
+		{"0/0", 1, 0, "", true},
 	}
 	for _, tt := range intTestCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -215,13 +316,14 @@ func TestDiv(t *testing.T) {
 	var uintTestCases = []struct {
 		name        string
 		left, right uint64
-		expected    uint64
+		expected    string
 		null        bool
 	}{
-		{"1 / 1", 1, 1, 1, false},
-		{"0 / 1234567890", 0, 12345677890, 0, false},
-		{"1/0", 1, 0, 0, true},
-		{"0/0", 1, 0, 0, true},
+		{"1 / 1", 1, 1, "1.0000", false},
+		{"1 / 3", 1, 3, "0.3333", false},
+		{"0 / 1234567890", 0, 12345677890, "0.0000", false},
+		{"1/0", 1, 0, "", true},
+		{"0/0", 1, 0, "", true},
 	}
 	for _, tt := range uintTestCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,6 +339,50 @@ func TestDiv(t *testing.T) {
 			}
 		})
 	}
+
+	var decimalTestCases = []struct {
+		name        string
+		left, right interface{}
+		lt, rt      sql.Type
+		expected    interface{}
+	}{
+		{"decimal / int", "7.25", int64(2), sql.MustCreateDecimalType(10, 2), sql.Int64, "3.625000"},
+		{"int / decimal", int64(1), "0.50", sql.Int64, sql.MustCreateDecimalType(10, 2), "2.0000"},
+		{"decimal / 0", "7.25", int64(0), sql.MustCreateDecimalType(10, 2), sql.Int64, sql.Null},
+		{"decimal / float", "7.25", float64(2), sql.MustCreateDecimalType(10, 2), sql.Float64, 3.625},
+	}
+	for _, tt := range decimalTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewDiv(
+				NewLiteral(tt.left, tt.lt),
+				NewLiteral(tt.right, tt.rt),
+			).Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDivPrecisionIncrement(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, "div_precision_increment", int64(8)))
+
+	result, err := NewDiv(
+		NewLiteral(int64(1), sql.Int64),
+		NewLiteral(int64(3), sql.Int64),
+	).Eval(ctx, sql.NewRow())
+	require.NoError(err)
+	require.Equal("0.33333333", result)
+
+	require.NoError(ctx.SetSessionVariable(ctx, "div_precision_increment", int64(0)))
+	result, err = NewDiv(
+		NewLiteral(int64(7), sql.Int64),
+		NewLiteral(int64(2), sql.Int64),
+	).Eval(ctx, sql.NewRow())
+	require.NoError(err)
+	require.Equal("4", result)
 }
 
 func TestShiftLeft(t *testing.T) {
@@ -249,6 +395,8 @@ func TestShiftLeft(t *testing.T) {
 		{"1 << 3", 1, 3, 8},
 		{"1024 << 0", 1024, 0, 1024},
 		{"0 << 1024", 0, 1024, 0},
+		{"1 << 63", 1, 63, 9223372036854775808},
+		{"1 << 64", 1, 64, 0},
 	}
 
 	for _, tt := range testCases {
@@ -275,6 +423,7 @@ func TestShiftRight(t *testing.T) {
 		{"3 >> 1", 3, 1, 1},
 		{"1024 >> 0", 1024, 0, 1024},
 		{"0 >> 1024", 0, 1024, 0},
+		{"18446744073709551615 >> 63", 18446744073709551615, 63, 1},
 	}
 
 	for _, tt := range testCases {
@@ -294,13 +443,15 @@ func TestBitAnd(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 & 1", 1, 1, 1},
 		{"8 & 1", 8, 1, 0},
 		{"3 & 1", 3, 1, 1},
 		{"1024 & 0", 1024, 0, 0},
 		{"0 & 1024", 0, 1024, 0},
+		{"-1 & 1", -1, 1, 1},
+		{"-1 & -2", -1, -2, 18446744073709551614},
 	}
 
 	for _, tt := range testCases {
@@ -320,13 +471,14 @@ func TestBitOr(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 | 1", 1, 1, 1},
 		{"8 | 1", 8, 1, 9},
 		{"3 | 1", 3, 1, 3},
 		{"1024 | 0", 1024, 0, 1024},
 		{"0 | 1024", 0, 1024, 1024},
+		{"-1 | 0", -1, 0, 18446744073709551615},
 	}
 
 	for _, tt := range testCases {
@@ -346,13 +498,13 @@ func TestBitXor(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 ^ 1", 1, 1, 0},
 		{"8 ^ 1", 8, 1, 9},
 		{"3 ^ 1", 3, 1, 2},
 		{"1024 ^ 0", 1024, 0, 1024},
-		{"0 ^ -1024", 0, -1024, -1024},
+		{"0 ^ -1024", 0, -1024, 18446744073709550592},
 	}
 
 	for _, tt := range testCases {
@@ -398,26 +550,55 @@ func TestIntDiv(t *testing.T) {
 			}
 		})
 	}
+
+	var nonIntTestCases = []struct {
+		name        string
+		left, right interface{}
+		lt, rt      sql.Type
+		expected    interface{}
+	}{
+		{"7.5 div 2", 7.5, int64(2), sql.Float64, sql.Int64, int64(3)},
+		{"-7.5 div 2", -7.5, int64(2), sql.Float64, sql.Int64, int64(-3)},
+		{"7 div 2.5", int64(7), "2.5", sql.Int64, sql.MustCreateDecimalType(10, 1), int64(2)},
+		{"7.5 div 0", 7.5, int64(0), sql.Float64, sql.Int64, sql.Null},
+	}
+	for _, tt := range nonIntTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			result, err := NewIntDiv(
+				NewLiteral(tt.left, tt.lt),
+				NewLiteral(tt.right, tt.rt),
+			).Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
 }
 
 func TestMod(t *testing.T) {
 	var testCases = []struct {
 		name        string
-		left, right int64
-		expected    int64
+		left, right interface{}
+		lt, rt      sql.Type
+		expected    interface{}
 	}{
-		{"1 % 1", 1, 1, 0},
-		{"8 % 3", 8, 3, 2},
-		{"1 % 3", 1, 3, 1},
-		{"0 % -1024", 0, -1024, 0},
+		{"1 % 1", int64(1), int64(1), sql.Int64, sql.Int64, int64(0)},
+		{"8 % 3", int64(8), int64(3), sql.Int64, sql.Int64, int64(2)},
+		{"1 % 3", int64(1), int64(3), sql.Int64, sql.Int64, int64(1)},
+		{"0 % -1024", int64(0), int64(-1024), sql.Int64, sql.Int64, int64(0)},
+		{"1 % 0", int64(1), int64(0), sql.Int64, sql.Int64, sql.Null},
+		{"7.5 % 2", 7.5, int64(2), sql.Float64, sql.Int64, 1.5},
+		{"7.5 % 0", 7.5, int64(0), sql.Float64, sql.Int64, sql.Null},
+		{"-7.25 % 2", "-7.25", int64(2), sql.MustCreateDecimalType(10, 2), sql.Int64, "-1.25"},
+		{"7 % 2.5", int64(7), "2.5", sql.Int64, sql.MustCreateDecimalType(10, 1), "2.0"},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
 			result, err := NewMod(
-				NewLiteral(tt.left, sql.Int64),
-				NewLiteral(tt.right, sql.Int64),
+				NewLiteral(tt.left, tt.lt),
+				NewLiteral(tt.right, tt.rt),
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(err)
 			require.Equal(tt.expected, result)
@@ -457,13 +638,13 @@ func TestAllInt64(t *testing.T) {
 	var testCases = []struct {
 		op       string
 		value    int64
-		expected int64
+		expected interface{}
 	}{
-		{"|", 1, 1},
-		{"&", 3, 1},
-		{"^", 1024, 1025},
-		{"%", 1024, 1},
-		{"div", 1024, 0},
+		{"|", 1, uint64(1)},
+		{"&", 3, uint64(1)},
+		{"^", 1024, uint64(1025)},
+		{"%", 1024, int64(1)},
+		{"div", 1024, int64(0)},
 	}
 
 	// (((((0 | 1) & 3) ^ 1024) % 1024) div 1024) == 0
@@ -482,6 +663,62 @@ func TestAllInt64(t *testing.T) {
 	}
 }
 
+func TestBitOperands(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		left, right interface{}
+		lt, rt      sql.Type
+		expected    uint64
+	}{
+		{"3.7 & 7", 3.7, int64(7), sql.Float64, sql.Int64, 4},
+		{"-1.5 & 255", -1.5, int64(255), sql.Float64, sql.Int64, 254},
+		{"'12' & 15", "12", int64(15), sql.LongText, sql.Int64, 12},
+		{"'3.7' & 7", "3.7", int64(7), sql.LongText, sql.Int64, 4},
+		{"'abc' & 7", "abc", int64(7), sql.LongText, sql.Int64, 0},
+		{"'18446744073709551615' & 3", "18446744073709551615", int64(3), sql.LongText, sql.Int64, 3},
+		{"1e30 & 3", 1e30, int64(3), sql.Float64, sql.Int64, 3},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			result, err := NewBitAnd(
+				NewLiteral(tt.left, tt.lt),
+				NewLiteral(tt.right, tt.rt),
+			).Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func TestBitNot(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		value    interface{}
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"~0", int64(0), sql.Int64, uint64(18446744073709551615)},
+		{"~1", int64(1), sql.Int64, uint64(18446744073709551614)},
+		{"~-1", int64(-1), sql.Int64, uint64(0)},
+		{"~18446744073709551615", uint64(18446744073709551615), sql.Uint64, uint64(0)},
+		{"~1.6", 1.6, sql.Float64, uint64(18446744073709551613)},
+		{"~NULL", nil, sql.Null, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			e := NewBitNot(NewLiteral(tt.value, tt.typ))
+			require.Equal(sql.Uint64, e.Type())
+			result, err := e.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
 func TestUnaryMinus(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -16,6 +16,7 @@ package expression
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -25,11 +26,46 @@ type Between struct {
 	Val   sql.Expression
 	Lower sql.Expression
 	Upper sql.Expression
+	// lowerLit and upperLit hold the converted values of literal bounds, which are the same for every row
+	lowerLit *convertedLiteral
+	upperLit *convertedLiteral
 }
 
 // NewBetween creates a new Between expression.
 func NewBetween(val, lower, upper sql.Expression) *Between {
-	return &Between{val, lower, upper}
+	return &Between{
+		Val:      val,
+		Lower:    lower,
+		Upper:    upper,
+		lowerLit: &convertedLiteral{},
+		upperLit: &convertedLiteral{},
+	}
+}
+
+// convertedLiteral caches the value of a literal converted to another type, computed on first use.
+type convertedLiteral struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// evalBound returns the value of the bound given converted to the type given. The conversion of a literal bound is
+// cached in the convertedLiteral given, if any.
+func evalBound(ctx *sql.Context, row sql.Row, bound sql.Expression, typ sql.Type, cache *convertedLiteral) (interface{}, error) {
+	if lit, ok := bound.(*Literal); ok && cache != nil {
+		cache.once.Do(func() {
+			if lit.Value() != nil {
+				cache.value, cache.err = typ.Convert(lit.Value())
+			}
+		})
+		return cache.value, cache.err
+	}
+
+	v, err := bound.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return typ.Convert(v)
 }
 
 func (b *Between) String() string {
@@ -76,7 +112,7 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	lower, err := b.Lower.Eval(ctx, row)
+	lower, err := evalBound(ctx, row, b.Lower, typ, b.lowerLit)
 	if err != nil {
 		return nil, err
 	}
@@ -85,12 +121,7 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	lower, err = typ.Convert(lower)
-	if err != nil {
-		return nil, err
-	}
-
-	upper, err := b.Upper.Eval(ctx, row)
+	upper, err := evalBound(ctx, row, b.Upper, typ, b.upperLit)
 	if err != nil {
 		return nil, err
 	}
@@ -99,11 +130,6 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	upper, err = typ.Convert(upper)
-	if err != nil {
-		return nil, err
-	}
-
 	cmpLower, err := typ.Compare(val, lower)
 	if err != nil {
 		return nil, err
@@ -16,6 +16,7 @@ package expression
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -61,6 +62,46 @@ func TestBetween(t *testing.T) {
 	}
 }
 
+func TestBetweenLiteralBounds(t *testing.T) {
+	b := NewBetween(
+		NewGetField(0, sql.Datetime, "val", true),
+		NewLiteral("2021-01-01", sql.LongText),
+		NewLiteral("2021-02-01 12:00:00", sql.LongText),
+	)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"val is null", sql.NewRow(nil), nil},
+		{"val is lower", sql.NewRow(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), true},
+		{"val is between lower and upper", sql.NewRow(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)), true},
+		{"val is less than lower", sql.NewRow(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)), false},
+		{"val is more than upper", sql.NewRow(time.Date(2021, 2, 1, 12, 0, 1, 0, time.UTC)), false},
+	}
+
+	// Evaluate every case twice, so that the cached bounds are exercised
+	for i := 0; i < 2; i++ {
+		for _, tt := range testCases {
+			t.Run(tt.name, func(t *testing.T) {
+				require := require.New(t)
+				result, err := b.Eval(sql.NewEmptyContext(), tt.row)
+				require.NoError(err)
+				require.Equal(tt.expected, result)
+			})
+		}
+	}
+
+	bad := NewBetween(
+		NewGetField(0, sql.Int64, "val", true),
+		NewLiteral("lower", sql.LongText),
+		NewLiteral(3, sql.Int64),
+	)
+	_, err := bad.Eval(sql.NewEmptyContext(), sql.NewRow(1))
+	require.Error(t, err)
+}
+
 func TestBetweenIsNullable(t *testing.T) {
 	testCases := []struct {
 		name     string
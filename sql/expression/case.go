@@ -68,11 +68,11 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 			return sql.Float32
 		}
 		if sql.IsDecimal(left) || sql.IsDecimal(right) {
-			return sql.MustCreateDecimalType(65, 10)
+			return combinedDecimalType(left, right)
 		}
 		if left == sql.Uint64 && sql.IsSigned(right) ||
 			right == sql.Uint64 && sql.IsSigned(left) {
-			return sql.MustCreateDecimalType(65, 10)
+			return combinedDecimalType(left, right)
 		}
 		if !sql.IsSigned(left) && !sql.IsSigned(right) {
 			return sql.Uint64
@@ -83,6 +83,48 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 	return sql.LongText
 }
 
+// combinedDecimalType returns the decimal type that holds the values of both the integer or decimal types given: it has
+// the larger scale of the two, and room for the larger number of integral digits of the two.
+func combinedDecimalType(left, right sql.Type) sql.Type {
+	leftDigits, leftScale := decimalDigits(left)
+	rightDigits, rightScale := decimalDigits(right)
+	if rightDigits > leftDigits {
+		leftDigits = rightDigits
+	}
+	if rightScale > leftScale {
+		leftScale = rightScale
+	}
+
+	precision := leftDigits + leftScale
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	return sql.MustCreateDecimalType(precision, leftScale)
+}
+
+// decimalDigits returns the number of integral digits and the scale of the values of the integer or decimal type given.
+func decimalDigits(t sql.Type) (uint8, uint8) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return dt.Precision() - dt.Scale(), dt.Scale()
+	}
+	switch t {
+	case sql.Int8, sql.Uint8:
+		return 3, 0
+	case sql.Int16, sql.Uint16:
+		return 5, 0
+	case sql.Int24:
+		return 7, 0
+	case sql.Uint24:
+		return 8, 0
+	case sql.Int32, sql.Uint32:
+		return 10, 0
+	case sql.Int64:
+		return 19, 0
+	default:
+		return 20, 0
+	}
+}
+
 // Type implements the sql.Expression interface.
 func (c *Case) Type() sql.Type {
 	curr := sql.Null
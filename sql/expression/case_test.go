@@ -215,7 +215,7 @@ func TestCaseType(t *testing.T) {
 		{
 			"uint64 and int8 to decimal",
 			caseExpr(NewLiteral(uint64(10), sql.Uint64), NewLiteral(int8(0), sql.Int8)),
-			decimalType,
+			sql.MustCreateDecimalType(20, 0),
 		},
 		{
 			"int and text to text",
@@ -232,6 +232,16 @@ func TestCaseType(t *testing.T) {
 			caseExpr(NewLiteral(int32(10), sql.Int32), NewLiteral(decimal.NewFromInt(1), decimalType)),
 			decimalType,
 		},
+		{
+			"decimal and int8 to decimal of the decimal's scale",
+			caseExpr(NewLiteral(decimal.RequireFromString("2.5"), sql.MustCreateDecimalType(2, 1)), NewLiteral(int8(1), sql.Int8)),
+			sql.MustCreateDecimalType(4, 1),
+		},
+		{
+			"decimals to decimal of the larger scale and integral digits",
+			caseExpr(NewLiteral(decimal.RequireFromString("123.4"), sql.MustCreateDecimalType(4, 1)), NewLiteral(decimal.RequireFromString("0.25"), sql.MustCreateDecimalType(3, 2))),
+			sql.MustCreateDecimalType(5, 2),
+		},
 		{
 			"date and date stays date",
 			caseExpr(NewLiteral("2020-04-07", sql.Date), NewLiteral("2020-04-07", sql.Date)),
@@ -0,0 +1,56 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// ResolveCollation returns the collation two strings are compared with. As in MySQL, binary strings are compared byte
+// by byte, and otherwise the collation of a column or variable takes precedence over the one of other expressions.
+// The default collation is used when neither expression is a string.
+func ResolveCollation(left, right sql.Expression) sql.Collation {
+	leftCollation, leftOk := expressionCollation(left)
+	rightCollation, rightOk := expressionCollation(right)
+	switch {
+	case leftOk && leftCollation.Equals(sql.Collation_binary), rightOk && rightCollation.Equals(sql.Collation_binary):
+		return sql.Collation_binary
+	case leftOk && isColumnOrVariable(left):
+		return leftCollation
+	case rightOk && isColumnOrVariable(right):
+		return rightCollation
+	case leftOk:
+		return leftCollation
+	case rightOk:
+		return rightCollation
+	default:
+		return sql.Collation_Default
+	}
+}
+
+// expressionCollation returns the collation of the values of the expression given, if they're strings.
+func expressionCollation(e sql.Expression) (sql.Collation, bool) {
+	if st, ok := e.Type().(sql.StringType); ok {
+		return st.Collation(), true
+	}
+	return sql.Collation{}, false
+}
+
+func isColumnOrVariable(e sql.Expression) bool {
+	switch e.(type) {
+	case *GetField, *UserVar, *SystemVar, *ProcedureParam:
+		return true
+	default:
+		return false
+	}
+}
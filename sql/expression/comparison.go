@@ -16,6 +16,7 @@ package expression
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -39,59 +40,157 @@ var ErrNilOperand = errors.NewKind("nil operand found in comparison")
 
 type comparison struct {
 	BinaryExpression
+	// cmp holds the function used to compare non-NULL operand values. The choice of function depends only on the
+	// operand types, so it's made once, the first time the comparison is evaluated, rather than for every row.
+	cmp *compareFuncOnce
+}
+
+type compareFuncOnce struct {
+	once sync.Once
+	fn   sql.CompareFunc
+	// tupleOnce and tupleFns are the same for the elements of row value operands.
+	tupleOnce sync.Once
+	tupleFns  []sql.CompareFunc
 }
 
 func newComparison(left, right sql.Expression) comparison {
-	return comparison{BinaryExpression{left, right}}
+	return comparison{BinaryExpression: BinaryExpression{left, right}, cmp: &compareFuncOnce{}}
 }
 
 // Compare the two given values using the types of the expressions in the comparison.
 // Since both types should be equal, it does not matter which type is used, but for
 // reference, the left type is always used.
 func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
-	left, right, err := c.evalLeftAndRight(ctx, row)
+	result, isNull, err := c.compareNullable(ctx, row)
 	if err != nil {
 		return 0, err
 	}
 
-	if left == nil || right == nil {
+	if isNull {
 		return 0, ErrNilOperand.New()
 	}
 
-	if sql.TypesEqual(c.Left().Type(), c.Right().Type()) {
-		return c.Left().Type().Compare(left, right)
+	return result, nil
+}
+
+// compareNullable is like Compare, but reports a NULL operand with isNull rather than with an ErrNilOperand, which
+// is costly to create for every row. The right operand isn't evaluated when the left one is NULL.
+func (c *comparison) compareNullable(ctx *sql.Context, row sql.Row) (result int, isNull bool, err error) {
+	left, err := c.Left().Eval(ctx, row)
+	if err != nil {
+		return 0, false, err
+	}
+	if left == nil {
+		return 0, true, nil
 	}
 
-	// ENUM and SET must be considered when doing comparisons, as they can match arbitrary strings to numbers based on
-	// their elements. For other types it seems there are other considerations, therefore we only take the type for
-	// ENUM and SET, and default to direct literal comparisons for all other types. Eventually we will need to make our
-	// comparisons context-sensitive, as all comparisons should probably be based on the column/variable if present.
-	// Until then, this is a workaround specifically for ENUM and SET.
-	var compareType sql.Type
-	switch c.Left().(type) {
-	case *GetField, *UserVar, *SystemVar, *ProcedureParam:
-		compareType = c.Left().Type()
-	default:
-		switch c.Right().(type) {
-		case *GetField, *UserVar, *SystemVar, *ProcedureParam:
-			compareType = c.Right().Type()
-		}
+	right, err := c.Right().Eval(ctx, row)
+	if err != nil {
+		return 0, false, err
 	}
-	if compareType != nil {
-		_, isEnum := compareType.(sql.EnumType)
-		_, isSet := compareType.(sql.SetType)
-		if !isEnum && !isSet {
-			compareType = nil
+	if right == nil {
+		return 0, true, nil
+	}
+
+	if sql.IsTuple(c.Left().Type()) && sql.IsTuple(c.Right().Type()) {
+		return c.compareTuples(left.([]interface{}), right.([]interface{}))
+	}
+
+	left, right = c.numericOperands(ctx, left, right)
+	result, err = c.compareFunc()(left, right)
+	return result, false, err
+}
+
+// equalNullable is like compareNullable, but only tells whether the operands are equal, which for row value operands
+// isn't decided by their order: see sql.EqualTuples.
+func (c *comparison) equalNullable(ctx *sql.Context, row sql.Row) (equal bool, isNull bool, err error) {
+	if !sql.IsTuple(c.Left().Type()) || !sql.IsTuple(c.Right().Type()) {
+		result, isNull, err := c.compareNullable(ctx, row)
+		return result == 0, isNull, err
+	}
+
+	left, err := c.Left().Eval(ctx, row)
+	if err != nil {
+		return false, false, err
+	}
+	if left == nil {
+		return false, true, nil
+	}
+
+	right, err := c.Right().Eval(ctx, row)
+	if err != nil {
+		return false, false, err
+	}
+	if right == nil {
+		return false, true, nil
+	}
+
+	return sql.EqualTuples(left.([]interface{}), right.([]interface{}), c.tupleCompareFuncs())
+}
+
+// compareTuples compares the values of row value operands element by element, e.g. (a, b) < (1, 2) is a < 1 OR
+// (a = 1 AND b < 2). As in MySQL, the result is NULL if a NULL element is reached before the order is decided.
+func (c *comparison) compareTuples(left, right []interface{}) (result int, isNull bool, err error) {
+	if len(left) != len(right) {
+		return 0, false, sql.ErrInvalidOperandColumns.New(len(left), len(right))
+	}
+
+	fns := c.tupleCompareFuncs()
+	for i := range left {
+		if left[i] == nil || right[i] == nil {
+			return 0, true, nil
+		}
+		result, err = fns[i](left[i], right[i])
+		if err != nil || result != 0 {
+			return result, false, err
 		}
 	}
-	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
-		if err != nil {
-			return 0, err
+	return 0, false, nil
+}
+
+// numericOperands returns the operand values given as they're compared when strings are compared with numbers, which
+// warns about strings that aren't entirely numbers.
+func (c *comparison) numericOperands(ctx *sql.Context, left, right interface{}) (interface{}, interface{}) {
+	_, lok := left.(string)
+	_, rok := right.(string)
+	if !lok && !rok {
+		return left, right
+	}
+	lt, rt := c.Left().Type(), c.Right().Type()
+	if t := sql.ComparisonType(lt, rt); t != sql.Int64 && t != sql.Uint64 && t != sql.Float64 && !sql.IsDecimal(t) {
+		return left, right
+	}
+	return numericOperand(ctx, lt, left), numericOperand(ctx, rt, right)
+}
+
+// tupleCompareFuncs returns the functions used to compare the elements of row value operands, choosing them on first
+// use.
+func (c *comparison) tupleCompareFuncs() []sql.CompareFunc {
+	if c.cmp == nil {
+		return c.resolveTupleCompareFuncs()
+	}
+	c.cmp.tupleOnce.Do(func() {
+		c.cmp.tupleFns = c.resolveTupleCompareFuncs()
+	})
+	return c.cmp.tupleFns
+}
+
+// resolveTupleCompareFuncs returns functions that compare the elements of row value operands. Elements of tuple
+// expressions are compared like the operands of a comparison of their own, others with the comparison types of the
+// element types.
+func (c *comparison) resolveTupleCompareFuncs() []sql.CompareFunc {
+	lt, lok := c.Left().(Tuple)
+	rt, rok := c.Right().(Tuple)
+	if lok && rok && len(lt) == len(rt) {
+		fns := make([]sql.CompareFunc, len(lt))
+		for i := range lt {
+			elem := newComparison(lt[i], rt[i])
+			fns[i] = elem.resolveCompareFunc()
 		}
+		return fns
 	}
 
-	return compareType.Compare(left, right)
+	return sql.TupleCompareFuncs(c.Left().Type().(sql.TupleType), c.Right().Type().(sql.TupleType))
 }
 
 // NullSafeCompare the two given values using the types of the expressions in the comparison.
@@ -111,8 +210,27 @@ func (c *comparison) NullSafeCompare(ctx *sql.Context, row sql.Row) (int, error)
 		return -1, nil
 	}
 
-	if sql.TypesEqual(c.Left().Type(), c.Right().Type()) {
-		return c.Left().Type().Compare(left, right)
+	left, right = c.numericOperands(ctx, left, right)
+	return c.compareFunc()(left, right)
+}
+
+// compareFunc returns the function used to compare non-NULL operand values, choosing it on first use.
+func (c *comparison) compareFunc() sql.CompareFunc {
+	if c.cmp == nil {
+		return c.resolveCompareFunc()
+	}
+	c.cmp.once.Do(func() {
+		c.cmp.fn = c.resolveCompareFunc()
+	})
+	return c.cmp.fn
+}
+
+// resolveCompareFunc returns a function that compares non-NULL operand values according to the operand types.
+func (c *comparison) resolveCompareFunc() sql.CompareFunc {
+	leftType := c.Left().Type()
+	rightType := c.Right().Type()
+	if sql.TypesEqual(leftType, rightType) {
+		return sql.CompareFuncForType(leftType)
 	}
 
 	// ENUM and SET must be considered when doing comparisons, as they can match arbitrary strings to numbers based on
@@ -123,121 +241,73 @@ func (c *comparison) NullSafeCompare(ctx *sql.Context, row sql.Row) (int, error)
 	var compareType sql.Type
 	switch c.Left().(type) {
 	case *GetField, *UserVar, *SystemVar, *ProcedureParam:
-		compareType = c.Left().Type()
+		compareType = leftType
 	default:
 		switch c.Right().(type) {
 		case *GetField, *UserVar, *SystemVar, *ProcedureParam:
-			compareType = c.Right().Type()
+			compareType = rightType
 		}
 	}
 	if compareType != nil {
 		_, isEnum := compareType.(sql.EnumType)
 		_, isSet := compareType.(sql.SetType)
-		if !isEnum && !isSet {
-			compareType = nil
+		if isEnum || isSet {
+			return compareType.Compare
 		}
-	}
-	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
-		if err != nil {
-			return 0, err
+		// Strings are compared with the collation of the column or variable, rather than the one of the other operand
+		if st, ok := compareType.(sql.StringType); ok && sql.IsText(leftType) && sql.IsText(rightType) {
+			return sql.CompareFuncForType(sql.CreateLongText(st.Collation()))
 		}
 	}
 
-	return compareType.Compare(left, right)
-}
-
-func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
-	left, err := c.Left().Eval(ctx, row)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	right, err := c.Right().Eval(ctx, row)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return left, right, nil
-}
-
-func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, sql.Type, error) {
-	leftType := c.Left().Type()
-	rightType := c.Right().Type()
-	if sql.IsTuple(leftType) && sql.IsTuple(rightType) {
-		return left, right, c.Left().Type(), nil
+	compareType = sql.ComparisonType(leftType, rightType)
+	compare := sql.CompareFuncForType(compareType)
+	if sql.IsTuple(compareType) {
+		return compare
 	}
 
-	if sql.IsNumber(leftType) || sql.IsNumber(rightType) {
-		if sql.IsDecimal(leftType) || sql.IsDecimal(rightType) {
-			//TODO: We need to set to the actual DECIMAL type
-			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			if sql.IsDecimal(leftType) {
-				return l, r, leftType, nil
-			} else {
-				return l, r, rightType, nil
+	// A literal operand has the same converted value for every row, so convert it just once here. Literals that
+	// can't be converted are left to the per-row conversion below.
+	if lit, ok := c.Right().(*Literal); ok {
+		if r, err := sql.ConvertForComparison(lit.Value(), compareType); err == nil && r != nil {
+			return func(left, _ interface{}) (int, error) {
+				l, err := sql.ConvertForComparison(left, compareType)
+				if err != nil {
+					return 0, err
+				}
+				return compare(l, r)
 			}
 		}
-
-		if sql.IsFloat(leftType) || sql.IsFloat(rightType) {
-			l, r, err := convertLeftAndRight(left, right, ConvertToDouble)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			return l, r, sql.Float64, nil
-		}
-
-		if sql.IsSigned(leftType) || sql.IsSigned(rightType) {
-			l, r, err := convertLeftAndRight(left, right, ConvertToSigned)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			return l, r, sql.Int64, nil
-		}
-
-		l, r, err := convertLeftAndRight(left, right, ConvertToUnsigned)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		return l, r, sql.Uint64, nil
 	}
-
-	if sql.IsTime(leftType) || sql.IsTime(rightType) {
-		l, r, err := convertLeftAndRight(left, right, ConvertToDatetime)
-		if err != nil {
-			return nil, nil, nil, err
+	if lit, ok := c.Left().(*Literal); ok {
+		if l, err := sql.ConvertForComparison(lit.Value(), compareType); err == nil && l != nil {
+			return func(_, right interface{}) (int, error) {
+				r, err := sql.ConvertForComparison(right, compareType)
+				if err != nil {
+					return 0, err
+				}
+				return compare(l, r)
+			}
 		}
-
-		return l, r, sql.Datetime, nil
 	}
 
-	left, right, err := convertLeftAndRight(left, right, ConvertToChar)
-	if err != nil {
-		return nil, nil, nil, err
+	return func(left, right interface{}) (int, error) {
+		return sql.Compare(left, right, compareType)
 	}
-
-	return left, right, sql.LongText, nil
 }
 
-func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
-	l, err := convertValue(left, convertTo)
+func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
+	left, err := c.Left().Eval(ctx, row)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	r, err := convertValue(right, convertTo)
+	right, err := c.Right().Eval(ctx, row)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return l, r, nil
+	return left, right, nil
 }
 
 // Type implements the Expression interface.
@@ -263,16 +333,15 @@ func NewEquals(left sql.Expression, right sql.Expression) *Equals {
 
 // Eval implements the Expression interface.
 func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := e.Compare(ctx, row)
+	equal, isNull, err := e.equalNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
-	return result == 0, nil
+	return equal, nil
 }
 
 // WithChildren implements the Expression interface.
@@ -308,36 +377,15 @@ func (e *NullSafeEquals) Type() sql.Type {
 	return sql.Int8
 }
 
+// Compare implements the Comparer interface. Unlike other comparisons, NULL operands are ordered rather than
+// reported as an error.
 func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
-	left, right, err := e.evalLeftAndRight(ctx, row)
-	if err != nil {
-		return 0, err
-	}
-
-	if left == nil && right == nil {
-		return 0, nil
-	} else if left == nil {
-		return 1, nil
-	} else if right == nil {
-		return -1, nil
-	}
-
-	if sql.TypesEqual(e.Left().Type(), e.Right().Type()) {
-		return e.Left().Type().Compare(left, right)
-	}
-
-	var compareType sql.Type
-	left, right, compareType, err = e.castLeftAndRight(left, right)
-	if err != nil {
-		return 0, err
-	}
-
-	return compareType.Compare(left, right)
+	return e.NullSafeCompare(ctx, row)
 }
 
 // Eval implements the Expression interface.
 func (e *NullSafeEquals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := e.Compare(ctx, row)
+	result, err := e.NullSafeCompare(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -396,14 +444,13 @@ func (re *Regexp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return re.compareRegexp(ctx, row)
 	}
 
-	result, err := re.Compare(ctx, row)
+	result, isNull, err := re.compareNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
 	return result == 0, nil
 }
@@ -430,16 +477,17 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 		if rerr != nil || right == nil {
 			return right, rerr
 		}
-		matcher, err = regex.NewDisposableMatcher(regex.Default(), *right)
+		matcher, err = regex.NewDisposableMatcher(regexpEngine(ctx), *right)
 	} else {
 		re.once.Do(func() {
 			right, err := re.evalRight(ctx, row)
+			engine := regexpEngine(ctx)
 			re.pool = &sync.Pool{
 				New: func() interface{} {
 					if err != nil || right == nil {
 						return matcherErrTuple{nil, err}
 					}
-					m, e := regex.NewDisposableMatcher(regex.Default(), *right)
+					m, e := regex.NewDisposableMatcher(engine, *right)
 					return matcherErrTuple{m, e}
 				},
 			}
@@ -454,16 +502,53 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 		return nil, nil
 	}
 
-	ok := matcher.Match(left.(string))
+	var ok bool
+	if cm, isContextMatcher := matcher.(regex.ContextMatcher); isContextMatcher {
+		ok, err = cm.MatchContext(ctx, left.(string), regexpLimits())
+	} else {
+		ok = matcher.Match(left.(string))
+	}
 
 	if !re.cached {
 		matcher.Dispose()
 	} else {
 		re.pool.Put(matcherErrTuple{matcher, nil})
 	}
+	if err != nil {
+		return nil, err
+	}
 	return ok, nil
 }
 
+// regexpLimits returns the limits of the matches of REGEXP, which are set with the regexp_stack_limit and
+// regexp_time_limit system variables.
+func regexpLimits() regex.Limits {
+	limits := regex.DefaultICULimits
+	if _, val, ok := sql.SystemVariables.GetGlobal("regexp_stack_limit"); ok {
+		if stack, ok := val.(int64); ok {
+			limits.Stack = stack
+		}
+	}
+	if _, val, ok := sql.SystemVariables.GetGlobal("regexp_time_limit"); ok {
+		if time, ok := val.(int64); ok {
+			limits.Time = time
+		}
+	}
+	return limits
+}
+
+// regexpEngine returns the regex engine REGEXP uses in the session, which is set with the regexp_engine system
+// variable: "icu" for the engine following the syntax of MySQL's, or "go" for the default one, which is faster but
+// doesn't support lookarounds nor backreferences.
+func regexpEngine(ctx *sql.Context) string {
+	if val, err := ctx.GetSessionVariable(ctx, "regexp_engine"); err == nil {
+		if engine, ok := val.(string); ok && strings.EqualFold(engine, "icu") {
+			return "icu"
+		}
+	}
+	return regex.Default()
+}
+
 func (re *Regexp) evalRight(ctx *sql.Context, row sql.Row) (*string, error) {
 	right, err := re.Right().Eval(ctx, row)
 	if err != nil {
@@ -508,14 +593,13 @@ func NewGreaterThan(left sql.Expression, right sql.Expression) *GreaterThan {
 
 // Eval implements the Expression interface.
 func (gt *GreaterThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := gt.Compare(ctx, row)
+	result, isNull, err := gt.compareNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
 	return result == 1, nil
 }
@@ -585,14 +669,13 @@ func NewLessThan(left sql.Expression, right sql.Expression) *LessThan {
 
 // Eval implements the expression interface.
 func (lt *LessThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := lt.Compare(ctx, row)
+	result, isNull, err := lt.compareNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
 	return result == -1, nil
 }
@@ -663,14 +746,13 @@ func NewGreaterThanOrEqual(left sql.Expression, right sql.Expression) *GreaterTh
 
 // Eval implements the Expression interface.
 func (gte *GreaterThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := gte.Compare(ctx, row)
+	result, isNull, err := gte.compareNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
 	return result > -1, nil
 }
@@ -741,14 +823,13 @@ func NewLessThanOrEqual(left sql.Expression, right sql.Expression) *LessThanOrEq
 
 // Eval implements the Expression interface.
 func (lte *LessThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	result, err := lte.Compare(ctx, row)
+	result, isNull, err := lte.compareNullable(ctx, row)
 	if err != nil {
-		if ErrNilOperand.Is(err) {
-			return nil, nil
-		}
-
 		return nil, err
 	}
+	if isNull {
+		return nil, nil
+	}
 
 	return result < 1, nil
 }
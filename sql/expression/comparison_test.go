@@ -15,7 +15,9 @@
 package expression_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -218,6 +220,185 @@ func TestGreaterThan(t *testing.T) {
 	}
 }
 
+func TestComparisonNullLeftOperand(t *testing.T) {
+	require := require.New(t)
+
+	// The right operand panics if evaluated, which it shouldn't be when the left operand is NULL.
+	left := expression.NewLiteral(nil, sql.Null)
+	right := expression.NewUnresolvedColumn("foo")
+
+	for _, e := range []sql.Expression{
+		expression.NewEquals(left, right),
+		expression.NewLessThan(left, right),
+		expression.NewGreaterThan(left, right),
+		expression.NewLessThanOrEqual(left, right),
+		expression.NewGreaterThanOrEqual(left, right),
+	} {
+		var v interface{}
+		var err error
+		require.NotPanics(func() {
+			v, err = e.Eval(sql.NewEmptyContext(), nil)
+		}, e.String())
+		require.NoError(err)
+		require.Nil(v)
+	}
+}
+
+func TestComparisonMixedTypes(t *testing.T) {
+	require := require.New(t)
+
+	eq := expression.NewEquals(
+		expression.NewGetField(0, sql.Int64, "a", true),
+		expression.NewGetField(1, sql.LongText, "b", true),
+	)
+
+	for _, tt := range []struct {
+		row      sql.Row
+		expected interface{}
+	}{
+		{sql.NewRow(int64(1), "1"), true},
+		{sql.NewRow(int64(2), "1"), false},
+		{sql.NewRow(int64(2), "2.0"), true},
+		{sql.NewRow(int64(2), "2.5"), false},
+		{sql.NewRow(int64(1), "1a"), true},
+		{sql.NewRow(int64(0), "abc"), true},
+		{sql.NewRow(int64(1), "abc"), false},
+		{sql.NewRow(nil, "2"), nil},
+		{sql.NewRow(int64(2), nil), nil},
+	} {
+		v, err := eq.Eval(sql.NewEmptyContext(), tt.row)
+		require.NoError(err)
+		require.Equal(tt.expected, v, "%v", tt.row)
+	}
+
+	// Strings that aren't entirely numbers are compared by their leading number, with a warning
+	ctx := sql.NewEmptyContext()
+	v, err := eq.Eval(ctx, sql.NewRow(int64(1), "1a"))
+	require.NoError(err)
+	require.Equal(true, v)
+	require.Equal(uint16(1), ctx.WarningCount())
+	require.Equal("Truncated incorrect DOUBLE value: '1a'", ctx.Warnings()[0].Message)
+}
+
+func TestComparisonNumericCoercion(t *testing.T) {
+	require := require.New(t)
+
+	dec := sql.MustCreateDecimalType(10, 2)
+	tests := []struct {
+		e        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{
+			expression.NewLessThan(expression.NewGetField(0, sql.Int64, "a", false), expression.NewGetField(1, sql.Uint64, "b", false)),
+			sql.NewRow(int64(-1), uint64(math.MaxUint64)),
+			true,
+		},
+		{
+			expression.NewEquals(expression.NewGetField(0, sql.Int64, "a", false), expression.NewGetField(1, sql.Uint64, "b", false)),
+			sql.NewRow(int64(-1), uint64(math.MaxUint64)),
+			false,
+		},
+		{
+			expression.NewGreaterThan(expression.NewGetField(0, sql.Uint64, "a", false), expression.NewGetField(1, sql.Int8, "b", false)),
+			sql.NewRow(uint64(0), int8(-5)),
+			true,
+		},
+		{
+			expression.NewEquals(expression.NewGetField(0, dec, "a", false), expression.NewGetField(1, sql.Int64, "b", false)),
+			sql.NewRow("3.00", int64(3)),
+			true,
+		},
+		{
+			expression.NewLessThan(expression.NewGetField(0, dec, "a", false), expression.NewGetField(1, sql.Int64, "b", false)),
+			sql.NewRow("2.99", int64(3)),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		v, err := tt.e.Eval(sql.NewEmptyContext(), tt.row)
+		require.NoError(err)
+		require.Equal(tt.expected, v, "%s %v", tt.e, tt.row)
+	}
+}
+
+func TestComparisonTuples(t *testing.T) {
+	require := require.New(t)
+
+	row := expression.NewTuple(
+		expression.NewGetField(0, sql.Int64, "a", true),
+		expression.NewGetField(1, sql.Int64, "b", true),
+	)
+	lit := func(a, b interface{}) sql.Expression {
+		return expression.NewTuple(expression.NewLiteral(a, sql.Int64), expression.NewLiteral(b, sql.Int64))
+	}
+
+	tests := []struct {
+		e        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(3)), true},
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(2)), false},
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(2), int64(0)), true},
+		{expression.NewGreaterThanOrEqual(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(2)), true},
+		{expression.NewLessThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(0), int64(9)), true},
+		{expression.NewLessThanOrEqual(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(3)), false},
+		{expression.NewEquals(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(2)), true},
+		{expression.NewEquals(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), int64(3)), false},
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(2), nil), true},
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(int64(1), nil), nil},
+		{expression.NewGreaterThan(row, lit(int64(1), int64(2))), sql.NewRow(nil, int64(3)), nil},
+	}
+
+	for _, tt := range tests {
+		v, err := tt.e.Eval(sql.NewEmptyContext(), tt.row)
+		require.NoError(err)
+		require.Equal(tt.expected, v, "%s %v", tt.e, tt.row)
+	}
+
+	_, err := expression.NewEquals(row, expression.NewTuple(
+		expression.NewLiteral(int64(1), sql.Int64),
+		expression.NewLiteral(int64(2), sql.Int64),
+		expression.NewLiteral(int64(3), sql.Int64),
+	)).Eval(sql.NewEmptyContext(), sql.NewRow(int64(1), int64(2)))
+	require.Error(err)
+}
+
+func TestComparisonLiteralConversion(t *testing.T) {
+	require := require.New(t)
+
+	col := expression.NewGetField(0, sql.Timestamp, "ts", true)
+	lit := expression.NewLiteral("2021-06-01 10:00:00", sql.LongText)
+	badLit := expression.NewLiteral("not a date", sql.LongText)
+
+	before := sql.NewRow(time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC))
+	equal := sql.NewRow(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		e        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{expression.NewEquals(col, lit), equal, true},
+		{expression.NewEquals(col, lit), before, false},
+		{expression.NewLessThan(col, lit), before, true},
+		{expression.NewLessThan(lit, col), before, false},
+		{expression.NewGreaterThan(lit, col), before, true},
+		{expression.NewEquals(col, badLit), equal, false},
+	}
+
+	for _, tt := range tests {
+		// Evaluate twice, so that the literal's cached conversion is exercised
+		for i := 0; i < 2; i++ {
+			v, err := tt.e.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(err)
+			require.Equal(tt.expected, v, tt.e.String())
+		}
+	}
+}
+
 func TestRegexp(t *testing.T) {
 	for _, engine := range regex.Engines() {
 		regex.SetDefault(engine)
@@ -19,6 +19,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -59,6 +61,11 @@ type Convert struct {
 	UnaryExpression
 	// Type to cast
 	castToType string
+	// typeLength is the length given to a BINARY(n) or CHAR(n) cast, or the precision given to a DECIMAL(m,d) cast,
+	// or 0 if none was given.
+	typeLength int
+	// typeScale is the scale given to a DECIMAL(m,d) cast.
+	typeScale int
 }
 
 // NewConvert creates a new Convert expression.
@@ -69,6 +76,29 @@ func NewConvert(expr sql.Expression, castToType string) *Convert {
 	}
 }
 
+// NewConvertWithLength creates a new Convert expression to a BINARY(n) or CHAR(n) type of the length given. Results
+// of a BINARY(n) cast are padded with zero bytes to the length given, and results of both casts longer than it are
+// truncated with a warning.
+func NewConvertWithLength(expr sql.Expression, castToType string, typeLength int) *Convert {
+	c := NewConvert(expr, castToType)
+	switch c.castToType {
+	case ConvertToBinary, ConvertToChar, ConvertToNChar:
+		c.typeLength = typeLength
+	}
+	return c
+}
+
+// NewConvertWithLengthAndScale creates a new Convert expression to a DECIMAL(m,d) type of the precision and scale
+// given.
+func NewConvertWithLengthAndScale(expr sql.Expression, castToType string, typeLength, typeScale int) *Convert {
+	c := NewConvertWithLength(expr, castToType, typeLength)
+	if c.castToType == ConvertToDecimal {
+		c.typeLength = typeLength
+		c.typeScale = typeScale
+	}
+	return c
+}
+
 // IsNullable implements the Expression interface.
 func (c *Convert) IsNullable() bool {
 	switch c.castToType {
@@ -83,14 +113,29 @@ func (c *Convert) IsNullable() bool {
 func (c *Convert) Type() sql.Type {
 	switch c.castToType {
 	case ConvertToBinary:
+		if c.typeLength > 0 {
+			if t, err := sql.CreateBinary(sqltypes.Binary, int64(c.typeLength)); err == nil {
+				return t
+			}
+		}
 		return sql.LongBlob
 	case ConvertToChar, ConvertToNChar:
+		if c.typeLength > 0 {
+			if t, err := sql.CreateStringWithDefaults(sqltypes.VarChar, int64(c.typeLength)); err == nil {
+				return t
+			}
+		}
 		return sql.LongText
 	case ConvertToDate:
 		return sql.Date
 	case ConvertToDatetime:
 		return sql.Datetime
 	case ConvertToDecimal:
+		if c.typeLength > 0 {
+			if t, err := sql.CreateDecimalType(uint8(c.typeLength), uint8(c.typeScale)); err == nil {
+				return t
+			}
+		}
 		//TODO: these values are completely arbitrary, we need to get the given precision/scale and store it
 		return sql.MustCreateDecimalType(65, 10)
 	case ConvertToDouble, ConvertToReal:
@@ -110,6 +155,12 @@ func (c *Convert) Type() sql.Type {
 
 // Name implements the Expression interface.
 func (c *Convert) String() string {
+	if c.castToType == ConvertToDecimal && c.typeLength > 0 {
+		return fmt.Sprintf("convert(%v, %v(%d,%d))", c.Child, c.castToType, c.typeLength, c.typeScale)
+	}
+	if c.typeLength > 0 {
+		return fmt.Sprintf("convert(%v, %v(%d))", c.Child, c.castToType, c.typeLength)
+	}
 	return fmt.Sprintf("convert(%v, %v)", c.Child, c.castToType)
 }
 
@@ -118,7 +169,7 @@ func (c *Convert) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvert(children[0], c.castToType), nil
+	return NewConvertWithLengthAndScale(children[0], c.castToType, c.typeLength, c.typeScale), nil
 }
 
 // Eval implements the Expression interface.
@@ -137,9 +188,40 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
 	}
 
+	if c.castToType == ConvertToDecimal && c.typeLength > 0 {
+		d, err := c.Type().Convert(casted)
+		if err != nil {
+			return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
+		}
+		return d, nil
+	}
+	if c.typeLength > 0 && casted != nil {
+		return c.applyLength(ctx, casted.(string)), nil
+	}
 	return casted, nil
 }
 
+// applyLength truncates or pads the result of a BINARY(n) or CHAR(n) cast to the length of the cast.
+func (c *Convert) applyLength(ctx *sql.Context, s string) string {
+	if c.castToType == ConvertToBinary {
+		if len(s) > c.typeLength {
+			ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect BINARY(%d) value: '%s'", c.typeLength, s)
+			return s[:c.typeLength]
+		}
+		return s + strings.Repeat("\x00", c.typeLength-len(s))
+	}
+
+	chars := 0
+	for i := range s {
+		if chars == c.typeLength {
+			ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect CHAR(%d) value: '%s'", c.typeLength, s)
+			return s[:i]
+		}
+		chars++
+	}
+	return s
+}
+
 // convertValue only returns an error if converting to JSON, and returns the zero value for float types.
 // Nil is returned in all other cases.
 func convertValue(val interface{}, castTo string) (interface{}, error) {
@@ -185,3 +185,67 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertWithLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		castTo   string
+		length   int
+		expected interface{}
+		warnings int
+	}{
+		{"binary is padded", "ab", ConvertToBinary, 4, "ab\x00\x00", 0},
+		{"binary of exact length", "abcd", ConvertToBinary, 4, "abcd", 0},
+		{"binary is truncated", "abcdef", ConvertToBinary, 4, "abcd", 1},
+		{"binary counts bytes", "ñu", ConvertToBinary, 2, "\xc3\xb1", 1},
+		{"char is not padded", "ab", ConvertToChar, 4, "ab", 0},
+		{"char is truncated", "abcdef", ConvertToChar, 3, "abc", 1},
+		{"char counts characters", "ñuñu", ConvertToChar, 3, "ñuñ", 1},
+		{"nchar is truncated", "abcdef", ConvertToNChar, 3, "abc", 1},
+		{"null", nil, ConvertToBinary, 4, nil, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			convert := NewConvertWithLength(NewLiteral(test.value, sql.LongText), test.castTo, test.length)
+			val, err := convert.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(test.expected, val)
+			require.Equal(test.warnings, len(ctx.Warnings()))
+		})
+	}
+
+	require.Equal(t, `convert("ab", binary(4))`, NewConvertWithLength(NewLiteral("ab", sql.LongText), ConvertToBinary, 4).String())
+}
+
+func TestConvertUsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		charset  sql.CharacterSet
+		expected interface{}
+	}{
+		{"ascii keeps ascii", "abc", sql.CharacterSet_ascii, "abc"},
+		{"ascii replaces others", "ñu€", sql.CharacterSet_ascii, "?u?"},
+		{"latin1 keeps latin1", "ñu€", sql.CharacterSet_latin1, "ñu€"},
+		{"latin1 replaces others", "ñu日", sql.CharacterSet_latin1, "ñu?"},
+		{"utf8mb3 replaces 4 byte characters", "a😀", sql.CharacterSet_utf8mb3, "a?"},
+		{"utf8mb4 keeps everything", "a😀", sql.CharacterSet_utf8mb4, "a😀"},
+		{"number", 42, sql.CharacterSet_latin1, "42"},
+		{"null", nil, sql.CharacterSet_latin1, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			convert := NewConvertUsing(NewLiteral(test.value, sql.LongText), test.charset)
+			val, err := convert.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(test.expected, val)
+			require.Equal(test.charset, convert.Type().(sql.StringType).CharacterSet())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ConvertUsing represents a CONVERT(x USING charset) operation that transcodes the string x to the character set
+// given. Characters the character set can't represent are replaced by '?'.
+type ConvertUsing struct {
+	UnaryExpression
+	charset sql.CharacterSet
+}
+
+var _ sql.Expression = (*ConvertUsing)(nil)
+
+// NewConvertUsing creates a new ConvertUsing expression.
+func NewConvertUsing(expr sql.Expression, charset sql.CharacterSet) *ConvertUsing {
+	return &ConvertUsing{
+		UnaryExpression: UnaryExpression{Child: expr},
+		charset:         charset,
+	}
+}
+
+// CharacterSet returns the character set the child is converted to.
+func (c *ConvertUsing) CharacterSet() sql.CharacterSet {
+	return c.charset
+}
+
+// Type implements the Expression interface.
+func (c *ConvertUsing) Type() sql.Type {
+	return sql.CreateLongText(c.charset.DefaultCollation())
+}
+
+// String implements the Expression interface.
+func (c *ConvertUsing) String() string {
+	return fmt.Sprintf("convert(%v using %v)", c.Child, c.charset)
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (c *ConvertUsing) DebugString() string {
+	return fmt.Sprintf("convert(%v using %v)", sql.DebugString(c.Child), c.charset)
+}
+
+// WithChildren implements the Expression interface.
+func (c *ConvertUsing) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewConvertUsing(children[0], c.charset), nil
+}
+
+// Eval implements the Expression interface.
+func (c *ConvertUsing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, ErrConvertExpression.Wrap(err, c.String(), c.charset)
+	}
+
+	return c.charset.Transcode(val.(string)), nil
+}
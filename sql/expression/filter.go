@@ -0,0 +1,75 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// FilterSupported returns whether the filter given is made only of columns, literals and the kinds of expressions in
+// the capabilities given, so that a table declaring those capabilities can evaluate it.
+func FilterSupported(caps sql.FilterCapabilities, filter sql.Expression) bool {
+	var required sql.FilterCapabilities
+	switch e := filter.(type) {
+	case *GetField, *Literal:
+		return true
+	case *Equals, *NullSafeEquals, *GreaterThan, *NullSafeGreaterThan, *LessThan, *NullSafeLessThan,
+		*GreaterThanOrEqual, *NullSafeGreaterThanOrEqual, *LessThanOrEqual, *NullSafeLessThanOrEqual:
+		required = sql.FilterComparison
+	case *IsNull:
+		required = sql.FilterIsNull
+	case *And:
+		required = sql.FilterAnd
+	case *Or:
+		required = sql.FilterOr
+	case *Not:
+		required = sql.FilterNot
+	case *Like:
+		// The escape character isn't visible to tables, so only the default one can be pushed down.
+		if e.escape != nil {
+			return false
+		}
+		required = sql.FilterLike
+	case *InTuple:
+		return caps.Has(sql.FilterIn) && inListSupported(caps, e.Left(), e.Right())
+	case *HashInTuple:
+		return caps.Has(sql.FilterIn) && inListSupported(caps, e.Left(), e.Right())
+	default:
+		return false
+	}
+
+	if !caps.Has(required) {
+		return false
+	}
+	for _, child := range filter.Children() {
+		if child != nil && !FilterSupported(caps, child) {
+			return false
+		}
+	}
+	return true
+}
+
+func inListSupported(caps sql.FilterCapabilities, left, right sql.Expression) bool {
+	values, ok := right.(Tuple)
+	if !ok || !FilterSupported(caps, left) {
+		return false
+	}
+	for _, value := range values {
+		if !FilterSupported(caps, value) {
+			return false
+		}
+	}
+	return true
+}
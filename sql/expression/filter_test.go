@@ -0,0 +1,57 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestFilterSupported(t *testing.T) {
+	col := NewGetFieldWithTable(0, sql.Int64, "t", "a", true)
+	name := NewGetFieldWithTable(1, sql.LongText, "t", "b", true)
+	one := NewLiteral(int64(1), sql.Int64)
+	two := NewLiteral(int64(2), sql.Int64)
+	comparisons := sql.FilterComparison | sql.FilterAnd
+
+	testCases := []struct {
+		name     string
+		caps     sql.FilterCapabilities
+		filter   sql.Expression
+		expected bool
+	}{
+		{"comparison", sql.FilterComparison, NewEquals(col, one), true},
+		{"comparison without capability", sql.FilterIsNull, NewLessThan(col, one), false},
+		{"and of comparisons", comparisons, NewAnd(NewGreaterThan(col, one), NewLessThan(col, two)), true},
+		{"or without capability", comparisons, NewOr(NewEquals(col, one), NewEquals(col, two)), false},
+		{"or", comparisons | sql.FilterOr, NewOr(NewEquals(col, one), NewEquals(col, two)), true},
+		{"not", sql.FilterNot | sql.FilterIsNull, NewNot(NewIsNull(col)), true},
+		{"in", sql.FilterIn, NewInTuple(col, NewTuple(one, two)), true},
+		{"in without capability", sql.FilterComparison, NewInTuple(col, NewTuple(one, two)), false},
+		{"in with expressions", sql.FilterIn, NewInTuple(col, NewTuple(one, NewPlus(one, two))), false},
+		{"like", sql.FilterLike, NewLike(name, NewLiteral("a%", sql.LongText), nil), true},
+		{"like with escape", sql.FilterLike, NewLike(name, NewLiteral("a%", sql.LongText), NewLiteral("$", sql.LongText)), false},
+		{"arithmetic", sql.FilterComparison, NewEquals(NewPlus(col, one), two), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, FilterSupported(tt.caps, tt.filter))
+		})
+	}
+}
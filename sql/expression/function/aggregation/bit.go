@@ -0,0 +1,167 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// BitOp is the bit operator a BitAggregation combines its values with.
+type BitOp byte
+
+const (
+	// BitAnd is the operator of BIT_AND.
+	BitAnd BitOp = iota
+	// BitOr is the operator of BIT_OR.
+	BitOr
+	// BitXor is the operator of BIT_XOR.
+	BitXor
+)
+
+// BitAggregation node to combine the bits of a numeric column with BIT_AND, BIT_OR or BIT_XOR. As with the bit
+// operators, values are unsigned 64-bit integers, and negative values keep their two's complement representation.
+type BitAggregation struct {
+	expression.UnaryExpression
+	Op BitOp
+}
+
+var _ sql.FunctionExpression = (*BitAggregation)(nil)
+var _ sql.Aggregation = (*BitAggregation)(nil)
+
+// NewBitAnd creates a new BitAggregation node for BIT_AND.
+func NewBitAnd(e sql.Expression) *BitAggregation {
+	return &BitAggregation{expression.UnaryExpression{Child: e}, BitAnd}
+}
+
+// NewBitOr creates a new BitAggregation node for BIT_OR.
+func NewBitOr(e sql.Expression) *BitAggregation {
+	return &BitAggregation{expression.UnaryExpression{Child: e}, BitOr}
+}
+
+// NewBitXor creates a new BitAggregation node for BIT_XOR.
+func NewBitXor(e sql.Expression) *BitAggregation {
+	return &BitAggregation{expression.UnaryExpression{Child: e}, BitXor}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitAggregation) FunctionName() string {
+	switch b.Op {
+	case BitAnd:
+		return "bit_and"
+	case BitOr:
+		return "bit_or"
+	default:
+		return "bit_xor"
+	}
+}
+
+// Description implements sql.FunctionExpression
+func (b *BitAggregation) Description() string {
+	switch b.Op {
+	case BitAnd:
+		return "returns the bitwise AND of all bits in expr."
+	case BitOr:
+		return "returns the bitwise OR of all bits in expr."
+	default:
+		return "returns the bitwise XOR of all bits in expr."
+	}
+}
+
+func (b *BitAggregation) String() string {
+	return fmt.Sprintf("%s(%s)", strings.ToUpper(b.FunctionName()), b.Child)
+}
+
+// Type implements Expression interface.
+func (b *BitAggregation) Type() sql.Type {
+	return sql.Uint64
+}
+
+// IsNullable implements Expression interface.
+func (b *BitAggregation) IsNullable() bool {
+	return false
+}
+
+// Eval implements Expression interface.
+func (b *BitAggregation) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("BitAggregation")
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitAggregation) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return &BitAggregation{expression.UnaryExpression{Child: children[0]}, b.Op}, nil
+}
+
+// NewBuffer implements Aggregation interface.
+func (b *BitAggregation) NewBuffer() (sql.AggregationBuffer, error) {
+	bufferChild, err := expression.Clone(b.UnaryExpression.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without rows, the result is the identity of the operator: all bits set for BIT_AND and none for the others
+	var bits uint64
+	if b.Op == BitAnd {
+		bits = math.MaxUint64
+	}
+	return &bitBuffer{b.Op, bits, bufferChild}, nil
+}
+
+type bitBuffer struct {
+	op   BitOp
+	bits uint64
+	expr sql.Expression
+}
+
+// Update implements the AggregationBuffer interface.
+func (b *bitBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := b.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	operand := expression.BitOperand(v)
+	switch b.op {
+	case BitAnd:
+		b.bits &= operand
+	case BitOr:
+		b.bits |= operand
+	default:
+		b.bits ^= operand
+	}
+
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (b *bitBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return b.bits, nil
+}
+
+// Dispose implements the Disposable interface.
+func (b *bitBuffer) Dispose() {
+	expression.Dispose(b.expr)
+}
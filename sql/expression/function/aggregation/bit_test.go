@@ -0,0 +1,65 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestBitAggregation_String(t *testing.T) {
+	require := require.New(t)
+
+	col := expression.NewGetField(0, sql.Int32, "col1", true)
+	require.Equal("BIT_AND(col1)", NewBitAnd(col).String())
+	require.Equal("BIT_OR(col1)", NewBitOr(col).String())
+	require.Equal("BIT_XOR(col1)", NewBitXor(col).String())
+}
+
+func TestBitAggregation(t *testing.T) {
+	col := expression.NewGetField(0, sql.Int64, "col1", true)
+	flags := []sql.Row{{int64(0x7)}, {nil}, {uint8(0x5)}, {"13"}}
+
+	testCases := []struct {
+		name     string
+		agg      sql.Aggregation
+		rows     []sql.Row
+		expected uint64
+	}{
+		{"bit_and no rows", NewBitAnd(col), nil, math.MaxUint64},
+		{"bit_and nulls", NewBitAnd(col), []sql.Row{{nil}}, math.MaxUint64},
+		{"bit_and", NewBitAnd(col), flags, 0x5},
+		{"bit_and negative", NewBitAnd(col), []sql.Row{{int64(-1)}, {int64(-2)}}, math.MaxUint64 - 1},
+		{"bit_and unsigned", NewBitAnd(col), []sql.Row{{uint64(math.MaxUint64)}, {uint64(1 << 63)}}, 1 << 63},
+		{"bit_or no rows", NewBitOr(col), nil, 0},
+		{"bit_or", NewBitOr(col), flags, 0xf},
+		{"bit_or rounded", NewBitOr(col), []sql.Row{{1.6}, {float32(4.2)}}, 0x6},
+		{"bit_or negative", NewBitOr(col), []sql.Row{{int8(-128)}}, math.MaxUint64 - 127},
+		{"bit_xor no rows", NewBitXor(col), nil, 0},
+		{"bit_xor", NewBitXor(col), flags, 0xf},
+		{"bit_xor twice", NewBitXor(col), []sql.Row{{int64(9)}, {int64(9)}}, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, aggregate(t, tt.agg, tt.rows...))
+		})
+	}
+}
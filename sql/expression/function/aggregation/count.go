@@ -175,7 +175,7 @@ func (c *countDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
 			return err
 		}
 
-		value = v
+		value = sql.CollationKey(c.expr.Type(), v)
 	}
 
 	hash, err := hashstructure.Hash(value, nil)
@@ -0,0 +1,186 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Variance node to calculate the variance or the standard deviation of a numeric column, of either the population or
+// a sample of it. These are VAR_POP, VAR_SAMP, STDDEV_POP and STDDEV_SAMP, and their synonyms.
+type Variance struct {
+	expression.UnaryExpression
+	// Sample is whether the rows are a sample of the population, rather than the whole population.
+	Sample bool
+	// StdDev is whether the standard deviation is calculated, rather than the variance.
+	StdDev bool
+}
+
+var _ sql.FunctionExpression = (*Variance)(nil)
+var _ sql.Aggregation = (*Variance)(nil)
+
+// NewVarPop creates a new Variance node for the population variance.
+func NewVarPop(e sql.Expression) *Variance {
+	return &Variance{UnaryExpression: expression.UnaryExpression{Child: e}}
+}
+
+// NewVarSamp creates a new Variance node for the sample variance.
+func NewVarSamp(e sql.Expression) *Variance {
+	return &Variance{UnaryExpression: expression.UnaryExpression{Child: e}, Sample: true}
+}
+
+// NewStdDevPop creates a new Variance node for the population standard deviation.
+func NewStdDevPop(e sql.Expression) *Variance {
+	return &Variance{UnaryExpression: expression.UnaryExpression{Child: e}, StdDev: true}
+}
+
+// NewStdDevSamp creates a new Variance node for the sample standard deviation.
+func NewStdDevSamp(e sql.Expression) *Variance {
+	return &Variance{UnaryExpression: expression.UnaryExpression{Child: e}, Sample: true, StdDev: true}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (v *Variance) FunctionName() string {
+	switch {
+	case v.StdDev && v.Sample:
+		return "stddev_samp"
+	case v.StdDev:
+		return "stddev_pop"
+	case v.Sample:
+		return "var_samp"
+	default:
+		return "var_pop"
+	}
+}
+
+// Description implements sql.FunctionExpression
+func (v *Variance) Description() string {
+	switch {
+	case v.StdDev && v.Sample:
+		return "returns the sample standard deviation of expr."
+	case v.StdDev:
+		return "returns the population standard deviation of expr."
+	case v.Sample:
+		return "returns the sample variance of expr."
+	default:
+		return "returns the population variance of expr."
+	}
+}
+
+func (v *Variance) String() string {
+	return fmt.Sprintf("%s(%s)", strings.ToUpper(v.FunctionName()), v.Child)
+}
+
+// Type implements Expression interface.
+func (v *Variance) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements Expression interface.
+func (v *Variance) IsNullable() bool {
+	return true
+}
+
+// Eval implements Expression interface.
+func (v *Variance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("Variance")
+}
+
+// WithChildren implements the Expression interface.
+func (v *Variance) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	nv := *v
+	nv.Child = children[0]
+	return &nv, nil
+}
+
+// NewBuffer implements Aggregation interface.
+func (v *Variance) NewBuffer() (sql.AggregationBuffer, error) {
+	bufferChild, err := expression.Clone(v.UnaryExpression.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	return &varianceBuffer{sample: v.Sample, stdDev: v.StdDev, expr: bufferChild}, nil
+}
+
+// varianceBuffer computes the variance in a single pass with Welford's algorithm, which, unlike summing the values
+// and their squares, doesn't lose precision when the variance is small compared to the mean.
+type varianceBuffer struct {
+	sample bool
+	stdDev bool
+	rows   int64
+	mean   float64
+	// m2 is the sum of the squared differences from the mean.
+	m2   float64
+	expr sql.Expression
+}
+
+// Update implements the AggregationBuffer interface.
+func (v *varianceBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	val, err := v.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return nil
+	}
+
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		val = float64(0)
+	}
+	x := val.(float64)
+
+	v.rows++
+	delta := x - v.mean
+	v.mean += delta / float64(v.rows)
+	v.m2 += delta * (x - v.mean)
+
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (v *varianceBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	// As in MySQL, there is no variance without rows, and no sample variance with a single row
+	if v.rows == 0 || v.sample && v.rows == 1 {
+		return nil, nil
+	}
+
+	var variance float64
+	if v.sample {
+		variance = v.m2 / float64(v.rows-1)
+	} else {
+		variance = v.m2 / float64(v.rows)
+	}
+
+	if v.stdDev {
+		return math.Sqrt(variance), nil
+	}
+	return variance, nil
+}
+
+// Dispose implements the Disposable interface.
+func (v *varianceBuffer) Dispose() {
+	expression.Dispose(v.expr)
+}
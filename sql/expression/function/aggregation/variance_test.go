@@ -0,0 +1,75 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestVariance_String(t *testing.T) {
+	require := require.New(t)
+
+	col := expression.NewGetField(0, sql.Int32, "col1", true)
+	require.Equal("VAR_POP(col1)", NewVarPop(col).String())
+	require.Equal("VAR_SAMP(col1)", NewVarSamp(col).String())
+	require.Equal("STDDEV_POP(col1)", NewStdDevPop(col).String())
+	require.Equal("STDDEV_SAMP(col1)", NewStdDevSamp(col).String())
+}
+
+func TestVariance(t *testing.T) {
+	col := expression.NewGetField(0, sql.Float64, "col1", true)
+	// The values are far from zero compared to their differences, which loses precision unless the variance is
+	// computed from the differences to the mean
+	large := []sql.Row{{1e9 + 4}, {1e9 + 7}, {1e9 + 13}, {1e9 + 16}}
+
+	testCases := []struct {
+		name     string
+		agg      sql.Aggregation
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"var_pop no rows", NewVarPop(col), nil, nil},
+		{"var_pop nulls", NewVarPop(col), []sql.Row{{nil}, {nil}}, nil},
+		{"var_pop one row", NewVarPop(col), []sql.Row{{int64(5)}}, float64(0)},
+		{"var_pop", NewVarPop(col), []sql.Row{{int64(1)}, {nil}, {int64(2)}, {int64(3)}, {int64(6)}}, float64(3.5)},
+		{"var_pop strings", NewVarPop(col), []sql.Row{{"2"}, {"4"}}, float64(1)},
+		{"var_pop large values", NewVarPop(col), large, float64(22.5)},
+		{"var_samp one row", NewVarSamp(col), []sql.Row{{int64(5)}}, nil},
+		{"var_samp", NewVarSamp(col), []sql.Row{{int64(1)}, {nil}, {int64(2)}, {int64(3)}, {int64(6)}}, float64(14) / 3},
+		{"var_samp large values", NewVarSamp(col), large, float64(30)},
+		{"stddev_pop no rows", NewStdDevPop(col), nil, nil},
+		{"stddev_pop", NewStdDevPop(col), []sql.Row{{int64(2)}, {int64(4)}, {int64(4)}, {int64(4)}, {int64(5)}, {int64(5)}, {int64(7)}, {int64(9)}}, float64(2)},
+		{"stddev_samp one row", NewStdDevSamp(col), []sql.Row{{int64(5)}}, nil},
+		{"stddev_samp", NewStdDevSamp(col), []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}}, float64(1)},
+		{"stddev_samp large values", NewStdDevSamp(col), large, math.Sqrt(30)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result := aggregate(t, tt.agg, tt.rows...)
+			if expected, ok := tt.expected.(float64); ok {
+				require.InDelta(t, expected, result, 1e-9)
+			} else {
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Aggregation is an aggregate function, such as SUM or COUNT, used as a window function with an OVER clause. The
+// value of each row is the aggregation of the rows of its window frame, computed with the buffers of the aggregate
+// function itself. The aggregate function isn't a child of this expression, only its arguments are, so that it isn't
+// mistaken for an aggregation of the whole result.
+type Aggregation struct {
+	window *sql.Window
+	agg    sql.Aggregation
+	pos    int
+}
+
+var _ sql.FunctionExpression = (*Aggregation)(nil)
+var _ sql.WindowAggregation = (*Aggregation)(nil)
+var _ sql.OrderedWindowAggregation = (*Aggregation)(nil)
+
+// NewAggregation returns the aggregate function given as a window function over the window given.
+func NewAggregation(agg sql.Aggregation, window *sql.Window) *Aggregation {
+	return &Aggregation{window: window, agg: agg}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *Aggregation) FunctionName() string {
+	if f, ok := a.agg.(sql.FunctionExpression); ok {
+		return f.FunctionName()
+	}
+	return a.agg.String()
+}
+
+// Description implements sql.FunctionExpression
+func (a *Aggregation) Description() string {
+	if f, ok := a.agg.(sql.FunctionExpression); ok {
+		return f.Description()
+	}
+	return ""
+}
+
+// Window implements sql.WindowExpression
+func (a *Aggregation) Window() *sql.Window {
+	return a.window
+}
+
+// Resolved implements sql.Expression
+func (a *Aggregation) Resolved() bool {
+	return windowResolved(a.window) && a.agg.Resolved()
+}
+
+func (a *Aggregation) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (a *Aggregation) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(a.agg.String())
+	if a.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(a.window.String())
+	}
+	return sb.String()
+}
+
+func (a *Aggregation) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(sql.DebugString(a.agg))
+	if a.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(a.window))
+	}
+	return sb.String()
+}
+
+// Type implements sql.Expression
+func (a *Aggregation) Type() sql.Type {
+	return a.agg.Type()
+}
+
+// IsNullable implements sql.Expression
+func (a *Aggregation) IsNullable() bool {
+	return a.agg.IsNullable()
+}
+
+// Eval implements sql.Expression
+func (a *Aggregation) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (a *Aggregation) Children() []sql.Expression {
+	return append(a.window.ToExpressions(), a.agg.Children()...)
+}
+
+// WithChildren implements sql.Expression
+func (a *Aggregation) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	windowLen := len(a.window.ToExpressions())
+	if len(children) != windowLen+len(a.agg.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), windowLen+len(a.agg.Children()))
+	}
+
+	window, err := a.window.FromExpressions(children[:windowLen])
+	if err != nil {
+		return nil, err
+	}
+
+	agg, err := a.agg.WithChildren(children[windowLen:]...)
+	if err != nil {
+		return nil, err
+	}
+	aggregation, ok := agg.(sql.Aggregation)
+	if !ok {
+		return nil, sql.ErrInvalidType.New(reflect.TypeOf(agg).String())
+	}
+
+	na := *a
+	na.window = window
+	na.agg = aggregation
+	return &na, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (a *Aggregation) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	na := *a
+	na.window = window
+	return &na, nil
+}
+
+// Add implements sql.WindowAggregation
+func (a *Aggregation) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// order -> row, aggregationIdx, originalIndex
+	buffer[0] = append(rows, append(row, nil, a.pos))
+	a.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (a *Aggregation) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	order, err := expression.SortedIndexes(ctx, a.window.PartitionSortFields(), rows)
+	if err != nil {
+		return err
+	}
+	return a.FinishOrdered(ctx, buffer, order)
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (a *Aggregation) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	starts, ends, err := frameBounds(ctx, a.window, rows)
+	if err != nil {
+		return err
+	}
+
+	// Frames start with their partition and only grow within it, so each partition needs a single aggregation buffer,
+	// updated with the rows joining the frame before the value of each row is taken from it
+	aggregationIdx := len(rows[0]) - 2
+	var aggBuffer sql.AggregationBuffer
+	defer func() {
+		if aggBuffer != nil {
+			aggBuffer.Dispose()
+		}
+	}()
+
+	added := 0
+	for i, row := range rows {
+		if starts[i] == i {
+			if aggBuffer != nil {
+				aggBuffer.Dispose()
+			}
+			aggBuffer, err = a.agg.NewBuffer()
+			if err != nil {
+				return err
+			}
+		}
+
+		for ; added < ends[i]; added++ {
+			if err := aggBuffer.Update(ctx, rows[added][:aggregationIdx]); err != nil {
+				return err
+			}
+		}
+
+		row[aggregationIdx], err = aggBuffer.Eval(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EvalRow implements sql.WindowAggregation
+func (a *Aggregation) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	aggregationIdx := len(rows[0]) - 2
+	return rows[i][aggregationIdx], nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DenseRank is the DENSE_RANK() window function, which ranks each row in its partition by its window's order by values. Peers share a rank, and there are no gaps in the ranking after them.
+type DenseRank struct {
+	window *sql.Window
+	pos    int
+}
+
+var _ sql.FunctionExpression = (*DenseRank)(nil)
+var _ sql.WindowAggregation = (*DenseRank)(nil)
+var _ sql.OrderedWindowAggregation = (*DenseRank)(nil)
+
+func NewDenseRank() sql.Expression {
+	return &DenseRank{}
+}
+
+// Description implements sql.FunctionExpression
+func (d *DenseRank) Description() string {
+	return "returns the rank of the current row within its partition, without gaps."
+}
+
+// Window implements sql.WindowExpression
+func (d *DenseRank) Window() *sql.Window {
+	return d.window
+}
+
+// Resolved implements sql.Expression
+func (d *DenseRank) Resolved() bool {
+	return windowResolved(d.window)
+}
+
+func (d *DenseRank) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (d *DenseRank) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("dense_rank()")
+	if d.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(d.window.String())
+	}
+	return sb.String()
+}
+
+func (d *DenseRank) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString("dense_rank()")
+	if d.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(d.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (d *DenseRank) FunctionName() string {
+	return "DENSE_RANK"
+}
+
+// Type implements sql.Expression
+func (d *DenseRank) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable implements sql.Expression
+func (d *DenseRank) IsNullable() bool {
+	return false
+}
+
+// Eval implements sql.Expression
+func (d *DenseRank) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (d *DenseRank) Children() []sql.Expression {
+	return d.window.ToExpressions()
+}
+
+// WithChildren implements sql.Expression
+func (d *DenseRank) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	window, err := d.window.FromExpressions(children)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.WithWindow(window)
+}
+
+// WithWindow implements sql.WindowAggregation
+func (d *DenseRank) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nr := *d
+	nr.window = window
+	return &nr, nil
+}
+
+// Add implements sql.WindowAggregation
+func (d *DenseRank) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// without an ordering, every row is a peer of every other and ranks first
+	buffer[0] = append(rows, append(row, 1, d.pos))
+	d.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (d *DenseRank) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) > 0 && d.window != nil && d.window.OrderBy != nil {
+		order, err := expression.SortedIndexes(ctx, d.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
+		}
+		return d.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (d *DenseRank) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return rankRows(ctx, d.window, rows, len(rows[0])-2, true)
+}
+
+// EvalRow implements sql.WindowAggregation
+func (d *DenseRank) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	return rows[i][len(rows[i])-2], nil
+}
@@ -16,7 +16,6 @@ package window
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -27,14 +26,17 @@ import (
 type FirstValue struct {
 	window *sql.Window
 	expression.UnaryExpression
-	pos int
+	ignoreNulls bool
+	pos         int
 }
 
 var _ sql.FunctionExpression = (*FirstValue)(nil)
 var _ sql.WindowAggregation = (*FirstValue)(nil)
+var _ sql.OrderedWindowAggregation = (*FirstValue)(nil)
+var _ sql.NullTreatmentWindowAggregation = (*FirstValue)(nil)
 
 func NewFirstValue(e sql.Expression) sql.Expression {
-	return &FirstValue{nil, expression.UnaryExpression{Child: e}, 0}
+	return &FirstValue{UnaryExpression: expression.UnaryExpression{Child: e}}
 }
 
 // Description implements sql.FunctionExpression
@@ -59,6 +61,9 @@ func (f *FirstValue) NewBuffer() sql.Row {
 func (f *FirstValue) String() string {
 	sb := strings.Builder{}
 	sb.WriteString(fmt.Sprintf("first_value(%s)", f.Child.String()))
+	if f.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if f.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(f.window.String())
@@ -68,7 +73,10 @@ func (f *FirstValue) String() string {
 
 func (f *FirstValue) DebugString() string {
 	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf("first_value(%s)", f.Child.String()))
+	sb.WriteString(fmt.Sprintf("first_value(%s)", sql.DebugString(f.Child)))
+	if f.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if f.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(sql.DebugString(f.window))
@@ -88,7 +96,7 @@ func (f *FirstValue) Type() sql.Type {
 
 // IsNullable implements sql.Expression
 func (f *FirstValue) IsNullable() bool {
-	return false
+	return true
 }
 
 // Eval implements sql.Expression
@@ -129,11 +137,18 @@ func (f *FirstValue) WithWindow(window *sql.Window) (sql.WindowAggregation, erro
 	return &nr, nil
 }
 
+// WithIgnoreNulls implements sql.NullTreatmentWindowAggregation
+func (f *FirstValue) WithIgnoreNulls(ignoreNulls bool) sql.WindowAggregation {
+	nr := *f
+	nr.ignoreNulls = ignoreNulls
+	return &nr
+}
+
 // Add implements sql.WindowAggregation
 func (f *FirstValue) Add(ctx *sql.Context, buffer, row sql.Row) error {
 	rows := buffer[0].([]sql.Row)
 	// order -> row, firstValueIdx, originalIndex
-	buffer[0] = append(rows, append(row, 0, f.pos))
+	buffer[0] = append(rows, append(row, nil, f.pos))
 	f.pos++
 	return nil
 }
@@ -141,45 +156,39 @@ func (f *FirstValue) Add(ctx *sql.Context, buffer, row sql.Row) error {
 // Finish implements sql.WindowAggregation
 func (f *FirstValue) Finish(ctx *sql.Context, buffer sql.Row) error {
 	rows := buffer[0].([]sql.Row)
-	if len(rows) > 0 && f.window != nil && f.window.OrderBy != nil {
-		sorter := &expression.Sorter{
-			SortFields: append(partitionsToSortFields(f.Window().PartitionBy), f.Window().OrderBy...),
-			Rows:       rows,
-			Ctx:        ctx,
-		}
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return sorter.LastError
+	if len(rows) > 0 && f.window != nil {
+		order, err := expression.SortedIndexes(ctx, f.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
 		}
+		return f.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
 
-		// Now that we have the rows in sorted order, set the firstValue
-		firstValueIdx := len(rows[0]) - 2
-		originalIdx := len(rows[0]) - 1
-		var last sql.Row
-		var err error
-		var isNew bool
-		var firstValue interface{}
-		for _, row := range rows {
-			// every time we encounter a new partition, reset the firstValue
-			isNew, err = isNewPartition(ctx, f.window.PartitionBy, last, row)
-			if err != nil {
-				return err
-			}
-			if isNew {
-				firstValue, err = f.Child.Eval(ctx, row)
-				if err != nil {
-					return nil
-				}
-			}
-			row[firstValueIdx] = firstValue
-			last = row
-		}
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (f *FirstValue) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	values, err := evalRows(ctx, f.Child, rows)
+	if err != nil {
+		return err
+	}
 
-		// And finally sort again by the original order
-		sort.SliceStable(rows, func(i, j int) bool {
-			return rows[i][originalIdx].(int) < rows[j][originalIdx].(int)
-		})
+	starts, ends, err := frameBounds(ctx, f.window, rows)
+	if err != nil {
+		return err
 	}
+
+	// Now that we have the rows in sorted order, set the first value of each row's frame
+	firstValueIdx := len(rows[0]) - 2
+	for i, row := range rows {
+		row[firstValueIdx], _ = nthValue(values, starts[i], ends[i], 1, f.ignoreNulls)
+	}
+
 	return nil
 }
 
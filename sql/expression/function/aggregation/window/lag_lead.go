@@ -0,0 +1,259 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// LagLead is the LAG(expr [, N [, default]]) window function, or the LEAD window function when Lead is true. It
+// returns the value of expr from the row N rows before (or after) the current row in its partition, or default if
+// there is no such row. N is 1 and default is NULL unless given.
+type LagLead struct {
+	window *sql.Window
+	// Lead is whether the function is LEAD, which looks at the rows after the current row, rather than LAG.
+	Lead        bool
+	expr        sql.Expression
+	offset      sql.Expression
+	def         sql.Expression
+	ignoreNulls bool
+	pos         int
+}
+
+var _ sql.FunctionExpression = (*LagLead)(nil)
+var _ sql.WindowAggregation = (*LagLead)(nil)
+var _ sql.OrderedWindowAggregation = (*LagLead)(nil)
+var _ sql.NullTreatmentWindowAggregation = (*LagLead)(nil)
+
+// NewLag creates a new LagLead node for LAG.
+func NewLag(args ...sql.Expression) (sql.Expression, error) {
+	return newLagLead(false, args)
+}
+
+// NewLead creates a new LagLead node for LEAD.
+func NewLead(args ...sql.Expression) (sql.Expression, error) {
+	return newLagLead(true, args)
+}
+
+func newLagLead(lead bool, args []sql.Expression) (*LagLead, error) {
+	l := &LagLead{Lead: lead}
+	if len(args) < 1 || len(args) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New(l.FunctionName(), "1, 2, or 3", len(args))
+	}
+
+	l.expr = args[0]
+	l.offset = expression.NewLiteral(int8(1), sql.Int8)
+	if len(args) > 1 {
+		l.offset = args[1]
+	}
+	l.def = expression.NewLiteral(nil, sql.Null)
+	if len(args) > 2 {
+		l.def = args[2]
+	}
+	return l, nil
+}
+
+// Description implements sql.FunctionExpression
+func (l *LagLead) Description() string {
+	if l.Lead {
+		return "returns value of argument from row leading current row within partition."
+	}
+	return "returns value of argument from row lagging current row within partition."
+}
+
+// Window implements sql.WindowExpression
+func (l *LagLead) Window() *sql.Window {
+	return l.window
+}
+
+// Resolved implements sql.Expression
+func (l *LagLead) Resolved() bool {
+	return windowResolved(l.window) && expression.ExpressionsResolved(l.expr, l.offset, l.def)
+}
+
+func (l *LagLead) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (l *LagLead) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("%s(%s, %s, %s)", strings.ToLower(l.FunctionName()), l.expr, l.offset, l.def))
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if l.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(l.window.String())
+	}
+	return sb.String()
+}
+
+func (l *LagLead) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("%s(%s, %s, %s)", strings.ToLower(l.FunctionName()),
+		sql.DebugString(l.expr), sql.DebugString(l.offset), sql.DebugString(l.def)))
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if l.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(l.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l *LagLead) FunctionName() string {
+	if l.Lead {
+		return "LEAD"
+	}
+	return "LAG"
+}
+
+// Type implements sql.Expression
+func (l *LagLead) Type() sql.Type {
+	return l.expr.Type()
+}
+
+// IsNullable implements sql.Expression
+func (l *LagLead) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (l *LagLead) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (l *LagLead) Children() []sql.Expression {
+	return append(l.window.ToExpressions(), l.expr, l.offset, l.def)
+}
+
+// WithChildren implements sql.Expression
+func (l *LagLead) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 3)
+	}
+
+	nl := *l
+	window, err := l.window.FromExpressions(children[:len(children)-3])
+	if err != nil {
+		return nil, err
+	}
+
+	args := children[len(children)-3:]
+	nl.expr, nl.offset, nl.def = args[0], args[1], args[2]
+	nl.window = window
+
+	return &nl, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (l *LagLead) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nl := *l
+	nl.window = window
+	return &nl, nil
+}
+
+// WithIgnoreNulls implements sql.NullTreatmentWindowAggregation
+func (l *LagLead) WithIgnoreNulls(ignoreNulls bool) sql.WindowAggregation {
+	nl := *l
+	nl.ignoreNulls = ignoreNulls
+	return &nl
+}
+
+// Add implements sql.WindowAggregation
+func (l *LagLead) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// order -> row, valueIdx, originalIndex
+	buffer[0] = append(rows, append(row, nil, l.pos))
+	l.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (l *LagLead) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) > 0 && l.window != nil {
+		order, err := expression.SortedIndexes(ctx, l.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
+		}
+		return l.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (l *LagLead) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	offset, err := evalPositiveInt(ctx, strings.ToLower(l.FunctionName()), l.offset, true)
+	if err != nil {
+		return err
+	}
+
+	values, err := evalRows(ctx, l.expr, rows)
+	if err != nil {
+		return err
+	}
+
+	starts, ends, err := partitionBounds(ctx, l.window, rows)
+	if err != nil {
+		return err
+	}
+
+	// Now that we have the rows in sorted order, look the value of each row up in the rows before or after it in its
+	// partition
+	valueIdx := len(rows[0]) - 2
+	for i, row := range rows {
+		var value interface{}
+		var ok bool
+		switch {
+		case offset == 0:
+			value, ok = values[i], true
+		case l.Lead:
+			value, ok = nthValue(values, i+1, ends[i], offset, l.ignoreNulls)
+		default:
+			value, ok = nthValue(values, starts[i], i, -offset, l.ignoreNulls)
+		}
+
+		if !ok {
+			value, err = l.def.Eval(ctx, row)
+			if err != nil {
+				return err
+			}
+		}
+		row[valueIdx] = value
+	}
+
+	return nil
+}
+
+// EvalRow implements sql.WindowAggregation
+func (l *LagLead) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	valueIdx := len(rows[0]) - 2
+	return rows[i][valueIdx], nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+type LastValue struct {
+	window *sql.Window
+	expression.UnaryExpression
+	ignoreNulls bool
+	pos         int
+}
+
+var _ sql.FunctionExpression = (*LastValue)(nil)
+var _ sql.WindowAggregation = (*LastValue)(nil)
+var _ sql.OrderedWindowAggregation = (*LastValue)(nil)
+var _ sql.NullTreatmentWindowAggregation = (*LastValue)(nil)
+
+func NewLastValue(e sql.Expression) sql.Expression {
+	return &LastValue{UnaryExpression: expression.UnaryExpression{Child: e}}
+}
+
+// Description implements sql.FunctionExpression
+func (l *LastValue) Description() string {
+	return "returns value of argument from last row of window frame."
+}
+
+// Window implements sql.WindowExpression
+func (l *LastValue) Window() *sql.Window {
+	return l.window
+}
+
+// Resolved implements sql.Expression
+func (l *LastValue) Resolved() bool {
+	return windowResolved(l.window)
+}
+
+func (l *LastValue) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (l *LastValue) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("last_value(%s)", l.Child.String()))
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if l.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(l.window.String())
+	}
+	return sb.String()
+}
+
+func (l *LastValue) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("last_value(%s)", sql.DebugString(l.Child)))
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if l.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(l.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l *LastValue) FunctionName() string {
+	return "LAST_VALUE"
+}
+
+// Type implements sql.Expression
+func (l *LastValue) Type() sql.Type {
+	return l.Child.Type()
+}
+
+// IsNullable implements sql.Expression
+func (l *LastValue) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (l *LastValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (l *LastValue) Children() []sql.Expression {
+	if l == nil {
+		return nil
+	}
+	return append(l.window.ToExpressions(), l.Child)
+}
+
+// WithChildren implements sql.Expression
+func (l *LastValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 2)
+	}
+
+	nf := *l
+	window, err := l.window.FromExpressions(children[:len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	nf.Child = children[len(children)-1]
+	nf.window = window
+
+	return &nf, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (l *LastValue) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nr := *l
+	nr.window = window
+	return &nr, nil
+}
+
+// WithIgnoreNulls implements sql.NullTreatmentWindowAggregation
+func (l *LastValue) WithIgnoreNulls(ignoreNulls bool) sql.WindowAggregation {
+	nr := *l
+	nr.ignoreNulls = ignoreNulls
+	return &nr
+}
+
+// Add implements sql.WindowAggregation
+func (l *LastValue) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// order -> row, lastValueIdx, originalIndex
+	buffer[0] = append(rows, append(row, nil, l.pos))
+	l.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (l *LastValue) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) > 0 && l.window != nil {
+		order, err := expression.SortedIndexes(ctx, l.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
+		}
+		return l.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (l *LastValue) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	values, err := evalRows(ctx, l.Child, rows)
+	if err != nil {
+		return err
+	}
+
+	starts, ends, err := frameBounds(ctx, l.window, rows)
+	if err != nil {
+		return err
+	}
+
+	// Now that we have the rows in sorted order, set the last value of each row's frame
+	lastValueIdx := len(rows[0]) - 2
+	for i, row := range rows {
+		row[lastValueIdx], _ = nthValue(values, starts[i], ends[i], -1, l.ignoreNulls)
+	}
+
+	return nil
+}
+
+// EvalRow implements sql.WindowAggregation
+func (l *LastValue) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	lastValueIdx := len(rows[0]) - 2
+	return rows[i][lastValueIdx], nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// NthValue is the NTH_VALUE(expr, N) window function, which returns the value of expr from the Nth row of the window
+// frame, or NULL if the frame has fewer rows.
+type NthValue struct {
+	window *sql.Window
+	expression.BinaryExpression
+	ignoreNulls bool
+	pos         int
+}
+
+var _ sql.FunctionExpression = (*NthValue)(nil)
+var _ sql.WindowAggregation = (*NthValue)(nil)
+var _ sql.OrderedWindowAggregation = (*NthValue)(nil)
+var _ sql.NullTreatmentWindowAggregation = (*NthValue)(nil)
+
+func NewNthValue(e, n sql.Expression) sql.Expression {
+	return &NthValue{BinaryExpression: expression.BinaryExpression{Left: e, Right: n}}
+}
+
+// Description implements sql.FunctionExpression
+func (n *NthValue) Description() string {
+	return "returns value of argument from N-th row of window frame."
+}
+
+// Window implements sql.WindowExpression
+func (n *NthValue) Window() *sql.Window {
+	return n.window
+}
+
+// Resolved implements sql.Expression
+func (n *NthValue) Resolved() bool {
+	return windowResolved(n.window) && n.BinaryExpression.Resolved()
+}
+
+func (n *NthValue) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (n *NthValue) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %s)", n.Left, n.Right))
+	if n.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if n.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(n.window.String())
+	}
+	return sb.String()
+}
+
+func (n *NthValue) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %s)", sql.DebugString(n.Left), sql.DebugString(n.Right)))
+	if n.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+	if n.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(n.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (n *NthValue) FunctionName() string {
+	return "NTH_VALUE"
+}
+
+// Type implements sql.Expression
+func (n *NthValue) Type() sql.Type {
+	return n.Left.Type()
+}
+
+// IsNullable implements sql.Expression
+func (n *NthValue) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (n *NthValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (n *NthValue) Children() []sql.Expression {
+	return append(n.window.ToExpressions(), n.Left, n.Right)
+}
+
+// WithChildren implements sql.Expression
+func (n *NthValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 2)
+	}
+
+	nn := *n
+	window, err := n.window.FromExpressions(children[:len(children)-2])
+	if err != nil {
+		return nil, err
+	}
+
+	nn.Left = children[len(children)-2]
+	nn.Right = children[len(children)-1]
+	nn.window = window
+
+	return &nn, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (n *NthValue) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nn := *n
+	nn.window = window
+	return &nn, nil
+}
+
+// WithIgnoreNulls implements sql.NullTreatmentWindowAggregation
+func (n *NthValue) WithIgnoreNulls(ignoreNulls bool) sql.WindowAggregation {
+	nn := *n
+	nn.ignoreNulls = ignoreNulls
+	return &nn
+}
+
+// Add implements sql.WindowAggregation
+func (n *NthValue) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// order -> row, nthValueIdx, originalIndex
+	buffer[0] = append(rows, append(row, nil, n.pos))
+	n.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (n *NthValue) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) > 0 && n.window != nil {
+		order, err := expression.SortedIndexes(ctx, n.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
+		}
+		return n.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (n *NthValue) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	nth, err := evalPositiveInt(ctx, "nth_value", n.Right, false)
+	if err != nil {
+		return err
+	}
+
+	values, err := evalRows(ctx, n.Left, rows)
+	if err != nil {
+		return err
+	}
+
+	starts, ends, err := frameBounds(ctx, n.window, rows)
+	if err != nil {
+		return err
+	}
+
+	// Now that we have the rows in sorted order, set the nth value of each row's frame
+	nthValueIdx := len(rows[0]) - 2
+	for i, row := range rows {
+		row[nthValueIdx], _ = nthValue(values, starts[i], ends[i], nth, n.ignoreNulls)
+	}
+
+	return nil
+}
+
+// EvalRow implements sql.WindowAggregation
+func (n *NthValue) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	nthValueIdx := len(rows[0]) - 2
+	return rows[i][nthValueIdx], nil
+}
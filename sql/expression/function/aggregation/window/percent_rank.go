@@ -15,7 +15,6 @@
 package window
 
 import (
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -30,6 +29,7 @@ type PercentRank struct {
 
 var _ sql.FunctionExpression = (*PercentRank)(nil)
 var _ sql.WindowAggregation = (*PercentRank)(nil)
+var _ sql.OrderedWindowAggregation = (*PercentRank)(nil)
 
 func NewPercentRank() sql.Expression {
 	return &PercentRank{}
@@ -120,7 +120,7 @@ func (p *PercentRank) WithWindow(window *sql.Window) (sql.WindowAggregation, err
 func (p *PercentRank) Add(ctx *sql.Context, buffer, row sql.Row) error {
 	rows := buffer[0].([]sql.Row)
 	// order -> row, partitionCount, rowIndex, originalIndex
-	buffer[0] = append(rows, append(row, nil, 1, p.pos))
+	buffer[0] = append(rows, append(row, 1, 1, p.pos))
 	p.pos++
 	return nil
 }
@@ -129,73 +129,54 @@ func (p *PercentRank) Add(ctx *sql.Context, buffer, row sql.Row) error {
 func (p *PercentRank) Finish(ctx *sql.Context, buffer sql.Row) error {
 	rows := buffer[0].([]sql.Row)
 	if len(rows) > 0 && p.window != nil && p.window.OrderBy != nil {
-		sorter := &expression.Sorter{
-			SortFields: append(partitionsToSortFields(p.Window().PartitionBy), p.Window().OrderBy...),
-			Rows:       rows,
-			Ctx:        ctx,
-		}
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return sorter.LastError
+		order, err := expression.SortedIndexes(ctx, p.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
 		}
+		return p.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
 
-		// Now that we have the rows in sorted order, number them
-		partitionCountIdx := len(rows[0]) - 3
-		rowNumIdx := len(rows[0]) - 2
-		originalIdx := len(rows[0]) - 1
-		partitionCounts := make([]int, 0)
-		var last sql.Row
-		var err error
-		var isNew bool
-		rowNum := 0
-		partitionCnt := 0
-		for _, row := range rows {
-			// every time we encounter a new partition, start the count over
-			isNew, err = isNewPartition(ctx, p.window.PartitionBy, last, row)
-			if err != nil {
-				return err
-			}
-			if isNew {
-				if partitionCnt > 0 {
-					partitionCounts = append(partitionCounts, partitionCnt)
-				}
-				partitionCnt = 1
-				rowNum = 1
-			} else {
-				// only bump row num when we have unique order by columns
-				isNew, err = isNewOrderValue(ctx, p.window.OrderBy.ToExpressions(), last, row)
-				if err != nil {
-					return err
-				}
-				partitionCnt++
-				if isNew {
-					rowNum = partitionCnt
-				}
-			}
-
-			row[rowNumIdx] = rowNum
-
-			last = row
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (p *PercentRank) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Now that we have the rows in sorted order, rank them
+	partitionCountIdx := len(rows[0]) - 3
+	rowNumIdx := len(rows[0]) - 2
+	if err := rankRows(ctx, p.window, rows, rowNumIdx, false); err != nil {
+		return err
+	}
+
+	// set partition counts
+	var last sql.Row
+	partitionStart := 0
+	for i, row := range rows {
+		isNew, err := isNewPartition(ctx, p.window.PartitionBy, last, row)
+		if err != nil {
+			return err
 		}
-		partitionCounts = append(partitionCounts, partitionCnt)
-
-		// set partition counts
-		currentPartitionIdx := 0
-		for _, row := range rows {
-			if row[rowNumIdx].(int) == 0 && currentPartitionIdx != 0 {
-				currentPartitionIdx += 1
-			}
-			row[partitionCountIdx] = partitionCounts[currentPartitionIdx]
+		if isNew {
+			setPartitionCount(rows[partitionStart:i], partitionCountIdx)
+			partitionStart = i
 		}
-
-		// And finally sort again by the original order
-		sort.SliceStable(rows, func(i, j int) bool {
-			return rows[i][originalIdx].(int) < rows[j][originalIdx].(int)
-		})
+		last = row
 	}
+	setPartitionCount(rows[partitionStart:], partitionCountIdx)
+
 	return nil
 }
 
+func setPartitionCount(partition []sql.Row, partitionCountIdx int) {
+	for _, row := range partition {
+		row[partitionCountIdx] = len(partition)
+	}
+}
+
 // EvalRow implements sql.WindowAggregation
 func (p *PercentRank) EvalRow(i int, buffer sql.Row) (interface{}, error) {
 	rows := buffer[0].([]sql.Row)
@@ -206,5 +187,10 @@ func (p *PercentRank) EvalRow(i int, buffer sql.Row) (interface{}, error) {
 	rowNumIdx := len(rows[0]) - 2
 	rowNum := rows[i][rowNumIdx].(int)
 
+	// the only row of a partition has no rows to rank it against
+	if partitionCount < 2 {
+		return float64(0), nil
+	}
+
 	return float64(rowNum-1) / float64(partitionCount-1), nil
 }
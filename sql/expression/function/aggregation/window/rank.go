@@ -0,0 +1,156 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Rank is the RANK() window function, which ranks each row in its partition by its window's order by values. Peers share a rank, and there are gaps in the ranking after them.
+type Rank struct {
+	window *sql.Window
+	pos    int
+}
+
+var _ sql.FunctionExpression = (*Rank)(nil)
+var _ sql.WindowAggregation = (*Rank)(nil)
+var _ sql.OrderedWindowAggregation = (*Rank)(nil)
+
+func NewRank() sql.Expression {
+	return &Rank{}
+}
+
+// Description implements sql.FunctionExpression
+func (r *Rank) Description() string {
+	return "returns the rank of the current row within its partition, with gaps."
+}
+
+// Window implements sql.WindowExpression
+func (r *Rank) Window() *sql.Window {
+	return r.window
+}
+
+// Resolved implements sql.Expression
+func (r *Rank) Resolved() bool {
+	return windowResolved(r.window)
+}
+
+func (r *Rank) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+func (r *Rank) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("rank()")
+	if r.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(r.window.String())
+	}
+	return sb.String()
+}
+
+func (r *Rank) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString("rank()")
+	if r.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(r.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *Rank) FunctionName() string {
+	return "RANK"
+}
+
+// Type implements sql.Expression
+func (r *Rank) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable implements sql.Expression
+func (r *Rank) IsNullable() bool {
+	return false
+}
+
+// Eval implements sql.Expression
+func (r *Rank) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (r *Rank) Children() []sql.Expression {
+	return r.window.ToExpressions()
+}
+
+// WithChildren implements sql.Expression
+func (r *Rank) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	window, err := r.window.FromExpressions(children)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.WithWindow(window)
+}
+
+// WithWindow implements sql.WindowAggregation
+func (r *Rank) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nr := *r
+	nr.window = window
+	return &nr, nil
+}
+
+// Add implements sql.WindowAggregation
+func (r *Rank) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	// without an ordering, every row is a peer of every other and ranks first
+	buffer[0] = append(rows, append(row, 1, r.pos))
+	r.pos++
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (r *Rank) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) > 0 && r.window != nil && r.window.OrderBy != nil {
+		order, err := expression.SortedIndexes(ctx, r.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
+		}
+		return r.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
+
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (r *Rank) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return rankRows(ctx, r.window, rows, len(rows[0])-2, false)
+}
+
+// EvalRow implements sql.WindowAggregation
+func (r *Rank) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	return rows[i][len(rows[i])-2], nil
+}
@@ -15,7 +15,6 @@
 package window
 
 import (
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -30,6 +29,7 @@ type RowNumber struct {
 
 var _ sql.FunctionExpression = (*RowNumber)(nil)
 var _ sql.WindowAggregation = (*RowNumber)(nil)
+var _ sql.OrderedWindowAggregation = (*RowNumber)(nil)
 
 func NewRowNumber() sql.Expression {
 	return &RowNumber{}
@@ -128,42 +128,42 @@ func (r *RowNumber) Add(ctx *sql.Context, buffer, row sql.Row) error {
 func (r *RowNumber) Finish(ctx *sql.Context, buffer sql.Row) error {
 	rows := buffer[0].([]sql.Row)
 	if len(rows) > 0 && r.window != nil && r.window.OrderBy != nil {
-		sorter := &expression.Sorter{
-			SortFields: append(partitionsToSortFields(r.Window().PartitionBy), r.Window().OrderBy...),
-			Rows:       rows,
-			Ctx:        ctx,
-		}
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return sorter.LastError
+		order, err := expression.SortedIndexes(ctx, r.window.PartitionSortFields(), rows)
+		if err != nil {
+			return err
 		}
+		return r.FinishOrdered(ctx, buffer, order)
+	}
+	return nil
+}
 
-		// Now that we have the rows in sorted order, number them
-		rowNumIdx := len(rows[0]) - 2
-		originalOrderIdx := len(rows[0]) - 1
-		var last sql.Row
-		var rowNum int
-		for _, row := range rows {
-			// every time we encounter a new partition, start the count over
-			isNew, err := isNewPartition(ctx, r.window.PartitionBy, last, row)
-			if err != nil {
-				return err
-			}
-			if isNew {
-				rowNum = 1
-			}
-
-			row[rowNumIdx] = rowNum
-
-			rowNum++
-			last = row
+// FinishOrdered implements sql.OrderedWindowAggregation
+func (r *RowNumber) FinishOrdered(ctx *sql.Context, buffer sql.Row, order []int) error {
+	rows := orderedRows(buffer[0].([]sql.Row), order)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Now that we have the rows in sorted order, number them
+	rowNumIdx := len(rows[0]) - 2
+	var last sql.Row
+	var rowNum int
+	for _, row := range rows {
+		// every time we encounter a new partition, start the count over
+		isNew, err := isNewPartition(ctx, r.window.PartitionBy, last, row)
+		if err != nil {
+			return err
+		}
+		if isNew {
+			rowNum = 1
 		}
 
-		// And finally sort again by the original order
-		sort.SliceStable(rows, func(i, j int) bool {
-			return rows[i][originalOrderIdx].(int) < rows[j][originalOrderIdx].(int)
-		})
+		row[rowNumIdx] = rowNum
+
+		rowNum++
+		last = row
 	}
+
 	return nil
 }
 
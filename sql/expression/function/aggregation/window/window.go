@@ -26,15 +26,14 @@ func windowResolved(window *sql.Window) bool {
 	return expression.ExpressionsResolved(append(window.OrderBy.ToExpressions(), window.PartitionBy...)...)
 }
 
-func partitionsToSortFields(partitionExprs []sql.Expression) sql.SortFields {
-	sfs := make(sql.SortFields, len(partitionExprs))
-	for i, expr := range partitionExprs {
-		sfs[i] = sql.SortField{
-			Column: expr,
-			Order:  sql.Ascending,
-		}
+// orderedRows returns the rows given in the order given by the indexes in order. The rows themselves are not copied,
+// so changes made to them are visible through the original slice.
+func orderedRows(rows []sql.Row, order []int) []sql.Row {
+	ordered := make([]sql.Row, len(order))
+	for i, idx := range order {
+		ordered[i] = rows[idx]
 	}
-	return sfs
+	return ordered
 }
 
 func evalExprs(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, error) {
@@ -104,3 +103,152 @@ func isNewOrderValue(ctx *sql.Context, orderByExprs []sql.Expression, last sql.R
 
 	return false, nil
 }
+
+// rankRows sets the rank of each of the rows given, which must be in the order of the window given, in the column
+// given. Rows with the same order by values are peers and share a rank. When dense is false, the rank of a row is one
+// more than the number of rows before it in its partition, so there are gaps after peer groups; when dense is true, the
+// rank is one more than the number of distinct peer groups before it, with no gaps.
+func rankRows(ctx *sql.Context, window *sql.Window, rows []sql.Row, rankIdx int, dense bool) error {
+	var last sql.Row
+	rank := 0
+	partitionCnt := 0
+	for _, row := range rows {
+		// every time we encounter a new partition, start the count over
+		isNew, err := isNewPartition(ctx, window.PartitionBy, last, row)
+		if err != nil {
+			return err
+		}
+		if isNew {
+			partitionCnt = 1
+			rank = 1
+		} else {
+			// only bump the rank when we have unique order by columns
+			isNew, err = isNewOrderValue(ctx, window.OrderBy.ToExpressions(), last, row)
+			if err != nil {
+				return err
+			}
+			partitionCnt++
+			if isNew {
+				if dense {
+					rank++
+				} else {
+					rank = partitionCnt
+				}
+			}
+		}
+
+		row[rankIdx] = rank
+		last = row
+	}
+
+	return nil
+}
+
+// frameBounds returns the frame of each of the rows given, which must be in the order of the window given, as the
+// index of its first row and the index after its last row. Without a frame clause, a frame starts with the first row
+// of its partition and ends with the last peer of the current row. Without an order by clause, every row of a
+// partition is a peer of every other, so the frame is the whole partition.
+func frameBounds(ctx *sql.Context, window *sql.Window, rows []sql.Row) ([]int, []int, error) {
+	starts := make([]int, len(rows))
+	ends := make([]int, len(rows))
+	partitionStart, peersStart := 0, 0
+	var last sql.Row
+	for i, row := range rows {
+		newPartition, err := isNewPartition(ctx, window.PartitionBy, last, row)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		newPeers := newPartition
+		if !newPeers {
+			newPeers, err = isNewOrderValue(ctx, window.OrderBy.ToExpressions(), last, row)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if newPartition {
+			partitionStart = i
+		}
+		if newPeers {
+			for j := peersStart; j < i; j++ {
+				ends[j] = i
+			}
+			peersStart = i
+		}
+
+		starts[i] = partitionStart
+		last = row
+	}
+	for j := peersStart; j < len(rows); j++ {
+		ends[j] = len(rows)
+	}
+
+	return starts, ends, nil
+}
+
+// partitionBounds returns the partition of each of the rows given, which must be in the order of the window given, as
+// the index of its first row and the index after its last row.
+func partitionBounds(ctx *sql.Context, window *sql.Window, rows []sql.Row) ([]int, []int, error) {
+	return frameBounds(ctx, &sql.Window{PartitionBy: window.PartitionBy}, rows)
+}
+
+// nthValue returns the nth of the values between the indexes start and end, counting from the first one when n is
+// positive and from the last one when n is negative. When ignoreNulls is true, null values are skipped. It returns
+// false if there is no such value.
+func nthValue(values []interface{}, start, end, n int, ignoreNulls bool) (interface{}, bool) {
+	i, stop, step := start, end, 1
+	if n < 0 {
+		i, stop, step, n = end-1, start-1, -1, -n
+	}
+
+	for ; i != stop; i += step {
+		if ignoreNulls && values[i] == nil {
+			continue
+		}
+		n--
+		if n == 0 {
+			return values[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// evalRows evaluates the expression given for each of the rows given.
+func evalRows(ctx *sql.Context, expr sql.Expression, rows []sql.Row) ([]interface{}, error) {
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		var err error
+		values[i], err = expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// evalPositiveInt evaluates the argument given of the window function named, which must be a positive integer
+// literal, or a non-negative one when zero is allowed.
+func evalPositiveInt(ctx *sql.Context, name string, expr sql.Expression, allowZero bool) (int, error) {
+	if _, ok := expr.(*expression.Literal); !ok {
+		return 0, sql.ErrInvalidArgument.New(name)
+	}
+
+	v, err := expr.Eval(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if v == nil {
+		return 0, sql.ErrInvalidArgument.New(name)
+	}
+
+	n, err := sql.Int64.Convert(v)
+	if err != nil {
+		return 0, sql.ErrInvalidArgument.New(name)
+	}
+	if i := n.(int64); i > 0 || i == 0 && allowZero {
+		return int(i), nil
+	}
+	return 0, sql.ErrInvalidArgument.New(name)
+}
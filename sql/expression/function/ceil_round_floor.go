@@ -87,6 +87,14 @@ func (c *Ceil) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Ceil(child.(float64))), nil
 	}
 
+	if sql.IsDecimal(c.Child.Type()) {
+		dec, err := sql.InternalDecimalType.ConvertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return dec.Decimal.Ceil().StringFixed(0), nil
+	}
+
 	if !sql.IsFloat(c.Child.Type()) {
 		return child, err
 	}
@@ -165,6 +173,14 @@ func (f *Floor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Floor(child.(float64))), nil
 	}
 
+	if sql.IsDecimal(f.Child.Type()) {
+		dec, err := sql.InternalDecimalType.ConvertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return dec.Decimal.Floor().StringFixed(0), nil
+	}
+
 	if !sql.IsFloat(f.Child.Type()) {
 		return child, err
 	}
@@ -286,6 +302,18 @@ func (r *Round) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Round(xNum*math.Pow(10.0, dVal)) / math.Pow(10.0, dVal)), nil
 	}
 
+	if sql.IsDecimal(r.Left.Type()) {
+		dec, err := sql.InternalDecimalType.ConvertToDecimal(xVal)
+		if err != nil {
+			return nil, err
+		}
+		places := int32(dVal)
+		if places < 0 {
+			return dec.Decimal.Round(places).StringFixed(0), nil
+		}
+		return dec.Decimal.Round(places).StringFixed(places), nil
+	}
+
 	switch xNum := xVal.(type) {
 	case float64:
 		return math.Round(xNum*math.Pow(10.0, dVal)) / math.Pow(10.0, dVal), nil
@@ -40,6 +40,7 @@ func TestCeil(t *testing.T) {
 		{"int32 is ok", sql.Int32, sql.NewRow(int32(6)), int32(6), nil},
 		{"int64 is nil", sql.Int64, sql.NewRow(nil), nil, nil},
 		{"int64 is ok", sql.Int64, sql.NewRow(int64(6)), int64(6), nil},
+		{"decimal is ok", sql.MustCreateDecimalType(10, 2), sql.NewRow("-5.80"), "-5", nil},
 		{"blob is nil", sql.Blob, sql.NewRow(nil), nil, nil},
 		{"blob is ok", sql.Blob, sql.NewRow([]byte{1, 2, 3}), int32(0), nil},
 		{"string int is ok", sql.Text, sql.NewRow("1"), int32(1), nil},
@@ -71,6 +72,9 @@ func TestCeil(t *testing.T) {
 			case sql.IsInteger(tt.rowType):
 				require.True(sql.IsInteger(f.Type()))
 				require.False(f.IsNullable())
+			case sql.IsDecimal(tt.rowType):
+				require.True(sql.IsDecimal(f.Type()))
+				require.False(f.IsNullable())
 			default:
 				require.True(sql.IsInteger(f.Type()))
 				require.False(f.IsNullable())
@@ -95,6 +99,7 @@ func TestFloor(t *testing.T) {
 		{"int32 is ok", sql.Int32, sql.NewRow(int32(6)), int32(6), nil},
 		{"int64 is nil", sql.Int64, sql.NewRow(nil), nil, nil},
 		{"int64 is ok", sql.Int64, sql.NewRow(int64(6)), int64(6), nil},
+		{"decimal is ok", sql.MustCreateDecimalType(10, 2), sql.NewRow("-5.80"), "-6", nil},
 		{"blob is nil", sql.Blob, sql.NewRow(nil), nil, nil},
 		{"blob is ok", sql.Blob, sql.NewRow([]byte{1, 2, 3}), int32(0), nil},
 		{"string int is ok", sql.Text, sql.NewRow("1"), int32(1), nil},
@@ -126,6 +131,9 @@ func TestFloor(t *testing.T) {
 			case sql.IsInteger(tt.rowType):
 				require.True(sql.IsInteger(f.Type()))
 				require.False(f.IsNullable())
+			case sql.IsDecimal(tt.rowType):
+				require.True(sql.IsDecimal(f.Type()))
+				require.False(f.IsNullable())
 			default:
 				require.True(sql.IsInteger(f.Type()))
 				require.False(f.IsNullable())
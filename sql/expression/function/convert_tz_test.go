@@ -124,6 +124,41 @@ func TestConvertTz(t *testing.T) {
 			toTimeZone:     "10:00",
 			expectedResult: nil,
 		},
+		{
+			name:           "Named time zones in standard time",
+			datetime:       "2021-01-15 12:00:00",
+			fromTimeZone:   "America/New_York",
+			toTimeZone:     "Asia/Kolkata",
+			expectedResult: time.Date(2021, 1, 15, 22, 30, 0, 0, time.UTC),
+		},
+		{
+			name:           "Named time zones in daylight saving time",
+			datetime:       "2021-07-15 12:00:00",
+			fromTimeZone:   "America/New_York",
+			toTimeZone:     "UTC",
+			expectedResult: time.Date(2021, 7, 15, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Named time zone to offset",
+			datetime:       "2021-07-15 12:00:00",
+			fromTimeZone:   "Europe/Berlin",
+			toTimeZone:     "+05:30",
+			expectedResult: time.Date(2021, 7, 15, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			name:           "Offset out of range returns nil",
+			datetime:       "2021-07-15 12:00:00",
+			fromTimeZone:   "+00:00",
+			toTimeZone:     "+15:00",
+			expectedResult: nil,
+		},
+		{
+			name:           "Time before the TIMESTAMP range isn't converted",
+			datetime:       "1960-01-01 12:00:00",
+			fromTimeZone:   "UTC",
+			toTimeZone:     "America/New_York",
+			expectedResult: time.Date(1960, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
 	}
 
 	for _, test := range tests {
@@ -18,6 +18,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -317,7 +320,13 @@ func NewDatetime(args ...sql.Expression) (sql.Expression, error) {
 	return &DatetimeConversion{args[0]}, nil
 }
 
-// UnixTimestamp converts the argument to the number of seconds since 1970-01-01 00:00:00 UTC.
+// maxUnixTimestamp is the instant of the largest datetime that UNIX_TIMESTAMP() and FROM_UNIXTIME() convert,
+// 3001-01-18 23:59:59.999999 UTC.
+var maxUnixTimestamp = time.Date(3001, time.January, 18, 23, 59, 59, 999999000, time.UTC)
+
+// UnixTimestamp converts the argument to the number of seconds since 1970-01-01 00:00:00 UTC, with a fractional part
+// for the microseconds of the argument. The argument is in the session time zone. Like in MySQL, arguments that aren't
+// valid datetimes or whose instant isn't between the Unix epoch and 3001-01-18 23:59:59.999999 UTC are converted to 0.
 // With no argument, returns number of seconds since unix epoch for the current time.
 type UnixTimestamp struct {
 	Date sql.Expression
@@ -373,7 +382,8 @@ func (ut *UnixTimestamp) WithChildren(children ...sql.Expression) (sql.Expressio
 
 func (ut *UnixTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if ut.Date == nil {
-		return toUnixTimestamp(ctx.QueryTime())
+		// The current time has no fractional part
+		return toUnixTimestamp(ctx.QueryTime().Truncate(time.Second))
 	}
 
 	date, err := ut.Date.Eval(ctx, row)
@@ -385,16 +395,28 @@ func (ut *UnixTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error
 		return nil, nil
 	}
 
-	date, err = sql.Datetime.Convert(date)
+	converted, err := sql.Datetime.Convert(date)
+	if err != nil {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Incorrect datetime value: '%v'", date)
+		return float64(0), nil
+	}
+
+	// The date is in the session time zone
+	t, err := sql.FromSessionTime(ctx, converted.(time.Time))
 	if err != nil {
 		return nil, err
 	}
+	if t.Before(time.Unix(0, 0)) || t.After(maxUnixTimestamp) {
+		return float64(0), nil
+	}
 
-	return toUnixTimestamp(date.(time.Time))
+	return toUnixTimestamp(t)
 }
 
 func toUnixTimestamp(t time.Time) (interface{}, error) {
-	return sql.Float64.Convert(float64(t.Unix()) + float64(t.Nanosecond())/float64(1000000000))
+	// Rounded to microseconds, which is the precision of datetimes
+	micros := t.Round(time.Microsecond).Nanosecond() / int(time.Microsecond)
+	return sql.Float64.Convert(float64(t.Unix()) + float64(micros)/float64(1000000))
 }
 
 func (ut *UnixTimestamp) String() string {
@@ -405,15 +427,30 @@ func (ut *UnixTimestamp) String() string {
 	}
 }
 
-// FromUnixtime converts the argument to a datetime.
+// FromUnixtime converts the number of seconds since 1970-01-01 00:00:00 UTC given, which may have a fractional part,
+// to a datetime in the session time zone. With a format, the datetime is formatted as DATE_FORMAT() does. Like in
+// MySQL, it's NULL for negative numbers of seconds and ones past 3001-01-18 23:59:59.999999 UTC.
 type FromUnixtime struct {
-	*UnaryFunc
+	Timestamp sql.Expression
+	Format    sql.Expression
 }
 
 var _ sql.FunctionExpression = (*FromUnixtime)(nil)
 
-func NewFromUnixtime(arg sql.Expression) sql.Expression {
-	return &FromUnixtime{NewUnaryFunc(arg, "FROM_UNIXTIME", sql.Datetime)}
+func NewFromUnixtime(args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 1:
+		return &FromUnixtime{Timestamp: args[0]}, nil
+	case 2:
+		return &FromUnixtime{Timestamp: args[0], Format: args[1]}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("FROM_UNIXTIME", "1 or 2", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *FromUnixtime) FunctionName() string {
+	return "from_unixtime"
 }
 
 // Description implements sql.FunctionExpression
@@ -421,8 +458,35 @@ func (r *FromUnixtime) Description() string {
 	return "formats Unix timestamp as a date."
 }
 
+// Children implements the Expression interface.
+func (r *FromUnixtime) Children() []sql.Expression {
+	if r.Format != nil {
+		return []sql.Expression{r.Timestamp, r.Format}
+	}
+	return []sql.Expression{r.Timestamp}
+}
+
+// Resolved implements the Expression interface.
+func (r *FromUnixtime) Resolved() bool {
+	return r.Timestamp.Resolved() && (r.Format == nil || r.Format.Resolved())
+}
+
+// IsNullable implements the Expression interface.
+func (r *FromUnixtime) IsNullable() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (r *FromUnixtime) Type() sql.Type {
+	if r.Format != nil {
+		return sql.LongText
+	}
+	return sql.Datetime
+}
+
+// Eval implements the Expression interface.
 func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	val, err := r.EvalChild(ctx, row)
+	val, err := r.Timestamp.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -431,19 +495,59 @@ func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
-	n, err := sql.Int64.Convert(val)
+	n, err := sql.InternalDecimalType.ConvertToDecimal(val)
 	if err != nil {
 		return nil, err
 	}
 
-	return time.Unix(n.(int64), 0), nil
+	// Seconds are rounded to microseconds, which is the precision of datetimes
+	d := n.Decimal.Round(6)
+	if d.IsNegative() {
+		return nil, nil
+	}
+	secs := d.IntPart()
+	micros := d.Sub(decimal.NewFromInt(secs)).Shift(6).IntPart()
+	t := time.Unix(secs, micros*int64(time.Microsecond)).UTC()
+	if t.After(maxUnixTimestamp) {
+		return nil, nil
+	}
+
+	t, err = sql.ToSessionTime(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	if r.Format == nil {
+		return t, nil
+	}
+
+	format, err := r.Format.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if format == nil {
+		return nil, nil
+	}
+	format, err = sql.LongText.Convert(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatDate(format.(string), t)
+}
+
+func (r *FromUnixtime) String() string {
+	if r.Format != nil {
+		return fmt.Sprintf("FROM_UNIXTIME(%s, %s)", r.Timestamp, r.Format)
+	}
+	return fmt.Sprintf("FROM_UNIXTIME(%s)", r.Timestamp)
 }
 
+// WithChildren implements the Expression interface.
 func (r *FromUnixtime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
-	if len(children) != 1 {
-		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
 	}
-	return NewFromUnixtime(children[0]), nil
+	return NewFromUnixtime(children...)
 }
 
 type CurrDate struct {
@@ -470,7 +574,10 @@ func NewCurrentDate() sql.Expression {
 }
 
 func currDateLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t, err := sql.ToSessionTime(ctx, ctx.QueryTime())
+	if err != nil {
+		return nil, err
+	}
 	return fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day()), nil
 }
 
@@ -17,24 +17,21 @@ package function
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/lestrrat-go/strftime"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-func panicIfErr(err error) {
-	if err != nil {
-		panic(err)
-	}
-}
-
 func monthNum(t time.Time) string {
 	return strconv.FormatInt(int64(t.Month()), 10)
 }
 
+func monthNumPadded(t time.Time) string {
+	return fmt.Sprintf("%02d", t.Month())
+}
+
 func dayWithSuffix(t time.Time) string {
 	suffix := "th"
 	day := int64(t.Day())
@@ -56,6 +53,14 @@ func dayOfMonth(t time.Time) string {
 	return strconv.FormatInt(int64(t.Day()), 10)
 }
 
+func dayOfMonthPadded(t time.Time) string {
+	return fmt.Sprintf("%02d", t.Day())
+}
+
+func dayOfYearPadded(t time.Time) string {
+	return fmt.Sprintf("%03d", t.YearDay())
+}
+
 func microsecondsStr(t time.Time) string {
 	micros := t.Nanosecond() / int(time.Microsecond)
 	return fmt.Sprintf("%06d", micros)
@@ -89,163 +94,150 @@ func twelveHourNoPadding(t time.Time) string {
 	return fmt.Sprintf("%d", hour)
 }
 
+func twentyFourHourPadded(t time.Time) string {
+	return fmt.Sprintf("%02d", t.Hour())
+}
+
 func twentyFourHourNoPadding(t time.Time) string {
 	return fmt.Sprintf("%d", t.Hour())
 }
 
+func ampm(t time.Time) string {
+	_, ampm := twelveHour(t)
+	return ampm
+}
+
+// Month and day names are always in English, as with the default lc_time_names of en_US.
 func fullMonthName(t time.Time) string {
 	return t.Month().String()
 }
 
+func abbrevMonthName(t time.Time) string {
+	return t.Month().String()[:3]
+}
+
 func ampmClockStr(t time.Time) string {
 	hour, ampm := twelveHour(t)
 	return fmt.Sprintf("%02d:%02d:%02d %s", hour, t.Minute(), t.Second(), ampm)
 }
 
+func clockStr(t time.Time) string {
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+}
+
 func secondsStr(t time.Time) string {
 	return fmt.Sprintf("%02d", t.Second())
 }
 
-func yearWeek(mode int32, t time.Time) (int32, int32) {
-	yw := YearWeek{expression.NewLiteral(t, sql.Datetime), expression.NewLiteral(mode, sql.Int32)}
-	res, _ := yw.Eval(nil, nil)
-	yr := res.(int32) / 100
-	wk := res.(int32) % 100
-
-	return yr, wk
+// yearWeek returns the year the week of t is counted in and the week itself, as WEEK() does for the given week
+// behaviour.
+func yearWeek(t time.Time, wb weekBehaviour) (int32, int32) {
+	return calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), wb)
 }
 
 func weekMode0(t time.Time) string {
-	yr, wk := yearWeek(0, t)
-
-	if yr < int32(t.Year()) {
-		wk = 0
-	} else if yr > int32(t.Year()) {
-		wk = 53
-	}
-
+	_, wk := yearWeek(t, weekBehaviourFirstWeekday)
 	return fmt.Sprintf("%02d", wk)
 }
 
 func weekMode1(t time.Time) string {
-	yr, wk := yearWeek(1, t)
-
-	if yr < int32(t.Year()) {
-		wk = 0
-	} else if yr > int32(t.Year()) {
-		wk = 53
-	}
-
+	_, wk := yearWeek(t, weekBehaviourMondayFirst)
 	return fmt.Sprintf("%02d", wk)
 }
 
 func weekMode2(t time.Time) string {
-	_, wk := yearWeek(2, t)
+	_, wk := yearWeek(t, weekBehaviourYear|weekBehaviourFirstWeekday)
 	return fmt.Sprintf("%02d", wk)
 }
 
 func weekMode3(t time.Time) string {
-	_, wk := yearWeek(3, t)
+	_, wk := yearWeek(t, weekBehaviourYear|weekBehaviourMondayFirst)
 	return fmt.Sprintf("%02d", wk)
 }
 
 func yearMode0(t time.Time) string {
-	yr, _ := yearWeek(0, t)
-	return strconv.FormatInt(int64(yr), 10)
+	yr, _ := yearWeek(t, weekBehaviourYear|weekBehaviourFirstWeekday)
+	return fmt.Sprintf("%04d", yr)
 }
 
 func yearMode1(t time.Time) string {
-	yr, _ := yearWeek(1, t)
-	return strconv.FormatInt(int64(yr), 10)
+	yr, _ := yearWeek(t, weekBehaviourYear|weekBehaviourMondayFirst)
+	return fmt.Sprintf("%04d", yr)
 }
 
 func dayName(t time.Time) string {
 	return t.Weekday().String()
 }
 
-func yearTwoDigit(t time.Time) string {
-	return strconv.FormatInt(int64(t.Year())%100, 10)
+func abbrevDayName(t time.Time) string {
+	return t.Weekday().String()[:3]
 }
 
-type AppendFuncWrapper struct {
-	fn func(time.Time) string
+func weekdayNum(t time.Time) string {
+	return strconv.FormatInt(int64(t.Weekday()), 10)
 }
 
-func wrap(fn func(time.Time) string) strftime.Appender {
-	return AppendFuncWrapper{fn}
+func yearFourDigit(t time.Time) string {
+	return fmt.Sprintf("%04d", t.Year())
 }
 
-func (af AppendFuncWrapper) Append(bytes []byte, t time.Time) []byte {
-	s := af.fn(t)
-	return append(bytes, []byte(s)...)
+func yearTwoDigit(t time.Time) string {
+	return fmt.Sprintf("%02d", t.Year()%100)
 }
 
-var mysqlDateFormatSpec = strftime.NewSpecificationSet()
 var specifierToFunc = map[byte]func(time.Time) string{
-	'a': nil,
-	'b': nil,
+	'a': abbrevDayName,
+	'b': abbrevMonthName,
 	'c': monthNum,
 	'D': dayWithSuffix,
-	'd': nil,
+	'd': dayOfMonthPadded,
 	'e': dayOfMonth,
 	'f': microsecondsStr,
-	'H': nil,
+	'H': twentyFourHourPadded,
 	'h': twelveHourPadded,
 	'I': twelveHourPadded,
 	'i': minutesStr,
-	'j': nil,
+	'j': dayOfYearPadded,
 	'k': twentyFourHourNoPadding,
 	'l': twelveHourNoPadding,
 	'M': fullMonthName,
-	'm': nil,
-	'p': nil,
+	'm': monthNumPadded,
+	'p': ampm,
 	'r': ampmClockStr,
-	'S': nil,
+	'S': secondsStr,
 	's': secondsStr,
-	'T': nil,
+	'T': clockStr,
 	'U': weekMode0,
 	'u': weekMode1,
 	'V': weekMode2,
 	'v': weekMode3,
 	'W': dayName,
-	'w': nil,
+	'w': weekdayNum,
 	'X': yearMode0,
 	'x': yearMode1,
-	'Y': nil,
+	'Y': yearFourDigit,
 	'y': yearTwoDigit,
 }
 
-func init() {
-	for specifier, fn := range specifierToFunc {
-		if fn != nil {
-			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(fn)))
+// formatDate formats t as DATE_FORMAT does. Any character following a % that isn't a specifier is written as is,
+// which also covers %%, and so is a % ending the format.
+func formatDate(format string, t time.Time) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			sb.WriteByte(format[i])
+			continue
 		}
-	}
 
-	// replace any strftime specifiers that aren't supported
-	fn := func(b byte) {
-		if _, ok := specifierToFunc[b]; !ok {
-			panicIfErr(mysqlDateFormatSpec.Set(b, wrap(func(time.Time) string {
-				return string(b)
-			})))
+		i++
+		if fn, ok := specifierToFunc[format[i]]; ok {
+			sb.WriteString(fn(t))
+		} else {
+			sb.WriteByte(format[i])
 		}
 	}
 
-	capToLower := byte('a' - 'A')
-	for i := byte('A'); i <= 'Z'; i++ {
-		fn(i)
-		fn(i + capToLower)
-	}
-}
-
-func formatDate(format string, t time.Time) (string, error) {
-	formatter, err := strftime.New(format, strftime.WithSpecificationSet(mysqlDateFormatSpec))
-
-	if err != nil {
-		return "", err
-	}
-
-	return formatter.FormatString(t), nil
+	return sb.String(), nil
 }
 
 // DateFormat function returns a string representation of the date specified in the format specified
@@ -307,13 +299,12 @@ func (f *DateFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	formatStr, ok := right.(string)
-
-	if !ok {
+	formatStr, err := sql.LongText.Convert(right)
+	if err != nil {
 		return nil, ErrInvalidArgument.New("DATE_FORMAT", "format must be a string")
 	}
 
-	return formatDate(formatStr, t)
+	return formatDate(formatStr.(string), t)
 }
 
 // Type implements the Expression interface.
@@ -81,6 +81,48 @@ func TestDateFormatting(t *testing.T) {
 	}
 }
 
+func TestDateFormattingEdgeCases(t *testing.T) {
+	tests := []struct {
+		date      time.Time
+		formatStr string
+		expected  string
+	}{
+		{time.Date(1999, 12, 31, 23, 59, 59, 999999000, time.UTC), "%a %b %j %f", "Fri Dec 365 999999"},
+		{time.Date(1999, 12, 31, 23, 59, 59, 999999000, time.UTC), "%h %I %l %k %H %p", "11 11 11 23 23 PM"},
+		{time.Date(1999, 12, 31, 23, 59, 59, 999999000, time.UTC), "%r %T", "11:59:59 PM 23:59:59"},
+		{time.Date(1999, 12, 31, 23, 59, 59, 999999000, time.UTC), "%U %u %V %v %X %x", "52 52 52 52 1999 1999"},
+		{time.Date(2020, 12, 31, 12, 0, 0, 0, time.UTC), "%j %h %l %p %r", "366 12 12 PM 12:00:00 PM"},
+		{time.Date(2020, 12, 31, 12, 0, 0, 0, time.UTC), "%U %u %V %v %X %x", "52 53 52 53 2020 2020"},
+		{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "%j %h %l %k %H %p %r", "001 12 12 0 00 AM 12:00:00 AM"},
+		{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "%U %u %V %v %X %x", "00 00 52 53 2020 2020"},
+		{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "%D %e %d %c %m %w %W", "1st 1 01 1 01 5 Friday"},
+		{time.Date(2005, 3, 2, 0, 0, 0, 0, time.UTC), "%D %y %a %b %M %W %w", "2nd 05 Wed Mar March Wednesday 3"},
+		{time.Date(2005, 3, 11, 0, 0, 0, 0, time.UTC), "%D", "11th"},
+		{time.Date(2005, 3, 12, 0, 0, 0, 0, time.UTC), "%D", "12th"},
+		{time.Date(2005, 3, 13, 0, 0, 0, 0, time.UTC), "%D", "13th"},
+		{time.Date(2005, 3, 22, 0, 0, 0, 0, time.UTC), "%D", "22nd"},
+		{time.Date(2005, 3, 23, 0, 0, 0, 0, time.UTC), "%D", "23rd"},
+		{time.Date(2005, 3, 31, 0, 0, 0, 0, time.UTC), "%D", "31st"},
+		{time.Date(999, 9, 9, 9, 9, 9, 0, time.UTC), "%Y %y %j", "0999 99 252"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "", ""},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "%", "%"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "100%%", "100%"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "%%Y", "%Y"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "%Y%", "2020%"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "%1 %- %Q", "1 - Q"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "%Y年%m月%d日", "2020年02月03日"},
+		{time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC), "week %v of %x", "week 06 of 2020"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.date.String()+test.formatStr, func(t *testing.T) {
+			result, err := formatDate(test.formatStr, test.date)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func TestUnsupportedSpecifiers(t *testing.T) {
 	testFunc := func(t *testing.T, b byte) {
 		if _, ok := specifierToFunc[b]; !ok {
@@ -169,6 +211,11 @@ func TestDateFormatEval(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "2020-02-03 04:05:06.000007", res)
 
+	dateFormat = NewDateFormat(dateLit, expression.NewLiteral(int64(2020), sql.Int64))
+	res, err = dateFormat.Eval(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "2020", res)
+
 	dateFormat = NewDateFormat(dateLit, nil)
 	res, err = dateFormat.Eval(nil, nil)
 	assert.NoError(t, err)
@@ -147,14 +147,83 @@ func TestUnixTimestamp(t *testing.T) {
 	result, err = ut.Eval(ctx, nil)
 	require.NoError(err)
 	require.Equal(expected, result)
+
+	testCases := []struct {
+		date     interface{}
+		expected float64
+	}{
+		{"2018-05-02 10:20:30.123456", 1525256430.123456},
+		{time.Date(2018, 5, 2, 10, 20, 30, 500000000, time.UTC), 1525256430.5},
+		{"1970-01-01 00:00:00", 0},
+		{"1969-12-31 23:59:59", 0},
+		{"3001-01-18 23:59:59.999999", 32536771199.999999},
+		{"3001-01-19 00:00:00", 0},
+	}
+	for _, tt := range testCases {
+		ut, err = NewUnixTimestamp(expression.NewLiteral(tt.date, sql.LongText))
+		require.NoError(err)
+		result, err = ut.Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(tt.expected, result, "UNIX_TIMESTAMP(%v)", tt.date)
+	}
+
+	ut, err = NewUnixTimestamp(expression.NewLiteral("not a date", sql.LongText))
+	require.NoError(err)
+	result, err = ut.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(float64(0), result)
+	require.Equal(uint16(1), ctx.WarningCount())
 }
 
 func TestFromUnixtime(t *testing.T) {
 	require := require.New(t)
+	ctx := sql.NewEmptyContext()
 
-	_, err := NewUnixTimestamp(expression.NewLiteral(0, sql.Int64))
+	_, err := NewFromUnixtime()
+	require.Error(err)
+
+	testCases := []struct {
+		timestamp interface{}
+		format    interface{}
+		expected  interface{}
+	}{
+		{int64(0), nil, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{int64(1447430881), nil, time.Date(2015, 11, 13, 16, 8, 1, 0, time.UTC)},
+		{1447430881.123456, nil, time.Date(2015, 11, 13, 16, 8, 1, 123456000, time.UTC)},
+		{"1447430881.5", nil, time.Date(2015, 11, 13, 16, 8, 1, 500000000, time.UTC)},
+		{"32536771199.999999", nil, time.Date(3001, 1, 18, 23, 59, 59, 999999000, time.UTC)},
+		{"32536771200", nil, nil},
+		{int64(-1), nil, nil},
+		{nil, nil, nil},
+		{int64(1447430881), "%Y %D %M %h:%i:%s %x", "2015 13th November 04:08:01 2015"},
+		{1447430881.25, "%s.%f", "01.250000"},
+		{int64(1447430881), nil, time.Date(2015, 11, 13, 16, 8, 1, 0, time.UTC)},
+	}
+	for _, tt := range testCases {
+		args := []sql.Expression{expression.NewLiteral(tt.timestamp, sql.LongText)}
+		if tt.format != nil {
+			args = append(args, expression.NewLiteral(tt.format, sql.LongText))
+		}
+		f, err := NewFromUnixtime(args...)
+		require.NoError(err)
+		result, err := f.Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(tt.expected, result, "%s", f)
+	}
+
+	f, err := NewFromUnixtime(expression.NewLiteral(int64(0), sql.Int64), expression.NewLiteral(nil, sql.Null))
+	require.NoError(err)
+	result, err := f.Eval(ctx, nil)
 	require.NoError(err)
+	require.Nil(result)
+	require.Equal(sql.LongText, f.Type())
 
-	_, err = NewUnixTimestamp(expression.NewLiteral(1447430881, sql.Int64))
+	// The datetime is in the session time zone
+	require.NoError(ctx.SetSessionVariable(ctx, "time_zone", "+02:00"))
+	f, err = NewFromUnixtime(expression.NewLiteral(int64(0), sql.Int64))
+	require.NoError(err)
+	result, err = f.Eval(ctx, nil)
 	require.NoError(err)
+	require.Equal(time.Date(1970, 1, 1, 2, 0, 0, 0, time.UTC), result)
+	require.Equal(sql.Datetime, f.Type())
 }
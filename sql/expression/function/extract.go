@@ -0,0 +1,159 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrInvalidExtractUnit is returned when the unit of EXTRACT isn't one of the units MySQL supports.
+var ErrInvalidExtractUnit = errors.NewKind("invalid unit of EXTRACT: %s")
+
+// extractUnitParts are the parts of the composite units of EXTRACT, from the most to the least significant.
+var extractUnitParts = map[string][]string{
+	"YEAR_MONTH":         {"YEAR", "MONTH"},
+	"DAY_HOUR":           {"DAY", "HOUR"},
+	"DAY_MINUTE":         {"DAY", "HOUR", "MINUTE"},
+	"DAY_SECOND":         {"DAY", "HOUR", "MINUTE", "SECOND"},
+	"DAY_MICROSECOND":    {"DAY", "HOUR", "MINUTE", "SECOND", "MICROSECOND"},
+	"HOUR_MINUTE":        {"HOUR", "MINUTE"},
+	"HOUR_SECOND":        {"HOUR", "MINUTE", "SECOND"},
+	"HOUR_MICROSECOND":   {"HOUR", "MINUTE", "SECOND", "MICROSECOND"},
+	"MINUTE_SECOND":      {"MINUTE", "SECOND"},
+	"MINUTE_MICROSECOND": {"MINUTE", "SECOND", "MICROSECOND"},
+	"SECOND_MICROSECOND": {"SECOND", "MICROSECOND"},
+}
+
+// Extract is the EXTRACT(unit FROM date) function, which returns the part of a date given by its unit. The parts of
+// composite units, such as DAY_MINUTE, are returned as a single number with their digits one after the other.
+type Extract struct {
+	expression.UnaryExpression
+	Unit string
+}
+
+var _ sql.FunctionExpression = (*Extract)(nil)
+
+// NewExtract creates a new Extract expression of the part of the date given by the unit.
+func NewExtract(unit string, date sql.Expression) (sql.Expression, error) {
+	unit = strings.ToUpper(unit)
+	if _, ok := extractUnitParts[unit]; !ok && !isExtractPart(unit) {
+		return nil, ErrInvalidExtractUnit.New(unit)
+	}
+	return &Extract{expression.UnaryExpression{Child: date}, unit}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Extract) FunctionName() string {
+	return "extract"
+}
+
+// Description implements sql.FunctionExpression
+func (e *Extract) Description() string {
+	return "returns the part of the given date given by the unit."
+}
+
+func (e *Extract) String() string { return fmt.Sprintf("EXTRACT(%s FROM %s)", e.Unit, e.Child) }
+
+// Type implements the Expression interface.
+func (e *Extract) Type() sql.Type { return sql.Int64 }
+
+// Eval implements the Expression interface.
+func (e *Extract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	date, err := getDate(ctx, e.UnaryExpression, row)
+	if err != nil || date == nil {
+		return nil, err
+	}
+	t := date.(time.Time)
+
+	parts, ok := extractUnitParts[e.Unit]
+	if !ok {
+		parts = []string{e.Unit}
+	}
+
+	var result int64
+	for _, part := range parts {
+		n, err := extractPart(ctx, part, t)
+		if err != nil {
+			return nil, err
+		}
+		if part == "MICROSECOND" {
+			result = result*1000000 + n
+		} else {
+			result = result*100 + n
+		}
+	}
+	return result, nil
+}
+
+// WithChildren implements the Expression interface.
+func (e *Extract) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return NewExtract(e.Unit, children[0])
+}
+
+func isExtractPart(unit string) bool {
+	switch unit {
+	case "MICROSECOND", "SECOND", "MINUTE", "HOUR", "DAY", "WEEK", "MONTH", "QUARTER", "YEAR":
+		return true
+	default:
+		return false
+	}
+}
+
+// extractPart returns the part of the date given by a unit that isn't composite, using the same functions as the
+// date part functions, such as YEAR and MONTH.
+func extractPart(ctx *sql.Context, unit string, t time.Time) (int64, error) {
+	switch unit {
+	case "MICROSECOND":
+		return int64(t.Nanosecond()) / int64(time.Microsecond), nil
+	case "SECOND":
+		return int64(second(t).(int32)), nil
+	case "MINUTE":
+		return int64(minute(t).(int32)), nil
+	case "HOUR":
+		return int64(hour(t).(int32)), nil
+	case "DAY":
+		return int64(day(t).(int32)), nil
+	case "WEEK":
+		// As in MySQL, weeks are counted with the mode of default_week_format
+		mode, err := ctx.GetSessionVariable(ctx, "default_week_format")
+		if err != nil {
+			return 0, err
+		}
+		i64, err := sql.Int64.Convert(mode)
+		if err != nil {
+			return 0, err
+		}
+		_, week := calcWeek(year(t).(int32), month(t).(int32), day(t).(int32), weekMode(i64.(int64)))
+		return int64(week), nil
+	case "MONTH":
+		return int64(month(t).(int32)), nil
+	case "QUARTER":
+		return int64(month(t).(int32)+2) / 3, nil
+	case "YEAR":
+		return int64(year(t).(int32)), nil
+	default:
+		return 0, ErrInvalidExtractUnit.New(unit)
+	}
+}
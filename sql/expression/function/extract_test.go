@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestExtract(t *testing.T) {
+	const date = "2019-07-02 03:04:05.123456"
+	testCases := []struct {
+		unit     string
+		expected interface{}
+	}{
+		{"MICROSECOND", int64(123456)},
+		{"SECOND", int64(5)},
+		{"MINUTE", int64(4)},
+		{"HOUR", int64(3)},
+		{"DAY", int64(2)},
+		{"WEEK", int64(26)},
+		{"MONTH", int64(7)},
+		{"QUARTER", int64(3)},
+		{"YEAR", int64(2019)},
+		{"SECOND_MICROSECOND", int64(5123456)},
+		{"MINUTE_MICROSECOND", int64(405123456)},
+		{"MINUTE_SECOND", int64(405)},
+		{"HOUR_MICROSECOND", int64(30405123456)},
+		{"HOUR_SECOND", int64(30405)},
+		{"HOUR_MINUTE", int64(304)},
+		{"DAY_MICROSECOND", int64(2030405123456)},
+		{"DAY_SECOND", int64(2030405)},
+		{"DAY_MINUTE", int64(20304)},
+		{"DAY_HOUR", int64(203)},
+		{"year_month", int64(201907)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.unit, func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewExtract(tt.unit, expression.NewGetField(0, sql.LongText, "date", true))
+			require.NoError(err)
+
+			result, err := f.Eval(sql.NewEmptyContext(), sql.Row{date})
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+
+			result, err = f.Eval(sql.NewEmptyContext(), sql.Row{nil})
+			require.NoError(err)
+			require.Nil(result)
+		})
+	}
+
+	t.Run("default_week_format", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+		f, err := NewExtract("WEEK", expression.NewLiteral("2021-01-03", sql.LongText))
+		require.NoError(err)
+
+		result, err := f.Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(int64(1), result)
+
+		require.NoError(ctx.SetSessionVariable(ctx, "default_week_format", int64(1)))
+		result, err = f.Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(int64(0), result)
+	})
+
+	_, err := NewExtract("DAY_YEAR", expression.NewLiteral("2021-01-03", sql.LongText))
+	require.True(t, ErrInvalidExtractUnit.Is(err))
+}
@@ -40,6 +40,9 @@ func compEval(
 	if returnType == sql.Null {
 		return nil, nil
 	}
+	if sql.IsDecimal(returnType) {
+		return compEvalDecimal(returnType, args, ctx, row, cmp)
+	}
 
 	var selectedNum float64
 	var selectedString string
@@ -137,6 +140,45 @@ func compEval(
 	return float64(selectedNum), nil
 }
 
+// compEvalDecimal is used to implement Greatest/Least Eval() for arguments of a decimal return type, which are
+// compared exactly instead of as floats.
+func compEvalDecimal(
+	returnType sql.Type,
+	args []sql.Expression,
+	ctx *sql.Context,
+	row sql.Row,
+	cmp compareFn,
+) (interface{}, error) {
+	var selected interface{}
+	for i, arg := range args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+
+		d, err := returnType.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			selected = d
+			continue
+		}
+
+		c, err := returnType.Compare(d, selected)
+		if err != nil {
+			return nil, err
+		}
+		if cmp(int64(c), int64(0)) {
+			selected = d
+		}
+	}
+	return selected, nil
+}
+
 // compRetType is used to determine the type from args based on the rules described for
 // Greatest/Least
 func compRetType(args ...sql.Expression) (sql.Type, error) {
@@ -147,6 +189,7 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 	allString := true
 	allInt := true
 	allDatetime := true
+	var numType sql.Type
 
 	for _, arg := range args {
 		if !arg.Resolved() {
@@ -158,6 +201,11 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 		} else if sql.IsNumber(argType) {
 			allString = false
 			allDatetime = false
+			if numType == nil {
+				numType = argType
+			} else {
+				numType = sql.NumericCoercionType(numType, argType)
+			}
 			if sql.IsFloat(argType) {
 				allString = false
 				allInt = false
@@ -179,6 +227,10 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 	if allString {
 		return sql.LongText, nil
 	} else if allInt {
+		// Decimals win over integers
+		if sql.IsDecimal(numType) {
+			return numType, nil
+		}
 		return sql.Int64, nil
 	} else if allDatetime {
 		return sql.Datetime, nil
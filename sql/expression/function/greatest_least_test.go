@@ -112,6 +112,31 @@ func TestGreatest(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"decimal and ints",
+			[]sql.Expression{
+				expression.NewLiteral(int8(1), sql.Int8),
+				expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1)),
+			},
+			"2.5",
+		},
+		{
+			"decimals of different scales",
+			[]sql.Expression{
+				expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1)),
+				expression.NewLiteral("2.55", sql.MustCreateDecimalType(3, 2)),
+				expression.NewLiteral(int64(2), sql.Int64),
+			},
+			"2.55",
+		},
+		{
+			"decimal and float",
+			[]sql.Expression{
+				expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1)),
+				expression.NewLiteral(float64(1), sql.Float64),
+			},
+			float64(2.5),
+		},
 	}
 
 	for _, tt := range testCases {
@@ -228,6 +253,23 @@ func TestLeast(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"decimal and ints",
+			[]sql.Expression{
+				expression.NewLiteral(int8(3), sql.Int8),
+				expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1)),
+			},
+			"2.5",
+		},
+		{
+			"decimals of different scales",
+			[]sql.Expression{
+				expression.NewLiteral("2.5", sql.MustCreateDecimalType(2, 1)),
+				expression.NewLiteral("2.45", sql.MustCreateDecimalType(3, 2)),
+				expression.NewLiteral(int64(3), sql.Int64),
+			},
+			"2.45",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -0,0 +1,94 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_ARRAY([val[, val] ...])
+//
+// JSONArray Evaluates a (possibly empty) list of values and returns a JSON array containing those values.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-creation-functions.html#function_json-array
+type JSONArray struct {
+	vals []sql.Expression
+}
+
+var _ sql.FunctionExpression = JSONArray{}
+
+// NewJSONArray creates a new JSONArray function.
+func NewJSONArray(args ...sql.Expression) (sql.Expression, error) {
+	return JSONArray{vals: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j JSONArray) FunctionName() string {
+	return "json_array"
+}
+
+// Description implements sql.FunctionExpression
+func (j JSONArray) Description() string {
+	return "creates JSON array."
+}
+
+// Resolved implements the sql.Expression interface.
+func (j JSONArray) Resolved() bool {
+	return expression.ExpressionsResolved(j.vals...)
+}
+
+func (j JSONArray) String() string {
+	return fmt.Sprintf("JSON_ARRAY(%s)", joinExpressions(j.vals))
+}
+
+// Type implements the sql.Expression interface.
+func (j JSONArray) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j JSONArray) IsNullable() bool {
+	return false
+}
+
+// Eval implements the sql.Expression interface.
+func (j JSONArray) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arr := make([]interface{}, len(j.vals))
+	for i, expr := range j.vals {
+		val, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		arr[i], err = toJSONValue(ctx, expr.Type(), val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.JSONDocument{Val: arr}, nil
+}
+
+// Children implements the sql.Expression interface.
+func (j JSONArray) Children() []sql.Expression {
+	return j.vals
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j JSONArray) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONArray(children...)
+}
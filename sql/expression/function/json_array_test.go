@@ -0,0 +1,70 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONArray(t *testing.T) {
+	require := require.New(t)
+
+	f, err := NewJSONArray(
+		expression.NewGetField(0, sql.Int64, "i", true),
+		expression.NewGetField(1, sql.LongText, "s", true),
+		expression.NewGetField(2, sql.MustCreateDecimalType(10, 2), "d", true),
+		expression.NewGetField(3, sql.Datetime, "t", true),
+		expression.NewGetField(4, sql.JSON, "j", true),
+	)
+	require.NoError(err)
+	require.Equal("JSON_ARRAY(i, s, d, t, j)", f.String())
+
+	result, err := f.Eval(sql.NewEmptyContext(), sql.Row{
+		int64(1),
+		`{"a": 1}`,
+		"1.50",
+		time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		sql.MustJSON(`{"a": 1}`),
+	})
+	require.NoError(err)
+	require.Equal(sql.JSONDocument{Val: []interface{}{
+		int64(1),
+		`{"a": 1}`,
+		json.Number("1.50"),
+		"2021-01-02 03:04:05",
+		map[string]interface{}{"a": float64(1)},
+	}}, result)
+
+	s, err := result.(sql.JSONValue).ToString(sql.NewEmptyContext())
+	require.NoError(err)
+	require.Equal(`[1,"{\"a\": 1}",1.50,"2021-01-02 03:04:05",{"a":1}]`, s)
+
+	result, err = f.Eval(sql.NewEmptyContext(), sql.Row{nil, nil, nil, nil, nil})
+	require.NoError(err)
+	require.Equal(sql.JSONDocument{Val: []interface{}{nil, nil, nil, nil, nil}}, result)
+
+	f, err = NewJSONArray()
+	require.NoError(err)
+	result, err = f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal(sql.JSONDocument{Val: []interface{}{}}, result)
+}
@@ -76,11 +76,6 @@ func (j *JSONContains) Description() string {
 	return "returns whether JSON document contains specific object at path."
 }
 
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONContains) IsUnsupported() bool {
-	return true
-}
-
 func (j *JSONContains) Resolved() bool {
 	for _, child := range j.Children() {
 		if child != nil && !child.Resolved() {
@@ -107,35 +102,32 @@ func (j *JSONContains) Type() sql.Type {
 }
 
 func (j *JSONContains) IsNullable() bool {
-	return j.JSONTarget.IsNullable() || j.JSONCandidate.IsNullable() || j.Path.IsNullable()
+	return j.JSONTarget.IsNullable() || j.JSONCandidate.IsNullable() || j.Path != nil && j.Path.IsNullable()
 }
 
 func (j *JSONContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	target, err := getSearchableJSONVal(ctx, row, j.JSONTarget)
-	if err != nil {
+	if err != nil || target == nil {
 		return nil, err
 	}
 
 	candidate, err := getSearchableJSONVal(ctx, row, j.JSONCandidate)
-	if err != nil {
+	if err != nil || candidate == nil {
 		return nil, err
 	}
 
 	// If there's path reevaluate target based off of this path
 	if j.Path != nil {
-		// Evaluate the given path if there is one
-		path, err := j.Path.Eval(ctx, row)
-		if err != nil {
+		path, err := getJSONPath(ctx, row, j.Path)
+		if err != nil || path == nil {
 			return nil, err
 		}
-
-		path, err = sql.LongText.Convert(path)
-		if err != nil {
-			return nil, err
+		if path.HasWildcards() {
+			return nil, sql.ErrJSONPathWildcard.New()
 		}
 
-		result, err := target.Extract(ctx, path.(string))
-		if err != nil {
+		result, err := target.Extract(ctx, path.String())
+		if err != nil || result == nil {
 			return nil, err
 		}
 
@@ -149,9 +141,10 @@ func (j *JSONContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return target.Contains(ctx, candidate)
 }
 
+// getSearchableJSONVal evaluates the JSON document given, returning nil if it's NULL.
 func getSearchableJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (sql.SearchableJSONValue, error) {
 	js, err := json.Eval(ctx, row)
-	if err != nil {
+	if err != nil || js == nil {
 		return nil, err
 	}
 
@@ -162,7 +155,7 @@ func getSearchableJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (s
 
 	searchable, ok := converted.(sql.SearchableJSONValue)
 	if !ok {
-		searchable, err = js.(sql.JSONValue).Unmarshall(ctx)
+		searchable, err = converted.(sql.JSONValue).Unmarshall(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -171,6 +164,21 @@ func getSearchableJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (s
 	return searchable, nil
 }
 
+// getJSONPath evaluates the JSON path given, returning nil if it's NULL.
+func getJSONPath(ctx *sql.Context, row sql.Row, path sql.Expression) (*sql.JSONPath, error) {
+	p, err := path.Eval(ctx, row)
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	p, err = sql.LongText.Convert(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.ParseJSONPath(p.(string))
+}
+
 func (j *JSONContains) Children() []sql.Expression {
 	if j.Path != nil {
 		return []sql.Expression{j.JSONTarget, j.JSONCandidate, j.Path}
@@ -0,0 +1,152 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_CONTAINS_PATH(json_doc, one_or_all, path[, path] ...)
+//
+// JSONContainsPath Returns 0 or 1 to indicate whether a JSON document contains data at a given path or paths. Returns
+// NULL if any argument is NULL. An error occurs if the json_doc argument is not a valid JSON document, any path
+// argument is not a valid path expression, or one_or_all is not 'one' or 'all'. To check for a specific value at a
+// path, use JSON_CONTAINS() instead.
+//
+// The return value is 0 if no specified path exists within the document. Otherwise, the return value depends on the
+// one_or_all argument:
+//   - 'one': 1 if at least one path exists within the document, 0 otherwise.
+//   - 'all': 1 if all paths exist within the document, 0 otherwise.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-contains-path
+type JSONContainsPath struct {
+	JSON     sql.Expression
+	OneOrAll sql.Expression
+	Paths    []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*JSONContainsPath)(nil)
+
+// NewJSONContainsPath creates a new JSONContainsPath function.
+func NewJSONContainsPath(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_CONTAINS_PATH", "3 or more", len(args))
+	}
+
+	return &JSONContainsPath{args[0], args[1], args[2:]}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j *JSONContainsPath) FunctionName() string {
+	return "json_contains_path"
+}
+
+// Description implements sql.FunctionExpression
+func (j *JSONContainsPath) Description() string {
+	return "returns whether JSON document contains any data at path."
+}
+
+// Resolved implements the sql.Expression interface.
+func (j *JSONContainsPath) Resolved() bool {
+	return expression.ExpressionsResolved(j.Children()...)
+}
+
+func (j *JSONContainsPath) String() string {
+	return fmt.Sprintf("JSON_CONTAINS_PATH(%s)", joinExpressions(j.Children()))
+}
+
+// Type implements the sql.Expression interface.
+func (j *JSONContainsPath) Type() sql.Type {
+	return sql.Boolean
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j *JSONContainsPath) IsNullable() bool {
+	return true
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JSONContainsPath) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	target, err := getSearchableJSONVal(ctx, row, j.JSON)
+	if err != nil || target == nil {
+		return nil, err
+	}
+
+	all, err := evalOneOrAll(ctx, row, j.OneOrAll, j.FunctionName())
+	if err != nil || all == nil {
+		return nil, err
+	}
+
+	doc, err := target.Unmarshall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]*sql.JSONPath, len(j.Paths))
+	for i, p := range j.Paths {
+		paths[i], err = getJSONPath(ctx, row, p)
+		if err != nil || paths[i] == nil {
+			return nil, err
+		}
+	}
+
+	for _, path := range paths {
+		found := len(path.Find(doc.Val)) > 0
+		if found != *all {
+			return found, nil
+		}
+	}
+
+	return *all, nil
+}
+
+// evalOneOrAll evaluates the one_or_all argument of the JSON function given, returning whether it's 'all', or nil if
+// it's NULL.
+func evalOneOrAll(ctx *sql.Context, row sql.Row, oneOrAll sql.Expression, funcName string) (*bool, error) {
+	val, err := oneOrAll.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	var all bool
+	switch strings.ToLower(val.(string)) {
+	case "one":
+		all = false
+	case "all":
+		all = true
+	default:
+		return nil, sql.ErrInvalidJSONOneOrAll.New(funcName)
+	}
+	return &all, nil
+}
+
+// Children implements the sql.Expression interface.
+func (j *JSONContainsPath) Children() []sql.Expression {
+	return append([]sql.Expression{j.JSON, j.OneOrAll}, j.Paths...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JSONContainsPath) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONContainsPath(children...)
+}
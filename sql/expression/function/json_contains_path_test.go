@@ -0,0 +1,76 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONContainsPath(t *testing.T) {
+	_, err := NewJSONContainsPath(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+	)
+	require.Error(t, err)
+
+	f, err := NewJSONContainsPath(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+		expression.NewGetField(2, sql.LongText, "arg3", false),
+		expression.NewGetField(3, sql.LongText, "arg4", false),
+	)
+	require.NoError(t, err)
+
+	json := `{"a": 1, "b": 2, "c": {"d": 4}}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      error
+	}{
+		{f, sql.Row{json, "one", "$.a", "$.e"}, true, nil},
+		{f, sql.Row{json, "all", "$.a", "$.e"}, false, nil},
+		{f, sql.Row{json, "ALL", "$.a", "$.c.d"}, true, nil},
+		{f, sql.Row{json, "one", "$.e", "$.c.e"}, false, nil},
+		{f, sql.Row{json, "one", "$.e", "$.*"}, true, nil},
+		{f, sql.Row{json, "all", "$.e", "$**.d"}, false, nil},
+		{f, sql.Row{nil, "one", "$.a", "$.e"}, nil, nil},
+		{f, sql.Row{json, nil, "$.a", "$.e"}, nil, nil},
+		{f, sql.Row{json, "one", "$.a", nil}, nil, nil},
+		{f, sql.Row{json, "some", "$.a", "$.e"}, nil, sql.ErrInvalidJSONOneOrAll.New("json_contains_path")},
+		{f, sql.Row{json, "one", "$.a", "a"}, nil, sql.ErrInvalidJSONPath.New(1)},
+		{f, sql.Row{"{", "one", "$.a", "$.e"}, nil, sql.ErrInvalidJSONText.New("{")},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			require := require.New(t)
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err == nil {
+				require.NoError(err)
+			} else {
+				require.Equal(tt.err.Error(), err.Error())
+			}
+
+			require.Equal(tt.expected, result)
+		})
+	}
+}
@@ -51,11 +51,6 @@ func (j *JSONExtract) Description() string {
 	return "returns data from JSON document"
 }
 
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONExtract) IsUnsupported() bool {
-	return true
-}
-
 // Resolved implements the sql.Expression interface.
 func (j *JSONExtract) Resolved() bool {
 	for _, p := range j.Paths {
@@ -75,7 +70,7 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	defer span.Finish()
 
 	js, err := j.JSON.Eval(ctx, row)
-	if err != nil {
+	if err != nil || js == nil {
 		return nil, err
 	}
 
@@ -92,10 +87,10 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
-	var results = make([]sql.JSONValue, len(j.Paths))
+	paths := make([]string, len(j.Paths))
 	for i, p := range j.Paths {
 		path, err := p.Eval(ctx, row)
-		if err != nil {
+		if err != nil || path == nil {
 			return nil, err
 		}
 
@@ -103,18 +98,34 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		paths[i] = path.(string)
+	}
 
-		results[i], err = searchable.Extract(ctx, path.(string))
-		if err != nil {
+	if len(paths) == 1 {
+		result, err := searchable.Extract(ctx, paths[0])
+		if err != nil || result == nil {
 			return nil, err
 		}
+		return result, nil
 	}
 
-	if len(results) == 1 {
-		return results[0], nil
+	// With several paths, the values they all select are returned in an array, or NULL if they select nothing
+	doc, err := searchable.Unmarshall(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	return sql.ConcatenateJSONValues(ctx, results...)
+	var matches []interface{}
+	for _, path := range paths {
+		p, err := sql.ParseJSONPath(path)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, p.Find(doc.Val)...)
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return sql.JSONDocument{Val: matches}, nil
 }
 
 // IsNullable implements the sql.Expression interface.
@@ -15,7 +15,7 @@
 package function
 
 import (
-	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -72,15 +72,24 @@ func TestJSONExtract(t *testing.T) {
 		expected interface{}
 		err      error
 	}{
-		{f2, sql.Row{json, "FOO"}, nil, errors.New("should start with '$'")},
-		{f2, sql.Row{nil, "$.b.c"}, sql.JSONDocument{Val: nil}, nil},
-		{f2, sql.Row{json, "$.foo"}, sql.JSONDocument{Val: nil}, nil},
+		{f2, sql.Row{json, "FOO"}, nil, sql.ErrInvalidJSONPath.New(1)},
+		{f2, sql.Row{nil, "$.b.c"}, nil, nil},
+		{f2, sql.Row{json, nil}, nil, nil},
+		{f2, sql.Row{json, "$.foo"}, nil, nil},
 		{f2, sql.Row{json, "$.b.c"}, sql.JSONDocument{Val: "foo"}, nil},
+		{f2, sql.Row{json, "$.a[last]"}, sql.JSONDocument{Val: float64(4)}, nil},
+		{f2, sql.Row{json, "$.a[1 to 2]"}, sql.JSONDocument{Val: []interface{}{float64(2), float64(3)}}, nil},
+		{f2, sql.Row{json, "$.b.*"}, sql.JSONDocument{Val: []interface{}{"foo", true}}, nil},
+		{f2, sql.Row{json, "$.e[*][0]"}, sql.JSONDocument{Val: []interface{}{float64(1), float64(3)}}, nil},
+		{f2, sql.Row{json, "$**.c"}, sql.JSONDocument{Val: []interface{}{"foo"}}, nil},
+		{f2, sql.Row{json, "$.b[0].c"}, sql.JSONDocument{Val: "foo"}, nil},
 		{f3, sql.Row{json, "$.b.c", "$.b.d"}, sql.JSONDocument{Val: []interface{}{"foo", true}}, nil},
+		{f3, sql.Row{json, "$.foo", "$.bar"}, nil, nil},
 		{f4, sql.Row{json, "$.b.c", "$.b.d", "$.e[0][*]"}, sql.JSONDocument{Val: []interface{}{
 			"foo",
 			true,
-			[]interface{}{1., 2.},
+			1.,
+			2.,
 		}}, nil},
 
 		{f2, sql.Row{json, `$.f."key.with.dots"`}, sql.JSONDocument{Val: 0}, nil},
@@ -99,7 +108,7 @@ func TestJSONExtract(t *testing.T) {
 	for _, tt := range testCases {
 		var paths []string
 		for _, path := range tt.row[1:] {
-			paths = append(paths, path.(string))
+			paths = append(paths, fmt.Sprint(path))
 		}
 
 		t.Run(tt.f.String()+"."+strings.Join(paths, ","), func(t *testing.T) {
@@ -108,7 +117,7 @@ func TestJSONExtract(t *testing.T) {
 			if tt.err == nil {
 				require.NoError(err)
 			} else {
-				require.Error(tt.err, err)
+				require.EqualError(err, tt.err.Error())
 			}
 
 			require.Equal(tt.expected, result)
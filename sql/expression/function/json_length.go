@@ -0,0 +1,134 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_LENGTH(json_doc[, path])
+//
+// JSONLength Returns the length of a JSON document, or, if a path argument is given, the length of the value within
+// the document identified by the path. Returns NULL if any argument is NULL or the path argument does not identify a
+// value in the document. An error occurs if the json_doc argument is not a valid JSON document or the path argument is
+// not a valid path expression or contains a * or ** wildcard. The length of a document is determined as follows:
+//   - The length of a scalar is 1.
+//   - The length of an array is the number of array elements.
+//   - The length of an object is the number of object members.
+//   - The length does not count the length of nested arrays or objects.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-attribute-functions.html#function_json-length
+type JSONLength struct {
+	JSON sql.Expression
+	Path sql.Expression
+}
+
+var _ sql.FunctionExpression = (*JSONLength)(nil)
+
+// NewJSONLength creates a new JSONLength function.
+func NewJSONLength(args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 1:
+		return &JSONLength{args[0], nil}, nil
+	case 2:
+		return &JSONLength{args[0], args[1]}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_LENGTH", "1 or 2", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j *JSONLength) FunctionName() string {
+	return "json_length"
+}
+
+// Description implements sql.FunctionExpression
+func (j *JSONLength) Description() string {
+	return "returns length of JSON object."
+}
+
+// Resolved implements the sql.Expression interface.
+func (j *JSONLength) Resolved() bool {
+	return expression.ExpressionsResolved(j.Children()...)
+}
+
+func (j *JSONLength) String() string {
+	return fmt.Sprintf("JSON_LENGTH(%s)", joinExpressions(j.Children()))
+}
+
+// Type implements the sql.Expression interface.
+func (j *JSONLength) Type() sql.Type {
+	return sql.Int32
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j *JSONLength) IsNullable() bool {
+	return true
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JSONLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	target, err := getSearchableJSONVal(ctx, row, j.JSON)
+	if err != nil || target == nil {
+		return nil, err
+	}
+
+	doc, err := target.Unmarshall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	val := doc.Val
+	if j.Path != nil {
+		path, err := getJSONPath(ctx, row, j.Path)
+		if err != nil || path == nil {
+			return nil, err
+		}
+		if path.HasWildcards() {
+			return nil, sql.ErrJSONPathWildcard.New()
+		}
+
+		matches := path.Find(val)
+		if len(matches) == 0 {
+			return nil, nil
+		}
+		val = matches[0]
+	}
+
+	switch v := val.(type) {
+	case []interface{}:
+		return int32(len(v)), nil
+	case map[string]interface{}:
+		return int32(len(v)), nil
+	default:
+		return int32(1), nil
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (j *JSONLength) Children() []sql.Expression {
+	if j.Path != nil {
+		return []sql.Expression{j.JSON, j.Path}
+	}
+	return []sql.Expression{j.JSON}
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JSONLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONLength(children...)
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONLength(t *testing.T) {
+	_, err := NewJSONLength()
+	require.Error(t, err)
+
+	f, err := NewJSONLength(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+	)
+	require.NoError(t, err)
+
+	f2, err := NewJSONLength(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+	)
+	require.NoError(t, err)
+
+	json := `{"a": 1, "b": {"c": 30}, "d": [1, 2, [3]]}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      error
+	}{
+		{f, sql.Row{json}, int32(3), nil},
+		{f, sql.Row{"[1, 2, {\"a\": 3}]"}, int32(3), nil},
+		{f, sql.Row{`"abc"`}, int32(1), nil},
+		{f, sql.Row{"[]"}, int32(0), nil},
+		{f, sql.Row{nil}, nil, nil},
+		{f2, sql.Row{json, "$.b"}, int32(1), nil},
+		{f2, sql.Row{json, "$.d"}, int32(3), nil},
+		{f2, sql.Row{json, "$.a"}, int32(1), nil},
+		{f2, sql.Row{json, "$.e"}, nil, nil},
+		{f2, sql.Row{json, nil}, nil, nil},
+		{f2, sql.Row{json, "$.*"}, nil, sql.ErrJSONPathWildcard.New()},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			require := require.New(t)
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err == nil {
+				require.NoError(err)
+			} else {
+				require.Equal(tt.err.Error(), err.Error())
+			}
+
+			require.Equal(tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_MERGE_PATCH(json_doc, json_doc[, json_doc] ...)
+//
+// JSONMergePatch Performs an RFC 7396 compliant merge of two or more JSON documents and returns the merged result,
+// without preserving members having duplicate keys. Raises an error if at least one of the documents passed as arguments
+// to this function is not valid. JSONMergePatch performs a merge as follows:
+//   - If the first argument is not an object, the result of the merge is the same as if an empty object had been merged
+//     with the second argument.
+//   - If the second argument is not an object, the result of the merge is the second argument.
+//   - If both arguments are objects, the result of the merge is an object with the members of the first object whose
+//     key isn't in the second object, the members of the second object whose key isn't in the first object and whose
+//     value is not the JSON null literal, and the members whose key is in both objects and whose value in the second
+//     object is not the JSON null literal. The values of the last ones are the results of recursively merging the
+//     value in the first object with the value in the second object.
+//
+// The behavior of JSONMergePatch is the same as that of JSONMergePreserve, with the following two exceptions:
+//   - JSONMergePatch removes any member in the first object with a matching key in the second object, provided that
+//     the value associated with the key in the second object is not JSON null.
+//   - If the second object has a member with a key matching a member in the first object, JSONMergePatch replaces
+//     the value in the first object with the value in the second object, whereas JSONMergePreserve appends the
+//     second value to the first value.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-merge-patch
+type JSONMergePatch struct {
+	docs []sql.Expression
+}
+
+var _ sql.FunctionExpression = JSONMergePatch{}
+
+// NewJSONMergePatch creates a new JSONMergePatch function.
+func NewJSONMergePatch(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_MERGE_PATCH", "2 or more", len(args))
+	}
+	return JSONMergePatch{docs: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j JSONMergePatch) FunctionName() string {
+	return "json_merge_patch"
+}
+
+// Description implements sql.FunctionExpression
+func (j JSONMergePatch) Description() string {
+	return "merges JSON documents, replacing values of duplicate keys"
+}
+
+// Resolved implements the sql.Expression interface.
+func (j JSONMergePatch) Resolved() bool {
+	return expression.ExpressionsResolved(j.docs...)
+}
+
+func (j JSONMergePatch) String() string {
+	return fmt.Sprintf("JSON_MERGE_PATCH(%s)", joinExpressions(j.docs))
+}
+
+// Type implements the sql.Expression interface.
+func (j JSONMergePatch) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j JSONMergePatch) IsNullable() bool {
+	return true
+}
+
+// Eval implements the sql.Expression interface.
+func (j JSONMergePatch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return mergeJSONDocuments(ctx, row, j.docs, mergePatchJSON)
+}
+
+// Children implements the sql.Expression interface.
+func (j JSONMergePatch) Children() []sql.Expression {
+	return j.docs
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j JSONMergePatch) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONMergePatch(children...)
+}
+
+// mergePatchJSON merges the JSON value b into a as described by RFC 7396.
+func mergePatchJSON(a, b interface{}) interface{} {
+	patch, ok := b.(map[string]interface{})
+	if !ok {
+		return b
+	}
+
+	target, _ := a.(map[string]interface{})
+	merged := make(map[string]interface{}, len(target)+len(patch))
+	for k, v := range target {
+		merged[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(merged, k)
+		} else {
+			merged[k] = mergePatchJSON(merged[k], v)
+		}
+	}
+	return merged
+}
+
+// JSON_MERGE_PRESERVE(json_doc, json_doc[, json_doc] ...)
+//
+// JSONMergePreserve Merges two or more JSON documents and returns the merged result. Returns NULL if any argument is
+// NULL. An error occurs if any argument is not a valid JSON document. Merging takes place according to the following
+// rules:
+//   - Adjacent arrays are merged to a single array.
+//   - Adjacent objects are merged to a single object.
+//   - A scalar value is autowrapped as an array and merged as an array.
+//   - An adjacent array and object are merged by autowrapping the object as an array and merging the two arrays.
+//
+// This function was added in MySQL 8.0.3 as a synonym for JSONMerge. The JSONMerge function is now deprecated,
+// and is subject to removal in a future release of MySQL.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-merge-preserve
+type JSONMergePreserve struct {
+	docs []sql.Expression
+}
+
+var _ sql.FunctionExpression = JSONMergePreserve{}
+
+// NewJSONMergePreserve creates a new JSONMergePreserve function.
+func NewJSONMergePreserve(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_MERGE_PRESERVE", "2 or more", len(args))
+	}
+	return JSONMergePreserve{docs: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j JSONMergePreserve) FunctionName() string {
+	return "json_merge_preserve"
+}
+
+// Description implements sql.FunctionExpression
+func (j JSONMergePreserve) Description() string {
+	return "merges JSON documents, preserving duplicate keys."
+}
+
+// Resolved implements the sql.Expression interface.
+func (j JSONMergePreserve) Resolved() bool {
+	return expression.ExpressionsResolved(j.docs...)
+}
+
+func (j JSONMergePreserve) String() string {
+	return fmt.Sprintf("JSON_MERGE_PRESERVE(%s)", joinExpressions(j.docs))
+}
+
+// Type implements the sql.Expression interface.
+func (j JSONMergePreserve) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j JSONMergePreserve) IsNullable() bool {
+	return true
+}
+
+// Eval implements the sql.Expression interface.
+func (j JSONMergePreserve) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return mergeJSONDocuments(ctx, row, j.docs, mergePreserveJSON)
+}
+
+// Children implements the sql.Expression interface.
+func (j JSONMergePreserve) Children() []sql.Expression {
+	return j.docs
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j JSONMergePreserve) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONMergePreserve(children...)
+}
+
+// mergePreserveJSON merges the JSON value b into a, merging the values of the keys both objects have, and
+// concatenating any other values as arrays.
+func mergePreserveJSON(a, b interface{}) interface{} {
+	aObj, aOk := a.(map[string]interface{})
+	bObj, bOk := b.(map[string]interface{})
+	if aOk && bOk {
+		merged := make(map[string]interface{}, len(aObj)+len(bObj))
+		for k, v := range aObj {
+			merged[k] = v
+		}
+		for k, v := range bObj {
+			if existing, ok := merged[k]; ok {
+				merged[k] = mergePreserveJSON(existing, v)
+			} else {
+				merged[k] = v
+			}
+		}
+		return merged
+	}
+
+	var merged []interface{}
+	for _, v := range []interface{}{a, b} {
+		if arr, ok := v.([]interface{}); ok {
+			merged = append(merged, arr...)
+		} else {
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
+
+// mergeJSONDocuments evaluates the JSON documents given and merges them from left to right, returning NULL if any of
+// them is NULL.
+func mergeJSONDocuments(ctx *sql.Context, row sql.Row, docs []sql.Expression, merge func(a, b interface{}) interface{}) (interface{}, error) {
+	var merged interface{}
+	for i, expr := range docs {
+		val, err := expr.Eval(ctx, row)
+		if err != nil || val == nil {
+			return nil, err
+		}
+
+		val, err = sql.JSON.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := val.(sql.JSONValue).Unmarshall(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			merged = doc.Val
+		} else {
+			merged = merge(merged, doc.Val)
+		}
+	}
+
+	return sql.JSONDocument{Val: merged}, nil
+}
+
+func joinExpressions(exprs []sql.Expression) string {
+	parts := make([]string, len(exprs))
+	for i, e := range exprs {
+		parts[i] = e.String()
+	}
+	return strings.Join(parts, ", ")
+}
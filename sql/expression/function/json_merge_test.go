@@ -0,0 +1,92 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONMergePatch(t *testing.T) {
+	_, err := NewJSONMergePatch(expression.NewLiteral(`{}`, sql.LongText))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONMergePatch(
+		expression.NewGetField(0, sql.LongText, "doc1", true),
+		expression.NewGetField(1, sql.JSON, "doc2", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		row      sql.Row
+		expected interface{}
+	}{
+		{sql.Row{`{"a": 1, "b": 2}`, `{"a": 3, "c": 4}`}, sql.MustJSON(`{"a": 3, "b": 2, "c": 4}`)},
+		{sql.Row{`{"a": 1, "b": 2}`, `{"a": null}`}, sql.MustJSON(`{"b": 2}`)},
+		{sql.Row{`{"a": {"b": 1, "c": 2}}`, `{"a": {"b": null, "d": 3}}`}, sql.MustJSON(`{"a": {"c": 2, "d": 3}}`)},
+		{sql.Row{`[1, 2]`, `{"a": null, "b": 1}`}, sql.MustJSON(`{"b": 1}`)},
+		{sql.Row{`{"a": 1}`, `true`}, sql.MustJSON(`true`)},
+		{sql.Row{`{"a": 1}`, sql.MustJSON(`[1]`)}, sql.MustJSON(`[1]`)},
+		{sql.Row{nil, `{}`}, nil},
+		{sql.Row{`{}`, nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(f.String(), func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err = f.Eval(sql.NewEmptyContext(), sql.Row{`{}`, `{`})
+	require.Error(t, err)
+}
+
+func TestJSONMergePreserve(t *testing.T) {
+	_, err := NewJSONMergePreserve(expression.NewLiteral(`{}`, sql.LongText))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONMergePreserve(
+		expression.NewGetField(0, sql.LongText, "doc1", true),
+		expression.NewGetField(1, sql.LongText, "doc2", true),
+		expression.NewGetField(2, sql.LongText, "doc3", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		row      sql.Row
+		expected interface{}
+	}{
+		{sql.Row{`[1, 2]`, `[true, false]`, `"a"`}, sql.MustJSON(`[1, 2, true, false, "a"]`)},
+		{sql.Row{`{"a": 1}`, `{"b": 2}`, `{"a": 3}`}, sql.MustJSON(`{"a": [1, 3], "b": 2}`)},
+		{sql.Row{`{"a": {"b": 1}}`, `{"a": {"b": 2, "c": 3}}`, `{}`}, sql.MustJSON(`{"a": {"b": [1, 2], "c": 3}}`)},
+		{sql.Row{`1`, `2`, `{"a": 1}`}, sql.MustJSON(`[1, 2, {"a": 1}]`)},
+		{sql.Row{`{"a": 1}`, `[2]`, `null`}, sql.MustJSON(`[{"a": 1}, 2, null]`)},
+		{sql.Row{`[1]`, nil, `[2]`}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(f.String(), func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
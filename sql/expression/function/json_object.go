@@ -15,8 +15,10 @@
 package function
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -52,11 +54,6 @@ func (j JSONObject) Description() string {
 	return "creates JSON object."
 }
 
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONObject) IsUnsupported() bool {
-	return true
-}
-
 func (j JSONObject) Resolved() bool {
 	for _, child := range j.Children() {
 		if child != nil && !child.Resolved() {
@@ -96,18 +93,55 @@ func (j JSONObject) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, err
 		}
 		if i%2 == 0 {
+			if val == nil {
+				return nil, sql.ErrJSONObjectAggNullKey.New()
+			}
 			var ok bool
 			if key, ok = val.(string); !ok {
 				return nil, sql.ErrInvalidType.New(expr.Type())
 			}
 		} else {
-			obj[key] = val
+			obj[key], err = toJSONValue(ctx, expr.Type(), val)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	return sql.JSONDocument{Val: obj}, nil
 }
 
+// toJSONValue returns the value of a JSON document representing the SQL value of the type given. JSON values are
+// embedded as they are, and other values become JSON strings unless they're numbers or booleans.
+func toJSONValue(ctx *sql.Context, typ sql.Type, val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case nil, bool:
+		return v, nil
+	case sql.JSONValue:
+		doc, err := v.Unmarshall(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return doc.Val, nil
+	case string:
+		// Decimals are represented as strings, but they're numbers in JSON documents
+		if sql.IsDecimal(typ) {
+			return json.Number(v), nil
+		}
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		s, err := sql.LongText.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return v, nil
+	}
+}
+
 func (j JSONObject) Children() []sql.Expression {
 	return j.keyValPairs
 }
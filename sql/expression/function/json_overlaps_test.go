@@ -0,0 +1,70 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONOverlaps(t *testing.T) {
+	_, err := NewJSONOverlaps(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+	)
+	require.Error(t, err)
+
+	f, err := NewJSONOverlaps(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.JSON, "arg2", false),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      error
+	}{
+		{f, sql.Row{"[1,3,5,7]", "[2,5,7]"}, true, nil},
+		{f, sql.Row{"[1,3,5,7]", "[2,6,8]"}, false, nil},
+		{f, sql.Row{"[[1,2],[3,4],5]", "[1,[2,3],[4,5]]"}, false, nil},
+		{f, sql.Row{"[4,5,6,7]", "6"}, true, nil},
+		{f, sql.Row{`{"a":1,"b":10,"d":10}`, `{"c":1,"e":10,"f":1,"d":10}`}, true, nil},
+		{f, sql.Row{`{"a":1,"b":10,"d":10}`, `{"a":5,"e":10,"f":1,"d":20}`}, false, nil},
+		{f, sql.Row{`{"a":1}`, "1"}, false, nil},
+		{f, sql.Row{`"abc"`, `"abc"`}, true, nil},
+		{f, sql.Row{"5", "6"}, false, nil},
+		{f, sql.Row{nil, "6"}, nil, nil},
+		{f, sql.Row{"[1", "6"}, nil, sql.ErrInvalidJSONText.New("[1")},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			require := require.New(t)
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err == nil {
+				require.NoError(err)
+			} else {
+				require.Equal(tt.err.Error(), err.Error())
+			}
+
+			require.Equal(tt.expected, result)
+		})
+	}
+}
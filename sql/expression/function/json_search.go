@@ -0,0 +1,206 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_SEARCH(json_doc, one_or_all, search_str[, escape_char[, path] ...])
+//
+// JSONSearch Returns the path to the given string within a JSON document. Returns NULL if any of the json_doc,
+// search_str, or path arguments are NULL; no path exists within the document; or search_str is not found. An error
+// occurs if the json_doc argument is not a valid JSON document, any path argument is not a valid path expression,
+// one_or_all is not 'one' or 'all', or escape_char is not a constant expression.
+// The one_or_all argument affects the search as follows:
+//   - 'one': The search terminates after the first match and returns one path string. It is undefined which match is
+//     considered first.
+//   - 'all': The search returns all matching path strings such that no duplicate paths are included. If there are
+//     multiple strings, they are autowrapped as an array. The order of the array elements is undefined.
+//
+// Within the search_str search string argument, the % and _ characters work as for the LIKE operator: % matches any
+// number of characters (including zero characters), and _ matches exactly one character.
+//
+// To specify a literal % or _ character in the search string, precede it by the escape character. The default is \ if
+// the escape_char argument is missing or NULL. Otherwise, escape_char must be a constant that is empty or one character.
+// For more information about matching and escape character behavior, see the description of LIKE in Section 12.8.1,
+// “String Comparison Functions and Operators”: https://dev.mysql.com/doc/refman/8.0/en/string-comparison-functions.html
+// For escape character handling, a difference from the LIKE behavior is that the escape character for JSON_SEARCH()
+// must evaluate to a constant at compile time, not just at execution time. For example, if JSON_SEARCH() is used in a
+// prepared statement and the escape_char argument is supplied using a ? parameter, the parameter value might be
+// constant at execution time, but is not at compile time.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-search
+type JSONSearch struct {
+	JSON     sql.Expression
+	OneOrAll sql.Expression
+	Search   sql.Expression
+	Escape   sql.Expression
+	Paths    []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*JSONSearch)(nil)
+
+// NewJSONSearch creates a new JSONSearch function.
+func NewJSONSearch(args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 0, 1, 2:
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_SEARCH", "3 or more", len(args))
+	case 3:
+		return &JSONSearch{JSON: args[0], OneOrAll: args[1], Search: args[2]}, nil
+	default:
+		return &JSONSearch{args[0], args[1], args[2], args[3], args[4:]}, nil
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j *JSONSearch) FunctionName() string {
+	return "json_search"
+}
+
+// Description implements sql.FunctionExpression
+func (j *JSONSearch) Description() string {
+	return "returns path to the given string within a JSON document."
+}
+
+// Resolved implements the sql.Expression interface.
+func (j *JSONSearch) Resolved() bool {
+	return expression.ExpressionsResolved(j.Children()...)
+}
+
+func (j *JSONSearch) String() string {
+	return fmt.Sprintf("JSON_SEARCH(%s)", joinExpressions(j.Children()))
+}
+
+// Type implements the sql.Expression interface.
+func (j *JSONSearch) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j *JSONSearch) IsNullable() bool {
+	return true
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JSONSearch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	target, err := getSearchableJSONVal(ctx, row, j.JSON)
+	if err != nil || target == nil {
+		return nil, err
+	}
+
+	all, err := evalOneOrAll(ctx, row, j.OneOrAll, j.FunctionName())
+	if err != nil || all == nil {
+		return nil, err
+	}
+
+	search, err := j.Search.Eval(ctx, row)
+	if err != nil || search == nil {
+		return nil, err
+	}
+	search, err = sql.LongText.Convert(search)
+	if err != nil {
+		return nil, err
+	}
+
+	escape := "\\"
+	if j.Escape != nil {
+		e, err := j.Escape.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if e != nil {
+			e, err = sql.LongText.Convert(e)
+			if err != nil {
+				return nil, err
+			}
+			if e.(string) != "" {
+				escape = e.(string)
+			}
+		}
+	}
+
+	doc, err := target.Unmarshall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without paths the whole document is searched
+	vals, locations := []interface{}{doc.Val}, []string{"$"}
+	if len(j.Paths) > 0 {
+		vals, locations = nil, nil
+		for _, p := range j.Paths {
+			path, err := getJSONPath(ctx, row, p)
+			if err != nil || path == nil {
+				return nil, err
+			}
+			matches, paths := path.Locate(doc.Val)
+			vals = append(vals, matches...)
+			locations = append(locations, paths...)
+		}
+	}
+
+	// Strings are matched the way LIKE matches them
+	like := expression.NewLike(
+		expression.NewGetField(0, sql.LongText, "", false),
+		expression.NewLiteral(search, sql.LongText),
+		expression.NewLiteral(escape, sql.LongText),
+	)
+
+	var found []interface{}
+	seen := make(map[string]bool)
+	for i, v := range vals {
+		sql.WalkJSONStrings(v, locations[i], func(s, path string) {
+			if err != nil || seen[path] {
+				return
+			}
+			var ok interface{}
+			ok, err = like.Eval(ctx, sql.Row{s})
+			if ok == true {
+				seen[path] = true
+				found = append(found, path)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(found) == 0:
+		return nil, nil
+	case len(found) == 1 || !*all:
+		return sql.JSONDocument{Val: found[0]}, nil
+	default:
+		return sql.JSONDocument{Val: found}, nil
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (j *JSONSearch) Children() []sql.Expression {
+	children := []sql.Expression{j.JSON, j.OneOrAll, j.Search}
+	if j.Escape != nil {
+		children = append(children, j.Escape)
+	}
+	return append(children, j.Paths...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JSONSearch) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONSearch(children...)
+}
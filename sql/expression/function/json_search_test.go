@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONSearch(t *testing.T) {
+	_, err := NewJSONSearch(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+	)
+	require.Error(t, err)
+
+	f, err := NewJSONSearch(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+		expression.NewGetField(2, sql.LongText, "arg3", false),
+	)
+	require.NoError(t, err)
+
+	f2, err := NewJSONSearch(
+		expression.NewGetField(0, sql.JSON, "arg1", false),
+		expression.NewGetField(1, sql.LongText, "arg2", false),
+		expression.NewGetField(2, sql.LongText, "arg3", false),
+		expression.NewGetField(3, sql.LongText, "arg4", false),
+		expression.NewGetField(4, sql.LongText, "arg5", false),
+	)
+	require.NoError(t, err)
+
+	json := `["abc", [{"k": "10"}, "def"], {"x": "abc"}, {"y": "bcd"}]`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      error
+	}{
+		{f, sql.Row{json, "one", "abc"}, sql.JSONDocument{Val: "$[0]"}, nil},
+		{f, sql.Row{json, "all", "abc"}, sql.JSONDocument{Val: []interface{}{"$[0]", "$[2].x"}}, nil},
+		{f, sql.Row{json, "all", "ghi"}, nil, nil},
+		{f, sql.Row{json, "all", "10"}, sql.JSONDocument{Val: "$[1][0].k"}, nil},
+		{f, sql.Row{json, "all", "%b%"}, sql.JSONDocument{Val: []interface{}{"$[0]", "$[2].x", "$[3].y"}}, nil},
+		{f, sql.Row{json, "all", "ABC"}, nil, nil},
+		{f, sql.Row{nil, "all", "abc"}, nil, nil},
+		{f, sql.Row{json, "all", nil}, nil, nil},
+		{f, sql.Row{json, "any", "abc"}, nil, sql.ErrInvalidJSONOneOrAll.New("json_search")},
+		{f2, sql.Row{json, "all", "10", nil, "$"}, sql.JSONDocument{Val: "$[1][0].k"}, nil},
+		{f2, sql.Row{json, "all", "%b%", nil, "$[3]"}, sql.JSONDocument{Val: "$[3].y"}, nil},
+		{f2, sql.Row{json, "all", "%b%", nil, "$[*].x"}, sql.JSONDocument{Val: "$[2].x"}, nil},
+		{f2, sql.Row{json, "all", "%b%", "", "$[*].x"}, sql.JSONDocument{Val: "$[2].x"}, nil},
+		{f2, sql.Row{json, "all", "%b%", nil, nil}, nil, nil},
+		{f2, sql.Row{`{"a": "10%", "b": "100"}`, "all", "10|%", "|", "$"}, sql.JSONDocument{Val: "$.a"}, nil},
+		{f2, sql.Row{`{"a key": "x"}`, "one", "x", nil, "$"}, sql.JSONDocument{Val: `$."a key"`}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			require := require.New(t)
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err == nil {
+				require.NoError(err)
+			} else {
+				require.Equal(tt.err.Error(), err.Error())
+			}
+
+			require.Equal(tt.expected, result)
+		})
+	}
+}
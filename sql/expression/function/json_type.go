@@ -0,0 +1,147 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// JSON_TYPE(json_val)
+//
+// Returns a utf8mb4 string indicating the type of a JSON value. This can be an object, an array, or a scalar type.
+// JSONType returns NULL if the argument is NULL. An error occurs if the argument is not a valid JSON value
+//
+// https://dev.mysql.com/doc/refman/8.0/en/json-attribute-functions.html#function_json-type
+type JSONType struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*JSONType)(nil)
+
+// NewJSONType creates a new JSONType function.
+func NewJSONType(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_TYPE", 1, len(args))
+	}
+
+	return &JSONType{expression.UnaryExpression{Child: args[0]}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (j *JSONType) FunctionName() string {
+	return "json_type"
+}
+
+// Description implements sql.FunctionExpression
+func (j *JSONType) Description() string {
+	return "returns type of JSON value."
+}
+
+func (j *JSONType) String() string {
+	return fmt.Sprintf("JSON_TYPE(%s)", j.Child)
+}
+
+// Type implements the sql.Expression interface.
+func (j *JSONType) Type() sql.Type {
+	return sql.LongText
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JSONType) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := j.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	// JSON text is decoded keeping its numbers as written, so that integers can be told apart from doubles
+	if s, ok := val.(string); ok {
+		var v interface{}
+		dec := json.NewDecoder(strings.NewReader(s))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return nil, sql.ErrInvalidJSONText.New(s)
+		}
+		if _, err := dec.Token(); err != io.EOF {
+			return nil, sql.ErrInvalidJSONText.New(s)
+		}
+		return jsonTypeName(v), nil
+	}
+
+	converted, err := sql.JSON.Convert(val)
+	if err != nil {
+		return nil, sql.ErrInvalidJSONText.New(val)
+	}
+	doc, err := converted.(sql.JSONValue).Unmarshall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonTypeName(doc.Val), nil
+}
+
+// jsonTypeName returns the name of the JSON type of the value given, as reported by JSON_TYPE.
+func jsonTypeName(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		return "BOOLEAN"
+	case string:
+		return "STRING"
+	case []interface{}:
+		return "ARRAY"
+	case map[string]interface{}:
+		return "OBJECT"
+	case json.Number:
+		if bytes.ContainsAny([]byte(v), ".eE") {
+			return "DOUBLE"
+		}
+		return "INTEGER"
+	case float32:
+		return jsonTypeName(float64(v))
+	case float64:
+		// Parsed JSON numbers are all doubles, so integral ones are reported as integers
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "INTEGER"
+		}
+		return "DOUBLE"
+	case int, int8, int16, int32, int64:
+		return "INTEGER"
+	case uint, uint8, uint16, uint32, uint64:
+		return "UNSIGNED INTEGER"
+	case decimal.Decimal:
+		return "DECIMAL"
+	case time.Time:
+		return "DATETIME"
+	default:
+		return "OPAQUE"
+	}
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JSONType) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONType(children...)
+}
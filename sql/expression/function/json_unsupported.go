@@ -27,45 +27,6 @@ var ErrUnsupportedJSONFunction = errors.NewKind("unsupported JSON function: %s")
 // JSON search functions //
 ///////////////////////////
 
-// JSON_CONTAINS_PATH(json_doc, one_or_all, path[, path] ...)
-//
-// JSONContainsPath Returns 0 or 1 to indicate whether a JSON document contains data at a given path or paths. Returns
-// NULL if any argument is NULL. An error occurs if the json_doc argument is not a valid JSON document, any path
-// argument is not a valid path expression, or one_or_all is not 'one' or 'all'. To check for a specific value at a
-// path, use JSON_CONTAINS() instead.
-//
-// The return value is 0 if no specified path exists within the document. Otherwise, the return value depends on the
-// one_or_all argument:
-//   - 'one': 1 if at least one path exists within the document, 0 otherwise.
-//   - 'all': 1 if all paths exist within the document, 0 otherwise.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-contains-path
-type JSONContainsPath struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONContainsPath{}
-
-// NewJSONContainsPath creates a new JSONContainsPath function.
-func NewJSONContainsPath(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONContainsPath{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONContainsPath) FunctionName() string {
-	return "json_contains_path"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONContainsPath) Description() string {
-	return "returns whether JSON document contains any data at path."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONContainsPath) IsUnsupported() bool {
-	return true
-}
-
 // JSON_KEYS(json_doc[, path])
 //
 // JSONKeys Returns the keys from the top-level value of a JSON object as a JSON array, or, if a path argument is given,
@@ -102,96 +63,6 @@ func (j JSONKeys) IsUnsupported() bool {
 	return true
 }
 
-// JSON_OVERLAPS(json_doc1, json_doc2)
-//
-// JSONOverlaps Compares two JSON documents. Returns true (1) if the two document have any key-value pairs or array
-// elements in common. If both arguments are scalars, the function performs a simple equality test.
-//
-// This function serves as counterpart to JSON_CONTAINS(), which requires all elements of the array searched for to be
-// present in the array searched in. Thus, JSON_CONTAINS() performs an AND operation on search keys, while
-// JSON_OVERLAPS() performs an OR operation.
-//
-// Queries on JSON columns of InnoDB tables using JSON_OVERLAPS() in the WHERE clause can be optimized using
-// multi-valued indexes. Multi-Valued Indexes, provides detailed information and examples.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-overlaps
-type JSONOverlaps struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONOverlaps{}
-
-// NewJSONOverlaps creates a new JSONOverlaps function.
-func NewJSONOverlaps(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONOverlaps{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONOverlaps) FunctionName() string {
-	return "json_overlaps"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONOverlaps) Description() string {
-	return "compares two JSON documents, returns TRUE (1) if these have any key-value pairs or array elements in common, otherwise FALSE (0)."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONOverlaps) IsUnsupported() bool {
-	return true
-}
-
-// JSON_SEARCH(json_doc, one_or_all, search_str[, escape_char[, path] ...])
-//
-// JSONSearch Returns the path to the given string within a JSON document. Returns NULL if any of the json_doc,
-// search_str, or path arguments are NULL; no path exists within the document; or search_str is not found. An error
-// occurs if the json_doc argument is not a valid JSON document, any path argument is not a valid path expression,
-// one_or_all is not 'one' or 'all', or escape_char is not a constant expression.
-// The one_or_all argument affects the search as follows:
-//   - 'one': The search terminates after the first match and returns one path string. It is undefined which match is
-//     considered first.
-//   - 'all': The search returns all matching path strings such that no duplicate paths are included. If there are
-//     multiple strings, they are autowrapped as an array. The order of the array elements is undefined.
-//
-// Within the search_str search string argument, the % and _ characters work as for the LIKE operator: % matches any
-// number of characters (including zero characters), and _ matches exactly one character.
-//
-// To specify a literal % or _ character in the search string, precede it by the escape character. The default is \ if
-// the escape_char argument is missing or NULL. Otherwise, escape_char must be a constant that is empty or one character.
-// For more information about matching and escape character behavior, see the description of LIKE in Section 12.8.1,
-// “String Comparison Functions and Operators”: https://dev.mysql.com/doc/refman/8.0/en/string-comparison-functions.html
-// For escape character handling, a difference from the LIKE behavior is that the escape character for JSON_SEARCH()
-// must evaluate to a constant at compile time, not just at execution time. For example, if JSON_SEARCH() is used in a
-// prepared statement and the escape_char argument is supplied using a ? parameter, the parameter value might be
-// constant at execution time, but is not at compile time.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-search
-type JSONSearch struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONSearch{}
-
-// NewJSONSearch creates a new NewJSONSearch function.
-func NewJSONSearch(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONSearch{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONSearch) FunctionName() string {
-	return "json_search"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONSearch) Description() string {
-	return "path to value within JSON document."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONSearch) IsUnsupported() bool {
-	return true
-}
-
 // JSON_VALUE(json_doc, path)
 //
 // JSONValue Extracts a value from a JSON document at the path given in the specified document, and returns the
@@ -238,37 +109,6 @@ func (j JSONValue) IsUnsupported() bool {
 // JSON creation functions //
 /////////////////////////////
 
-// JSON_ARRAY([val[, val] ...])
-//
-// JSONArray Evaluates a (possibly empty) list of values and returns a JSON array containing those values.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-creation-functions.html#function_json-array
-type JSONArray struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONArray{}
-
-// NewJSONArray creates a new JSONArray function.
-func NewJSONArray(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONArray{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONArray) FunctionName() string {
-	return "json_array"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONArray) Description() string {
-	return "creates JSON array."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONArray) IsUnsupported() bool {
-	return true
-}
-
 // JSON_QUOTE(string)
 //
 // JSONQuote Quotes a string as a JSON value by wrapping it with double quote characters and escaping interior quote and
@@ -423,57 +263,6 @@ func (j JSONInsert) IsUnsupported() bool {
 	return true
 }
 
-// JSON_MERGE_PATCH(json_doc, json_doc[, json_doc] ...)
-//
-// JSONMergePatch Performs an RFC 7396 compliant merge of two or more JSON documents and returns the merged result,
-// without preserving members having duplicate keys. Raises an error if at least one of the documents passed as arguments
-// to this function is not valid. JSONMergePatch performs a merge as follows:
-//   - If the first argument is not an object, the result of the merge is the same as if an empty object had been merged
-//	   with the second argument.
-//   - If the second argument is not an object, the result of the merge is the second argument.
-//   - If both arguments are objects, the result of the merge is an object with the following members:
-//     - All members of the first object which do not have a corresponding member with the same key in the second
-//       object.
-//     - All members of the second object which do not have a corresponding key in the first object, and whose value is
-//       not the JSON null literal.
-//     - All members with a key that exists in both the first and the second object, and whose value in the second
-//       object is not the JSON null literal. The values of these members are the results of recursively merging the
-//       value in the first object with the value in the second object.
-//
-// The behavior of JSONMergePatch is the same as that of JSONMergePreserve, with the following two exceptions:
-//   - JSONMergePatch removes any member in the first object with a matching key in the second object, provided that
-//     the value associated with the key in the second object is not JSON null.
-//   - If the second object has a member with a key matching a member in the first object, JSONMergePatch replaces
-//     the value in the first object with the value in the second object, whereas JSONMergePreserve appends the
-//     second value to the first value.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-merge-patch
-type JSONMergePatch struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONMergePatch{}
-
-// NewJSONMergePatch creates a new JSONMergePatch function.
-func NewJSONMergePatch(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONMergePatch{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONMergePatch) FunctionName() string {
-	return "json_merge_patch"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONMergePatch) Description() string {
-	return "merges JSON documents, replacing values of duplicate keys"
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONMergePatch) IsUnsupported() bool {
-	return true
-}
-
 // JSON_MERGE(json_doc, json_doc[, json_doc] ...)
 //
 // JSONMerge Merges two or more JSON documents. Synonym for JSONMergePreserve(); deprecated in MySQL 8.0.3 and subject
@@ -484,53 +273,6 @@ type JSONMerge struct {
 	sql.Expression
 }
 
-// JSON_MERGE_PRESERVE(json_doc, json_doc[, json_doc] ...)
-//
-// JSONMergePreserve Merges two or more JSON documents and returns the merged result. Returns NULL if any argument is
-// NULL. An error occurs if any argument is not a valid JSON document. Merging takes place according to the following
-// rules:
-//   - Adjacent arrays are merged to a single array.
-//   - Adjacent objects are merged to a single object.
-//   - A scalar value is autowrapped as an array and merged as an array.
-//   - An adjacent array and object are merged by autowrapping the object as an array and merging the two arrays.
-//
-// This function was added in MySQL 8.0.3 as a synonym for JSONMerge. The JSONMerge function is now deprecated,
-// and is subject to removal in a future release of MySQL.
-//
-// The behavior of JSONMergePatch is the same as that of JSONMergePreserve, with the following two exceptions:
-//   - JSONMergePatch removes any member in the first object with a matching key in the second object, provided that
-//     the value associated with the key in the second object is not JSON null.
-//   - If the second object has a member with a key matching a member in the first object, JSONMergePatch replaces
-//     the value in the first object with the value in the second object, whereas JSONMergePreserve appends the
-//     second value to the first value.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-merge-preserve
-type JSONMergePreserve struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONMergePreserve{}
-
-// NewJSONMergePreserve creates a new JSONMergePreserve function.
-func NewJSONMergePreserve(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONMergePreserve{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONMergePreserve) FunctionName() string {
-	return "json_merge_preserve"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONMergePreserve) Description() string {
-	return "merges JSON documents, preserving duplicate keys."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONMergePreserve) IsUnsupported() bool {
-	return true
-}
-
 // JSON_REMOVE(json_doc, path[, path] ...)
 //
 // JSONRemove Removes data from a JSON document and returns the result. Returns NULL if any argument is NULL. An error
@@ -681,76 +423,6 @@ func (j JSONDepth) IsUnsupported() bool {
 	return true
 }
 
-// JSON_LENGTH(json_doc[, path])
-//
-// JSONLength Returns the length of a JSON document, or, if a path argument is given, the length of the value within
-// the document identified by the path. Returns NULL if any argument is NULL or the path argument does not identify a
-// value in the document. An error occurs if the json_doc argument is not a valid JSON document or the path argument is
-// not a valid path expression or contains a * or ** wildcard. The length of a document is determined as follows:
-//   - The length of a scalar is 1.
-//   - The length of an array is the number of array elements.
-//   - The length of an object is the number of object members.
-//   - The length does not count the length of nested arrays or objects.
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-attribute-functions.html#function_json-length
-type JSONLength struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONLength{}
-
-// NewJSONLength creates a new JSONLength function.
-func NewJSONLength(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONLength{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONLength) FunctionName() string {
-	return "json_length("
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONLength) Description() string {
-	return "returns number of elements in JSON document."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONLength) IsUnsupported() bool {
-	return true
-}
-
-// JSON_TYPE(json_val)
-//
-// Returns a utf8mb4 string indicating the type of a JSON value. This can be an object, an array, or a scalar type.
-// JSONType returns NULL if the argument is NULL. An error occurs if the argument is not a valid JSON value
-//
-// https://dev.mysql.com/doc/refman/8.0/en/json-attribute-functions.html#function_json-type
-type JSONType struct {
-	sql.Expression
-}
-
-var _ sql.FunctionExpression = JSONType{}
-
-// NewJSONType creates a new JSONType function.
-func NewJSONType(args ...sql.Expression) (sql.Expression, error) {
-	return nil, ErrUnsupportedJSONFunction.New(JSONType{}.FunctionName())
-}
-
-// FunctionName implements sql.FunctionExpression
-func (j JSONType) FunctionName() string {
-	return "json_type"
-}
-
-// Description implements sql.FunctionExpression
-func (j JSONType) Description() string {
-	return "returns type of JSON value."
-}
-
-// IsUnsupported implements sql.UnsupportedFunctionStub
-func (j JSONType) IsUnsupported() bool {
-	return true
-}
-
 // JSON_VALID(val)
 //
 // Returns 0 or 1 to indicate whether a value is valid JSON. Returns NULL if the argument is NULL.
@@ -111,7 +111,8 @@ func (l *Length) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	var content string
-	switch l.Child.Type() {
+	charset := sql.Collation_Default.CharacterSet()
+	switch t := l.Child.Type(); t {
 	case sql.TinyBlob, sql.Blob, sql.MediumBlob, sql.LongBlob:
 		val, err = sql.LongBlob.Convert(val)
 		if err != nil {
@@ -119,6 +120,7 @@ func (l *Length) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 
 		content = val.(string)
+		charset = sql.CharacterSet_binary
 	default:
 		val, err = sql.LongText.Convert(val)
 		if err != nil {
@@ -126,10 +128,15 @@ func (l *Length) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 
 		content = val.(string)
+		if st, ok := t.(sql.StringType); ok {
+			charset = st.CharacterSet()
+		}
 	}
 
+	// Strings are held as UTF-8 whatever their character set, so their length in bytes is the one they'd have once
+	// encoded in it.
 	if l.CountType == NumBytes {
-		return int32(len(content)), nil
+		return int32(charset.EncodedLength(content)), nil
 	}
 
 	return int32(utf8.RuneCountInString(content)), nil
@@ -38,6 +38,20 @@ func TestLength(t *testing.T) {
 			NewLength,
 			int32(4),
 		},
+		{
+			"length latin1 string",
+			"fóo",
+			sql.CreateLongText(sql.CharacterSet_latin1.DefaultCollation()),
+			NewLength,
+			int32(3),
+		},
+		{
+			"length utf16 string",
+			"fóo",
+			sql.CreateLongText(sql.CharacterSet_utf16.DefaultCollation()),
+			NewLength,
+			int32(6),
+		},
 		{
 			"length binary",
 			[]byte("fóo"),
@@ -73,6 +87,13 @@ func TestLength(t *testing.T) {
 			NewCharLength,
 			int32(3),
 		},
+		{
+			"char_length latin1 string",
+			"fóo",
+			sql.CreateLongText(sql.CharacterSet_latin1.DefaultCollation()),
+			NewCharLength,
+			int32(3),
+		},
 		{
 			"char_length binary",
 			[]byte("fóo"),
@@ -40,7 +40,10 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "avg", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAvg(e) }},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
+	sql.Function1{Name: "bit_and", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitAnd(e) }},
 	sql.Function1{Name: "bit_length", Fn: NewBitlength},
+	sql.Function1{Name: "bit_or", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitOr(e) }},
+	sql.Function1{Name: "bit_xor", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitXor(e) }},
 	sql.Function1{Name: "ceil", Fn: NewCeil},
 	sql.Function1{Name: "ceiling", Fn: NewCeil},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
@@ -78,7 +81,7 @@ var BuiltIns = []sql.Function{
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.FunctionN{Name: "format", Fn: NewFormat},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
-	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
+	sql.FunctionN{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
 	sql.Function1{Name: "hex", Fn: NewHex},
@@ -165,12 +168,19 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "round", Fn: NewRound},
 	sql.Function0{Name: "row_count", Fn: NewRowCount},
 	sql.Function0{Name: "row_number", Fn: window.NewRowNumber},
+	sql.Function0{Name: "rank", Fn: window.NewRank},
+	sql.Function0{Name: "dense_rank", Fn: window.NewDenseRank},
 	sql.Function0{Name: "percent_rank", Fn: window.NewPercentRank},
 	sql.Function1{Name: "first_value", Fn: window.NewFirstValue},
+	sql.Function1{Name: "last_value", Fn: window.NewLastValue},
+	sql.Function2{Name: "nth_value", Fn: window.NewNthValue},
+	sql.FunctionN{Name: "lag", Fn: window.NewLag},
+	sql.FunctionN{Name: "lead", Fn: window.NewLead},
 	sql.FunctionN{Name: "rpad", Fn: NewRightPad},
 	sql.Function1{Name: "rtrim", Fn: NewRightTrim},
 	sql.Function0{Name: "schema", Fn: NewDatabase},
 	sql.Function1{Name: "second", Fn: NewSecond},
+	sql.NewFunction0("session_user", NewSessionUser),
 	sql.Function1{Name: "sha", Fn: NewSHA1},
 	sql.Function1{Name: "sha1", Fn: NewSHA1},
 	sql.Function2{Name: "sha2", Fn: NewSHA2},
@@ -180,11 +190,18 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
 	sql.Function2{Name: "split", Fn: NewSplit},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
+	sql.Function1{Name: "std", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev_pop", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev_samp", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevSamp(e) }},
 	sql.FunctionN{Name: "str_to_date", Fn: NewStrToDate},
+	sql.Function2{Name: "strcmp", Fn: NewStrcmp},
 	sql.FunctionN{Name: "substr", Fn: NewSubstring},
 	sql.FunctionN{Name: "substring", Fn: NewSubstring},
 	sql.Function3{Name: "substring_index", Fn: NewSubstringIndex},
 	sql.Function1{Name: "sum", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewSum(e) }},
+	sql.FunctionN{Name: "sysdate", Fn: NewSysdate},
+	sql.NewFunction0("system_user", NewSystemUser),
 	sql.Function1{Name: "tan", Fn: NewTan},
 	sql.Function1{Name: "time_to_sec", Fn: NewTimeToSec},
 	sql.Function2{Name: "timediff", Fn: NewTimeDiff},
@@ -198,6 +215,9 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "utc_timestamp", Fn: NewUTCTimestamp},
 	sql.Function0{Name: "uuid", Fn: NewUUIDFunc},
 	sql.FunctionN{Name: "uuid_to_bin", Fn: NewUUIDToBin},
+	sql.Function1{Name: "var_pop", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarPop(e) }},
+	sql.Function1{Name: "var_samp", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarSamp(e) }},
+	sql.Function1{Name: "variance", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarPop(e) }},
 	sql.FunctionN{Name: "week", Fn: NewWeek},
 	sql.Function1{Name: "values", Fn: NewValues},
 	sql.Function1{Name: "weekday", Fn: NewWeekday},
@@ -216,7 +236,8 @@ func GetLockingFuncs(ls *sql.LockSubsystem) []sql.Function {
 	}
 }
 
-// Registry is used to register functions
+// Registry is used to register functions. A Registry can be shared by the catalogs of many engines, as long as no
+// functions are registered on it once it's in use.
 type Registry map[string]sql.Function
 
 var _ sql.FunctionProvider = Registry{}
@@ -3,6 +3,8 @@ package function
 import (
 	"fmt"
 
+	"github.com/dolthub/vitess/go/mysql"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/parse/dateparse"
 )
@@ -52,7 +54,8 @@ func (s StringToDatetime) IsNullable() bool {
 	return true
 }
 
-// Eval evaluates the given row and returns a result.
+// Eval evaluates the given row and returns a result. Dates that don't match the format give NULL with a warning, and
+// characters of the date left over once the format is done are ignored with a warning.
 func (s StringToDatetime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	date, err := s.Date.Eval(ctx, row)
 	if err != nil {
@@ -62,19 +65,27 @@ func (s StringToDatetime) Eval(ctx *sql.Context, row sql.Row) (interface{}, erro
 	if err != nil {
 		return nil, err
 	}
-	dateStr, ok := date.(string)
-	if !ok {
-		// TODO: improve this error
-		return nil, sql.ErrInvalidType.New(fmt.Sprintf("%T", date))
+	if date == nil || format == nil {
+		return nil, nil
 	}
-	formatStr, ok := format.(string)
-	if !ok {
-		// TODO: improve this error
-		return nil, sql.ErrInvalidType.New(fmt.Sprintf("%T", formatStr))
+
+	date, err = sql.LongText.Convert(date)
+	if err != nil {
+		return nil, err
 	}
-	goTime, err := dateparse.ParseDateWithFormat(dateStr, formatStr)
+	format, err = sql.LongText.Convert(format)
 	if err != nil {
-		return sql.Null, nil
+		return nil, err
+	}
+	dateStr, formatStr := date.(string), format.(string)
+
+	goTime, rest, err := dateparse.ParseDatePrefixWithFormat(dateStr, formatStr)
+	if err != nil {
+		ctx.Warn(1411, "Incorrect datetime value: '%s' for function %s", dateStr, s.FunctionName())
+		return nil, nil
+	}
+	if rest != "" {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect datetime value: '%s'", dateStr)
 	}
 	return goTime, nil
 }
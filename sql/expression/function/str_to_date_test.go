@@ -20,6 +20,8 @@ func TestStrToDate(t *testing.T) {
 		expected string
 	}{
 		{"standard", "Dec 26, 2000 2:13:15", "%b %e, %Y %T", "2000-12-26 02:13:15 -0600 CST"},
+		{"fractional seconds", "2021-03-15 10:20:30.25", "%Y-%m-%d %H:%i:%s.%f", "2021-03-15 10:20:30.25 -0500 CDT"},
+		{"week", "200442 Monday", "%X%V %W", "2004-10-18 00:00:00 -0500 CDT"},
 	}
 
 	for _, tt := range testCases {
@@ -48,6 +50,8 @@ func TestStrToDateFailure(t *testing.T) {
 		fmtStr  string
 	}{
 		{"standard", "BadMonth 26, 2000 2:13:15", "%b %e, %Y %T"},
+		{"invalid date", "2021-02-30", "%Y-%m-%d"},
+		{"unknown specifier", "2021-02-01", "%Y-%m-%d %L"},
 	}
 
 	for _, tt := range testCases {
@@ -60,13 +64,47 @@ func TestStrToDateFailure(t *testing.T) {
 		}
 		t.Run(tt.name, func(t *testing.T) {
 			dtime := eval(t, f, sql.NewRow(tt.dateStr, tt.fmtStr))
-			require.Equal(t, sql.Null, dtime)
+			require.Nil(t, dtime)
 		})
 		req := require.New(t)
 		req.True(f.IsNullable())
 	}
 }
 
+func TestStrToDateWarnings(t *testing.T) {
+	setupTimezone(t)
+
+	f, err := NewStrToDate(
+		expression.NewGetField(0, sql.Text, "", true),
+		expression.NewGetField(1, sql.Text, "", true),
+	)
+	require.NoError(t, err)
+
+	ctx := sql.NewEmptyContext()
+	v, err := f.Eval(ctx, sql.NewRow("2021-02-30", "%Y-%m-%d"))
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.Len(t, ctx.Warnings(), 1)
+	require.Equal(t, 1411, ctx.Warnings()[0].Code)
+	require.Equal(t, "Incorrect datetime value: '2021-02-30' for function str_to_date", ctx.Warnings()[0].Message)
+
+	ctx = sql.NewEmptyContext()
+	v, err = f.Eval(ctx, sql.NewRow("2021-02-01 extra", "%Y-%m-%d"))
+	require.NoError(t, err)
+	require.Equal(t, "2021-02-01 00:00:00 -0600 CST", v.(time.Time).String())
+	require.Len(t, ctx.Warnings(), 1)
+	require.Equal(t, "Truncated incorrect datetime value: '2021-02-01 extra'", ctx.Warnings()[0].Message)
+
+	ctx = sql.NewEmptyContext()
+	v, err = f.Eval(ctx, sql.NewRow(nil, "%Y-%m-%d"))
+	require.NoError(t, err)
+	require.Nil(t, v)
+	v, err = f.Eval(ctx, sql.NewRow("2021-02-01", nil))
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.Empty(t, ctx.Warnings())
+}
+
 func setupTimezone(t *testing.T) {
 	loc, err := time.LoadLocation("America/Chicago")
 	if err != nil {
@@ -0,0 +1,90 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Strcmp compares two strings with the collation of its arguments, returning 0 if they're equal, -1 if the first one
+// sorts before the second one, and 1 otherwise.
+type Strcmp struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Strcmp)(nil)
+
+// NewStrcmp returns a new STRCMP function.
+func NewStrcmp(e1, e2 sql.Expression) sql.Expression {
+	return &Strcmp{
+		expression.BinaryExpression{
+			Left:  e1,
+			Right: e2,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *Strcmp) FunctionName() string {
+	return "strcmp"
+}
+
+// Description implements sql.FunctionExpression
+func (s *Strcmp) Description() string {
+	return "compares two strings, returning 0 if they're equal, -1 if the first one is smaller, and 1 otherwise."
+}
+
+// Type implements the Expression interface.
+func (s *Strcmp) Type() sql.Type {
+	return sql.Int32
+}
+
+// Eval implements the Expression interface.
+func (s *Strcmp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	left, err := s.Left.Eval(ctx, row)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	right, err := s.Right.Eval(ctx, row)
+	if err != nil || right == nil {
+		return nil, err
+	}
+
+	left, err = sql.LongText.Convert(left)
+	if err != nil {
+		return nil, err
+	}
+	right, err = sql.LongText.Convert(right)
+	if err != nil {
+		return nil, err
+	}
+
+	return int32(expression.ResolveCollation(s.Left, s.Right).Compare(left.(string), right.(string))), nil
+}
+
+func (s *Strcmp) String() string {
+	return fmt.Sprintf("STRCMP(%s, %s)", s.Left, s.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (s *Strcmp) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewStrcmp(children[0], children[1]), nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestStrcmp(t *testing.T) {
+	ci := sql.CreateLongText(sql.Collation_utf8mb4_0900_ai_ci)
+	bin := sql.LongBlob
+
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		expected    interface{}
+	}{
+		{"equal", expression.NewLiteral("abc", sql.LongText), expression.NewLiteral("abc", sql.LongText), int32(0)},
+		{"smaller", expression.NewLiteral("abc", sql.LongText), expression.NewLiteral("abd", sql.LongText), int32(-1)},
+		{"larger", expression.NewLiteral("abd", sql.LongText), expression.NewLiteral("abc", sql.LongText), int32(1)},
+		{"prefix", expression.NewLiteral("ab", sql.LongText), expression.NewLiteral("abc", sql.LongText), int32(-1)},
+		{"case sensitive", expression.NewLiteral("ABC", sql.LongText), expression.NewLiteral("abc", sql.LongText), int32(-1)},
+		{"case insensitive", expression.NewLiteral("ABC", ci), expression.NewLiteral("abc", sql.LongText), int32(0)},
+		{"column collation", expression.NewLiteral("ABC", sql.LongText), expression.NewGetField(0, ci, "s", true), int32(0)},
+		{"binary", expression.NewLiteral("ABC", ci), expression.NewLiteral([]byte("abc"), bin), int32(-1)},
+		{"numbers", expression.NewLiteral(10, sql.Int64), expression.NewLiteral(9, sql.Int64), int32(-1)},
+		{"left null", expression.NewLiteral(nil, sql.Null), expression.NewLiteral("abc", sql.LongText), nil},
+		{"right null", expression.NewLiteral("abc", sql.LongText), expression.NewGetField(1, ci, "n", true), nil},
+	}
+
+	row := sql.NewRow("abc", nil)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewStrcmp(tt.left, tt.right)
+			require.Equal(t, tt.expected, eval(t, f, row))
+			require.Equal(t, sql.Int32, f.Type())
+		})
+	}
+}
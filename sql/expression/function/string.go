@@ -113,6 +113,15 @@ func (h *Hex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	switch val := arg.(type) {
 	case string:
+		// Decimals are represented as strings, but they're numbers
+		if sql.IsDecimal(h.Child.Type()) {
+			d, err := decimal.NewFromString(val)
+			if err != nil {
+				return nil, err
+			}
+			f, _ := d.Float64()
+			return hexForFloat(f)
+		}
 		return hexForString(val), nil
 
 	case uint8, uint16, uint32, uint, int, int8, int16, int32, int64:
@@ -63,6 +63,27 @@ func TestHexFunc(t *testing.T) {
 	tf.Test(t, nil, nil)
 }
 
+func TestHexDecimal(t *testing.T) {
+	tests := []struct {
+		val string
+		out string
+	}{
+		{"2.5", "3"},
+		{"2.4", "2"},
+		{"-2.5", "FFFFFFFFFFFFFFFD"},
+		{"255.00", "FF"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.val, func(t *testing.T) {
+			f := NewHex(expression.NewLiteral(test.val, sql.MustCreateDecimalType(10, 2)))
+			res, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, test.out, res)
+		})
+	}
+}
+
 func TestUnhexFunc(t *testing.T) {
 	f := sql.Function1{Name: "unhex", Fn: NewUnhex}
 	tf := NewTestFactory(f.Fn)
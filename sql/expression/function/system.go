@@ -77,9 +77,15 @@ func NewUser() sql.Expression {
 	}
 }
 
-func NewCurrentUser() sql.Expression {
+func NewSessionUser() sql.Expression {
 	return User{
-		NoArgFunc: NoArgFunc{"current_user", sql.LongText},
+		NoArgFunc: NoArgFunc{"session_user", sql.LongText},
+	}
+}
+
+func NewSystemUser() sql.Expression {
+	return User{
+		NoArgFunc: NoArgFunc{"system_user", sql.LongText},
 	}
 }
 
@@ -92,3 +98,35 @@ func (c User) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func (c User) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(c, children)
 }
+
+// CurrentUser returns the account the client was authenticated as, which may differ from the user and host of the
+// client returned by User.
+type CurrentUser struct {
+	NoArgFunc
+}
+
+var _ sql.FunctionExpression = CurrentUser{}
+
+func NewCurrentUser() sql.Expression {
+	return CurrentUser{
+		NoArgFunc: NoArgFunc{"current_user", sql.LongText},
+	}
+}
+
+// Description implements sql.FunctionExpression
+func (c CurrentUser) Description() string {
+	return "returns the user name and host name of the authenticated account."
+}
+
+// Eval implements sql.Expression
+func (c CurrentUser) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if ctx.Account != "" {
+		return ctx.Account, nil
+	}
+	return userFuncLogic(ctx, row)
+}
+
+// WithChildren implements sql.Expression
+func (c CurrentUser) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(c, children)
+}
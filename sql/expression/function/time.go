@@ -763,33 +763,55 @@ var _ sql.FunctionExpression = (*Now)(nil)
 
 // NewNow returns a new Now node.
 func NewNow(args ...sql.Expression) (sql.Expression, error) {
-	var precision *int
+	precision, err := newPrecision("now", args)
+	if err != nil {
+		return nil, err
+	}
+	return &Now{precision}, nil
+}
+
+// newPrecision returns the fractional seconds precision given as the optional argument of the current time function
+// with the name given, which must be a constant integer from 0 to 6.
+func newPrecision(funcName string, args []sql.Expression) (*int, error) {
 	if len(args) > 1 {
-		return nil, sql.ErrInvalidArgumentNumber.New("TIMESTAMP", 1, len(args))
-	} else if len(args) == 1 {
-		argType := args[0].Type().Promote()
-		if argType != sql.Int64 && argType != sql.Uint64 {
-			return nil, sql.ErrInvalidType.New(args[0].Type().String())
-		}
-		// todo: making a context here is expensive
-		val, err := args[0].Eval(sql.NewEmptyContext(), nil)
-		if err != nil {
-			return nil, err
-		}
-		precisionArg, err := sql.Int32.Convert(val)
+		return nil, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(funcName), 1, len(args))
+	} else if len(args) == 0 {
+		return nil, nil
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	argType := args[0].Type().Promote()
+	if argType != sql.Int64 && argType != sql.Uint64 {
+		return nil, sql.ErrInvalidType.New(args[0].Type().String())
+	}
+	// todo: making a context here is expensive
+	val, err := args[0].Eval(sql.NewEmptyContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+	precisionArg, err := sql.Int32.Convert(val)
+	if err != nil {
+		return nil, err
+	}
 
-		n := int(precisionArg.(int32))
-		if n < 0 || n > 6 {
-			return nil, sql.ErrOutOfRange.New("precision", "now")
-		}
-		precision = &n
+	n := int(precisionArg.(int32))
+	if n < 0 || n > 6 {
+		return nil, sql.ErrOutOfRange.New("precision", funcName)
 	}
+	return &n, nil
+}
 
-	return &Now{precision}, nil
+// truncateToPrecision returns the time given without the fractional seconds beyond the precision given, as the
+// current time functions return it. No precision means whole seconds.
+func truncateToPrecision(t time.Time, precision *int) time.Time {
+	fsp := 0
+	if precision != nil {
+		fsp = *precision
+	}
+	unit := time.Second
+	for i := 0; i < fsp; i++ {
+		unit /= 10
+	}
+	return t.Add(-time.Duration(t.Nanosecond()) % unit)
 }
 
 func subSecondPrecision(t time.Time, precision int) string {
@@ -854,7 +876,10 @@ func (n *Now) Children() []sql.Expression { return nil }
 
 // Eval implements the sql.Expression interface.
 func (n *Now) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t, err := sql.ToSessionTime(ctx, ctx.QueryTime())
+	if err != nil {
+		return nil, err
+	}
 	// TODO: Now should return a string formatted depending on context.  This code handles string formatting
 	// and should be enabled at the time we fix the return type
 	/*s, err := formatDate("%Y-%m-%d %H:%i:%s", t)
@@ -867,12 +892,16 @@ func (n *Now) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 		s += subSecondPrecision(t, *n.precision)
 	}*/
 
-	return t, nil
+	return truncateToPrecision(t, n.precision), nil
 }
 
-// WithChildren implements the Expression interface.
+// WithChildren implements the Expression interface. The precision isn't a child, so it's kept.
 func (n *Now) WithChildren(children ...sql.Expression) (sql.Expression, error) {
-	return NewNow(children...)
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	nn := *n
+	return &nn, nil
 }
 
 // UTCTimestamp is a function that returns the current time.
@@ -884,32 +913,10 @@ var _ sql.FunctionExpression = (*UTCTimestamp)(nil)
 
 // NewUTCTimestamp returns a new UTCTimestamp node.
 func NewUTCTimestamp(args ...sql.Expression) (sql.Expression, error) {
-	var precision *int
-	if len(args) > 1 {
-		return nil, sql.ErrInvalidArgumentNumber.New("UTC_TIMESTAMP", 1, len(args))
-	} else if len(args) == 1 {
-		argType := args[0].Type().Promote()
-		if argType != sql.Int64 && argType != sql.Uint64 {
-			return nil, sql.ErrInvalidType.New(args[0].Type().String())
-		}
-		// todo: making a context here is expensive
-		val, err := args[0].Eval(sql.NewEmptyContext(), nil)
-		if err != nil {
-			return nil, err
-		}
-		precisionArg, err := sql.Int32.Convert(val)
-
-		if err != nil {
-			return nil, err
-		}
-
-		n := int(precisionArg.(int32))
-		if n < 0 || n > 6 {
-			return nil, sql.ErrOutOfRange.New("precision", "utc_timestamp")
-		}
-		precision = &n
+	precision, err := newPrecision("utc_timestamp", args)
+	if err != nil {
+		return nil, err
 	}
-
 	return &UTCTimestamp{precision}, nil
 }
 
@@ -949,12 +956,88 @@ func (ut *UTCTimestamp) Children() []sql.Expression { return nil }
 func (ut *UTCTimestamp) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 	t := ctx.QueryTime()
 	// TODO: Now should return a string formatted depending on context.  This code handles string formatting
-	return t.UTC(), nil
+	return truncateToPrecision(t.UTC(), ut.precision), nil
 }
 
-// WithChildren implements the Expression interface.
+// WithChildren implements the Expression interface. The precision isn't a child, so it's kept.
 func (ut *UTCTimestamp) WithChildren(children ...sql.Expression) (sql.Expression, error) {
-	return NewUTCTimestamp(children...)
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ut, len(children), 0)
+	}
+	nt := *ut
+	return &nt, nil
+}
+
+// Sysdate is a function that returns the time it's evaluated at, unlike NOW(), which returns the time the query started
+// at, unless the sysdate_is_now system variable is set.
+type Sysdate struct {
+	precision *int
+}
+
+var _ sql.FunctionExpression = (*Sysdate)(nil)
+
+// NewSysdate returns a new Sysdate node.
+func NewSysdate(args ...sql.Expression) (sql.Expression, error) {
+	precision, err := newPrecision("sysdate", args)
+	if err != nil {
+		return nil, err
+	}
+	return &Sysdate{precision}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *Sysdate) FunctionName() string {
+	return "sysdate"
+}
+
+// Description implements sql.FunctionExpression
+func (s *Sysdate) Description() string {
+	return "returns the time at which the function executes."
+}
+
+// Type implements the sql.Expression interface.
+func (s *Sysdate) Type() sql.Type {
+	return sql.Datetime
+}
+
+func (s *Sysdate) String() string {
+	if s.precision == nil {
+		return "SYSDATE()"
+	}
+
+	return fmt.Sprintf("SYSDATE(%d)", *s.precision)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *Sysdate) IsNullable() bool { return false }
+
+// Resolved implements the sql.Expression interface.
+func (s *Sysdate) Resolved() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (s *Sysdate) Children() []sql.Expression { return nil }
+
+// Eval implements the sql.Expression interface.
+func (s *Sysdate) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
+	now := ctx.Now()
+	if _, val, ok := sql.SystemVariables.GetGlobal("sysdate_is_now"); ok && val == int8(1) {
+		now = ctx.QueryTime()
+	}
+
+	t, err := sql.ToSessionTime(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	return truncateToPrecision(t, s.precision), nil
+}
+
+// WithChildren implements the Expression interface. The precision isn't a child, so it's kept.
+func (s *Sysdate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	ns := *s
+	return &ns, nil
 }
 
 // Date a function takes the DATE part out from a datetime expression.
@@ -1157,7 +1240,7 @@ type TimeToSec struct {
 var _ sql.FunctionExpression = (*TimeToSec)(nil)
 
 func NewTimeToSec(arg sql.Expression) sql.Expression {
-	return &TimeToSec{NewUnaryDatetimeFunc(arg, "TIME_TO_SEC", sql.Uint64)}
+	return &TimeToSec{NewUnaryDatetimeFunc(arg, "TIME_TO_SEC", sql.Int64)}
 }
 
 // Description implements sql.FunctionExpression
@@ -1166,13 +1249,27 @@ func (m *TimeToSec) Description() string {
 }
 
 func (m *TimeToSec) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	val, err := m.EvalChild(ctx, row)
-	if err != nil {
+	val, err := m.Child.Eval(ctx, row)
+	if err != nil || val == nil {
 		return nil, err
 	}
 
-	t := val.(time.Time)
-	return uint64(t.Hour()*3600 + t.Minute()*60 + t.Second()), nil
+	// Datetimes count the seconds of their time of day, anything else is a TIME value that may be negative or span more
+	// than a day
+	if t, ok := val.(time.Time); ok {
+		return int64(t.Hour()*3600 + t.Minute()*60 + t.Second()), nil
+	}
+
+	d, err := sql.Time.ConvertToTimeDuration(val)
+	if err != nil {
+		dt, dtErr := sql.Datetime.Convert(val)
+		if dtErr != nil {
+			return nil, err
+		}
+		t := dt.(time.Time)
+		return int64(t.Hour()*3600 + t.Minute()*60 + t.Second()), nil
+	}
+	return int64(d / time.Second), nil
 }
 
 func (m *TimeToSec) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -1313,18 +1410,21 @@ func (c CurrTime) Description() string {
 
 func NewCurrTime() sql.Expression {
 	return CurrTime{
-		NoArgFunc: NoArgFunc{"curtime", sql.LongText},
+		NoArgFunc: NoArgFunc{"curtime", sql.Time},
 	}
 }
 
 func NewCurrentTime() sql.Expression {
 	return CurrTime{
-		NoArgFunc: NoArgFunc{"current_time", sql.LongText},
+		NoArgFunc: NoArgFunc{"current_time", sql.Time},
 	}
 }
 
 func currTimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t, err := sql.ToSessionTime(ctx, ctx.QueryTime())
+	if err != nil {
+		return nil, err
+	}
 	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
 }
 
@@ -1405,9 +1505,11 @@ func (c *CurrTimestamp) Children() []sql.Expression {
 func (c *CurrTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// If no arguments, just return with 0 precision
 	if len(c.args) == 0 {
-		t := ctx.QueryTime()
-		_t := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
-		return _t, nil
+		t, err := sql.ToSessionTime(ctx, ctx.QueryTime())
+		if err != nil {
+			return nil, err
+		}
+		return truncateToPrecision(t, nil), nil
 	}
 
 	// If argument is null
@@ -1451,19 +1553,10 @@ func (c *CurrTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Get the timestamp
-	t := ctx.QueryTime()
-
-	// Calculate precision
-	prec := 1
-	for i := 0; i < 9-fsp; i++ {
-		prec *= 10
+	t, err := sql.ToSessionTime(ctx, ctx.QueryTime())
+	if err != nil {
+		return nil, err
 	}
 
-	// Round down nano based on precision
-	nano := prec * (t.Nanosecond() / prec)
-
-	// Generate a new timestamp
-	_t := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), nano, t.Location())
-
-	return _t, nil
+	return truncateToPrecision(t, &fsp), nil
 }
@@ -351,7 +351,7 @@ func TestCalcWeek(t *testing.T) {
 }
 
 func TestNow(t *testing.T) {
-	date := time.Date(2018, time.December, 2, 16, 25, 0, 0, time.Local)
+	date := time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)
 	testNowFunc := func() time.Time {
 		return date
 	}
@@ -490,6 +490,52 @@ func TestUTCTimestamp(t *testing.T) {
 	}
 }
 
+func TestCurrentTimePrecision(t *testing.T) {
+	queryTime := time.Date(2018, time.December, 2, 16, 25, 0, 123456789, time.UTC)
+	// SYSDATE() returns the time it's evaluated at, which is later than the time the query started at
+	clock := queryTime
+	ctx := sql.NewEmptyContext()
+	sql.WithClock(func() time.Time { return clock })(ctx)
+	clock = queryTime.Add(2 * time.Second)
+
+	tests := []struct {
+		fn       func(...sql.Expression) (sql.Expression, error)
+		args     []sql.Expression
+		expected time.Time
+	}{
+		{NewNow, nil, time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)},
+		{NewNow, []sql.Expression{expression.NewLiteral(0, sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)},
+		{NewNow, []sql.Expression{expression.NewLiteral(3, sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 0, 123000000, time.UTC)},
+		{NewNow, []sql.Expression{expression.NewLiteral(6, sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 0, 123456000, time.UTC)},
+		{NewUTCTimestamp, []sql.Expression{expression.NewLiteral(1, sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 0, 100000000, time.UTC)},
+		{NewCurrTimestamp, nil, time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)},
+		{NewCurrTimestamp, []sql.Expression{expression.NewLiteral(int8(4), sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 0, 123400000, time.UTC)},
+		{NewSysdate, nil, time.Date(2018, time.December, 2, 16, 25, 2, 0, time.UTC)},
+		{NewSysdate, []sql.Expression{expression.NewLiteral(6, sql.Int8)}, time.Date(2018, time.December, 2, 16, 25, 2, 123456000, time.UTC)},
+	}
+
+	for _, test := range tests {
+		f, err := test.fn(test.args...)
+		require.NoError(t, err)
+		t.Run(f.String(), func(t *testing.T) {
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, val)
+		})
+	}
+
+	_, err := NewSysdate(expression.NewLiteral(7, sql.Int8))
+	require.Error(t, err)
+
+	require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"sysdate_is_now": int8(1)}))
+	defer sql.SystemVariables.AssignValues(map[string]interface{}{"sysdate_is_now": int8(0)})
+	f, err := NewSysdate()
+	require.NoError(t, err)
+	val, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC), val)
+}
+
 func TestDate(t *testing.T) {
 	ctx := sql.NewEmptyContext()
 	f := NewDate(expression.NewGetField(0, sql.LongText, "foo", false))
@@ -15,7 +15,10 @@
 package function
 
 import (
+	"encoding/binary"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -73,6 +76,14 @@ func (u UUIDFunc) Type() sql.Type {
 }
 
 func (u UUIDFunc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if ctx != nil && ctx.Random() != nil {
+		nUUID, err := newTimeUUID(ctx.Now(), ctx.Random())
+		if err != nil {
+			return nil, err
+		}
+		return nUUID.String(), nil
+	}
+
 	nUUID, err := uuid.NewUUID()
 	if err != nil {
 		return nil, err
@@ -81,6 +92,29 @@ func (u UUIDFunc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return nUUID.String(), nil
 }
 
+// gregorianEpochOffset is the number of 100-nanosecond intervals between the start of the Gregorian calendar, which
+// the timestamps of version 1 UUIDs count from, and the Unix epoch.
+const gregorianEpochOffset = 122192928000000000
+
+// newTimeUUID returns a version 1 UUID for the time given, with a clock sequence and node read from the random source
+// given instead of the ones of the host, so that it's reproducible for a reproducible source.
+func newTimeUUID(t time.Time, r io.Reader) (uuid.UUID, error) {
+	var u uuid.UUID
+	if _, err := io.ReadFull(r, u[8:]); err != nil {
+		return u, err
+	}
+
+	ts := uint64(t.UnixNano()/100 + gregorianEpochOffset)
+	binary.BigEndian.PutUint32(u[0:], uint32(ts))
+	binary.BigEndian.PutUint16(u[4:], uint16(ts>>32))
+	binary.BigEndian.PutUint16(u[6:], uint16(ts>>48)&0x0fff|0x1000)
+	// RFC 4122 variant, and the multicast bit set to tell the random node from a MAC address
+	u[8] = u[8]&0x3f | 0x80
+	u[10] |= 0x01
+
+	return u, nil
+}
+
 func (u UUIDFunc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 0 {
 		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 0)
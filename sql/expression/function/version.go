@@ -27,10 +27,23 @@ type Version string
 
 var _ sql.FunctionExpression = (Version)("")
 
-// NewVersion creates a new Version UDF.
+// ServerVersion returns the version reported by default, followed by the postfix given if it's not empty.
+func ServerVersion(versionPostfix string) string {
+	if versionPostfix == "" {
+		return mysqlVersion
+	}
+	return fmt.Sprintf("%s-%s", mysqlVersion, versionPostfix)
+}
+
+// NewVersion creates a new Version UDF returning the default version followed by the postfix given.
 func NewVersion(versionPostfix string) func(...sql.Expression) (sql.Expression, error) {
+	return NewVersionString(ServerVersion(versionPostfix))
+}
+
+// NewVersionString creates a new Version UDF returning the version given.
+func NewVersionString(version string) func(...sql.Expression) (sql.Expression, error) {
 	return func(...sql.Expression) (sql.Expression, error) {
-		return Version(versionPostfix), nil
+		return Version(version), nil
 	}
 }
 
@@ -77,6 +90,5 @@ func (f Version) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if f == "" {
 		return mysqlVersion, nil
 	}
-
-	return fmt.Sprintf("%s-%s", mysqlVersion, string(f)), nil
+	return string(f), nil
 }
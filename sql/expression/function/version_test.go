@@ -40,4 +40,11 @@ func TestNewVersion(t *testing.T) {
 	val, err = f.Eval(ctx, nil)
 	require.NoError(err)
 	require.Equal("8.0.11", val)
+
+	f, err = NewVersionString("5.7.0-custom")()
+	require.NoError(err)
+
+	val, err = f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("5.7.0-custom", val)
 }
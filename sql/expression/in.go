@@ -70,11 +70,6 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// also if no match is found in the list and one of the expressions in the list is NULL.
 	rightNull := false
 
-	left, err = typ.Convert(left)
-	if err != nil {
-		return nil, err
-	}
-
 	switch right := in.Right().(type) {
 	case Tuple:
 		for _, el := range right {
@@ -83,6 +78,15 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			}
 		}
 
+		if sql.IsTuple(typ) {
+			return in.evalTuples(ctx, row, left.([]interface{}), right)
+		}
+
+		left, err = typ.Convert(left)
+		if err != nil {
+			return nil, err
+		}
+
 		for _, el := range right {
 			right, err := el.Eval(ctx, row)
 			if err != nil {
@@ -119,6 +123,37 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalTuples evaluates the expression when the operands are row values, which are equal as defined by
+// sql.EqualTuples. A NULL comparison makes the result NULL if there's no match, like a NULL element of the list does.
+func (in *InTuple) evalTuples(ctx *sql.Context, row sql.Row, left []interface{}, right Tuple) (interface{}, error) {
+	leftType := in.Left().Type().(sql.TupleType)
+	hasNull := false
+	for _, el := range right {
+		val, err := el.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			hasNull = true
+			continue
+		}
+
+		equal, isNull, err := sql.EqualTuples(left, val.([]interface{}), sql.TupleCompareFuncs(leftType, el.Type().(sql.TupleType)))
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			return true, nil
+		}
+		hasNull = hasNull || isNull
+	}
+
+	if hasNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InTuple) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -203,7 +238,7 @@ func hashOfSimple(i interface{}, t sql.Type) (uint64, error) {
 	if err != nil {
 		return 0, sql.ErrInvalidType.New(i)
 	}
-	if _, err := hash.Write([]byte(fmt.Sprintf("%#v,", x))); err != nil {
+	if _, err := hash.Write([]byte(fmt.Sprintf("%#v,", sql.CollationKey(t, x)))); err != nil {
 		return 0, err
 	}
 	return hash.Sum64(), nil
@@ -226,6 +261,15 @@ func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
+	// Row values with NULL elements can't be looked up by hash, see sql.EqualTuples
+	if vals, ok := leftVal.([]interface{}); ok {
+		for _, v := range vals {
+			if v == nil {
+				return hit.InTuple.evalTuples(ctx, row, vals, hit.Right().(Tuple))
+			}
+		}
+	}
+
 	key, err := hashOfSimple(leftVal, hit.Left().Type())
 	if err != nil {
 		return nil, err
@@ -15,7 +15,6 @@
 package expression
 
 import (
-	"bytes"
 	"fmt"
 	"regexp"
 	"strings"
@@ -25,10 +24,6 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-func newDefaultLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
-	return regex.NewDisposableMatcher("go", likeStr)
-}
-
 // Like performs pattern matching against two strings.
 type Like struct {
 	BinaryExpression
@@ -74,12 +69,8 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	createMatcher := newDefaultLikeMatcher
-	lType := l.Left.Type()
-	lm, likeOK := lType.(sql.LikeMatcher)
-	if likeOK {
-		createMatcher = lm.CreateMatcher
-	}
+	// The collation of the strings decides whether the matching ignores case
+	createMatcher := ResolveCollation(l.Left, l.Right).LikeMatcher
 
 	var likeMatcher regex.DisposableMatcher
 	if !l.cached {
@@ -133,37 +124,48 @@ func (l *Like) evalRight(ctx *sql.Context, row sql.Row) (*string, error) {
 		return nil, err
 	}
 
+	escape, err := l.escapeRune(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	pattern := likePatternToRegex(v.(string), escape)
+	return &pattern, nil
+}
+
+// escapeRune returns the escape character of the pattern, which is a backslash unless the ESCAPE clause sets another
+// one, or no character at all if it's empty.
+func (l *Like) escapeRune(ctx *sql.Context, row sql.Row) (rune, error) {
 	if l.escape == nil {
-		s := patternToGoRegex(v.(string))
-		return &s, nil
+		return '\\', nil
 	}
 
 	e, err := l.escape.Eval(ctx, row)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if e == nil {
+		return '\\', nil
 	}
-
 	e, err = sql.LongText.Convert(e)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	// Use normal patternToGoRegex when escape is empty string
-	if len(e.(string)) == 0 {
-		s := patternToGoRegex(v.(string))
-		return &s, nil
+	escape := []rune(e.(string))
+	switch len(escape) {
+	case 0:
+		return 0, nil
+	case 1:
+		return escape[0], nil
+	default:
+		return 0, sql.ErrInvalidArgument.New("ESCAPE")
 	}
-
-	// e should be exactly one character
-	if len(e.(string)) > 1 {
-		return nil, sql.ErrInvalidArgument.New("ESCAPE")
-	}
-
-	s := patternToGoRegexWithEscape(v.(string), e.(string))
-	return &s, nil
 }
 
 func (l *Like) String() string {
+	if l.escape != nil {
+		return fmt.Sprintf("%s LIKE %s ESCAPE %s", l.Left, l.Right, l.escape)
+	}
 	return fmt.Sprintf("%s LIKE %s", l.Left, l.Right)
 }
 
@@ -175,94 +177,28 @@ func (l *Like) WithChildren(children ...sql.Expression) (sql.Expression, error)
 	return NewLike(children[0], children[1], l.escape), nil
 }
 
-func patternToGoRegex(pattern string) string {
-	var buf bytes.Buffer
-	buf.WriteString("(?s)")
-	buf.WriteRune('^')
-	var escaped bool
-	for _, r := range strings.Replace(regexp.QuoteMeta(pattern), `\\`, `\`, -1) {
-		switch r {
-		case '_':
-			if escaped {
-				buf.WriteRune(r)
-			} else {
-				buf.WriteRune('.')
-			}
-		case '%':
-			if escaped {
-				buf.WriteRune(r)
-			} else {
-				buf.WriteString(".*")
-			}
-		case '\\':
-			if escaped {
-				buf.WriteString(`\\`)
-			} else {
-				escaped = true
-				continue
-			}
+// likePatternToRegex returns the Go regular expression matching the same strings as the LIKE pattern given, in which
+// % matches any sequence of characters, _ matches any single character, and the escape character makes the character
+// following it match itself. An escape character at the end of the pattern matches itself too. A zero escape means
+// there's no escape character.
+func likePatternToRegex(pattern string, escape rune) string {
+	var buf strings.Builder
+	buf.WriteString("(?s)^")
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case escape != 0 && r == escape && i+1 < len(runes):
+			i++
+			buf.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case r == '%':
+			buf.WriteString(".*")
+		case r == '_':
+			buf.WriteRune('.')
 		default:
-			if escaped {
-				buf.WriteString(`\`)
-			}
-			buf.WriteRune(r)
-		}
-
-		if escaped {
-			escaped = false
+			buf.WriteString(regexp.QuoteMeta(string(r)))
 		}
 	}
-
-	buf.WriteRune('$')
-	return buf.String()
-}
-
-func patternToGoRegexWithEscape(pattern, escape string) string {
-	var buf bytes.Buffer
-	buf.WriteString("(?s)")
-	buf.WriteRune('^')
-	var escaped bool
-
-	for _, r := range strings.Replace(strings.Replace(regexp.QuoteMeta(pattern), `\\`, `\`, -1), regexp.QuoteMeta(escape), escape, -1) {
-		switch r {
-		case rune(escape[0]):
-			if escaped {
-				buf.WriteString(regexp.QuoteMeta(escape))
-			} else {
-				escaped = true
-				continue
-			}
-		case '_':
-			if escaped {
-				buf.WriteRune(r)
-			} else {
-				buf.WriteRune('.')
-			}
-		case '%':
-			if escaped {
-				buf.WriteRune(r)
-			} else {
-				buf.WriteString(".*")
-			}
-		case '\\':
-			if escaped {
-				buf.WriteString(`\\`)
-			} else {
-				escaped = true
-				continue
-			}
-		default:
-			if escaped {
-				buf.WriteString(`\`)
-			}
-			buf.WriteRune(r)
-		}
-
-		if escaped {
-			escaped = false
-		}
-	}
-
 	buf.WriteRune('$')
 	return buf.String()
 }
@@ -38,6 +38,7 @@ func TestPatternToRegex(t *testing.T) {
 		{`a\_b`, `(?s)^a_b$`},
 		{`a\\b`, `(?s)^a\\b$`},
 		{`a\\\_b`, `(?s)^a\\_b$`},
+		{`a\`, `(?s)^a\\$`},
 		{`(ab)`, `(?s)^\(ab\)$`},
 		{`$`, `(?s)^\$$`},
 		{`$$`, `(?s)^\$\$$`},
@@ -45,58 +46,79 @@ func TestPatternToRegex(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.in, func(t *testing.T) {
-			require.Equal(t, tt.out, patternToGoRegex(tt.in))
+			require.Equal(t, tt.out, likePatternToRegex(tt.in, '\\'))
 		})
 	}
 }
 
 func TestCustomPatternToRegex(t *testing.T) {
 	testCases := []struct {
-		in, out, escape string
+		in, out string
+		escape  rune
 	}{
-		{`a%`, `(?s)^%$`, `a`},
-		{`a_`, `(?s)^_$`, `a`},
-		{`\_`, `(?s)^_$`, `a`},
-		{`\_`, `(?s)^_$`, `\`},
-		{`a%a%`, `(?s)^%%$`, `a`},
-		{`a%a_`, `(?s)^%_$`, `a`},
-		{`$%`, `(?s)^%$`, `$`},
-		{`$%$%`, `(?s)^%%$`, `$`},
-		{`$$`, `(?s)^\$$`, `$`},
-		{`$\`, `(?s)^\\$`, `$`},
-		{`\$`, `(?s)^\$$`, `$`},
+		{`a%`, `(?s)^%$`, 'a'},
+		{`a_`, `(?s)^_$`, 'a'},
+		{`\_`, `(?s)^\\.$`, 'a'},
+		{`\_`, `(?s)^_$`, '\\'},
+		{`a%a%`, `(?s)^%%$`, 'a'},
+		{`a%a_`, `(?s)^%_$`, 'a'},
+		{`$%`, `(?s)^%$`, '$'},
+		{`$%$%`, `(?s)^%%$`, '$'},
+		{`$$`, `(?s)^\$$`, '$'},
+		{`$\`, `(?s)^\\$`, '$'},
+		{`\$`, `(?s)^\\\$$`, '$'},
+		{`é%`, `(?s)^%$`, 'é'},
+		{`\%`, `(?s)^\\.*$`, 0},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.in, func(t *testing.T) {
-			require.Equal(t, tt.out, patternToGoRegexWithEscape(tt.in, tt.escape))
+			require.Equal(t, tt.out, likePatternToRegex(tt.in, tt.escape))
 		})
 	}
 }
 
 func TestLike(t *testing.T) {
-	f := NewLike(
-		NewGetField(0, sql.Text, "", false),
-		NewGetField(1, sql.Text, "", false),
-		nil,
-	)
-
 	testCases := []struct {
-		pattern, value, escape string
-		ok                     bool
+		pattern, value string
+		escape         interface{}
+		typ            sql.Type
+		ok             interface{}
 	}{
-		{"a__", "abc", "", true},
-		{"a__", "abcd", "", false},
-		{"a%b", "acb", "", true},
-		{"a%b", "acdkeflskjfdklb", "", true},
-		{"a%b", "ab", "", true},
-		{"a%b", "a", "", false},
-		{"a_b", "ab", "", false},
-		{"aa:%", "AA:BB:CC:DD:EE:FF", "", true},
+		{"a__", "abc", nil, sql.Text, true},
+		{"a__", "abcd", nil, sql.Text, false},
+		{"a%b", "acb", nil, sql.Text, true},
+		{"a%b", "acdkeflskjfdklb", nil, sql.Text, true},
+		{"a%b", "ab", nil, sql.Text, true},
+		{"a%b", "a", nil, sql.Text, false},
+		{"a_b", "ab", nil, sql.Text, false},
+		{"a_b", "a\nb", nil, sql.Text, true},
+		{"aa:%", "AA:BB:CC:DD:EE:FF", nil, sql.Text, false},
+		{"aa:%", "AA:BB:CC:DD:EE:FF", nil, sql.CreateLongText(sql.Collation_utf8mb4_0900_ai_ci), true},
+		{"AA:%", "aa:bb", nil, sql.CreateLongText(sql.Collation_utf8mb4_general_ci), true},
+		{"a\\%", "a%", nil, sql.Text, true},
+		{"a\\%", "ab", nil, sql.Text, false},
+		{"a\\", "a\\", nil, sql.Text, true},
+		{"a|%", "a%", "|", sql.Text, true},
+		{"a|%", "ab", "|", sql.Text, false},
+		{"a\\_", "a\\b", "|", sql.Text, true},
+		{"a\\_", "a_", "", sql.Text, false},
+		{"a\\%", "a\\bc", "", sql.Text, true},
+		{"a%", "ab", nil, sql.LongBlob, true},
+		{"A%", "ab", nil, sql.LongBlob, false},
 	}
 
 	for _, tt := range testCases {
-		t.Run(fmt.Sprintf("%q LIKE %q", tt.value, tt.pattern), func(t *testing.T) {
+		t.Run(fmt.Sprintf("%q LIKE %q ESCAPE %v", tt.value, tt.pattern, tt.escape), func(t *testing.T) {
+			var escape sql.Expression
+			if tt.escape != nil {
+				escape = NewLiteral(tt.escape, sql.LongText)
+			}
+			f := NewLike(
+				NewGetField(0, tt.typ, "", false),
+				NewGetField(1, sql.Text, "", false),
+				escape,
+			)
 			value, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(
 				tt.value,
 				tt.pattern,
@@ -105,4 +127,9 @@ func TestLike(t *testing.T) {
 			require.Equal(t, tt.ok, value)
 		})
 	}
+
+	f := NewLike(NewLiteral("a", sql.LongText), NewLiteral("a", sql.LongText), NewLiteral("||", sql.LongText))
+	_, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.True(t, sql.ErrInvalidArgument.Is(err))
+	require.Equal(t, `"a" LIKE "a" ESCAPE "||"`, f.String())
 }
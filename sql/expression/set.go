@@ -50,27 +50,46 @@ func (s *SetField) Type() sql.Type {
 // Eval implements the Expression interface.
 // Returns a copy of the given row with an updated value.
 func (s *SetField) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	updatedRow, _, err := s.eval(ctx, row, false)
+	if err != nil {
+		return nil, err
+	}
+	return updatedRow, nil
+}
+
+// EvalClamping is like Eval, but a number out of the range of the type of the field is replaced by the nearest limit
+// of the type instead of failing, as MySQL does outside strict mode. Returns whether the value was clamped.
+func (s *SetField) EvalClamping(ctx *sql.Context, row sql.Row) (sql.Row, bool, error) {
+	return s.eval(ctx, row, true)
+}
+
+func (s *SetField) eval(ctx *sql.Context, row sql.Row, clamp bool) (sql.Row, bool, error) {
 	getField, ok := s.Left.(*GetField)
 	if !ok {
-		return nil, errCannotSetField.New(s.Left)
+		return nil, false, errCannotSetField.New(s.Left)
 	}
 
 	if getField.fieldIndex < 0 || getField.fieldIndex >= len(row) {
-		return nil, ErrIndexOutOfBounds.New(getField.fieldIndex, len(row))
+		return nil, false, ErrIndexOutOfBounds.New(getField.fieldIndex, len(row))
 	}
 	val, err := s.Right.Eval(ctx, row)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	clamped := false
 	if val != nil {
-		val, err = getField.fieldType.Convert(val)
+		converted, err := getField.fieldType.Convert(val)
+		if nt, ok := getField.fieldType.(sql.NumberType); ok && clamp && sql.ErrOutOfRange.Is(err) {
+			converted, clamped, err = sql.ClampNumber(nt, val)
+		}
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
+		val = converted
 	}
 	updatedRow := row.Copy()
 	updatedRow[getField.fieldIndex] = val
-	return updatedRow, nil
+	return updatedRow, clamped, nil
 }
 
 // WithChildren implements the Expression interface.
@@ -16,6 +16,7 @@ package expression
 
 import (
 	"container/heap"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -25,6 +26,8 @@ type Sorter struct {
 	Rows       []sql.Row
 	LastError  error
 	Ctx        *sql.Context
+	// compareFuncs holds a comparison function for each sort field, resolved from the field's type on first use
+	compareFuncs []sql.CompareFunc
 }
 
 func (s *Sorter) Len() int {
@@ -40,10 +43,16 @@ func (s *Sorter) Less(i, j int) bool {
 		return false
 	}
 
+	if s.compareFuncs == nil {
+		s.compareFuncs = make([]sql.CompareFunc, len(s.SortFields))
+		for k, sf := range s.SortFields {
+			s.compareFuncs[k] = sql.CompareFuncForType(sf.Column.Type())
+		}
+	}
+
 	a := s.Rows[i]
 	b := s.Rows[j]
-	for _, sf := range s.SortFields {
-		typ := sf.Column.Type()
+	for k, sf := range s.SortFields {
 		av, err := sf.Column.Eval(s.Ctx, a)
 		if err != nil {
 			s.LastError = sql.ErrUnableSort.Wrap(err)
@@ -68,10 +77,15 @@ func (s *Sorter) Less(i, j int) bool {
 			return sf.NullOrdering != sql.NullsFirst
 		}
 
-		cmp, err := typ.Compare(av, bv)
+		cmp, err := s.compareFuncs[k](av, bv)
 		if err != nil {
-			s.LastError = err
-			return false
+			// Columns of derived tables and unions can hold values of different types, which the column's type
+			// can't always compare. These are compared like the operands of a comparison instead.
+			cmp, err = sql.Compare(av, bv, sql.ComparisonType(sql.ApproximateTypeFromValue(av), sql.ApproximateTypeFromValue(bv)))
+			if err != nil {
+				s.LastError = err
+				return false
+			}
 		}
 
 		switch cmp {
@@ -119,3 +133,41 @@ func (h *TopRowsHeap) Rows() ([]sql.Row, error) {
 	}
 	return res, h.LastError
 }
+
+// indexSorter implements sort.Interface over the indexes of a slice of rows, leaving the rows themselves untouched.
+type indexSorter struct {
+	Sorter
+	idxs []int
+}
+
+func (s *indexSorter) Swap(i, j int) {
+	s.idxs[i], s.idxs[j] = s.idxs[j], s.idxs[i]
+}
+
+func (s *indexSorter) Less(i, j int) bool {
+	return s.Sorter.Less(s.idxs[i], s.idxs[j])
+}
+
+// SortedIndexes returns the indexes of the rows given in the order they appear when stably sorted by the sort fields
+// given. The rows slice is not modified.
+func SortedIndexes(ctx *sql.Context, sortFields []sql.SortField, rows []sql.Row) ([]int, error) {
+	idxs := make([]int, len(rows))
+	for i := range idxs {
+		idxs[i] = i
+	}
+
+	s := &indexSorter{
+		Sorter: Sorter{
+			SortFields: sortFields,
+			Rows:       rows,
+			Ctx:        ctx,
+		},
+		idxs: idxs,
+	}
+	sort.Stable(s)
+	if s.LastError != nil {
+		return nil, s.LastError
+	}
+
+	return idxs, nil
+}
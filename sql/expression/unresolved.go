@@ -95,6 +95,8 @@ type UnresolvedFunction struct {
 	IsAggregate bool
 	// Window is the window for this function, if present
 	Window *sql.Window
+	// IgnoreNulls is whether the window function skips null values, as given by its IGNORE NULLS clause
+	IgnoreNulls bool
 	// Children of the expression.
 	Arguments []sql.Expression
 }
@@ -147,6 +149,9 @@ func (uf *UnresolvedFunction) String() string {
 	if uf.Window != nil {
 		over = fmt.Sprintf(" %s", uf.Window)
 	}
+	if uf.IgnoreNulls {
+		over = " ignore nulls" + over
+	}
 
 	return fmt.Sprintf("%s(%s)%s", uf.name, strings.Join(exprs, ", "), over)
 }
@@ -161,6 +166,9 @@ func (uf *UnresolvedFunction) DebugString() string {
 	if uf.Window != nil {
 		over = fmt.Sprintf(" %s", sql.DebugString(uf.Window))
 	}
+	if uf.IgnoreNulls {
+		over = " ignore nulls" + over
+	}
 
 	return fmt.Sprintf("%s(%s)%s", uf.name, strings.Join(exprs, ", "), over)
 }
@@ -181,5 +189,7 @@ func (uf *UnresolvedFunction) WithChildren(children ...sql.Expression) (sql.Expr
 		return nil, err
 	}
 
-	return NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...), nil
+	nf := NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...)
+	nf.IgnoreNulls = uf.IgnoreNulls
+	return nf, nil
 }
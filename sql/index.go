@@ -51,6 +51,35 @@ type Index interface {
 	ColumnExpressionTypes(ctx *Context) []ColumnExpressionType
 }
 
+// IndexOrder is the order in which an OrderedIndex returns the rows of a lookup.
+type IndexOrder byte
+
+const (
+	// IndexOrderNone means that the rows of a lookup are returned in no particular order.
+	IndexOrderNone IndexOrder = iota
+	// IndexOrderAsc means that the rows of a lookup are returned in ascending order of the index expressions, with
+	// NULL values first.
+	IndexOrderAsc
+)
+
+// OrderedIndex is an Index whose lookups return rows sorted by the index expressions, across all the partitions of
+// the table. The analyzer relies on this to avoid sorting rows that are read through the index.
+type OrderedIndex interface {
+	Index
+	// Order returns the order in which the rows of a lookup on this index are returned.
+	Order() IndexOrder
+}
+
+// SortedTable is a table that returns its rows sorted across all its partitions when it's read without an index
+// lookup, such as a table stored in primary key order. The analyzer relies on this to avoid sorting rows that are
+// read from the table in that order.
+type SortedTable interface {
+	Table
+	// SortOrder returns the expressions the rows of the table are sorted by, in the format of Index.Expressions, with
+	// ascending values and NULL values first. Rows are also sorted by any prefix of these expressions.
+	SortOrder() []string
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup. The IndexLookup must contain all necessary
 // information to retrieve exactly the rows in the table as specified by the ranges given to their parent index.
 // Implementors are responsible for all semantics of correctly returning rows that match an index lookup.
@@ -65,6 +65,7 @@ func (b *IndexBuilder) Equals(ctx *Context, colExpr string, keys ...interface{})
 	}
 	potentialRanges := make([]RangeColumnExpr, len(keys))
 	for i, key := range keys {
+		key = storedKey(ctx, typ, key)
 		potentialRanges[i] = ClosedRangeColumnExpr(key, key, typ)
 	}
 	b.updateCol(ctx, colExpr, potentialRanges...)
@@ -82,6 +83,7 @@ func (b *IndexBuilder) NotEquals(ctx *Context, colExpr string, key interface{})
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ), LessThanRangeColumnExpr(key, typ))
 	if !b.isInvalid {
 		ranges, err := SimplifyRangeColumn(b.ranges[colExpr]...)
@@ -111,7 +113,7 @@ func (b *IndexBuilder) GreaterThan(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
-	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ))
+	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(storedKey(ctx, typ, key), typ))
 	return b
 }
 
@@ -126,7 +128,7 @@ func (b *IndexBuilder) GreaterOrEqual(ctx *Context, colExpr string, key interfac
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
-	b.updateCol(ctx, colExpr, GreaterOrEqualRangeColumnExpr(key, typ))
+	b.updateCol(ctx, colExpr, GreaterOrEqualRangeColumnExpr(storedKey(ctx, typ, key), typ))
 	return b
 }
 
@@ -141,7 +143,7 @@ func (b *IndexBuilder) LessThan(ctx *Context, colExpr string, key interface{}) *
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
-	b.updateCol(ctx, colExpr, LessThanRangeColumnExpr(key, typ))
+	b.updateCol(ctx, colExpr, LessThanRangeColumnExpr(storedKey(ctx, typ, key), typ))
 	return b
 }
 
@@ -156,7 +158,7 @@ func (b *IndexBuilder) LessOrEqual(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
-	b.updateCol(ctx, colExpr, LessOrEqualRangeColumnExpr(key, typ))
+	b.updateCol(ctx, colExpr, LessOrEqualRangeColumnExpr(storedKey(ctx, typ, key), typ))
 	return b
 }
 
@@ -261,3 +263,17 @@ func (b *IndexBuilder) updateCol(ctx *Context, colExpr string, potentialRanges .
 	}
 	b.ranges[colExpr] = newRanges
 }
+
+// storedKey returns the key given for a column of the type given as it's stored in the index, which only differs from
+// the key for TIMESTAMP columns, whose values are stored in UTC.
+func storedKey(ctx *Context, typ Type, key interface{}) interface{} {
+	timestamps, err := NewTimestampConverter(ctx, Schema{{Type: typ}})
+	if err != nil || timestamps == nil {
+		return key
+	}
+	converted, err := typ.Convert(key)
+	if err != nil {
+		return key
+	}
+	return timestamps.ToUTC(Row{converted})[0]
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -73,6 +74,8 @@ const (
 	PartitionsTableName = "partitions"
 	// InnoDBTempTableName is the name of the INNODB_TEMP_TABLE_INFO table
 	InnoDBTempTableName = "innodb_temp_table_info"
+	// QueryProgressTableName is the name of the query_progress table
+	QueryProgressTableName = "query_progress"
 )
 
 var _ Database = (*informationSchemaDatabase)(nil)
@@ -443,6 +446,15 @@ var innoDBTempTableSchema = Schema{
 	{Name: "space", Type: Uint64, Default: nil, Nullable: false, Source: InnoDBTempTableName},
 }
 
+var queryProgressSchema = Schema{
+	{Name: "id", Type: Uint64, Default: nil, Nullable: false, Source: QueryProgressTableName},
+	{Name: "table_name", Type: LongText, Default: nil, Nullable: false, Source: QueryProgressTableName},
+	{Name: "partitions_processed", Type: Int64, Default: nil, Nullable: false, Source: QueryProgressTableName},
+	{Name: "partitions_total", Type: Int64, Default: nil, Nullable: true, Source: QueryProgressTableName},
+	{Name: "rows_processed", Type: Int64, Default: nil, Nullable: false, Source: QueryProgressTableName},
+	{Name: "rows_estimated", Type: Int64, Default: nil, Nullable: true, Source: QueryProgressTableName},
+}
+
 func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range cat.AllDatabases() {
@@ -476,7 +488,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				y2k,                        // create_time
 				y2k,                        // update_time
 				nil,                        // check_time
-				Collation_Default.String(), // table_collation
+				TableCollation(t).String(), // table_collation
 				nil,                        // checksum
 				nil,                        // create_options
 				"",                         // table_comment
@@ -530,41 +542,46 @@ func columnsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
 			for i, c := range t.Schema() {
 				var (
-					nullable string
-					charName interface{}
-					collName interface{}
+					nullable      string
+					charName      interface{}
+					collName      interface{}
+					charMaxLength interface{}
+					charOctLength interface{}
 				)
 				if c.Nullable {
 					nullable = "YES"
 				} else {
 					nullable = "NO"
 				}
-				if IsText(c.Type) {
-					charName = Collation_Default.CharacterSet().String()
-					collName = Collation_Default.String()
+				if collation, ok := c.Collation(); ok {
+					charName = collation.CharacterSet().String()
+					collName = collation.String()
+				}
+				if st, ok := c.Type.(StringType); ok {
+					charMaxLength, charOctLength = characterLengths(st)
 				}
 				rows = append(rows, Row{
-					"def",                            // table_catalog
-					db.Name(),                        // table_schema
-					t.Name(),                         // table_name
-					c.Name,                           // column_name
-					uint64(i),                        // ordinal_position
-					c.Default.String(),               // column_default
-					nullable,                         // is_nullable
-					strings.ToLower(c.Type.String()), // data_type
-					nil,                              // character_maximum_length
-					nil,                              // character_octet_length
-					nil,                              // numeric_precision
-					nil,                              // numeric_scale
-					nil,                              // datetime_precision
-					charName,                         // character_set_name
-					collName,                         // collation_name
-					strings.ToLower(c.Type.String()), // column_type
-					"",                               // column_key
-					c.Extra,                          // extra
-					"select",                         // privileges
-					c.Comment,                        // column_comment
-					"",                               // generation_expression
+					"def",              // table_catalog
+					db.Name(),          // table_schema
+					t.Name(),           // table_name
+					c.Name,             // column_name
+					uint64(i),          // ordinal_position
+					c.Default.String(), // column_default
+					nullable,           // is_nullable
+					c.TypeDefinition(), // data_type
+					charMaxLength,      // character_maximum_length
+					charOctLength,      // character_octet_length
+					nil,                // numeric_precision
+					nil,                // numeric_scale
+					nil,                // datetime_precision
+					charName,           // character_set_name
+					collName,           // collation_name
+					c.TypeDefinition(), // column_type
+					"",                 // column_key
+					c.Extra,            // extra
+					"select",           // privileges
+					c.Comment,          // column_comment
+					"",                 // generation_expression
 				})
 			}
 			return true, nil
@@ -577,16 +594,27 @@ func columnsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// characterLengths returns the maximum length in characters and bytes of the string type given, as reported in the
+// columns table. TEXT and BLOB types report the length in bytes of their size class for both.
+func characterLengths(t StringType) (uint64, uint64) {
+	if IsTextBlob(t) {
+		length := uint64(MaxClassByteLength(t))
+		return length, length
+	}
+	return uint64(t.MaxCharacterLength()), uint64(t.MaxByteLength())
+}
+
 func schemataRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	dbs := c.AllDatabases()
 
 	var rows []Row
 	for _, db := range dbs {
+		collation := DatabaseCollation(ctx, db)
 		rows = append(rows, Row{
 			"def",
 			db.Name(),
-			Collation_Default.CharacterSet().String(),
-			Collation_Default.String(),
+			collation.CharacterSet().String(),
+			collation.String(),
 			nil,
 		})
 	}
@@ -946,6 +974,46 @@ func innoDBTempTableIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// queryProgressRowIter returns the progress of the tables read by each of the running queries, with the same id as
+// the one of the query in the process list.
+func queryProgressRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	processes := ctx.ProcessList.Processes()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Pid < processes[j].Pid
+	})
+
+	var rows []Row
+	for _, proc := range processes {
+		names := make([]string, 0, len(proc.Progress))
+		for name := range proc.Progress {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			progress := proc.Progress[name]
+			rows = append(rows, Row{
+				uint64(proc.Connection),
+				name,
+				progress.Done,
+				unknownAsNull(progress.Total),
+				progress.Rows.Done,
+				unknownAsNull(progress.Rows.Total),
+			})
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// unknownAsNull returns nil for a negative progress total, which means it's unknown.
+func unknownAsNull(total int64) interface{} {
+	if total < 0 {
+		return nil
+	}
+	return total
+}
+
 func emptyRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(), nil
 }
@@ -1053,6 +1121,11 @@ func NewInformationSchemaDatabase() Database {
 				schema:  innoDBTempTableSchema,
 				rowIter: innoDBTempTableIter,
 			},
+			QueryProgressTableName: &informationSchemaTable{
+				name:    QueryProgressTableName,
+				schema:  queryProgressSchema,
+				rowIter: queryProgressRowIter,
+			},
 		},
 	}
 }
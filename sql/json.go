@@ -73,7 +73,7 @@ func (t jsonType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t jsonType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t jsonType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -0,0 +1,448 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrInvalidJSONPath is returned when a JSON path expression can't be parsed.
+var ErrInvalidJSONPath = errors.NewKind("Invalid JSON path expression. The error is around character position %d.")
+
+// ErrJSONPathWildcard is returned when a JSON path expression with wildcards is given where it must select at most one
+// value.
+var ErrJSONPathWildcard = errors.NewKind("In this situation, path expressions may not contain the * and ** tokens.")
+
+// JSONPath is a parsed MySQL JSON path expression, such as `$.a[1].*`, which selects values of JSON documents. It's
+// made of the scope `$`, which is the whole document, followed by legs selecting:
+//   - a member of an object: .key or ."quoted key"
+//   - all the members of an object: .*
+//   - an element of an array: [N], [last] or [last-N]
+//   - a range of elements of an array: [M to N]
+//   - all the elements of an array: [*]
+//   - all the values the rest of the path selects at any depth: **
+//
+// As in MySQL, selecting the first element of a value that isn't an array selects the value itself.
+// https://dev.mysql.com/doc/refman/8.0/en/json.html#json-path-syntax
+type JSONPath struct {
+	path string
+	legs []jsonPathLeg
+}
+
+type jsonPathLegKind byte
+
+const (
+	jsonPathMember jsonPathLegKind = iota
+	jsonPathMemberWildcard
+	jsonPathIndex
+	jsonPathRange
+	jsonPathElementWildcard
+	jsonPathDoubleWildcard
+)
+
+// jsonArrayIndex is an array index in a JSON path, which is relative to the last element if fromLast is set.
+type jsonArrayIndex struct {
+	n        int
+	fromLast bool
+}
+
+// resolve returns the position of the index in an array of the length given, which may be out of the array.
+func (i jsonArrayIndex) resolve(length int) int {
+	if i.fromLast {
+		return length - 1 - i.n
+	}
+	return i.n
+}
+
+type jsonPathLeg struct {
+	kind     jsonPathLegKind
+	key      string
+	from, to jsonArrayIndex
+}
+
+// ParseJSONPath parses the MySQL JSON path expression given.
+func ParseJSONPath(path string) (*JSONPath, error) {
+	p := &jsonPathParser{s: path}
+	legs, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &JSONPath{path: path, legs: legs}, nil
+}
+
+// String returns the path as it was given to ParseJSONPath.
+func (p *JSONPath) String() string {
+	return p.path
+}
+
+// HasWildcards returns whether the path can select more than one value, in which case the values it selects are
+// returned in an array by JSON_EXTRACT even if there's only one.
+func (p *JSONPath) HasWildcards() bool {
+	for _, leg := range p.legs {
+		if leg.kind != jsonPathMember && leg.kind != jsonPathIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the values of the document given selected by the path, in document order. Object members are visited
+// in the order MySQL stores them, which is by the length of their keys first and then by the keys.
+func (p *JSONPath) Find(doc interface{}) []interface{} {
+	var matches []interface{}
+	findJSONPath(doc, p.legs, nil, func(v interface{}, _ []string) {
+		matches = append(matches, v)
+	})
+	return matches
+}
+
+// Locate is like Find, but also returns the paths without wildcards locating the values selected, such as $.a[1].
+func (p *JSONPath) Locate(doc interface{}) (matches []interface{}, paths []string) {
+	findJSONPath(doc, p.legs, nil, func(v interface{}, at []string) {
+		matches = append(matches, v)
+		paths = append(paths, "$"+strings.Join(at, ""))
+	})
+	return matches, paths
+}
+
+// findJSONPath calls match with the values of v selected by the legs given, along with their locations, which are
+// the legs leading to them from the document, appended to the location of v given.
+func findJSONPath(v interface{}, legs []jsonPathLeg, at []string, match func(interface{}, []string)) {
+	if len(legs) == 0 {
+		match(v, at)
+		return
+	}
+
+	leg, rest := legs[0], legs[1:]
+	switch leg.kind {
+	case jsonPathMember:
+		if obj, ok := v.(map[string]interface{}); ok {
+			if member, ok := obj[leg.key]; ok {
+				findJSONPath(member, rest, appendJSONLocation(at, jsonMemberLeg(leg.key)), match)
+			}
+		}
+	case jsonPathMemberWildcard:
+		if obj, ok := v.(map[string]interface{}); ok {
+			for _, key := range jsonObjectKeys(obj) {
+				findJSONPath(obj[key], rest, appendJSONLocation(at, jsonMemberLeg(key)), match)
+			}
+		}
+	case jsonPathIndex, jsonPathRange:
+		arr, ok := v.([]interface{})
+		if !ok {
+			// Values that aren't arrays are treated as arrays with just the value
+			arr = []interface{}{v}
+		}
+		from, to := leg.from.resolve(len(arr)), leg.to.resolve(len(arr))
+		if leg.kind == jsonPathIndex {
+			to = from
+		} else if from < 0 {
+			// Ranges starting before the array start at its first element
+			from = 0
+		}
+		if from < 0 {
+			return
+		}
+		for i := from; i <= to && i < len(arr); i++ {
+			elemAt := at
+			if ok {
+				elemAt = appendJSONLocation(at, jsonIndexLeg(i))
+			}
+			findJSONPath(arr[i], rest, elemAt, match)
+		}
+	case jsonPathElementWildcard:
+		if arr, ok := v.([]interface{}); ok {
+			for i, e := range arr {
+				findJSONPath(e, rest, appendJSONLocation(at, jsonIndexLeg(i)), match)
+			}
+		}
+	case jsonPathDoubleWildcard:
+		// The rest of the path is matched against the value and all of its descendants
+		findJSONPath(v, rest, at, match)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for _, key := range jsonObjectKeys(v) {
+				findJSONPath(v[key], legs, appendJSONLocation(at, jsonMemberLeg(key)), match)
+			}
+		case []interface{}:
+			for i, e := range v {
+				findJSONPath(e, legs, appendJSONLocation(at, jsonIndexLeg(i)), match)
+			}
+		}
+	}
+}
+
+// WalkJSONStrings calls fn with every string of the JSON value given and its path, in document order. The path of the
+// value itself is given.
+func WalkJSONStrings(v interface{}, path string, fn func(s, path string)) {
+	switch v := v.(type) {
+	case string:
+		fn(v, path)
+	case map[string]interface{}:
+		for _, key := range jsonObjectKeys(v) {
+			WalkJSONStrings(v[key], path+jsonMemberLeg(key), fn)
+		}
+	case []interface{}:
+		for i, e := range v {
+			WalkJSONStrings(e, path+jsonIndexLeg(i), fn)
+		}
+	}
+}
+
+// appendJSONLocation returns a copy of the location given with the leg given appended, so that locations can be
+// extended in different ways without affecting each other.
+func appendJSONLocation(at []string, leg string) []string {
+	return append(at[:len(at):len(at)], leg)
+}
+
+// jsonMemberLeg returns the path leg selecting the object member with the key given, which is quoted unless it's an
+// identifier, as in MySQL.
+func jsonMemberLeg(key string) string {
+	if isJSONPathIdentifier(key) {
+		return "." + key
+	}
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(key)
+	return "." + strings.TrimSuffix(buf.String(), "\n")
+}
+
+func jsonIndexLeg(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+// isJSONPathIdentifier returns whether the key given is an ECMAScript identifier, which doesn't need to be quoted in
+// JSON paths.
+func isJSONPathIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || i > 0 && unicode.IsDigit(r) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// jsonObjectKeys returns the keys of the object given in the order MySQL stores them.
+func jsonObjectKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+type jsonPathParser struct {
+	s   string
+	pos int
+}
+
+func (p *jsonPathParser) parse() ([]jsonPathLeg, error) {
+	p.skipSpaces()
+	if !p.consume("$") {
+		return nil, p.error()
+	}
+
+	var legs []jsonPathLeg
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.s) {
+			break
+		}
+
+		var leg jsonPathLeg
+		var err error
+		switch {
+		case p.consume("**"):
+			leg = jsonPathLeg{kind: jsonPathDoubleWildcard}
+		case p.consume("."):
+			leg, err = p.parseMember()
+		case p.consume("["):
+			leg, err = p.parseArrayLeg()
+		default:
+			err = p.error()
+		}
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, leg)
+	}
+
+	// ** must be followed by the legs it matches
+	if len(legs) > 0 && legs[len(legs)-1].kind == jsonPathDoubleWildcard {
+		return nil, p.error()
+	}
+	return legs, nil
+}
+
+func (p *jsonPathParser) parseMember() (jsonPathLeg, error) {
+	p.skipSpaces()
+	switch {
+	case p.consume("*"):
+		return jsonPathLeg{kind: jsonPathMemberWildcard}, nil
+	case p.pos < len(p.s) && p.s[p.pos] == '"':
+		key, err := p.parseQuotedKey()
+		if err != nil {
+			return jsonPathLeg{}, err
+		}
+		return jsonPathLeg{kind: jsonPathMember, key: key}, nil
+	}
+
+	// Unquoted keys end at the next leg. Unlike MySQL, they can contain any other character.
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] != '.' && p.s[p.pos] != '[' && !strings.HasPrefix(p.s[p.pos:], "**") {
+		p.pos++
+	}
+	key := strings.TrimSpace(p.s[start:p.pos])
+	if key == "" {
+		return jsonPathLeg{}, p.error()
+	}
+	return jsonPathLeg{kind: jsonPathMember, key: key}, nil
+}
+
+// parseQuotedKey parses a key in double quotes, which is a JSON string.
+func (p *jsonPathParser) parseQuotedKey() (string, error) {
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.s) && p.s[p.pos] != '"' {
+		if p.s[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.s) {
+		return "", p.error()
+	}
+	p.pos++
+
+	var key string
+	if err := json.Unmarshal([]byte(p.s[start:p.pos]), &key); err != nil {
+		return "", p.errorAt(start)
+	}
+	return key, nil
+}
+
+func (p *jsonPathParser) parseArrayLeg() (jsonPathLeg, error) {
+	p.skipSpaces()
+	var leg jsonPathLeg
+	if p.consume("*") {
+		leg.kind = jsonPathElementWildcard
+	} else {
+		from, err := p.parseIndex()
+		if err != nil {
+			return jsonPathLeg{}, err
+		}
+		leg.kind, leg.from = jsonPathIndex, from
+
+		p.skipSpaces()
+		if p.consumeKeyword("to") {
+			to, err := p.parseIndex()
+			if err != nil {
+				return jsonPathLeg{}, err
+			}
+			leg.kind, leg.to = jsonPathRange, to
+		}
+	}
+
+	p.skipSpaces()
+	if !p.consume("]") {
+		return jsonPathLeg{}, p.error()
+	}
+	return leg, nil
+}
+
+// parseIndex parses an array index, which is either a number, last, or last-N.
+func (p *jsonPathParser) parseIndex() (jsonArrayIndex, error) {
+	p.skipSpaces()
+	if p.consumeKeyword("last") {
+		p.skipSpaces()
+		if !p.consume("-") {
+			return jsonArrayIndex{fromLast: true}, nil
+		}
+		p.skipSpaces()
+		n, err := p.parseNumber()
+		return jsonArrayIndex{n: n, fromLast: true}, err
+	}
+	n, err := p.parseNumber()
+	return jsonArrayIndex{n: n}, err
+}
+
+func (p *jsonPathParser) parseNumber() (int, error) {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		return 0, p.errorAt(start)
+	}
+	return n, nil
+}
+
+func (p *jsonPathParser) consume(token string) bool {
+	if strings.HasPrefix(p.s[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// consumeKeyword consumes the keyword given, ignoring case, if it's next and not followed by an identifier character.
+func (p *jsonPathParser) consumeKeyword(keyword string) bool {
+	end := p.pos + len(keyword)
+	if end > len(p.s) || !strings.EqualFold(p.s[p.pos:end], keyword) {
+		return false
+	}
+	if end < len(p.s) && isIdentifierChar(p.s[end]) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func (p *jsonPathParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n' || p.s[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *jsonPathParser) error() error {
+	return p.errorAt(p.pos)
+}
+
+// errorAt returns the error for the position given, which is 1-based in MySQL's messages.
+func (p *jsonPathParser) errorAt(pos int) error {
+	return ErrInvalidJSONPath.New(pos + 1)
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath(t *testing.T) {
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"a": [1, 2, [3, 4], {"b": 5}],
+		"b": {"c": "foo", "d": {"c": "bar"}},
+		"key with spaces": 6,
+		"key\"quoted": 7,
+		"aa": 8
+	}`), &doc))
+
+	tests := []struct {
+		path      string
+		matches   []interface{}
+		wildcards bool
+	}{
+		{`$`, []interface{}{doc}, false},
+		{` $ `, []interface{}{doc}, false},
+		{`$.a[0]`, []interface{}{1.}, false},
+		{`$.a [ 1 ]`, []interface{}{2.}, false},
+		{`$.a[2][1]`, []interface{}{4.}, false},
+		{`$.a[4]`, nil, false},
+		{`$.a[last]`, []interface{}{map[string]interface{}{"b": 5.}}, false},
+		{`$.a[last-3]`, []interface{}{1.}, false},
+		{`$.a[last-4]`, nil, false},
+		{`$.a[LAST - 1][0]`, []interface{}{3.}, false},
+		{`$.a[1 to 2]`, []interface{}{2., []interface{}{3., 4.}}, true},
+		{`$.a[last-1 to last]`, []interface{}{[]interface{}{3., 4.}, map[string]interface{}{"b": 5.}}, true},
+		{`$.a[3 to 10]`, []interface{}{map[string]interface{}{"b": 5.}}, true},
+		{`$.a[*].b`, []interface{}{5.}, true},
+		{`$.b.c`, []interface{}{"foo"}, false},
+		{`$.b[0].c`, []interface{}{"foo"}, false},
+		{`$.b[1]`, nil, false},
+		{`$.b.*`, []interface{}{"foo", map[string]interface{}{"c": "bar"}}, true},
+		{`$.*`, []interface{}{doc.(map[string]interface{})["a"], doc.(map[string]interface{})["b"], 8., 7., 6.}, true},
+		{`$**.c`, []interface{}{"foo", "bar"}, true},
+		{`$.b**.c`, []interface{}{"foo", "bar"}, true},
+		{`$**[1]`, []interface{}{2., 4.}, true},
+		{`$."key with spaces"`, []interface{}{6.}, false},
+		{`$.key with spaces`, []interface{}{6.}, false},
+		{`$."key\"quoted"`, []interface{}{7.}, false},
+		{`$.nope`, nil, false},
+		{`$.a.nope`, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			p, err := ParseJSONPath(test.path)
+			require.NoError(t, err)
+			require.Equal(t, test.matches, p.Find(doc))
+			require.Equal(t, test.wildcards, p.HasWildcards())
+		})
+	}
+
+	for _, path := range []string{``, `a`, `$.`, `$.[0]`, `$[`, `$[a]`, `$[1 to]`, `$**`, `$."unterminated`, `$[0]x`} {
+		t.Run(path, func(t *testing.T) {
+			_, err := ParseJSONPath(path)
+			require.True(t, ErrInvalidJSONPath.Is(err), "%v", err)
+		})
+	}
+}
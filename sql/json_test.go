@@ -16,6 +16,7 @@ package sql
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,16 +29,16 @@ func TestJsonCompare(t *testing.T) {
 		right string
 		cmp   int
 	}{
-		// type precedence hierarchy: BOOLEAN, ARRAY, OBJECT, STRING, DOUBLE, NULL
+		// type precedence hierarchy: BOOLEAN, ARRAY, OBJECT, STRING, INTEGER/DOUBLE, NULL
 		{`true`, `[0]`, 1},
 		{`[0]`, `{"a": 0}`, 1},
 		{`{"a": 0}`, `"a"`, 1},
 		{`"a"`, `0`, 1},
-		{`0`, `null`, -1},
+		{`0`, `null`, 1},
 
 		// null
-		{`null`, `0`, 1},
-		{`0`, `null`, -1},
+		{`null`, `0`, -1},
+		{`null`, `false`, -1},
 		{`null`, `null`, 0},
 
 		// boolean
@@ -54,6 +55,7 @@ func TestJsonCompare(t *testing.T) {
 		{`0`, `0.0`, 0},
 		{`0`, `-1`, 1},
 		{`0`, `3.14`, -1},
+		{`1e2`, `100`, 0},
 
 		// arrays
 		{`[1,2]`, `[1,2]`, 0},
@@ -62,6 +64,7 @@ func TestJsonCompare(t *testing.T) {
 
 		// objects
 		{`{"a": 0}`, `{"a": 0}`, 0},
+		{`{"a":1,"b":[2]}`, `{"b": [2.0], "a": 1}`, 0},
 		// deterministic object ordering with arbitrary rules
 		{`{"a": 1}`, `{"a": 0}`, 1},                 // 1 > 0
 		{`{"a": 0}`, `{"a": 0, "b": 1}`, -1},        // longer
@@ -93,6 +96,36 @@ func TestJsonCompare(t *testing.T) {
 	}
 }
 
+func TestJsonCompareNumbers(t *testing.T) {
+	tests := []struct {
+		left  interface{}
+		right interface{}
+		cmp   int
+	}{
+		{int64(1), float64(1), 0},
+		{int8(-1), uint64(0), -1},
+		{uint64(math.MaxUint64), float64(1), 1},
+		{int64(9223372036854775806), int64(9223372036854775807), -1},
+		{int64(9223372036854775807), 9.223372036854776e18, -1},
+		{9.223372036854776e18, uint64(9223372036854776000), 0},
+		{uint64(9223372036854776001), 9.223372036854776e18, 1},
+		{int32(2), "2", -1},
+		{int32(2), nil, 1},
+	}
+
+	for _, test := range tests {
+		name := fmt.Sprintf("%v_%v__%d", test.left, test.right, test.cmp)
+		t.Run(name, func(t *testing.T) {
+			cmp, err := JSON.Compare(
+				JSONDocument{Val: test.left},
+				JSONDocument{Val: test.right},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, test.cmp, cmp)
+		})
+	}
+}
+
 func TestJsonConvert(t *testing.T) {
 	tests := []struct {
 		val         interface{}
@@ -16,11 +16,12 @@ package sql
 
 import (
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"sort"
 	"strings"
 
-	"github.com/oliveagle/jsonpath"
+	"github.com/shopspring/decimal"
 )
 
 // JSONValue is an integrator specific implementation of a JSON field value.
@@ -42,7 +43,8 @@ type SearchableJSONValue interface {
 
 	// Contains is value-specific implementation of JSON_Contains()
 	Contains(ctx *Context, candidate JSONValue) (val interface{}, err error)
-	// Extract is value-specific implementation of JSON_Extract()
+	// Extract is value-specific implementation of JSON_Extract() with a single path. It returns nil if the path
+	// selects nothing.
 	Extract(ctx *Context, path string) (val JSONValue, err error)
 	// Keys is value-specific implementation of JSON_Keys()
 	Keys(ctx *Context, path string) (val JSONValue, err error)
@@ -87,24 +89,36 @@ func (doc JSONDocument) Contains(ctx *Context, candidate JSONValue) (val interfa
 	return containsJSON(doc.Val, other.Val)
 }
 
+// Extract returns the values selected by the path given, in an array if the path has wildcards, or nil if it selects
+// nothing.
 func (doc JSONDocument) Extract(ctx *Context, path string) (JSONValue, error) {
-	c, err := jsonpath.Compile(path)
+	p, err := ParseJSONPath(path)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(andy) handle error
-	val, _ := c.Lookup(doc.Val) // err ignored
-
-	return JSONDocument{Val: val}, nil
+	matches := p.Find(doc.Val)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	if !p.HasWildcards() {
+		return JSONDocument{Val: matches[0]}, nil
+	}
+	return JSONDocument{Val: matches}, nil
 }
 
 func (doc JSONDocument) Keys(ctx *Context, path string) (val JSONValue, err error) {
 	panic("not implemented")
 }
 
+// Overlaps returns whether the documents have any key-value pairs or array elements in common. Values that aren't
+// arrays overlap with the arrays they're elements of, and scalars only overlap with the scalars they're equal to.
 func (doc JSONDocument) Overlaps(ctx *Context, val SearchableJSONValue) (ok bool, err error) {
-	panic("not implemented")
+	other, err := val.Unmarshall(ctx)
+	if err != nil {
+		return false, err
+	}
+	return overlapsJSON(doc.Val, other.Val)
 }
 
 func (doc JSONDocument) Search(ctx *Context) (path string, err error) {
@@ -123,6 +137,56 @@ func ConcatenateJSONValues(ctx *Context, vals ...JSONValue) (JSONValue, error) {
 	return JSONDocument{Val: arr}, nil
 }
 
+func overlapsJSON(a, b interface{}) (bool, error) {
+	aArr, aIsArr := a.([]interface{})
+	bArr, bIsArr := b.([]interface{})
+	if aIsArr || bIsArr {
+		if !aIsArr {
+			aArr = []interface{}{a}
+		}
+		if !bIsArr {
+			bArr = []interface{}{b}
+		}
+		for _, aa := range aArr {
+			for _, bb := range bArr {
+				cmp, err := compareJSON(aa, bb)
+				if err != nil {
+					return false, err
+				}
+				if cmp == 0 {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	aObj, aIsObj := a.(map[string]interface{})
+	bObj, bIsObj := b.(map[string]interface{})
+	if aIsObj && bIsObj {
+		for k, av := range aObj {
+			bv, ok := bObj[k]
+			if !ok {
+				continue
+			}
+			cmp, err := compareJSON(av, bv)
+			if err != nil {
+				return false, err
+			}
+			if cmp == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	if aIsObj || bIsObj {
+		return false, nil
+	}
+
+	cmp, err := compareJSON(a, b)
+	return cmp == 0, err
+}
+
 func containsJSON(a, b interface{}) (interface{}, error) {
 	if a == nil || b == nil {
 		return nil, nil
@@ -137,9 +201,10 @@ func containsJSON(a, b interface{}) (interface{}, error) {
 		return containsJSONObject(a, b)
 	case string:
 		return containsJSONString(a, b)
-	case float64:
-		return containsJSONNumber(a, b)
 	default:
+		if isJSONNumber(a) {
+			return containsJSONNumber(a, b)
+		}
 		return false, ErrInvalidType.New(a)
 	}
 }
@@ -240,13 +305,19 @@ func containsJSONString(a string, b interface{}) (bool, error) {
 	}
 }
 
-func containsJSONNumber(a float64, b interface{}) (bool, error) {
-	switch b := b.(type) {
-	case float64:
-		return a == b, nil
-	default:
+func containsJSONNumber(a interface{}, b interface{}) (bool, error) {
+	if !isJSONNumber(b) {
 		return false, nil
 	}
+	da, err := jsonNumberToDecimal(a)
+	if err != nil {
+		return false, err
+	}
+	db, err := jsonNumberToDecimal(b)
+	if err != nil {
+		return false, err
+	}
+	return da.Equal(db), nil
 }
 
 // JSON values can be compared using the =, <, <=, >, >=, <>, !=, and <=> operators. BETWEEN IN() GREATEST() LEAST() are
@@ -262,7 +333,7 @@ func containsJSONNumber(a float64, b interface{}) (bool, error) {
 //
 // 		BLOB, BIT, OPAQUE, DATETIME, TIME, DATE, BOOLEAN, ARRAY, OBJECT, STRING, INTEGER, DOUBLE, NULL
 // 		TODO(andy): implement BLOB BIT OPAQUE DATETIME TIME DATE
-//      current precedence: BOOLEAN, ARRAY, OBJECT, STRING, DOUBLE, NULL
+//      current precedence: BOOLEAN, ARRAY, OBJECT, STRING, INTEGER/DOUBLE, NULL
 //
 // For JSON values of the same precedence, the comparison rules are type specific:
 //
@@ -286,7 +357,7 @@ func containsJSONNumber(a float64, b interface{}) (bool, error) {
 //       This ordering is equivalent to the ordering of SQL strings with collation utf8mb4_bin. Because utf8mb4_bin is a
 //       binary collation, comparison of JSON values is case-sensitive:
 //         e.g.   "A" < "a"
-//   - DOUBLE
+//   - INTEGER, DOUBLE
 //       JSON values can contain exact-value numbers and approximate-value numbers. For a general discussion of these
 //       types of numbers, see Section 9.1.2, “Numeric Literals”. The rules for comparing native MySQL numeric types are
 //       discussed in Section 12.3, “Type Conversion in Expression Evaluation”, but the rules for comparing numbers
@@ -301,13 +372,15 @@ func containsJSONNumber(a float64, b interface{}) (bool, error) {
 //             e.g.   9223372036854775805 < 9223372036854775806 < 9223372036854775807 < 9.223372036854776e18
 //                    = 9223372036854776000 < 9223372036854776001
 //   - NULL
-//       For comparison of any JSON value to SQL NULL, the result is UNKNOWN.
+//       The JSON null literal is less than any other JSON value. For comparison of any JSON value to SQL NULL, the
+//       result is UNKNOWN, which is handled by the comparison expressions before reaching here.
 //
-//   TODO(andy): BLOB, BIT, OPAQUE, DATETIME, TIME, DATE, INTEGER
+//   TODO(andy): BLOB, BIT, OPAQUE, DATETIME, TIME, DATE
 //
 // https://dev.mysql.com/doc/refman/8.0/en/json.html#json-comparison
 func compareJSON(a, b interface{}) (int, error) {
-	if hasNulls, res := compareNulls(a, b); hasNulls {
+	if hasNulls, res := compareNulls(b, a); hasNulls {
+		// null has the lowest precedence
 		return res, nil
 	}
 
@@ -320,9 +393,10 @@ func compareJSON(a, b interface{}) (int, error) {
 		return compareJSONObject(a, b)
 	case string:
 		return compareJSONString(a, b)
-	case float64:
-		return compareJSONNumber(a, b)
 	default:
+		if isJSONNumber(a) {
+			return compareJSONNumber(a, b)
+		}
 		return 0, ErrInvalidType.New(a)
 	}
 }
@@ -435,8 +509,8 @@ func compareJSONString(a string, b interface{}) (int, error) {
 	}
 }
 
-func compareJSONNumber(a float64, b interface{}) (int, error) {
-	switch b := b.(type) {
+func compareJSONNumber(a interface{}, b interface{}) (int, error) {
+	switch b.(type) {
 	case
 		bool,
 		[]interface{},
@@ -444,19 +518,73 @@ func compareJSONNumber(a float64, b interface{}) (int, error) {
 		string:
 		// a is lower precedence
 		return -1, nil
+	}
+
+	if !isJSONNumber(b) {
+		return 0, ErrInvalidType.New(b)
+	}
 
+	// Integers and doubles share the same precedence, and are compared as exact values so that integers too large
+	// for a double keep their order.
+	da, err := jsonNumberToDecimal(a)
+	if err != nil {
+		return 0, err
+	}
+	db, err := jsonNumberToDecimal(b)
+	if err != nil {
+		return 0, err
+	}
+	return da.Cmp(db), nil
+}
+
+// isJSONNumber returns whether the value given is a number of a JSON document. Documents unmarshalled from strings
+// hold float64 numbers, but those built from SQL values may hold any Go numeric type.
+func isJSONNumber(v interface{}) bool {
+	switch v.(type) {
+	case float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonNumberToDecimal returns the exact value of a number of a JSON document, or an error if isJSONNumber is false.
+// Doubles are converted to the shortest decimal representing them, so that 9.223372036854776e18 equals
+// 9223372036854776000 as in MySQL.
+func jsonNumberToDecimal(v interface{}) (decimal.Decimal, error) {
+	switch v := v.(type) {
 	case float64:
-		if a > b {
-			return 1, nil
-		}
-		if a < b {
-			return -1, nil
+		return decimal.NewFromFloat(v), nil
+	case float32:
+		return decimal.NewFromFloat32(v), nil
+	case int:
+		return decimal.NewFromInt(int64(v)), nil
+	case int8:
+		return decimal.NewFromInt(int64(v)), nil
+	case int16:
+		return decimal.NewFromInt(int64(v)), nil
+	case int32:
+		return decimal.NewFromInt(int64(v)), nil
+	case int64:
+		return decimal.NewFromInt(v), nil
+	case uint:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(uint64(v)), 0), nil
+	case uint8:
+		return decimal.NewFromInt(int64(v)), nil
+	case uint16:
+		return decimal.NewFromInt(int64(v)), nil
+	case uint32:
+		return decimal.NewFromInt(int64(v)), nil
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(v), 0), nil
+	case json.Number:
+		if d, err := decimal.NewFromString(string(v)); err == nil {
+			return d, nil
 		}
-		return 0, nil
-
+		f, _ := v.Float64()
+		return decimal.NewFromFloat(f), nil
 	default:
-		// a is higher precedence
-		return 1, nil
+		return decimal.Decimal{}, ErrInvalidType.New(v)
 	}
 }
 
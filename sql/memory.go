@@ -19,6 +19,7 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	errors "gopkg.in/src-d/go-errors.v1"
 )
@@ -115,6 +116,13 @@ func (processReporter) MaxMemory() uint64 { return maxMemory }
 // HasAvailableMemory reports whether more memory is available to the program if
 // it hasn't reached the max memory limit.
 func HasAvailableMemory(r Reporter) bool {
+	if r, ok := r.(*operatorMemory); ok {
+		return HasAvailableMemory(r.parent)
+	}
+	if q, ok := r.(*MemoryQuota); ok && !HasAvailableMemory(q.parent) {
+		return false
+	}
+
 	maxMemory := r.MaxMemory()
 	if maxMemory == 0 {
 		return true
@@ -123,6 +131,81 @@ func HasAvailableMemory(r Reporter) bool {
 	return r.UsedMemory() < maxMemory
 }
 
+// MemoryQuota is a reporter limiting the memory held by the row and history caches of the memory managers using it,
+// for example the ones of the queries of a workload class. The memory of a set of queries can't be measured, so the
+// memory used is the estimated size of the values in those caches. The limit of the parent reporter applies as well.
+type MemoryQuota struct {
+	parent Reporter
+	max    uint64
+	used   uint64
+}
+
+// NewMemoryQuota creates a new quota of the number of bytes given, which also has the limit of the parent reporter.
+// A limit of zero means no limit.
+func NewMemoryQuota(parent Reporter, max uint64) *MemoryQuota {
+	if parent == nil {
+		parent = ProcessMemory
+	}
+	return &MemoryQuota{parent: parent, max: max}
+}
+
+// MaxMemory implements the Reporter interface.
+func (q *MemoryQuota) MaxMemory() uint64 { return q.max }
+
+// UsedMemory implements the Reporter interface.
+func (q *MemoryQuota) UsedMemory() uint64 { return atomic.LoadUint64(&q.used) }
+
+// reserveMemory adds the estimated size of the value given to the memory used by the reporter given, if it's a
+// MemoryQuota, and returns it so that it can be released later.
+func reserveMemory(r Reporter, v interface{}) uint64 {
+	switch r := r.(type) {
+	case *MemoryQuota:
+		n := estimatedSize(v)
+		atomic.AddUint64(&r.used, n)
+		return n
+	case *operatorMemory:
+		// the parent reserves either the same size or nothing, so releasing this size releases it from both
+		reserveMemory(r.parent, v)
+		n := estimatedSize(v)
+		r.op.reserveMemory(n)
+		return n
+	default:
+		return 0
+	}
+}
+
+// releaseMemory releases the number of bytes given, as returned by reserveMemory, from the reporter given.
+func releaseMemory(r Reporter, n uint64) {
+	if n == 0 {
+		return
+	}
+	switch r := r.(type) {
+	case *MemoryQuota:
+		atomic.AddUint64(&r.used, ^(n - 1))
+	case *operatorMemory:
+		releaseMemory(r.parent, n)
+		r.op.releaseMemory(n)
+	}
+}
+
+// estimatedSize returns a rough estimate of the number of bytes used by the value given.
+func estimatedSize(v interface{}) uint64 {
+	switch v := v.(type) {
+	case Row:
+		n := uint64(24)
+		for _, x := range v {
+			n += estimatedSize(x)
+		}
+		return n
+	case string:
+		return 16 + uint64(len(v))
+	case []byte:
+		return 24 + uint64(len(v))
+	default:
+		return 16
+	}
+}
+
 // MemoryManager is in charge of keeping track and managing all the components that operate
 // in memory. There should only be one instance of a memory manager running at the
 // same time in each process.
@@ -131,6 +214,8 @@ type MemoryManager struct {
 	reporter Reporter
 	caches   map[uint64]Disposable
 	token    uint64
+	// root is the manager the caches are registered with, for the managers of plan operators. See forOperator.
+	root *MemoryManager
 }
 
 // NewMemoryManager creates a new manager with the given memory reporter. If nil is given,
@@ -146,6 +231,30 @@ func NewMemoryManager(r Reporter) *MemoryManager {
 	}
 }
 
+// forOperator returns a manager for the caches created while running the plan operator given, which accounts for
+// their memory in the operator's metrics. The caches are still registered with, and freed by, this manager.
+func (m *MemoryManager) forOperator(op *operatorMetrics) *MemoryManager {
+	root, reporter := m, m.reporter
+	if m.root != nil {
+		root = m.root
+	}
+	if r, ok := reporter.(*operatorMemory); ok {
+		reporter = r.parent
+	}
+	return &MemoryManager{
+		reporter: &operatorMemory{parent: reporter, op: op},
+		root:     root,
+	}
+}
+
+// registry returns the manager that caches are registered with.
+func (m *MemoryManager) registry() *MemoryManager {
+	if m.root != nil {
+		return m.root
+	}
+	return m
+}
+
 // HasAvailable reports whether the memory manager has any available memory.
 func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
@@ -157,33 +266,36 @@ type DisposeFunc func()
 // NewLRUCache returns an empty LRU cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewLRUCache(size uint) (KeyValueCache, DisposeFunc) {
-	c := newLRUCache(m, m.reporter, size)
-	pos := m.addCache(c)
+	r := m.registry()
+	c := newLRUCache(r, m.reporter, size)
+	pos := r.addCache(c)
 	return c, func() {
 		c.Dispose()
-		m.removeCache(pos)
+		r.removeCache(pos)
 	}
 }
 
 // NewHistoryCache returns an empty history cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewHistoryCache() (KeyValueCache, DisposeFunc) {
-	c := newHistoryCache(m, m.reporter)
-	pos := m.addCache(c)
+	r := m.registry()
+	c := newHistoryCache(r, m.reporter)
+	pos := r.addCache(c)
 	return c, func() {
 		c.Dispose()
-		m.removeCache(pos)
+		r.removeCache(pos)
 	}
 }
 
 // NewRowsCache returns an empty rows cache and a function to dispose it when it's
 // no longer needed.
 func (m *MemoryManager) NewRowsCache() (RowsCache, DisposeFunc) {
-	c := newRowsCache(m, m.reporter)
-	pos := m.addCache(c)
+	r := m.registry()
+	c := newRowsCache(r, m.reporter)
+	pos := r.addCache(c)
 	return c, func() {
 		c.Dispose()
-		m.removeCache(pos)
+		r.removeCache(pos)
 	}
 }
 
@@ -207,6 +319,10 @@ func (m *MemoryManager) removeCache(pos uint64) {
 
 // Free the memory of all freeable caches.
 func (m *MemoryManager) Free() {
+	if m.root != nil {
+		m.root.Free()
+		return
+	}
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -218,6 +334,9 @@ func (m *MemoryManager) Free() {
 }
 
 func (m *MemoryManager) NumCaches() int {
+	if m.root != nil {
+		return m.root.NumCaches()
+	}
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	return len(m.caches)
@@ -68,6 +68,62 @@ func TestHasAvailable(t *testing.T) {
 	require.False(t, HasAvailableMemory(fixedReporter(6, 5)))
 }
 
+func TestMemoryQuota(t *testing.T) {
+	require := require.New(t)
+
+	quota := NewMemoryQuota(fixedReporter(2, 5), 100)
+	cache := newRowsCache(mockMemory{}, quota)
+	require.NoError(cache.Add(NewRow("foo", int64(1))))
+	require.Equal(uint64(24+19+16), quota.UsedMemory())
+	require.NoError(cache.Add(NewRow("bar", int64(2))))
+	require.Error(cache.Add(NewRow("baz", int64(3))))
+
+	other := newHistoryCache(mockMemory{}, quota)
+	require.True(ErrNoMemoryAvailable.Is(other.Put(1, "foo")))
+
+	cache.Dispose()
+	require.Equal(uint64(0), quota.UsedMemory())
+	require.NoError(other.Put(1, "foo"))
+	require.NoError(other.Put(1, "foo"))
+	require.Equal(uint64(19), quota.UsedMemory())
+	other.Dispose()
+	require.Equal(uint64(0), quota.UsedMemory())
+
+	require.False(HasAvailableMemory(NewMemoryQuota(fixedReporter(6, 5), 100)))
+}
+
+func TestOperatorMemory(t *testing.T) {
+	require := require.New(t)
+
+	quota := NewMemoryQuota(fixedReporter(2, 5), 100)
+	m := NewMemoryManager(quota)
+	op := &operatorMetrics{name: "plan.Sort"}
+	opMemory := m.forOperator(op)
+	require.Equal(quota, opMemory.forOperator(op).reporter.(*operatorMemory).parent)
+
+	cache, dispose := opMemory.NewRowsCache()
+	require.Equal(1, m.NumCaches())
+	require.NoError(cache.Add(NewRow("foo", int64(1))))
+	require.NoError(cache.Add(NewRow("bar", int64(2))))
+	require.Equal(uint64(2*(24+19+16)), quota.UsedMemory())
+	require.Error(cache.Add(NewRow("baz", int64(3))))
+
+	dispose()
+	require.Equal(0, m.NumCaches())
+	require.Equal(uint64(0), quota.UsedMemory())
+	require.Equal(uint64(0), op.memory)
+	require.Equal(uint64(2*(24+19+16)), op.highWater)
+
+	// Without a quota, only the operator accounts for the memory
+	m = NewMemoryManager(fixedReporter(2, 5))
+	op = &operatorMetrics{name: "plan.Distinct"}
+	history, dispose := m.forOperator(op).NewHistoryCache()
+	require.NoError(history.Put(1, "foo"))
+	require.Equal(uint64(19), op.highWater)
+	dispose()
+	require.Equal(uint64(0), op.memory)
+}
+
 type mockReporter struct {
 	f   func() uint64
 	max uint64
@@ -0,0 +1,97 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+const (
+	// MySQLDatabaseName is the name of the mysql system database.
+	MySQLDatabaseName = "mysql"
+	// TimeZoneTableName is the name of the time zone table.
+	TimeZoneTableName = "time_zone"
+	// TimeZoneNameTableName is the name of the time zone name table.
+	TimeZoneNameTableName = "time_zone_name"
+	// TimeZoneTransitionTableName is the name of the time zone transition table.
+	TimeZoneTransitionTableName = "time_zone_transition"
+	// TimeZoneTransitionTypeTableName is the name of the time zone transition type table.
+	TimeZoneTransitionTypeTableName = "time_zone_transition_type"
+	// TimeZoneLeapSecondTableName is the name of the time zone leap second table.
+	TimeZoneLeapSecondTableName = "time_zone_leap_second"
+)
+
+var (
+	leapSecondsType  = sql.MustCreateEnumType([]string{"Y", "N"}, sql.Collation_Default)
+	abbreviationType = sql.MustCreateStringWithDefaults(sqltypes.Char, 8)
+)
+
+var timeZoneSchema = sql.Schema{
+	{Name: "Time_zone_id", Type: sql.Uint32, Source: TimeZoneTableName, PrimaryKey: true, AutoIncrement: true},
+	{Name: "Use_leap_seconds", Type: leapSecondsType, Source: TimeZoneTableName, Default: literalDefault("N", sql.LongText, leapSecondsType)},
+}
+
+var timeZoneNameSchema = sql.Schema{
+	{Name: "Name", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 64), Source: TimeZoneNameTableName, PrimaryKey: true},
+	{Name: "Time_zone_id", Type: sql.Uint32, Source: TimeZoneNameTableName},
+}
+
+var timeZoneTransitionSchema = sql.Schema{
+	{Name: "Time_zone_id", Type: sql.Uint32, Source: TimeZoneTransitionTableName, PrimaryKey: true},
+	{Name: "Transition_time", Type: sql.Int64, Source: TimeZoneTransitionTableName, PrimaryKey: true},
+	{Name: "Transition_type_id", Type: sql.Uint32, Source: TimeZoneTransitionTableName},
+}
+
+var timeZoneTransitionTypeSchema = sql.Schema{
+	{Name: "Time_zone_id", Type: sql.Uint32, Source: TimeZoneTransitionTypeTableName, PrimaryKey: true},
+	{Name: "Transition_type_id", Type: sql.Uint32, Source: TimeZoneTransitionTypeTableName, PrimaryKey: true},
+	{Name: "Offset", Type: sql.Int32, Source: TimeZoneTransitionTypeTableName, Default: literalDefault(int8(0), sql.Int8, sql.Int32)},
+	{Name: "Is_DST", Type: sql.Uint8, Source: TimeZoneTransitionTypeTableName, Default: literalDefault(int8(0), sql.Int8, sql.Uint8)},
+	{Name: "Abbreviation", Type: abbreviationType, Source: TimeZoneTransitionTypeTableName, Default: literalDefault("", sql.LongText, abbreviationType)},
+}
+
+var timeZoneLeapSecondSchema = sql.Schema{
+	{Name: "Transition_time", Type: sql.Int64, Source: TimeZoneLeapSecondTableName, PrimaryKey: true},
+	{Name: "Correction", Type: sql.Int32, Source: TimeZoneLeapSecondTableName},
+}
+
+// NewMySQLDatabase creates a new mysql system database. It has the time zone tables of MySQL, which are empty until
+// loaded, for instance with the statements mysql_tzinfo_to_sql generates. Time zones are always resolved from the Go
+// time zone database, the tables are only there for the compatibility of tools and clients expecting them.
+func NewMySQLDatabase() *memory.Database {
+	db := memory.NewDatabase(MySQLDatabaseName)
+	for name, schema := range map[string]sql.Schema{
+		TimeZoneTableName:               timeZoneSchema,
+		TimeZoneNameTableName:           timeZoneNameSchema,
+		TimeZoneTransitionTableName:     timeZoneTransitionSchema,
+		TimeZoneTransitionTypeTableName: timeZoneTransitionTypeSchema,
+		TimeZoneLeapSecondTableName:     timeZoneLeapSecondSchema,
+	} {
+		db.AddTable(name, memory.NewTable(name, sql.NewPrimaryKeySchema(schema)))
+	}
+	return db
+}
+
+func literalDefault(value interface{}, literalType, columnType sql.Type) *sql.ColumnDefaultValue {
+	def, err := sql.NewColumnDefaultValue(expression.NewLiteral(value, literalType), columnType, true, false)
+	if err != nil {
+		panic(err)
+	}
+	return def
+}
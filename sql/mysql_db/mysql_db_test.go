@@ -0,0 +1,55 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+func TestLoadTimeZoneTables(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(mysql_db.NewMySQLDatabase()))
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase(mysql_db.MySQLDatabaseName)
+
+	// Statements as generated by mysql_tzinfo_to_sql
+	for _, q := range []string{
+		"INSERT INTO time_zone (Use_leap_seconds) VALUES ('N')",
+		"SET @time_zone_id= LAST_INSERT_ID()",
+		"INSERT INTO time_zone_name (Name, Time_zone_id) VALUES ('America/New_York', @time_zone_id)",
+		"INSERT INTO time_zone_transition (Time_zone_id, Transition_time, Transition_type_id) VALUES (@time_zone_id, -2717650800, 1)",
+		"INSERT INTO time_zone_transition_type (Time_zone_id, Transition_type_id, Offset, Is_DST, Abbreviation) VALUES (@time_zone_id, 1, -18000, 0, 'EST')",
+	} {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+	}
+
+	_, iter, err := e.Query(ctx, "SELECT n.Name, z.Use_leap_seconds, t.Offset, t.Abbreviation FROM time_zone z "+
+		"JOIN time_zone_name n ON z.Time_zone_id = n.Time_zone_id "+
+		"JOIN time_zone_transition_type t ON z.Time_zone_id = t.Time_zone_id")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"America/New_York", "N", int32(-18000), "EST"}}, rows)
+}
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -312,6 +313,59 @@ func (t numberTypeImpl) Convert(v interface{}) (interface{}, error) {
 	}
 }
 
+// ClampNumber converts the value given to the number type given like Convert, but values out of the range of the type
+// are replaced by its nearest limit instead of failing. Returns whether the value was clamped.
+func ClampNumber(t NumberType, v interface{}) (interface{}, bool, error) {
+	converted, err := t.Convert(v)
+	if err == nil || !ErrOutOfRange.Is(err) {
+		return converted, false, err
+	}
+
+	nt, ok := t.(numberTypeImpl)
+	if !ok {
+		return nil, false, err
+	}
+	f, ferr := convertToFloat64(nt, v)
+	if ferr != nil {
+		return nil, false, err
+	}
+	min, max := nt.limits()
+	if f < 0 {
+		return min, true, nil
+	}
+	return max, true, nil
+}
+
+// limits returns the minimum and maximum values of the type.
+func (t numberTypeImpl) limits() (interface{}, interface{}) {
+	switch t.baseType {
+	case sqltypes.Int8:
+		return int8(math.MinInt8), int8(math.MaxInt8)
+	case sqltypes.Uint8:
+		return uint8(0), uint8(math.MaxUint8)
+	case sqltypes.Int16:
+		return int16(math.MinInt16), int16(math.MaxInt16)
+	case sqltypes.Uint16:
+		return uint16(0), uint16(math.MaxUint16)
+	case sqltypes.Int24:
+		return int32(-1 << 23), int32(1<<23 - 1)
+	case sqltypes.Uint24:
+		return uint32(0), uint32(1<<24 - 1)
+	case sqltypes.Int32:
+		return int32(math.MinInt32), int32(math.MaxInt32)
+	case sqltypes.Uint32:
+		return uint32(0), uint32(math.MaxUint32)
+	case sqltypes.Int64:
+		return int64(math.MinInt64), int64(math.MaxInt64)
+	case sqltypes.Uint64:
+		return uint64(0), uint64(math.MaxUint64)
+	case sqltypes.Float32:
+		return float32(-math.MaxFloat32), float32(math.MaxFloat32)
+	default:
+		return -math.MaxFloat64, math.MaxFloat64
+	}
+}
+
 // MustConvert implements the Type interface.
 func (t numberTypeImpl) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
@@ -336,26 +390,50 @@ func (t numberTypeImpl) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t numberTypeImpl) SQL(v interface{}) (sqltypes.Value, error) {
+func (t numberTypeImpl) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
 
-	var val []byte
+	// Values of the Go types of the column are serialized as they are, others are converted first.
+	if !t.isNativeValue(v) {
+		var err error
+		if v, err = t.Convert(v); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+
+	start := len(dest)
 	switch t.baseType {
 	case sqltypes.Int8, sqltypes.Int16, sqltypes.Int24, sqltypes.Int32, sqltypes.Int64:
-		val = []byte(strconv.FormatInt(mustInt64(v), 10))
+		dest = strconv.AppendInt(dest, mustInt64(v), 10)
 	case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64:
-		val = []byte(strconv.FormatUint(mustUint64(v), 10))
+		dest = strconv.AppendUint(dest, mustUint64(v), 10)
 	case sqltypes.Float32:
-		val = []byte(strconv.FormatFloat(float64(v.(float32)), 'f', -1, 32))
+		dest = strconv.AppendFloat(dest, float64(v.(float32)), 'f', -1, 32)
 	case sqltypes.Float64:
-		val = []byte(strconv.FormatFloat(v.(float64), 'f', -1, 64))
+		dest = strconv.AppendFloat(dest, v.(float64), 'f', -1, 64)
 	default:
 		panic(ErrInvalidBaseType.New(t.baseType.String(), "number"))
 	}
 
-	return sqltypes.MakeTrusted(t.baseType, val), nil
+	return sqltypes.MakeTrusted(t.baseType, dest[start:]), nil
+}
+
+// isNativeValue returns whether the value given is of one of the Go types SQL serializes without converting.
+func (t numberTypeImpl) isNativeValue(v interface{}) bool {
+	switch v.(type) {
+	case int, int8, int16, int32, int64:
+		return sqltypes.IsSigned(t.baseType)
+	case uint, uint8, uint16, uint32, uint64:
+		return sqltypes.IsUnsigned(t.baseType)
+	case float32:
+		return t.baseType == sqltypes.Float32
+	case float64:
+		return t.baseType == sqltypes.Float64
+	default:
+		return false
+	}
 }
 
 // String implements Type interface.
@@ -471,38 +549,21 @@ func convertToInt64(t numberTypeImpl, v interface{}) (int64, error) {
 		}
 		return int64(v), nil
 	case float32:
-		if float32(math.MaxInt64) >= v && v >= float32(math.MinInt64) {
-			return int64(v), nil
-		}
-		return 0, ErrOutOfRange.New(v, t)
+		return float64ToInt64(t, float64(v))
 	case float64:
-		if float64(math.MaxInt64) >= v && v >= float64(math.MinInt64) {
-			return int64(v), nil
-		}
-		return 0, ErrOutOfRange.New(v, t)
+		return float64ToInt64(t, v)
 	case decimal.Decimal:
 		if v.GreaterThan(dec_int64_max) || v.LessThan(dec_int64_min) {
 			return 0, ErrOutOfRange.New(v.String(), t)
 		}
 		return v.IntPart(), nil
 	case []byte:
-		i, err := strconv.ParseInt(string(v), 10, 64)
-		if err != nil {
-			return 0, ErrInvalidValue.New(v, t.String())
-		}
-		return i, nil
+		return stringToInt64(t, string(v))
 	case string:
-		// Parse first an integer, which allows for more values than float64
-		i, err := strconv.ParseInt(v, 10, 64)
-		if err == nil {
-			return i, nil
-		}
-		// If that fails, try as a float and truncate it to integral
-		f, err := strconv.ParseFloat(v, 64)
-		if err != nil {
-			return 0, ErrInvalidValue.New(v, t.String())
-		}
-		return int64(f), nil
+		return stringToInt64(t, v)
+	case json.Number:
+		// Numbers of JSON documents, like the decimals in them
+		return stringToInt64(t, string(v))
 	case bool:
 		if v {
 			return 1, nil
@@ -515,6 +576,64 @@ func convertToInt64(t numberTypeImpl, v interface{}) (int64, error) {
 	}
 }
 
+// float64ToInt64 truncates the float given to an integer, which must be within the range of an int64. The bounds are
+// checked against powers of two, as math.MaxInt64 can't be represented exactly as a float.
+func float64ToInt64(t numberTypeImpl, f float64) (int64, error) {
+	if f >= -math.MinInt64 || f < math.MinInt64 || math.IsNaN(f) {
+		return 0, ErrOutOfRange.New(f, t)
+	}
+	return int64(f), nil
+}
+
+// stringToInt64 parses the string given as an integer, or as a float truncated to integral if it's not one.
+func stringToInt64(t numberTypeImpl, s string) (int64, error) {
+	// Parse first an integer, which allows for more values than float64
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+	if isRangeError(err) {
+		return 0, ErrOutOfRange.New(s, t)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && !isRangeError(err) {
+		return 0, ErrInvalidValue.New(s, t.String())
+	}
+	return float64ToInt64(t, f)
+}
+
+func float64ToUint64(t numberTypeImpl, f float64) (uint64, error) {
+	if f >= 2*-math.MinInt64 || f < 0 || math.IsNaN(f) {
+		return 0, ErrOutOfRange.New(f, t)
+	}
+	return uint64(f), nil
+}
+
+// stringToUint64 parses the string given as an unsigned integer, or as a float truncated to integral if it's not one.
+func stringToUint64(t numberTypeImpl, s string) (uint64, error) {
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+	if isRangeError(err) {
+		return 0, ErrOutOfRange.New(s, t)
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil || isRangeError(err) {
+		// A negative integer
+		return 0, ErrOutOfRange.New(s, t)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && !isRangeError(err) {
+		return 0, ErrInvalidValue.New(s, t.String())
+	}
+	return float64ToUint64(t, f)
+}
+
+func isRangeError(err error) bool {
+	ne, ok := err.(*strconv.NumError)
+	return ok && ne.Err == strconv.ErrRange
+}
+
 func convertToUint64(t numberTypeImpl, v interface{}) (uint64, error) {
 	switch v := v.(type) {
 	case int:
@@ -553,34 +672,21 @@ func convertToUint64(t numberTypeImpl, v interface{}) (uint64, error) {
 	case uint64:
 		return v, nil
 	case float32:
-		if float32(math.MaxUint64) >= v && v >= 0 {
-			return uint64(v), nil
-		}
-		return 0, ErrOutOfRange.New(v, t)
+		return float64ToUint64(t, float64(v))
 	case float64:
-		if float64(math.MaxUint64) >= v && v >= 0 {
-			return uint64(v), nil
-		}
-		return 0, ErrOutOfRange.New(v, t)
+		return float64ToUint64(t, v)
 	case decimal.Decimal:
 		if v.GreaterThan(dec_uint64_max) || v.LessThan(dec_zero) {
 			return 0, ErrOutOfRange.New(v.String(), t)
 		}
-		// TODO: If we ever internally switch to using Decimal for large numbers, this will need to be updated
-		f, _ := v.Float64()
-		return uint64(f), nil
+		return v.Truncate(0).BigInt().Uint64(), nil
 	case []byte:
-		i, err := strconv.ParseUint(string(v), 10, 64)
-		if err != nil {
-			return 0, ErrInvalidValue.New(v, t.String())
-		}
-		return i, nil
+		return stringToUint64(t, string(v))
 	case string:
-		i, err := strconv.ParseUint(v, 10, 64)
-		if err != nil {
-			return 0, ErrInvalidValue.New(v, t.String())
-		}
-		return i, nil
+		return stringToUint64(t, v)
+	case json.Number:
+		// Numbers of JSON documents, like the decimals in them
+		return stringToUint64(t, string(v))
 	case bool:
 		if v {
 			return 1, nil
@@ -634,6 +740,9 @@ func convertToFloat64(t numberTypeImpl, v interface{}) (float64, error) {
 			return 0, ErrInvalidValue.New(v, t.String())
 		}
 		return i, nil
+	case json.Number:
+		// Numbers of JSON documents, like the decimals in them
+		return convertToFloat64(t, string(v))
 	case bool:
 		if v {
 			return 1, nil
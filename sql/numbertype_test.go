@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -186,6 +188,10 @@ func TestNumberConvert(t *testing.T) {
 		{Uint64, "01000", uint64(1000), false},
 		{Float32, "22.25", float32(22.25), false},
 		{Float64, float32(893.875), float64(893.875), false},
+		{Float64, json.Number("2.5"), float64(2.5), false},
+		{Float64, JSONDocument{Val: json.Number("2.5")}, float64(2.5), false},
+		{Int64, json.Number("12"), int64(12), false},
+		{Uint64, json.Number("12.0"), uint64(12), false},
 
 		{Boolean, math.MaxInt8 + 1, nil, true},
 		{Int8, math.MaxInt8 + 1, nil, true},
@@ -213,6 +219,17 @@ func TestNumberConvert(t *testing.T) {
 		{Uint24, -1, nil, true},
 		{Uint32, -1, nil, true},
 		{Uint64, -1, nil, true},
+		{Int64, "12.7", int64(12), false},
+		{Int64, []byte("12.7"), int64(12), false},
+		{Int64, "1e30", nil, true},
+		{Int64, "99999999999999999999", nil, true},
+		{Int64, float64(1 << 63), nil, true},
+		{Int64, float64(-1 << 63), int64(math.MinInt64), false},
+		{Uint64, "1e30", nil, true},
+		{Uint64, "-5", nil, true},
+		{Uint64, "5.5", uint64(5), false},
+		{Uint64, float64(1 << 64), nil, true},
+		{Uint64, decimal.RequireFromString("18446744073709551615"), uint64(math.MaxUint64), false},
 	}
 
 	for _, test := range tests {
@@ -228,6 +245,79 @@ func TestNumberConvert(t *testing.T) {
 	}
 }
 
+func TestClampNumber(t *testing.T) {
+	tests := []struct {
+		typ         NumberType
+		val         interface{}
+		expectedVal interface{}
+		clamped     bool
+	}{
+		{Int8, 100, int8(100), false},
+		{Int8, 300, int8(math.MaxInt8), true},
+		{Int8, -300, int8(math.MinInt8), true},
+		{Uint8, -1, uint8(0), true},
+		{Int24, "9999999999", int32(1<<23 - 1), true},
+		{Uint32, 1e20, uint32(math.MaxUint32), true},
+		{Int64, "-1e30", int64(math.MinInt64), true},
+		{Uint64, "1e30", uint64(math.MaxUint64), true},
+		{Float32, -math.MaxFloat64, float32(-math.MaxFloat32), true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			val, clamped, err := ClampNumber(test.typ, test.val)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedVal, val)
+			assert.Equal(t, test.clamped, clamped)
+		})
+	}
+
+	_, _, err := ClampNumber(Int8, "abc")
+	assert.True(t, ErrInvalidValue.Is(err))
+}
+
+func TestNumberSQL(t *testing.T) {
+	tests := []struct {
+		typ         Type
+		val         interface{}
+		expectedStr string
+	}{
+		{Int8, int8(-12), "-12"},
+		{Int32, int64(42), "42"},
+		{Int64, int64(math.MinInt64), "-9223372036854775808"},
+		{Int64, "17", "17"},
+		{Int64, uint8(3), "3"},
+		{Uint32, uint32(7), "7"},
+		{Uint64, uint64(math.MaxUint64), "18446744073709551615"},
+		{Uint64, int64(5), "5"},
+		{Float32, float32(1.5), "1.5"},
+		{Float64, 2.25, "2.25"},
+		{Float64, int64(3), "3"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			dest := make([]byte, 2, 64)
+			copy(dest, "ab")
+			val, err := test.typ.SQL(dest, test.val)
+			require.NoError(t, err)
+			assert.Equal(t, test.typ.Type(), val.Type())
+			assert.Equal(t, test.expectedStr, val.ToString())
+			assert.Equal(t, "ab", string(dest))
+			// the value was written in the free space of dest
+			assert.Equal(t, test.expectedStr, string(dest[2:2+len(test.expectedStr)]))
+		})
+	}
+
+	val, err := Int64.SQL(nil, int64(-1))
+	require.NoError(t, err)
+	assert.Equal(t, "-1", val.ToString())
+
+	val, err = Int64.SQL(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, val.IsNull())
+}
+
 func TestNumberString(t *testing.T) {
 	tests := []struct {
 		typ         Type
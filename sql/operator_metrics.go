@@ -0,0 +1,182 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// QueryMetrics collects the execution metrics of the operators of the plan of a query run with a context created with
+// WithQueryMetrics. The metrics can be read with Operators once the query's row iterator has been closed.
+type QueryMetrics struct {
+	mu        sync.Mutex
+	operators []*operatorMetrics
+}
+
+// NewQueryMetrics returns an empty *QueryMetrics.
+func NewQueryMetrics() *QueryMetrics {
+	return &QueryMetrics{}
+}
+
+// OperatorMetrics are the execution metrics of a plan operator.
+type OperatorMetrics struct {
+	// Operator is the name of the operator, the same as the name of its tracing span, such as plan.Filter.
+	Operator string
+	// RowsIn is the number of rows the operator read from its child operators.
+	RowsIn int64
+	// RowsOut is the number of rows the operator returned.
+	RowsOut int64
+	// Time is the time spent in the operator itself, leaving out the time spent in its child operators.
+	Time time.Duration
+	// TotalTime is the time spent in the operator, including the time spent in its child operators.
+	TotalTime time.Duration
+	// MemoryHighWater is the largest number of bytes held at once by the row and history caches of the operator. As
+	// for MemoryQuota, it's the estimated size of the values in those caches.
+	MemoryHighWater uint64
+	// Children are the metrics of the child operators, in the order they were started.
+	Children []*OperatorMetrics
+}
+
+// Operators returns the metrics of the root operators of the plan, which is usually only one, with the metrics of
+// their child operators. Operators run more than once, like the ones of subqueries, have an entry for every run.
+func (m *QueryMetrics) Operators() []*OperatorMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshots := make(map[*operatorMetrics]*OperatorMetrics, len(m.operators))
+	for _, op := range m.operators {
+		snapshots[op] = op.snapshot()
+	}
+
+	var roots []*OperatorMetrics
+	for _, op := range m.operators {
+		if parent := op.parentOperator(); parent != nil {
+			snapshots[parent].Children = append(snapshots[parent].Children, snapshots[op])
+		} else {
+			roots = append(roots, snapshots[op])
+		}
+	}
+	return roots
+}
+
+func (m *QueryMetrics) register(op *operatorMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	atomic.StoreInt32(&op.registered, 1)
+	m.operators = append(m.operators, op)
+}
+
+// operatorMetrics collects the metrics of a plan operator while it runs. Every span started with a context with query
+// metrics gets one, but only the ones of spans that row iterators are run in with NewSpanIter are registered as
+// operators. The others only link operators to their parent operators.
+type operatorMetrics struct {
+	// the counters are first to be aligned for atomic operations
+	rowsIn     int64
+	rowsOut    int64
+	total      int64
+	childTime  int64
+	memory     uint64
+	highWater  uint64
+	registered int32
+	name       string
+	parent     *operatorMetrics
+}
+
+// operatorSpan is a span along with the metrics of the operator run in it.
+type operatorSpan struct {
+	opentracing.Span
+	op      *operatorMetrics
+	metrics *QueryMetrics
+}
+
+// parentOperator returns the closest ancestor of the operator registered as an operator, or nil if there's none.
+func (op *operatorMetrics) parentOperator() *operatorMetrics {
+	for p := op.parent; p != nil; p = p.parent {
+		if atomic.LoadInt32(&p.registered) == 1 {
+			return p
+		}
+	}
+	return nil
+}
+
+// rowOut records a row returned by the operator, which took the time given to return, in the operator and its parent.
+func (op *operatorMetrics) rowOut(parent *operatorMetrics, elapsed time.Duration) {
+	atomic.AddInt64(&op.rowsOut, 1)
+	atomic.AddInt64(&op.total, int64(elapsed))
+	if parent != nil {
+		atomic.AddInt64(&parent.rowsIn, 1)
+		atomic.AddInt64(&parent.childTime, int64(elapsed))
+	}
+}
+
+// elapsed records time spent by the operator that didn't result in a row, such as reading the end of its rows.
+func (op *operatorMetrics) elapsed(parent *operatorMetrics, elapsed time.Duration) {
+	atomic.AddInt64(&op.total, int64(elapsed))
+	if parent != nil {
+		atomic.AddInt64(&parent.childTime, int64(elapsed))
+	}
+}
+
+func (op *operatorMetrics) reserveMemory(n uint64) {
+	used := atomic.AddUint64(&op.memory, n)
+	for {
+		highWater := atomic.LoadUint64(&op.highWater)
+		if used <= highWater || atomic.CompareAndSwapUint64(&op.highWater, highWater, used) {
+			return
+		}
+	}
+}
+
+func (op *operatorMetrics) releaseMemory(n uint64) {
+	atomic.AddUint64(&op.memory, ^(n - 1))
+}
+
+// selfTime returns the time spent in the operator itself. Child operators run concurrently, such as the ones of an
+// exchange, may take longer than their parent, in which case it's zero.
+func (op *operatorMetrics) selfTime() time.Duration {
+	self := time.Duration(atomic.LoadInt64(&op.total) - atomic.LoadInt64(&op.childTime))
+	if self < 0 {
+		return 0
+	}
+	return self
+}
+
+func (op *operatorMetrics) snapshot() *OperatorMetrics {
+	return &OperatorMetrics{
+		Operator:        op.name,
+		RowsIn:          atomic.LoadInt64(&op.rowsIn),
+		RowsOut:         atomic.LoadInt64(&op.rowsOut),
+		Time:            op.selfTime(),
+		TotalTime:       time.Duration(atomic.LoadInt64(&op.total)),
+		MemoryHighWater: atomic.LoadUint64(&op.highWater),
+	}
+}
+
+// operatorMemory is the reporter of the memory manager of the contexts that an operator runs with, which accounts
+// for the memory of the operator's caches. The memory available is the one of the parent reporter.
+type operatorMemory struct {
+	parent Reporter
+	op     *operatorMetrics
+}
+
+// MaxMemory implements the Reporter interface.
+func (r *operatorMemory) MaxMemory() uint64 { return r.parent.MaxMemory() }
+
+// UsedMemory implements the Reporter interface.
+func (r *operatorMemory) UsedMemory() uint64 { return r.parent.UsedMemory() }
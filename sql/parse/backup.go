@@ -0,0 +1,66 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var backupStatementRegex = regexp.MustCompile(`(?is)^(backup|restore)\s+database\s`)
+
+// isBackupStatement returns whether the statement given is a BACKUP DATABASE or RESTORE DATABASE statement, which the
+// SQL parser doesn't support.
+func isBackupStatement(s string) bool {
+	return backupStatementRegex.MatchString(s)
+}
+
+// convertBackup converts a BACKUP DATABASE or RESTORE DATABASE statement:
+//
+//	BACKUP DATABASE db_name TO 'file_name'
+//	RESTORE DATABASE db_name FROM 'file_name'
+func convertBackup(ctx *sql.Context, s string) (sql.Node, error) {
+	verb, pos, _ := scanIdent(s, 0)
+	op := plan.BackupOp(strings.ToLower(verb))
+	_, pos, _ = scanIdent(s, pos)
+
+	db, pos, ok := scanIdent(s, pos)
+	if !ok {
+		return nil, backupSyntaxError(s)
+	}
+
+	direction := "to"
+	if op == plan.BackupOpRestore {
+		direction = "from"
+	}
+	word, pos, ok := scanIdent(s, pos)
+	if !ok || !strings.EqualFold(word, direction) {
+		return nil, backupSyntaxError(s)
+	}
+
+	path, pos, ok := scanString(s, pos)
+	if !ok || path == "" || skipSpaces(s, pos) != len(s) {
+		return nil, backupSyntaxError(s)
+	}
+
+	return plan.NewBackup(op, sql.UnresolvedDatabase(db), path), nil
+}
+
+func backupSyntaxError(s string) error {
+	return sql.ErrSyntaxError.New("invalid backup statement: " + s)
+}
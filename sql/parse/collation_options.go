@@ -0,0 +1,156 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var alterDatabaseStatementRegex = regexp.MustCompile(`(?is)^alter\s+(database|schema)(\s|$)`)
+
+// isAlterDatabaseStatement returns whether the statement given is an ALTER DATABASE statement, which the SQL parser
+// doesn't support.
+func isAlterDatabaseStatement(s string) bool {
+	return alterDatabaseStatementRegex.MatchString(strings.TrimSpace(stripComments(s)))
+}
+
+// convertAlterDatabase converts an ALTER DATABASE statement:
+//
+//	ALTER {DATABASE | SCHEMA} [db_name] alter_option ...
+//
+// Only the DEFAULT CHARACTER SET and DEFAULT COLLATE options change the database, other options are ignored. Without a
+// name, the current database is altered.
+func convertAlterDatabase(ctx *sql.Context, s string) (sql.Node, error) {
+	s = stripComments(s)
+	_, pos, _ := scanIdent(s, 0)
+	_, pos, _ = scanIdent(s, pos)
+
+	var db string
+	if word, next, ok := scanIdent(s, pos); ok && !isDatabaseOption(word) {
+		db, pos = word, next
+	}
+
+	collation, ok, err := scanCollationOptions(s, pos)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrSyntaxError.New("invalid alter database statement: " + s)
+	}
+
+	return plan.NewAlterDatabase(sql.UnresolvedDatabase(db), collation), nil
+}
+
+// isDatabaseOption returns whether the word given starts an option of ALTER DATABASE rather than being the name of the
+// database.
+func isDatabaseOption(word string) bool {
+	switch strings.ToLower(word) {
+	case "default", "character", "charset", "collate", "encryption", "read":
+		return true
+	default:
+		return false
+	}
+}
+
+// createDatabaseCollation returns the default collation of the database created by the CREATE DATABASE statement given,
+// as given by its options, which the SQL parser skips, or the default collation of the server if it has none.
+func createDatabaseCollation(ctx *sql.Context, s string) (sql.Collation, error) {
+	s = stripComments(s)
+	_, pos, _ := scanIdent(s, 0)
+	_, pos, _ = scanIdent(s, pos)
+	if next, ok := scanKeywords(s, pos, "if", "not", "exists"); ok {
+		pos = next
+	}
+	_, pos, _ = scanIdent(s, pos)
+
+	collation, ok, err := scanCollationOptions(s, pos)
+	if err != nil || ok {
+		return collation, err
+	}
+	return sql.ServerCollation(ctx)
+}
+
+// scanCollationOptions scans the options of a database or a table from the position given, and returns the collation
+// given by their CHARACTER SET (or CHARSET) and COLLATE options, or false if they have neither. Other options are
+// skipped.
+func scanCollationOptions(s string, pos int) (sql.Collation, bool, error) {
+	var charset, collate string
+	var value *string
+	for {
+		pos = skipSpaces(s, pos)
+		if pos >= len(s) {
+			break
+		}
+
+		var token string
+		var next int
+		var ok bool
+		keyword := false
+		switch s[pos] {
+		case '\'', '"':
+			token, next, ok = scanString(s, pos)
+		case '`':
+			token, next, ok = scanIdent(s, pos)
+		default:
+			token, next, ok = scanIdent(s, pos)
+			keyword = ok
+		}
+		if !ok {
+			// The equal signs and commas between options
+			pos++
+			continue
+		}
+		pos = next
+
+		if value != nil {
+			*value, value = strings.ToLower(token), nil
+			continue
+		}
+		if !keyword {
+			continue
+		}
+		switch strings.ToLower(token) {
+		case "charset":
+			value = &charset
+		case "character":
+			if next, ok := scanKeywords(s, pos, "set"); ok {
+				pos, value = next, &charset
+			}
+		case "collate":
+			value = &collate
+		}
+	}
+
+	if charset == "" && collate == "" {
+		return sql.Collation_Default, false, nil
+	}
+	collation, err := sql.ParseCollation(&charset, &collate, false)
+	if err != nil {
+		return sql.Collation_Default, false, err
+	}
+	return collation, true, nil
+}
+
+// hasExplicitCollation returns whether the column defined has a character set or collation of its own, rather than
+// getting the default collation of its table.
+func hasExplicitCollation(cd *sqlparser.ColumnDefinition) bool {
+	return cd.Type.Charset != "" || cd.Type.Collate != ""
+}
@@ -17,9 +17,17 @@ import (
 //
 // Even more info: https://dev.mysql.com/doc/refman/8.0/en/date-and-time-functions.html#function_str-to-date
 func ParseDateWithFormat(date, format string) (time.Time, error) {
+	t, _, err := ParseDatePrefixWithFormat(date, format)
+	return t, err
+}
+
+// ParseDatePrefixWithFormat parses the start of the date string according to the given format string, like
+// ParseDateWithFormat, and also returns the rest of the date string, which the format doesn't cover. MySQL ignores
+// these characters with a warning.
+func ParseDatePrefixWithFormat(date, format string) (time.Time, string, error) {
 	parsers, err := parsersFromFormatString(format)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, "", err
 	}
 
 	// trim all leading and trailing whitespace
@@ -34,12 +42,16 @@ func ParseDateWithFormat(date, format string) (time.Time, error) {
 		target = takeAllSpaces(target)
 		rest, err := parser(&result, target)
 		if err != nil {
-			return time.Time{}, err
+			return time.Time{}, "", err
 		}
 		target = rest
 	}
 
-	return evaluate(result)
+	t, err := evaluate(result)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return t, takeAllSpaces(target), nil
 }
 
 // Convert the user-defined format string into a slice of parser functions
@@ -118,7 +130,16 @@ type datetime struct {
 	dayOfYear  *uint
 	weekOfYear *uint
 
-	// this is completely ignored, but we still parse it for correctness
+	// weeks start on Sunday rather than Monday, and strict weeks belong to weekYear rather than year, which are the
+	// differences between the %U, %u, %V and %v specifiers
+	sundayFirst bool
+	strictWeek  bool
+
+	// the year of strict weeks, and whether its weeks start on Sunday
+	weekYear            *uint
+	weekYearSundayFirst bool
+
+	// only used for dates given by the week of the year
 	weekday *time.Weekday
 
 	// true => AM, false => PM, nil => unspecified
@@ -207,14 +228,22 @@ var formatSpecifiers = map[byte]parser{
 	's': parseSecondsNumeric,
 	// %T	Time, 24-hour (hh:mm:ss)
 	'T': parse24HourTimestamp,
-	'U': nil,
-	'u': nil,
-	'V': nil,
-	'v': nil,
-	'W': nil,
-	'w': nil,
-	'X': nil,
-	'x': nil,
+	// %U	Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
+	'U': weekParser(true, false),
+	// %u	Week (00..53), where Monday is the first day of the week; WEEK() mode 1
+	'u': weekParser(false, false),
+	// %V	Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
+	'V': weekParser(true, true),
+	// %v	Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
+	'v': weekParser(false, true),
+	// %W	Weekday name (Sunday..Saturday)
+	'W': parseWeekdayName,
+	// %w	Day of the week (0=Sunday..6=Saturday)
+	'w': parseWeekdayNumeric,
+	// %X	Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
+	'X': weekYearParser(true),
+	// %x	Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
+	'x': weekYearParser(false),
 	// %Y	Year, numeric, four digits
 	'Y': parseYear4DigitNumeric,
 	// %y	Year, numeric (two digits)
@@ -276,6 +305,17 @@ func monthAbbrev(abbrev string) (time.Month, bool) {
 	return 0, false
 }
 
+// Convert the start of a weekday name to a defined weekday.
+func weekdayName(name string) (weekday time.Weekday, charCount int, ok bool) {
+	for i := 0; i < 7; i++ {
+		w := time.Weekday(i)
+		if strings.HasPrefix(name, strings.ToLower(w.String())) {
+			return w, len(w.String()), true
+		}
+	}
+	return 0, 0, false
+}
+
 // TODO: allow this to match partial months
 // janu should match janurary
 func monthName(name string) (month time.Month, charCount int, ok bool) {
@@ -48,7 +48,16 @@ func TestParseDate(t *testing.T) {
 		{"two_digit_date_2000", "september: 3, 70", "%M: %e, %y", "1970-09-03 00:00:00 -0500 CDT"},
 		{"two_digit_date_1900", "may: 3, 69", "%M: %e, %y", "2069-05-03 00:00:00 -0500 CDT"},
 
-		{"microseconds", "01/02/99 314", "%m/%e/%y %f", "1999-01-02 00:00:00.000314 -0600 CST"},
+		{"microseconds", "01/02/99 314", "%m/%e/%y %f", "1999-01-02 00:00:00.314 -0600 CST"},
+		{"microseconds_six_digits", "01/02/99 000314", "%m/%e/%y %f", "1999-01-02 00:00:00.000314 -0600 CST"},
+		{"digits_without_separators", "20210315 071530", "%Y%m%d %H%i%s", "2021-03-15 07:15:30 -0500 CDT"},
+		{"midnight", "2021-03-15 12:05 am", "%Y-%m-%d %h:%i %p", "2021-03-15 00:05:00 -0500 CDT"},
+		{"noon", "2021-03-15 12:05 PM", "%Y-%m-%d %l:%i %p", "2021-03-15 12:05:00 -0500 CDT"},
+		{"weekday_name", "Monday, 15 March 2021", "%W, %e %M %Y", "2021-03-15 00:00:00 -0500 CDT"},
+		{"week_sunday_first", "2021 10 Monday", "%Y %U %W", "2021-03-08 00:00:00 -0600 CST"},
+		{"week_monday_first", "2021 10 1", "%Y %u %w", "2021-03-08 00:00:00 -0600 CST"},
+		{"strict_week_sunday_first", "200442 Monday", "%X%V %W", "2004-10-18 00:00:00 -0500 CDT"},
+		{"strict_week_monday_first", "2021 01 Sunday", "%x %v %W", "2021-01-10 00:00:00 -0600 CST"},
 		{"hour_number", "01/02/99 5:14", "%m/%e/%y %h:%i", "1999-01-02 05:14:00 -0600 CST"},
 		{"hour_number_2", "01/02/99 5:14", "%m/%e/%y %I:%i", "1999-01-02 05:14:00 -0600 CST"},
 
@@ -92,6 +101,16 @@ func TestConversionFailure(t *testing.T) {
 		{"unknown_format_specifier", "Jan 3", "%b %e %L", `unknown format specifier "L"`},
 		{"invalid_number_hour", "0021:12:14", "%T", `specifier %T failed to parse "0021:12:14": expected literal ":", got "2"`},
 		{"invalid_number_hour_2", "0012:12:14", "%r", `specifier %r failed to parse "0012:12:14": expected literal ":", got "1"`},
+		{"only_year", "2000", "%Y", "day is ambiguous"},
+		{"month_out_of_range", "2000-13-01", "%Y-%m-%d", "month 13 out of range"},
+		{"day_out_of_range", "2021-02-29", "%Y-%m-%d", "day 29 out of range"},
+		{"hour_out_of_range", "2021-02-01 24:00", "%Y-%m-%d %H:%i", "hour 24 out of range"},
+		{"12_hour_out_of_range", "2021-02-01 13:00 PM", "%Y-%m-%d %h:%i %p", "hour 13 out of range"},
+		{"minute_out_of_range", "2021-02-01 10:60", "%Y-%m-%d %H:%i", "minute 60 out of range"},
+		{"day_of_week_out_of_range", "2021 10 7", "%Y %U %w", `specifier %w failed to parse "7": expected a day of the week from 0 to 6, got 7`},
+		{"strict_week_without_its_year", "2021 10 Monday", "%Y %V %W", "year of the week is ambiguous"},
+		{"strict_week_with_other_year", "2021 10 Monday", "%x %V %W", "year of the week is ambiguous"},
+		{"week_with_strict_year", "2021 10 Monday", "%X %U %W", "year of the week is ambiguous"},
 	}
 
 	for _, tt := range tests {
@@ -103,6 +122,19 @@ func TestConversionFailure(t *testing.T) {
 	}
 }
 
+func TestParseDatePrefix(t *testing.T) {
+	setupTimezone(t)
+
+	actual, rest, err := ParseDatePrefixWithFormat("2009-01-01 10:00 extra", "%Y-%m-%d")
+	require.NoError(t, err)
+	require.Equal(t, "2009-01-01 00:00:00 -0600 CST", actual.String())
+	require.Equal(t, "10:00 extra", rest)
+
+	_, rest, err = ParseDatePrefixWithFormat("2009-01-01  ", "%Y-%m-%d")
+	require.NoError(t, err)
+	require.Equal(t, "", rest)
+}
+
 func TestParseErr(t *testing.T) {
 	tests := [...]struct {
 		name          string
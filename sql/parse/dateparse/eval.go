@@ -8,15 +8,17 @@ import (
 // Validate that the combination of fields in datetime
 // can be evaluated unambiguously to a time.Time.
 func validate(dt datetime) error {
+	if dt.day == nil && dt.dayOfYear == nil && dt.weekOfYear != nil && dt.weekday != nil {
+		return validateWeek(dt)
+	}
 	if dt.year == nil && dt.day == nil && dt.month == nil && dt.dayOfYear == nil {
 		return nil
 	}
 	if dt.day == nil {
-		if dt.month != nil {
+		if dt.month != nil || dt.dayOfYear == nil {
 			// TODO: ensure this behaves as expected
 			return fmt.Errorf("day is ambiguous")
 		}
-		return nil
 	}
 	if dt.dayOfYear != nil && dt.day != nil {
 		return fmt.Errorf("day is ambiguous")
@@ -28,19 +30,86 @@ func validate(dt datetime) error {
 	return nil
 }
 
+// Validate that a date given by the week of the year and the day of the week has the year its week is counted in:
+// the year given by %X or %x for strict weeks, counted from the same day, or the calendar year otherwise.
+func validateWeek(dt datetime) error {
+	if dt.strictWeek {
+		if dt.weekYear == nil || dt.weekYearSundayFirst != dt.sundayFirst {
+			return fmt.Errorf("year of the week is ambiguous")
+		}
+		return nil
+	}
+	if dt.weekYear != nil {
+		return fmt.Errorf("year of the week is ambiguous")
+	}
+	if dt.year == nil {
+		return fmt.Errorf("year is ambiguous")
+	}
+	return nil
+}
+
+// Validate that the parsed datetime params are within the ranges
+// of their fields.
+func validateRanges(dt datetime) error {
+	if dt.month != nil && (*dt.month < time.January || *dt.month > time.December) {
+		return fmt.Errorf("month %d out of range", *dt.month)
+	}
+	if dt.day != nil && dt.month != nil && dt.year != nil {
+		if *dt.day < 1 || int(*dt.day) > daysIn(*dt.month, int(*dt.year)) {
+			return fmt.Errorf("day %d out of range", *dt.day)
+		}
+	}
+	if dt.dayOfYear != nil && dt.year != nil {
+		if *dt.dayOfYear < 1 || int(*dt.dayOfYear) > time.Date(int(*dt.year), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return fmt.Errorf("day of year %d out of range", *dt.dayOfYear)
+		}
+	}
+	if dt.weekOfYear != nil && *dt.weekOfYear > 53 {
+		return fmt.Errorf("week %d out of range", *dt.weekOfYear)
+	}
+	if dt.hours != nil {
+		if dt.am != nil && (*dt.hours < 1 || *dt.hours > 12) {
+			return fmt.Errorf("hour %d out of range", *dt.hours)
+		}
+		if *dt.hours > 23 {
+			return fmt.Errorf("hour %d out of range", *dt.hours)
+		}
+	}
+	if dt.minutes != nil && *dt.minutes > 59 {
+		return fmt.Errorf("minute %d out of range", *dt.minutes)
+	}
+	if dt.seconds != nil && *dt.seconds > 59 {
+		return fmt.Errorf("second %d out of range", *dt.seconds)
+	}
+	return nil
+}
+
+// daysIn returns the number of days of the month of the year given.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
 // Evaluate the parsed datetime params to a time.Time.
 func evaluate(dt datetime) (time.Time, error) {
 	err := validate(dt)
 	if err != nil {
 		return time.Time{}, err
 	}
+	err = validateRanges(dt)
+	if err != nil {
+		return time.Time{}, err
+	}
 
 	var hour, minute, second, miliseconds, microseconds, nanoseconds int
 	if dt.hours != nil {
-		if *dt.hours < 13 && dt.am != nil && !*dt.am {
-			*dt.hours += 12
-		}
 		hour = int(*dt.hours)
+		if dt.am != nil {
+			// 12 AM is midnight and 12 PM is noon
+			hour %= 12
+			if !*dt.am {
+				hour += 12
+			}
+		}
 	}
 	if dt.minutes != nil {
 		minute = int(*dt.minutes)
@@ -75,6 +144,8 @@ func evaluate(dt datetime) (time.Time, error) {
 	} else if dt.day != nil {
 		month = *dt.month
 		day = int(*dt.day)
+	} else if dt.weekOfYear != nil && dt.weekday != nil {
+		year, month, day = weekDate(dt)
 	}
 
 	// if timestamp only, add the duration to the 0 date
@@ -87,3 +158,33 @@ func evaluate(dt datetime) (time.Time, error) {
 
 	return time.Date(year, month, day, hour, minute, second, int(nanosecondDuration), time.Local), nil
 }
+
+// Evaluate the date given by the week of the year and the day of the week, as MySQL does. The first week of the year
+// is the one with its first day of the week in the year for weeks starting on Sunday, and the first one with four days
+// in the year for weeks starting on Monday.
+func weekDate(dt datetime) (int, time.Month, int) {
+	year := dt.year
+	if dt.strictWeek {
+		year = dt.weekYear
+	}
+	jan1 := time.Date(int(*year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	week := int(*dt.weekOfYear)
+
+	var offset int
+	if dt.sundayFirst {
+		jan1Weekday := int(jan1.Weekday())
+		if jan1Weekday != 0 {
+			offset = 7
+		}
+		offset += -jan1Weekday + (week-1)*7 + int(*dt.weekday)
+	} else {
+		jan1Weekday := (int(jan1.Weekday()) + 6) % 7
+		if jan1Weekday > 3 {
+			offset = 7
+		}
+		offset += -jan1Weekday + (week-1)*7 + (int(*dt.weekday)+6)%7
+	}
+
+	date := jan1.AddDate(0, 0, offset)
+	return date.Year(), date.Month(), date.Day()
+}
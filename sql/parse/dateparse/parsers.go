@@ -75,7 +75,7 @@ func parseMonthAbbreviation(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -85,7 +85,7 @@ func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -94,16 +94,21 @@ func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMicrosecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	numChars, _ := takeAtMost(6, chars, isNumeral)
+	num, rest, err := takeNumberAtMostNChars(6, chars)
 	if err != nil {
 		return "", err
 	}
+	// The digits are a fraction of a second, so fewer than six of them stand for more microseconds
+	for i := len(numChars); i < 6; i++ {
+		num *= 10
+	}
 	result.microseconds = &num
 	return rest, nil
 }
 
 func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	hour, rest, err := takeNumber(chars)
+	hour, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -112,7 +117,7 @@ func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -121,7 +126,7 @@ func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseMinuteNumeric(result *datetime, chars string) (rest string, _ error) {
-	min, rest, err := takeNumber(chars)
+	min, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -171,7 +176,7 @@ func parse12HourTimestamp(result *datetime, chars string) (rest string, _ error)
 }
 
 func parseSecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	sec, rest, err := takeNumber(chars)
+	sec, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -224,7 +229,7 @@ func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ erro
 	if len(chars) < 4 {
 		return "", fmt.Errorf("expected at least 4 chars, got %d", len(chars))
 	}
-	year, rest, err := takeNumber(chars)
+	year, rest, err := takeNumberAtMostNChars(4, chars)
 	if err != nil {
 		return "", err
 	}
@@ -233,7 +238,7 @@ func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -242,10 +247,61 @@ func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest stri
 }
 
 func parseDayOfYearNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(3, chars)
 	if err != nil {
 		return "", err
 	}
 	result.dayOfYear = &num
 	return rest, nil
 }
+
+func parseWeekdayName(result *datetime, chars string) (rest string, _ error) {
+	weekday, charCount, ok := weekdayName(chars)
+	if !ok {
+		return "", fmt.Errorf("unknown weekday name, got \"%s\"", chars)
+	}
+	result.weekday = &weekday
+	return trimPrefix(charCount, chars), nil
+}
+
+func parseWeekdayNumeric(result *datetime, chars string) (rest string, _ error) {
+	num, rest, err := takeNumberAtMostNChars(1, chars)
+	if err != nil {
+		return "", err
+	}
+	if num > 6 {
+		return "", fmt.Errorf("expected a day of the week from 0 to 6, got %d", num)
+	}
+	weekday := time.Weekday(num)
+	result.weekday = &weekday
+	return rest, nil
+}
+
+// weekParser returns a parser of the week of the year, counted from the first day of the week given. Strict weeks are
+// those of WEEK() modes 2 and 3, which belong to the year parsed by weekYearParser.
+func weekParser(sundayFirst, strict bool) parser {
+	return func(result *datetime, chars string) (rest string, _ error) {
+		num, rest, err := takeNumberAtMostNChars(2, chars)
+		if err != nil {
+			return "", err
+		}
+		result.weekOfYear = &num
+		result.sundayFirst = sundayFirst
+		result.strictWeek = strict
+		return rest, nil
+	}
+}
+
+// weekYearParser returns a parser of the year that strict weeks, counted from the first day of the week given, belong
+// to.
+func weekYearParser(sundayFirst bool) parser {
+	return func(result *datetime, chars string) (rest string, _ error) {
+		year, rest, err := takeNumberAtMostNChars(4, chars)
+		if err != nil {
+			return "", err
+		}
+		result.weekYear = &year
+		result.weekYearSundayFirst = sundayFirst
+		return rest, nil
+	}
+}
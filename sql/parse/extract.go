@@ -0,0 +1,145 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+)
+
+// The SQL parser doesn't support the EXTRACT function, whose unit is separated from its date by FROM:
+//
+//	EXTRACT(unit FROM date)
+//
+// Before the statement is parsed, the unit and FROM are replaced by a string literal argument of extractPrefix
+// followed by the text they replace, which makes the function an ordinary function call of two arguments.
+const extractPrefix = "__extract__:"
+
+var extractRegex = regexp.MustCompile("'" + regexp.QuoteMeta(extractPrefix) + "([^']*)',")
+
+// rewriteExtracts returns the statement given with the units of its EXTRACT functions turned into arguments.
+func rewriteExtracts(s string) string {
+	if !strings.Contains(strings.ToLower(s), "extract") {
+		return s
+	}
+
+	var sb strings.Builder
+	var quote byte
+	last := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case isIdentChar(c):
+			end := i
+			for end < len(s) && isIdentChar(s[end]) {
+				end++
+			}
+			word := s[i:end]
+			qualified := i > 0 && s[i-1] == '.'
+			i = end - 1
+			if qualified || !strings.EqualFold(word, "extract") {
+				continue
+			}
+
+			open := skipSpaces(s, end)
+			if open >= len(s) || s[open] != '(' {
+				continue
+			}
+			unitStart := skipSpaces(s, open+1)
+			if unitStart >= len(s) || s[unitStart] == '`' {
+				continue
+			}
+			_, unitEnd, ok := scanIdent(s, unitStart)
+			if !ok {
+				continue
+			}
+			fromEnd, ok := scanKeywords(s, unitEnd, "from")
+			if !ok {
+				continue
+			}
+
+			sb.WriteString(s[last:unitStart])
+			sb.WriteString("'")
+			sb.WriteString(extractPrefix)
+			sb.WriteString(s[unitStart:fromEnd])
+			sb.WriteString("',")
+			last = fromEnd
+			i = fromEnd - 1
+		}
+	}
+	if last == 0 {
+		return s
+	}
+
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// restoreExtracts returns the text given, taken from a statement rewritten by rewriteExtracts, with the units of its
+// EXTRACT functions back in place.
+func restoreExtracts(s string) string {
+	if !strings.Contains(s, extractPrefix) {
+		return s
+	}
+	return extractRegex.ReplaceAllString(s, "$1")
+}
+
+// convertExtract returns the EXTRACT function of the function call given, or false if it isn't the call of an EXTRACT
+// function rewritten by rewriteExtracts.
+func convertExtract(ctx *sql.Context, f *sqlparser.FuncExpr) (sql.Expression, bool, error) {
+	if f.Name.Lowered() != "extract" || len(f.Exprs) != 2 {
+		return nil, false, nil
+	}
+
+	arg, ok := f.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, false, nil
+	}
+	unit, ok := arg.Expr.(*sqlparser.SQLVal)
+	if !ok || unit.Type != sqlparser.StrVal || !strings.HasPrefix(string(unit.Val), extractPrefix) {
+		return nil, false, nil
+	}
+
+	date, err := selectExprToExpression(ctx, f.Exprs[1])
+	if err != nil {
+		return nil, true, err
+	}
+
+	// The unit is followed by FROM
+	fields := strings.Fields(strings.TrimPrefix(string(unit.Val), extractPrefix))
+	e, err := function.NewExtract(fields[0], date)
+	if err != nil {
+		return nil, true, sql.ErrSyntaxError.New(err.Error())
+	}
+	return e, true, nil
+}
+
+// restoreRewrites returns the text given, taken from a statement rewritten before it was parsed, as it was written.
+func restoreRewrites(s string) string {
+	return restoreExtracts(restoreNullTreatments(s))
+}
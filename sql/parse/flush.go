@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var flushTablesStatementRegex = regexp.MustCompile(`(?is)^flush\s+((no_write_to_binlog|local)\s+)?tables?(\s|$)`)
+
+// isFlushTablesStatement returns whether the statement given is a FLUSH TABLES statement, which the SQL parser doesn't
+// support. mysqldump writes the LOCAL option of these statements in a comment, so comments are ignored.
+func isFlushTablesStatement(s string) bool {
+	return flushTablesStatementRegex.MatchString(strings.TrimSpace(stripComments(s)))
+}
+
+// convertFlushTables converts a FLUSH TABLES statement:
+//
+//	FLUSH [NO_WRITE_TO_BINLOG | LOCAL] {TABLE | TABLES} [tbl_name [, tbl_name] ...] [WITH READ LOCK]
+//
+// Tables aren't cached, so FLUSH TABLES does nothing. With a read lock and no tables, every table is locked for read,
+// and with tables, they are locked for read as with LOCK TABLES.
+func convertFlushTables(ctx *sql.Context, s string) (sql.Node, error) {
+	s = stripComments(s)
+	_, pos, _ := scanIdent(s, 0)
+
+	word, pos, ok := scanIdent(s, pos)
+	if ok && (strings.EqualFold(word, "no_write_to_binlog") || strings.EqualFold(word, "local")) {
+		word, pos, ok = scanIdent(s, pos)
+	}
+	if !ok || !strings.EqualFold(word, "table") && !strings.EqualFold(word, "tables") {
+		return nil, flushTablesSyntaxError(s)
+	}
+
+	var tables []sql.Node
+	if word, _, ok = scanIdent(s, pos); ok && !strings.EqualFold(word, "with") && !strings.EqualFold(word, "for") {
+		for {
+			var db, name string
+			db, name, pos, ok = scanTableName(s, pos)
+			if !ok {
+				return nil, flushTablesSyntaxError(s)
+			}
+			tables = append(tables, plan.NewUnresolvedTable(name, db))
+
+			pos = skipSpaces(s, pos)
+			if pos >= len(s) || s[pos] != ',' {
+				break
+			}
+			pos++
+		}
+	}
+
+	readLock := false
+	if next, ok := scanKeywords(s, pos, "with", "read", "lock"); ok {
+		readLock, pos = true, next
+	} else if _, ok := scanKeywords(s, pos, "for", "export"); ok && len(tables) > 0 {
+		return nil, sql.ErrUnsupportedFeature.New("FLUSH TABLES ... FOR EXPORT")
+	}
+	if skipSpaces(s, pos) < len(s) {
+		return nil, flushTablesSyntaxError(s)
+	}
+
+	if readLock && len(tables) > 0 {
+		locks := make([]*plan.TableLock, len(tables))
+		for i, table := range tables {
+			locks[i] = &plan.TableLock{Table: table}
+		}
+		return plan.NewLockTables(locks), nil
+	}
+	return plan.NewFlushTables(readLock), nil
+}
+
+func flushTablesSyntaxError(s string) error {
+	return sql.ErrSyntaxError.New("invalid FLUSH TABLES statement: " + strings.TrimSpace(s))
+}
@@ -0,0 +1,236 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var handlerStatementRegex = regexp.MustCompile(`(?is)^handler\s`)
+
+// isHandlerStatement returns whether the statement given is a HANDLER statement, which the SQL parser doesn't support.
+func isHandlerStatement(s string) bool {
+	return handlerStatementRegex.MatchString(s)
+}
+
+// convertHandler converts a HANDLER statement:
+//
+//	HANDLER tbl_name OPEN [[AS] alias]
+//	HANDLER tbl_name READ {FIRST | NEXT | PREV | LAST} [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name READ index_name {FIRST | NEXT | PREV | LAST} [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name READ index_name {= | <= | >= | < | >} (value1, value2, ...) [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name CLOSE
+func convertHandler(ctx *sql.Context, s string) (sql.Node, error) {
+	pos := len("handler")
+	qualifier, name, pos, ok := scanTableName(s, pos)
+	if !ok {
+		return nil, handlerSyntaxError(s)
+	}
+
+	verb, pos, ok := scanIdent(s, pos)
+	if !ok {
+		return nil, handlerSyntaxError(s)
+	}
+
+	switch strings.ToLower(verb) {
+	case "open":
+		return convertHandlerOpen(s, qualifier, name, s[pos:])
+	case "close":
+		if strings.TrimSpace(s[pos:]) != "" {
+			return nil, handlerSyntaxError(s)
+		}
+		return plan.NewHandlerClose(name), nil
+	case "read":
+		return convertHandlerRead(ctx, s, name, pos)
+	default:
+		return nil, handlerSyntaxError(s)
+	}
+}
+
+func convertHandlerOpen(s, qualifier, name, rest string) (sql.Node, error) {
+	rest = strings.TrimSpace(rest)
+	var alias string
+	if rest != "" {
+		var pos int
+		var ok bool
+		alias, pos, ok = scanIdent(rest, 0)
+		if ok && strings.EqualFold(alias, "as") {
+			alias, pos, ok = scanIdent(rest, pos)
+		}
+		if !ok {
+			return nil, handlerSyntaxError(s)
+		}
+		if strings.TrimSpace(rest[pos:]) != "" {
+			return nil, handlerSyntaxError(s)
+		}
+	}
+
+	return plan.NewHandlerOpen(sql.UnresolvedDatabase(qualifier), name, alias), nil
+}
+
+func convertHandlerRead(ctx *sql.Context, s, name string, pos int) (sql.Node, error) {
+	word, pos, ok := scanIdent(s, pos)
+	if !ok {
+		return nil, handlerSyntaxError(s)
+	}
+
+	var read *plan.HandlerRead
+	if position, ok := handlerReadPosition(word); ok {
+		read = plan.NewHandlerRead(name, "", position)
+	} else {
+		index := word
+		pos = skipSpaces(s, pos)
+		if operator := handlerReadOperator(s[pos:]); operator != "" {
+			pos = skipSpaces(s, pos+len(operator))
+			key, end, err := convertHandlerKey(ctx, s, pos)
+			if err != nil {
+				return nil, err
+			}
+			pos = end
+			read = plan.NewHandlerReadKey(name, index, operator, key)
+		} else {
+			word, pos, ok = scanIdent(s, pos)
+			if !ok {
+				return nil, handlerSyntaxError(s)
+			}
+			position, ok := handlerReadPosition(word)
+			if !ok {
+				return nil, handlerSyntaxError(s)
+			}
+			read = plan.NewHandlerRead(name, index, position)
+		}
+	}
+
+	// The WHERE and LIMIT clauses are the same as the ones of a SELECT, so the SQL parser takes care of them.
+	stmt, err := sqlparser.Parse("select * from t " + s[pos:])
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Lock != "" {
+		return nil, handlerSyntaxError(s)
+	}
+
+	var node sql.Node = read
+	if sel.Where != nil {
+		node, err = whereToFilter(ctx, sel.Where, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if sel.Limit == nil {
+		return plan.NewLimit(expression.NewLiteral(int8(1), sql.Int8), node), nil
+	}
+	if sel.Limit.Offset != nil {
+		node, err = offsetToOffset(ctx, sel.Limit.Offset, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return limitToLimit(ctx, sel.Limit.Rowcount, node)
+}
+
+// convertHandlerKey converts the parenthesized list of values of a HANDLER ... READ statement starting at the position
+// given, returning them along with the position after the closing parenthesis.
+func convertHandlerKey(ctx *sql.Context, s string, pos int) ([]sql.Expression, int, error) {
+	if pos >= len(s) || s[pos] != '(' {
+		return nil, 0, handlerSyntaxError(s)
+	}
+
+	depth := 0
+	var quote byte
+	end := -1
+	for i := pos; i < len(s) && end < 0; i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end < 0 {
+		return nil, 0, handlerSyntaxError(s)
+	}
+
+	stmt, err := sqlparser.Parse("select " + s[pos+1:end])
+	if err != nil {
+		return nil, 0, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, 0, handlerSyntaxError(s)
+	}
+
+	key := make([]sql.Expression, len(sel.SelectExprs))
+	for i, se := range sel.SelectExprs {
+		ae, ok := se.(*sqlparser.AliasedExpr)
+		if !ok || !ae.As.IsEmpty() {
+			return nil, 0, handlerSyntaxError(s)
+		}
+		key[i], err = ExprToExpression(ctx, ae.Expr)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return key, end + 1, nil
+}
+
+func handlerReadPosition(word string) (plan.HandlerReadPosition, bool) {
+	switch strings.ToLower(word) {
+	case "first":
+		return plan.HandlerReadFirst, true
+	case "next":
+		return plan.HandlerReadNext, true
+	case "prev":
+		return plan.HandlerReadPrev, true
+	case "last":
+		return plan.HandlerReadLast, true
+	default:
+		return 0, false
+	}
+}
+
+func handlerReadOperator(s string) string {
+	for _, operator := range []string{"<=", ">=", "=", "<", ">"} {
+		if strings.HasPrefix(s, operator) {
+			return operator
+		}
+	}
+	return ""
+}
+
+func handlerSyntaxError(s string) error {
+	return sql.ErrSyntaxError.New("invalid HANDLER statement: " + s)
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// The SQL parser doesn't support the null treatment clause of window functions, RESPECT NULLS or IGNORE NULLS, which
+// comes between the arguments of the function and its OVER clause:
+//
+//	LAG(expr [, N [, default]]) [RESPECT NULLS | IGNORE NULLS] OVER (window_spec)
+//
+// Before the statement is parsed, the clause is moved into the window as a partition by expression: a quoted
+// identifier of nullTreatmentPrefix followed by the text the clause replaces, which is taken back out of the window
+// when the function is converted.
+const nullTreatmentPrefix = "__null_treatment__:"
+
+var nullTreatmentRegex = regexp.MustCompile("(?is)(over\\s*\\()PARTITION BY `" + regexp.QuoteMeta(nullTreatmentPrefix) + "([^`,]*),([^`]*)`(, | )")
+
+// rewriteNullTreatments returns the statement given with the null treatment clauses of its window functions moved
+// into their windows.
+func rewriteNullTreatments(s string) string {
+	if !strings.Contains(strings.ToLower(s), "nulls") {
+		return s
+	}
+
+	var sb strings.Builder
+	var quote byte
+	last := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ')':
+			clauseEnd, ok := scanKeywords(s, i+1, "ignore", "nulls")
+			if !ok {
+				clauseEnd, ok = scanKeywords(s, i+1, "respect", "nulls")
+			}
+			if !ok {
+				continue
+			}
+			overEnd, ok := scanKeywords(s, clauseEnd, "over")
+			if !ok {
+				continue
+			}
+			open := skipSpaces(s, overEnd)
+			if open >= len(s) || s[open] != '(' {
+				continue
+			}
+
+			next, separator, partitionBy := open+1, " ", ""
+			if pos, ok := scanKeywords(s, next, "partition", "by"); ok {
+				next, separator, partitionBy = pos, ", ", s[next:pos]
+			}
+
+			clauseStart, overStart := skipSpaces(s, i+1), skipSpaces(s, clauseEnd)
+			sb.WriteString(s[last:clauseStart])
+			sb.WriteString(s[overStart : open+1])
+			sb.WriteString("PARTITION BY `")
+			sb.WriteString(nullTreatmentPrefix)
+			sb.WriteString(s[clauseStart:overStart])
+			sb.WriteString(",")
+			sb.WriteString(partitionBy)
+			sb.WriteString("`")
+			sb.WriteString(separator)
+			last = next
+			i = next - 1
+		}
+	}
+	if last == 0 {
+		return s
+	}
+
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// restoreNullTreatments returns the text given, taken from a statement rewritten by rewriteNullTreatments, with the
+// null treatment clauses of its window functions back in place.
+func restoreNullTreatments(s string) string {
+	if !strings.Contains(s, nullTreatmentPrefix) {
+		return s
+	}
+
+	return nullTreatmentRegex.ReplaceAllStringFunc(s, func(m string) string {
+		groups := nullTreatmentRegex.FindStringSubmatch(m)
+		return groups[2] + groups[1] + groups[3]
+	})
+}
+
+// nullTreatment returns the OVER clause given without the partition by expression standing in for its function's
+// null treatment clause, and whether that clause is IGNORE NULLS.
+func nullTreatment(over *sqlparser.Over) (*sqlparser.Over, bool) {
+	if over == nil || len(over.PartitionBy) == 0 {
+		return over, false
+	}
+
+	col, ok := over.PartitionBy[0].(*sqlparser.ColName)
+	if !ok || !col.Qualifier.IsEmpty() || !strings.HasPrefix(col.Name.String(), nullTreatmentPrefix) {
+		return over, false
+	}
+
+	clause := strings.TrimPrefix(col.Name.String(), nullTreatmentPrefix)
+	no := *over
+	no.PartitionBy = over.PartitionBy[1:]
+	return &no, strings.EqualFold(strings.Fields(clause)[0], "ignore")
+}
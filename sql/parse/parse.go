@@ -17,6 +17,7 @@ package parse
 import (
 	goerrors "errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -104,18 +106,108 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	var remainder string
 
 	parsed = s
+	if parser, ok := customStatementParser(s); ok {
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := parser(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if loc := explainExtendedRegex.FindStringSubmatchIndex(s); loc != nil {
+		// The SQL parser doesn't support EXPLAIN EXTENDED, so the statement is parsed without EXTENDED
+		node, parsed, remainder, err := parse(ctx, s[loc[2]:loc[3]]+" "+s[loc[1]:], multi)
+		if d, ok := node.(*plan.DescribeQuery); ok {
+			d.Extended = true
+		}
+		return node, parsed, remainder, err
+	}
+
+	if isHandlerStatement(s) {
+		// HANDLER statements are not supported by the SQL parser, so they are parsed on their own
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertHandler(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if isTableMaintenanceStatement(s) {
+		// The SQL parser doesn't return the tables of OPTIMIZE TABLE and REPAIR TABLE statements
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertTableMaintenance(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if isBackupStatement(s) {
+		// BACKUP DATABASE and RESTORE DATABASE statements are not supported by the SQL parser
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertBackup(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if isAlterDatabaseStatement(s) {
+		// ALTER DATABASE statements are not supported by the SQL parser
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertAlterDatabase(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if isFlushTablesStatement(s) {
+		// FLUSH TABLES statements are not supported by the SQL parser
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertFlushTables(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	if isConsistentSnapshotStatement(s) {
+		// The SQL parser doesn't support START TRANSACTION WITH CONSISTENT SNAPSHOT
+		if multi {
+			parsed, remainder = splitStatement(s)
+		}
+		node, err := convertConsistentSnapshot(ctx, parsed)
+		return node, parsed, remainder, err
+	}
+
+	// The SQL parser doesn't support the null treatment clause of window functions
+	s = rewriteNullTreatments(s)
+
+	// The SQL parser doesn't support the EXTRACT function
+	s = rewriteExtracts(s)
+
+	// The SQL parser doesn't support placeholders as the pattern of SHOW ... LIKE
+	s, like := rewriteShowLikePlaceholders(s)
+
 	if !multi {
 		stmt, err = sqlparser.Parse(s)
 	} else {
 		var ri int
 		stmt, ri, err = sqlparser.ParseOne(s)
 		if ri != 0 && ri < len(s) {
-			parsed = s[:ri]
+			text := s
+			if like != nil {
+				text = like.restore(s)
+				if ri > like.start {
+					ri += len(like.text) - len(likePlaceholderLiteral)
+				} else {
+					// The placeholder belongs to a later statement
+					like = nil
+				}
+			}
+			parsed = restoreRewrites(text[:ri])
 			parsed = strings.TrimSpace(parsed)
 			if strings.HasSuffix(parsed, ";") {
 				parsed = parsed[:len(parsed)-1]
 			}
-			remainder = s[ri:]
+			remainder = restoreRewrites(text[ri:])
 		}
 	}
 
@@ -127,6 +219,11 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 		return nil, parsed, remainder, sql.ErrSyntaxError.New(err.Error())
 	}
 
+	if show, ok := stmt.(*sqlparser.Show); ok && like != nil {
+		node, err := convertShow(ctx, show, s, like)
+		return node, parsed, remainder, err
+	}
+
 	node, err := convert(ctx, stmt, s)
 
 	return node, parsed, remainder, err
@@ -167,7 +264,7 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		if query == "" {
 			return nil, sql.ErrUnsupportedFeature.New("SHOW in subquery")
 		}
-		return convertShow(ctx, n, query)
+		return convertShow(ctx, n, query, nil)
 	case *sqlparser.DDL:
 		// unlike other statements, DDL statements have loose parsing by default
 		// TODO: fix this
@@ -183,7 +280,7 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		}
 		return convertMultiAlterDDL(ctx, query, multiAlterDdl.(*sqlparser.MultiAlterDDL))
 	case *sqlparser.DBDDL:
-		return convertDBDDL(n)
+		return convertDBDDL(ctx, n, query)
 	case *sqlparser.Explain:
 		return convertExplain(ctx, n)
 	case *sqlparser.Insert:
@@ -352,6 +449,10 @@ func convertSelectStatement(ctx *sql.Context, ss sqlparser.SelectStatement) (sql
 	}
 }
 
+// explainExtendedRegex matches EXPLAIN EXTENDED statements, which report the indexes the analyzer couldn't use as
+// warnings.
+var explainExtendedRegex = regexp.MustCompile(`(?is)^(explain|describe|desc)\s+extended\s`)
+
 func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 	child, err := convert(ctx, n.Statement, "")
 	if err != nil {
@@ -371,7 +472,9 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		)
 	}
 
-	return plan.NewDescribeQuery(explainFmt, child), nil
+	describe := plan.NewDescribeQuery(explainFmt, child)
+	describe.Analyze = n.Analyze
+	return describe, nil
 }
 
 func convertUse(n *sqlparser.Use) (sql.Node, error) {
@@ -455,7 +558,9 @@ func isCharset(exprs sqlparser.SetVarExprs) bool {
 	return strings.ToLower(exprs[0].Name.String()) == "charset"
 }
 
-func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, error) {
+// convertShow returns the node of the SHOW statement given. The LIKE placeholder given, if any, takes the place of the
+// pattern of its LIKE clause.
+func convertShow(ctx *sql.Context, s *sqlparser.Show, query string, like *likePlaceholder) (sql.Node, error) {
 	showType := strings.ToLower(s.Type)
 	switch showType {
 	case "processlist":
@@ -491,7 +596,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 				} else if s.ShowTablesOpt.Filter.Like != "" {
 					filter = expression.NewLike(
 						expression.NewUnresolvedColumn("Table"),
-						expression.NewLiteral(s.ShowTablesOpt.Filter.Like, sql.LongText),
+						likePattern(s.ShowTablesOpt.Filter.Like, like),
 						nil,
 					)
 				}
@@ -517,7 +622,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 			} else if s.Filter.Like != "" {
 				filter = expression.NewLike(
 					expression.NewUnresolvedColumn("Name"),
-					expression.NewLiteral(s.Filter.Like, sql.LongText),
+					likePattern(s.Filter.Like, like),
 					nil,
 				)
 			}
@@ -529,7 +634,11 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 		return node, nil
 	case "index":
-		return plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), s.Database)), nil
+		dbName := s.Database
+		if dbName == "" {
+			dbName = s.Table.Qualifier.String()
+		}
+		return plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), dbName)), nil
 	case sqlparser.KeywordString(sqlparser.VARIABLES):
 		var likepattern string
 		if s.Filter != nil {
@@ -539,6 +648,12 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 			}
 			likepattern = s.Filter.Like
 		}
+		if like != nil {
+			return plan.NewFilter(
+				expression.NewLike(expression.NewUnresolvedColumn("Variable_name"), likePattern(likepattern, like), nil),
+				plan.NewShowVariables(""),
+			), nil
+		}
 		return plan.NewShowVariables(likepattern), nil
 	case sqlparser.KeywordString(sqlparser.TABLES):
 		var dbName string
@@ -560,7 +675,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 				} else if s.ShowTablesOpt.Filter.Like != "" {
 					filter = expression.NewLike(
 						expression.NewUnresolvedColumn("Table"),
-						expression.NewLiteral(s.ShowTablesOpt.Filter.Like, sql.LongText),
+						likePattern(s.ShowTablesOpt.Filter.Like, like),
 						nil,
 					)
 				}
@@ -592,7 +707,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 
 		if s.ShowTablesOpt != nil && s.ShowTablesOpt.Filter != nil {
 			if s.ShowTablesOpt.Filter.Like != "" {
-				pattern := expression.NewLiteral(s.ShowTablesOpt.Filter.Like, sql.LongText)
+				pattern := likePattern(s.ShowTablesOpt.Filter.Like, like)
 
 				node = plan.NewFilter(
 					expression.NewLike(
@@ -638,13 +753,14 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 		return node, nil
 	case "table status":
-		return convertShowTableStatus(ctx, s)
+		return convertShowTableStatus(ctx, s, like)
 	case sqlparser.KeywordString(sqlparser.COLLATION):
 		// show collation statements are functionally identical to selecting from the collations table in
 		// information_schema, with slightly different syntax and with some columns aliased.
 		// TODO: install information_schema automatically for all catalogs
-		infoSchemaSelect, err := Parse(ctx, "select collation_name as `collation`, character_set_name as charset, id,"+
-			"is_default as `default`, is_compiled as compiled, sortlen, pad_attribute from information_schema.collations")
+		infoSchemaSelect, err := Parse(ctx, "select collation_name as `Collation`, character_set_name as `Charset`, id as `Id`, "+
+			"is_default as `Default`, is_compiled as `Compiled`, sortlen as `Sortlen`, pad_attribute as `Pad_attribute` "+
+			"from information_schema.collations order by collation_name")
 		if err != nil {
 			return nil, err
 		}
@@ -684,7 +800,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 			} else if s.Filter.Like != "" {
 				filter = expression.NewLike(
 					expression.NewUnresolvedColumn("Charset"),
-					expression.NewLiteral(s.Filter.Like, sql.LongText),
+					likePattern(s.Filter.Like, like),
 					nil,
 				)
 			}
@@ -696,7 +812,9 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 		return node, nil
 	case sqlparser.KeywordString(sqlparser.ENGINES):
-		infoSchemaSelect, err := Parse(ctx, "select * from information_schema.engines")
+		// show engines is a select from the engines table in information_schema, with the columns named as in MySQL.
+		infoSchemaSelect, err := Parse(ctx, "select engine as `Engine`, support as `Support`, `comment` as `Comment`, "+
+			"transactions as `Transactions`, xa as `XA`, savepoints as `Savepoints` from information_schema.engines")
 		if err != nil {
 			return nil, err
 		}
@@ -753,18 +871,23 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 		}
 	}
 
-	node, err = selectToSelectionNode(ctx, s.SelectExprs, s.GroupBy, node)
-	if err != nil {
-		return nil, err
-	}
-
+	var having sql.Expression
 	if s.Having != nil {
-		node, err = havingToHaving(ctx, s.Having, node)
+		having, err = ExprToExpression(ctx, s.Having.Expr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	node, err = selectToSelectionNode(ctx, s.SelectExprs, s.GroupBy, having, node)
+	if err != nil {
+		return nil, err
+	}
+
+	if having != nil {
+		node = plan.NewHaving(having, node)
+	}
+
 	if s.Distinct != "" {
 		node = plan.NewDistinct(node)
 	}
@@ -895,10 +1018,14 @@ func convertMultiAlterDDL(ctx *sql.Context, query string, c *sqlparser.MultiAlte
 	return plan.NewBlock(statements), nil
 }
 
-func convertDBDDL(c *sqlparser.DBDDL) (sql.Node, error) {
+func convertDBDDL(ctx *sql.Context, c *sqlparser.DBDDL, query string) (sql.Node, error) {
 	switch strings.ToLower(c.Action) {
 	case sqlparser.CreateStr:
-		return plan.NewCreateDatabase(c.DBName, c.IfNotExists), nil
+		collation, err := createDatabaseCollation(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewCreateDatabase(c.DBName, c.IfNotExists, collation), nil
 	case sqlparser.DropStr:
 		return plan.NewDropDatabase(c.DBName, c.IfExists), nil
 	default:
@@ -927,7 +1054,7 @@ func convertCreateTrigger(ctx *sql.Context, query string, c *sqlparser.DDL) (sql
 		return nil, err
 	}
 
-	return plan.NewCreateTrigger(c.TriggerSpec.Name, c.TriggerSpec.Time, c.TriggerSpec.Event, triggerOrder, tableNameToUnresolvedTable(c.Table), body, query, bodyStr), nil
+	return plan.NewCreateTrigger(c.TriggerSpec.Name, c.TriggerSpec.Time, c.TriggerSpec.Event, triggerOrder, tableNameToUnresolvedTable(c.Table), body, restoreRewrites(query), restoreRewrites(bodyStr)), nil
 }
 
 func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.Node, error) {
@@ -1001,8 +1128,8 @@ func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (s
 		characteristics,
 		body,
 		comment,
-		query,
-		bodyStr,
+		restoreRewrites(query),
+		restoreRewrites(bodyStr),
 	), nil
 }
 
@@ -1156,14 +1283,23 @@ func convertRenameTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error)
 	}
 
 	var fromTables, toTables []string
-	for _, table := range ddl.FromTables {
+	var dbName string
+	for i, table := range ddl.FromTables {
+		if i == 0 {
+			dbName = table.Qualifier.String()
+		} else if !strings.EqualFold(dbName, table.Qualifier.String()) {
+			return nil, sql.ErrUnsupportedFeature.New("renaming tables of different databases in a single statement")
+		}
 		fromTables = append(fromTables, table.Name.String())
 	}
 	for _, table := range ddl.ToTables {
+		if qualifier := table.Qualifier.String(); qualifier != "" && !strings.EqualFold(dbName, qualifier) {
+			return nil, sql.ErrUnsupportedFeature.New("renaming a table to a different database")
+		}
 		toTables = append(toTables, table.Name.String())
 	}
 
-	return plan.NewRenameTable(sql.UnresolvedDatabase(""), fromTables, toTables), nil
+	return plan.NewRenameTable(sql.UnresolvedDatabase(dbName), fromTables, toTables), nil
 }
 
 func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
@@ -1208,7 +1344,9 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 			if err != nil {
 				return nil, err
 			}
-			return plan.NewAddColumn(sql.UnresolvedDatabase(""), tableNameToUnresolvedTable(ddl.Table), sch.Schema[0], columnOrderToColumnOrder(ddl.ColumnOrder)), nil
+			addColumn := plan.NewAddColumn(sql.UnresolvedDatabase(""), tableNameToUnresolvedTable(ddl.Table), sch.Schema[0], columnOrderToColumnOrder(ddl.ColumnOrder))
+			addColumn.ExplicitCollation = hasExplicitCollation(ddl.TableSpec.Columns[0])
+			return addColumn, nil
 		case sqlparser.DropStr:
 			return plan.NewDropColumn(sql.UnresolvedDatabase(""), tableNameToUnresolvedTable(ddl.Table), ddl.Column.String()), nil
 		case sqlparser.RenameStr:
@@ -1218,7 +1356,9 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 			if err != nil {
 				return nil, err
 			}
-			return plan.NewModifyColumn(sql.UnresolvedDatabase(""), tableNameToUnresolvedTable(ddl.Table), ddl.Column.String(), sch.Schema[0], columnOrderToColumnOrder(ddl.ColumnOrder)), nil
+			modifyColumn := plan.NewModifyColumn(sql.UnresolvedDatabase(""), tableNameToUnresolvedTable(ddl.Table), ddl.Column.String(), sch.Schema[0], columnOrderToColumnOrder(ddl.ColumnOrder))
+			modifyColumn.ExplicitCollation = hasExplicitCollation(ddl.TableSpec.Columns[0])
+			return modifyColumn, nil
 		}
 	}
 	if ddl.AutoIncSpec != nil {
@@ -1381,11 +1521,27 @@ func columnOrderToColumnOrder(order *sqlparser.ColumnOrder) *sql.ColumnOrder {
 }
 
 func convertDropTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
-	tableNames := make([]string, len(c.FromTables))
-	for i, t := range c.FromTables {
-		tableNames[i] = t.Name.String()
+	// Tables are dropped by one node per database, in the order their databases first appear
+	var dbNames []string
+	tableNames := make(map[string][]string)
+	for _, t := range c.FromTables {
+		dbName := t.Qualifier.String()
+		key := strings.ToLower(dbName)
+		if _, ok := tableNames[key]; !ok {
+			dbNames = append(dbNames, dbName)
+		}
+		tableNames[key] = append(tableNames[key], t.Name.String())
+	}
+
+	if len(dbNames) == 1 {
+		return plan.NewDropTable(sql.UnresolvedDatabase(dbNames[0]), c.IfExists, tableNames[strings.ToLower(dbNames[0])]...), nil
 	}
-	return plan.NewDropTable(sql.UnresolvedDatabase(""), c.IfExists, tableNames...), nil
+
+	drops := make([]sql.Node, len(dbNames))
+	for i, dbName := range dbNames {
+		drops[i] = plan.NewDropTable(sql.UnresolvedDatabase(dbName), c.IfExists, tableNames[strings.ToLower(dbName)]...)
+	}
+	return plan.NewBlock(drops), nil
 }
 
 func convertTruncateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
@@ -1398,7 +1554,7 @@ func convertTruncateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error)
 func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 	if c.OptLike != nil {
 		return plan.NewCreateTableLike(
-			sql.UnresolvedDatabase(""),
+			sql.UnresolvedDatabase(c.Table.Qualifier.String()),
 			c.Table.Name.String(),
 			plan.NewUnresolvedTable(c.OptLike.LikeTable.Name.String(), c.OptLike.LikeTable.Qualifier.String()),
 			plan.IfNotExistsOption(c.IfNotExists),
@@ -1511,6 +1667,20 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 		ChDefs:  chDefs,
 	}
 
+	// The SQL parser keeps the table options as they're written
+	collation, ok, err := scanCollationOptions(c.TableSpec.Options, 0)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		tableSpec.Collation = &collation
+	}
+	for _, cd := range c.TableSpec.Columns {
+		if hasExplicitCollation(cd) {
+			tableSpec.ExplicitCollationColumns = append(tableSpec.ExplicitCollationColumns, cd.Name.String())
+		}
+	}
+
 	if c.OptSelect != nil {
 		selectNode, err := convertSelectStatement(ctx, c.OptSelect.Select)
 		if err != nil {
@@ -1595,17 +1765,17 @@ func convertCreateView(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.No
 		return nil, err
 	}
 
-	selectStr := query[c.SubStatementPositionStart:c.SubStatementPositionEnd]
+	selectStr := restoreRewrites(query[c.SubStatementPositionStart:c.SubStatementPositionEnd])
 	queryAlias := plan.NewSubqueryAlias(c.View.Name.String(), selectStr, queryNode)
 
 	return plan.NewCreateView(
-		sql.UnresolvedDatabase(""), c.View.Name.String(), []string{}, queryAlias, c.OrReplace), nil
+		sql.UnresolvedDatabase(c.View.Qualifier.String()), c.View.Name.String(), []string{}, queryAlias, c.OrReplace), nil
 }
 
 func convertDropView(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 	plans := make([]sql.Node, len(c.FromViews))
 	for i, v := range c.FromViews {
-		plans[i] = plan.NewSingleDropView(sql.UnresolvedDatabase(""), v.Name.String())
+		plans[i] = plan.NewSingleDropView(sql.UnresolvedDatabase(v.Qualifier.String()), v.Name.String())
 	}
 	return plan.NewDropView(plans, c.IfExists), nil
 }
@@ -1851,9 +2021,16 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		return nil, err
 	}
 
+	onUpdateVal, err := convertOnUpdateExpression(ctx, cd.Name.String(), internalTyp, cd.Type.OnUpdate)
+	if err != nil {
+		return nil, err
+	}
+
 	extra := ""
 	if cd.Type.Autoincrement {
 		extra = "auto_increment"
+	} else if onUpdateVal != nil {
+		extra = "on update CURRENT_TIMESTAMP"
 	}
 
 	return &sql.Column{
@@ -1862,6 +2039,7 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		Name:          cd.Name.String(),
 		PrimaryKey:    isPkey,
 		Default:       defaultVal,
+		OnUpdate:      onUpdateVal,
 		AutoIncrement: bool(cd.Type.Autoincrement),
 		Comment:       comment,
 		Extra:         extra,
@@ -1883,6 +2061,44 @@ func convertDefaultExpression(ctx *sql.Context, defaultExpr sqlparser.Expr) (*sq
 	return ExpressionToColumnDefaultValue(ctx, parsedExpr, !isExpr)
 }
 
+// convertOnUpdateExpression returns the value of the ON UPDATE clause of the column given, which MySQL only allows to
+// be the current timestamp, with an optional precision, on datetime and timestamp columns.
+func convertOnUpdateExpression(ctx *sql.Context, colName string, colType sql.Type, onUpdateExpr sqlparser.Expr) (*sql.ColumnDefaultValue, error) {
+	if onUpdateExpr == nil {
+		return nil, nil
+	}
+	if !sql.IsTime(colType) || colType == sql.Date {
+		return nil, sql.ErrInvalidOnUpdate.New(colName)
+	}
+
+	var name string
+	var args []sql.Expression
+	switch e := onUpdateExpr.(type) {
+	case *sqlparser.FuncExpr:
+		name = e.Name.Lowered()
+	case *sqlparser.CurTimeFuncExpr:
+		name = e.Name.Lowered()
+		if e.Fsp != nil {
+			fsp, err := ExprToExpression(ctx, e.Fsp)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, fsp)
+		}
+	}
+	switch name {
+	case "current_timestamp", "localtime", "localtimestamp", "now":
+	default:
+		return nil, sql.ErrInvalidOnUpdate.New(colName)
+	}
+
+	now, err := function.NewCurrTimestamp(args...)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewColumnDefaultValue(now, colType, true, true)
+}
+
 func convertAccountName(names ...sqlparser.AccountName) []plan.UserName {
 	userNames := make([]plan.UserName, len(names))
 	for i, name := range names {
@@ -2348,15 +2564,6 @@ func limitToLimit(
 	return plan.NewLimit(rowCount, child), nil
 }
 
-func havingToHaving(ctx *sql.Context, having *sqlparser.Where, node sql.Node) (sql.Node, error) {
-	cond, err := ExprToExpression(ctx, having.Expr)
-	if err != nil {
-		return nil, err
-	}
-
-	return plan.NewHaving(cond, node), nil
-}
-
 func offsetToOffset(
 	ctx *sql.Context,
 	offset sqlparser.Expr,
@@ -2414,6 +2621,35 @@ func getInt64Value(ctx *sql.Context, expr sqlparser.Expr, errStr string) (int64,
 	return i.(int64), nil
 }
 
+// decimalLiteral returns a DECIMAL literal for the number given, which has a decimal point but no exponent. Its type
+// has as many digits as the number is written with, falling back to a double for numbers with too many digits.
+func decimalLiteral(s string) (sql.Expression, error) {
+	dec, err := decimal.NewFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	digits := strings.TrimLeft(strings.TrimLeft(s, "+-"), "0")
+	scale := 0
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		scale = len(digits) - i - 1
+		digits = digits[:i] + digits[i+1:]
+	}
+	precision := len(digits)
+	if precision < scale {
+		precision = scale
+	}
+	if precision == 0 {
+		precision = 1
+	}
+
+	if precision > sql.DecimalTypeMaxPrecision || scale > sql.DecimalTypeMaxScale {
+		f, _ := dec.Float64()
+		return expression.NewLiteral(f, sql.Float64), nil
+	}
+	return expression.NewLiteral(dec.StringFixed(int32(scale)), sql.MustCreateDecimalType(uint8(precision), uint8(scale))), nil
+}
+
 func isAggregateExpr(e sql.Expression) bool {
 	var isAgg bool
 	sql.Inspect(e, func(e sql.Expression) bool {
@@ -2433,6 +2669,7 @@ func selectToSelectionNode(
 	ctx *sql.Context,
 	se sqlparser.SelectExprs,
 	g sqlparser.GroupBy,
+	having sql.Expression,
 	child sql.Node,
 ) (sql.Node, error) {
 	selectExprs, err := selectExprsToExpressions(ctx, se)
@@ -2455,11 +2692,9 @@ func selectToSelectionNode(
 		for _, e := range selectExprs {
 			if isAggregateExpr(e) {
 				sql.Inspect(e, func(e sql.Expression) bool {
-					if uf, ok := e.(*expression.UnresolvedFunction); ok {
-						if uf.Window == nil || len(uf.Window.PartitionBy) > 0 || len(uf.Window.OrderBy) > 0 {
-							err = sql.ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an empty OVER () clause")
-							return false
-						}
+					if uf, ok := e.(*expression.UnresolvedFunction); ok && uf.IsAggregate && uf.Window == nil {
+						err = sql.ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an OVER clause")
+						return false
 					}
 					return true
 				})
@@ -2471,7 +2706,9 @@ func selectToSelectionNode(
 		return plan.NewWindow(selectExprs, child), nil
 	}
 
-	isAgg := len(g) > 0
+	// An aggregate function in the HAVING clause makes the whole select aggregated, even when neither the select list
+	// nor a GROUP BY clause does.
+	isAgg := len(g) > 0 || (having != nil && isAggregateExpr(having))
 	if !isAgg {
 		for _, e := range selectExprs {
 			if isAggregateExpr(e) {
@@ -2487,20 +2724,48 @@ func selectToSelectionNode(
 			return nil, err
 		}
 
+		// Positions can't be resolved against the select list until stars are expanded, which happens much later
+		hasStar := false
+		for _, e := range selectExprs {
+			if _, ok := e.(*expression.Star); ok {
+				hasStar = true
+			}
+		}
+
 		agglen := int64(len(selectExprs))
 		for i, ge := range groupingExprs {
-			// if GROUP BY index
-			if l, ok := ge.(*expression.Literal); ok && sql.IsNumber(l.Type()) {
-				if i64, err := sql.Int64.Convert(l.Value()); err == nil {
-					if idx, ok := i64.(int64); ok && idx > 0 && idx <= agglen {
-						aggexpr := selectExprs[idx-1]
-						if alias, ok := aggexpr.(*expression.Alias); ok {
-							aggexpr = expression.NewUnresolvedColumn(alias.Name())
-						}
-						groupingExprs[i] = aggexpr
-					}
+			// if GROUP BY index. Like MySQL, only unsigned integer literals are positions, any other constant is
+			// grouped on as is.
+			l, ok := ge.(*expression.Literal)
+			if !ok || !sql.IsInteger(l.Type()) {
+				continue
+			}
+			if hasStar {
+				return nil, sql.ErrUnsupportedFeature.New("GROUP BY position with * in the select list")
+			}
+			i64, err := sql.Int64.Convert(l.Value())
+			if err != nil {
+				return nil, err
+			}
+			idx := i64.(int64)
+			if idx < 1 || idx > agglen {
+				return nil, sql.ErrGroupByColumnIndex.New(idx)
+			}
+
+			aggexpr := selectExprs[idx-1]
+			if isAggregateExpr(aggexpr) {
+				name := aggexpr.String()
+				if n, ok := aggexpr.(sql.Nameable); ok {
+					name = n.Name()
 				}
+				return nil, sql.ErrGroupByAggregate.New(name)
+			}
+			// A position always refers to the expression in the select list, even when its alias is shadowed by a
+			// column of the same name, so group on the aliased expression itself.
+			if alias, ok := aggexpr.(*expression.Alias); ok {
+				aggexpr = alias.Child
 			}
+			groupingExprs[i] = aggexpr
 		}
 
 		return plan.NewGroupBy(selectExprs, groupingExprs, child), nil
@@ -2659,11 +2924,19 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		}
 		return expression.NewUnresolvedColumn(v.Name.String()), nil
 	case *sqlparser.FuncExpr:
+		if e, ok, err := convertExtract(ctx, v); ok {
+			return e, err
+		}
+
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
 			return nil, err
 		}
 
+		if v.Distinct && v.Over != nil {
+			return nil, sql.ErrUnsupportedFeature.New("DISTINCT in window functions")
+		}
+
 		// NOTE: The count distinct expressions work differently due to the * syntax. eg. COUNT(*)
 		if v.Distinct && v.Name.Lowered() == "count" {
 			if len(exprs) != 1 {
@@ -2683,8 +2956,10 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			exprs[0] = expression.NewDistinctExpression(exprs[0])
 		}
 
-		return expression.NewUnresolvedFunction(v.Name.Lowered(),
-			isAggregateFunc(v), overToWindow(ctx, v.Over), exprs...), nil
+		over, ignoreNulls := nullTreatment(v.Over)
+		uf := expression.NewUnresolvedFunction(v.Name.Lowered(), isAggregateFunc(v), overToWindow(ctx, over), exprs...)
+		uf.IgnoreNulls = ignoreNulls
+		return uf, nil
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -2741,7 +3016,27 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 
+		if v.Type.Length != nil {
+			length, err := strconv.Atoi(string(v.Type.Length.Val))
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewConvertWithLength(expr, v.Type.Type, length), nil
+		}
+
 		return expression.NewConvert(expr, v.Type.Type), nil
+	case *sqlparser.ConvertUsingExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		charset, err := sql.ParseCharacterSet(strings.ToLower(v.Type))
+		if err != nil {
+			return nil, err
+		}
+
+		return expression.NewConvertUsing(expr, charset), nil
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
 		if err != nil {
@@ -2885,6 +3180,11 @@ func convertVal(v *sqlparser.SQLVal) (sql.Expression, error) {
 	case sqlparser.IntVal:
 		return convertInt(string(v.Val), 10)
 	case sqlparser.FloatVal:
+		// Like MySQL, numbers with a decimal point are exact decimals, and only the ones in scientific notation are
+		// approximate doubles.
+		if !strings.ContainsAny(string(v.Val), "eE") {
+			return decimalLiteral(string(v.Val))
+		}
 		val, err := strconv.ParseFloat(string(v.Val), 64)
 		if err != nil {
 			return nil, err
@@ -3048,7 +3348,7 @@ func selectExprToExpression(ctx *sql.Context, se sqlparser.SelectExpr) (sql.Expr
 		}
 
 		if selectExprNeedsAlias(e, expr) {
-			return expression.NewAlias(e.InputExpression, expr), nil
+			return expression.NewAlias(restoreRewrites(e.InputExpression), expr), nil
 		}
 
 		return expr, nil
@@ -3085,6 +3385,12 @@ func unaryExprToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expres
 			return nil, err
 		}
 		return expression.NewUnaryMinus(expr), nil
+	case sqlparser.TildaStr:
+		expr, err := ExprToExpression(ctx, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewBitNot(expr), nil
 	case sqlparser.PlusStr:
 		// Unary plus expressions do nothing (do not turn the expression positive). Just return the underlying expression.
 		return ExprToExpression(ctx, e.Expr)
@@ -3149,7 +3455,23 @@ func binaryExprToExpression(ctx *sql.Context, be *sqlparser.BinaryExpr) (sql.Exp
 	case
 		sqlparser.JSONExtractOp,
 		sqlparser.JSONUnquoteExtractOp:
-		return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("(%s) JSON operators not supported", be.Operator))
+
+		l, err := ExprToExpression(ctx, be.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := ExprToExpression(ctx, be.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		extract := expression.NewUnresolvedFunction("json_extract", false, nil, l, r)
+		if be.Operator == sqlparser.JSONExtractOp {
+			return extract, nil
+		}
+		// col->>path is a shorthand for JSON_UNQUOTE(JSON_EXTRACT(col, path))
+		return expression.NewUnresolvedFunction("json_unquote", false, nil, extract), nil
 
 	default:
 		return nil, sql.ErrUnsupportedFeature.New(be.Operator)
@@ -3297,7 +3619,7 @@ func assignmentExprsToExpressions(ctx *sql.Context, e sqlparser.AssignmentExprs)
 	return res, nil
 }
 
-func convertShowTableStatus(ctx *sql.Context, s *sqlparser.Show) (sql.Node, error) {
+func convertShowTableStatus(ctx *sql.Context, s *sqlparser.Show, like *likePlaceholder) (sql.Node, error) {
 	var filter sql.Expression
 	if s.Filter != nil {
 		if s.Filter.Filter != nil {
@@ -3309,7 +3631,7 @@ func convertShowTableStatus(ctx *sql.Context, s *sqlparser.Show) (sql.Node, erro
 		} else if s.Filter.Like != "" {
 			filter = expression.NewLike(
 				expression.NewUnresolvedColumn("Name"),
-				expression.NewLiteral(s.Filter.Like, sql.LongText),
+				likePattern(s.Filter.Like, like),
 				nil,
 			)
 		}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -29,20 +30,24 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
-var showCollationProjection = plan.NewProject([]sql.Expression{
-	expression.NewAlias("collation", expression.NewUnresolvedColumn("collation_name")),
-	expression.NewAlias("charset", expression.NewUnresolvedColumn("character_set_name")),
-	expression.NewUnresolvedColumn("id"),
-	expression.NewAlias("default", expression.NewUnresolvedColumn("is_default")),
-	expression.NewAlias("compiled", expression.NewUnresolvedColumn("is_compiled")),
-	expression.NewUnresolvedColumn("sortlen"),
-	expression.NewUnresolvedColumn("pad_attribute"),
-},
-	plan.NewUnresolvedTable("collations", "information_schema"),
+var showCollationProjection = plan.NewSort(
+	[]sql.SortField{{Column: expression.NewUnresolvedColumn("collation_name"), Order: sql.Ascending, NullOrdering: sql.NullsFirst}},
+	plan.NewProject([]sql.Expression{
+		expression.NewAlias("Collation", expression.NewUnresolvedColumn("collation_name")),
+		expression.NewAlias("Charset", expression.NewUnresolvedColumn("character_set_name")),
+		expression.NewAlias("Id", expression.NewUnresolvedColumn("id")),
+		expression.NewAlias("Default", expression.NewUnresolvedColumn("is_default")),
+		expression.NewAlias("Compiled", expression.NewUnresolvedColumn("is_compiled")),
+		expression.NewAlias("Sortlen", expression.NewUnresolvedColumn("sortlen")),
+		expression.NewAlias("Pad_attribute", expression.NewUnresolvedColumn("pad_attribute")),
+	},
+		plan.NewUnresolvedTable("collations", "information_schema"),
+	),
 )
 
 var fixtures = map[string]sql.Node{
@@ -953,6 +958,16 @@ CREATE TABLE t2
 	`DROP TABLE IF EXISTS foo, bar, baz;`: plan.NewDropTable(
 		sql.UnresolvedDatabase(""), true, "foo", "bar", "baz",
 	),
+	`DROP TABLE mydb.foo, bar, mydb.baz;`: plan.NewBlock([]sql.Node{
+		plan.NewDropTable(sql.UnresolvedDatabase("mydb"), false, "foo", "baz"),
+		plan.NewDropTable(sql.UnresolvedDatabase(""), false, "bar"),
+	}),
+	`RENAME TABLE mydb.foo TO mydb.bar`: plan.NewRenameTable(
+		sql.UnresolvedDatabase("mydb"), []string{"foo"}, []string{"bar"},
+	),
+	`SHOW INDEX FROM mydb.foo`: plan.NewShowIndexes(
+		plan.NewUnresolvedTable("foo", "mydb"),
+	),
 	`RENAME TABLE foo TO bar`: plan.NewRenameTable(
 		sql.UnresolvedDatabase(""), []string{"foo"}, []string{"bar"},
 	),
@@ -1026,6 +1041,15 @@ CREATE TABLE t2
 			Default:  MustStringToColumnDefaultValue(sql.NewEmptyContext(), "32.0", nil, true),
 		}, nil,
 	),
+	`ALTER TABLE foo ADD COLUMN bar DATETIME ON UPDATE CURRENT_TIMESTAMP(3)`: plan.NewAddColumn(
+		sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("foo", ""), &sql.Column{
+			Name:     "bar",
+			Type:     sql.Datetime,
+			Nullable: true,
+			OnUpdate: mustOnUpdateValue(function.NewCurrTimestamp(expression.NewLiteral(int8(3), sql.Int8))),
+			Extra:    "on update CURRENT_TIMESTAMP",
+		}, nil,
+	),
 	`ALTER TABLE foo ADD COLUMN bar INT DEFAULT 1 FIRST`: plan.NewAddColumn(
 		sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("foo", ""), &sql.Column{
 			Name:     "bar",
@@ -1648,6 +1672,46 @@ CREATE TABLE t2
 		),
 		plan.NewShowTables(sql.UnresolvedDatabase(""), false, expression.NewLiteral("abc", sql.LongText)),
 	),
+	`SHOW TABLES AS OF ? LIKE ?`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Table"),
+			expression.NewBindVar("v2"),
+			nil,
+		),
+		plan.NewShowTables(sql.UnresolvedDatabase(""), false, expression.NewBindVar("v1")),
+	),
+	`SHOW TABLES LIKE :pattern`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Table"),
+			expression.NewBindVar("pattern"),
+			nil,
+		),
+		plan.NewShowTables(sql.UnresolvedDatabase(""), false, nil),
+	),
+	`SHOW TABLES LIKE '__like_placeholder__:v1'`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Table"),
+			expression.NewLiteral("__like_placeholder__:v1", sql.LongText),
+			nil,
+		),
+		plan.NewShowTables(sql.UnresolvedDatabase(""), false, nil),
+	),
+	`SHOW TABLES LIKE '?'`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Table"),
+			expression.NewLiteral("?", sql.LongText),
+			nil,
+		),
+		plan.NewShowTables(sql.UnresolvedDatabase(""), false, nil),
+	),
+	"SHOW TABLES WHERE `Table` LIKE ?": plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Table"),
+			expression.NewBindVar("v1"),
+			nil,
+		),
+		plan.NewShowTables(sql.UnresolvedDatabase(""), false, nil),
+	),
 	"SHOW TABLES WHERE `Table` = 'foo'": plan.NewFilter(
 		expression.NewEquals(
 			expression.NewUnresolvedColumn("Table"),
@@ -2053,6 +2117,14 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("mytable", ""),
 	),
+	`SELECT ~i FROM mytable`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewBitNot(
+				expression.NewUnresolvedColumn("i"),
+			),
+		},
+		plan.NewUnresolvedTable("mytable", ""),
+	),
 	`SELECT +i FROM mytable`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewAlias("+i",
@@ -2132,13 +2204,20 @@ CREATE TABLE t2
 		[]sql.Expression{
 			expression.NewAlias("1.0 * a + 2.0 * b",
 				expression.NewPlus(
-					expression.NewMult(expression.NewLiteral(float64(1.0), sql.Float64), expression.NewUnresolvedColumn("a")),
-					expression.NewMult(expression.NewLiteral(float64(2.0), sql.Float64), expression.NewUnresolvedColumn("b")),
+					expression.NewMult(expression.NewLiteral("1.0", sql.MustCreateDecimalType(2, 1)), expression.NewUnresolvedColumn("a")),
+					expression.NewMult(expression.NewLiteral("2.0", sql.MustCreateDecimalType(2, 1)), expression.NewUnresolvedColumn("b")),
 				),
 			),
 		},
 		plan.NewUnresolvedTable("t", ""),
 	),
+	`SELECT 0.25, 2.5e1;`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewLiteral("0.25", sql.MustCreateDecimalType(2, 2)),
+			expression.NewAlias("2.5e1", expression.NewLiteral(float64(25), sql.Float64)),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
 	`SELECT '1.0' + 2;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewAlias("'1.0' + 2",
@@ -2406,6 +2485,14 @@ CREATE TABLE t2
 	`SHOW VARIABLES LIKE 'gtid_mode'`:          plan.NewShowVariables("gtid_mode"),
 	`SHOW SESSION VARIABLES LIKE 'autocommit'`: plan.NewShowVariables("autocommit"),
 	`UNLOCK TABLES`:                            plan.NewUnlockTables(),
+	`SHOW VARIABLES LIKE ?`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Variable_name"),
+			expression.NewBindVar("v1"),
+			nil,
+		),
+		plan.NewShowVariables(""),
+	),
 	`LOCK TABLES foo READ`: plan.NewLockTables([]*plan.TableLock{
 		{Table: plan.NewUnresolvedTable("foo", "")},
 	}),
@@ -2517,6 +2604,38 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("dual", ""),
 	),
+	"HANDLER mydb.t1 OPEN AS h": plan.NewHandlerOpen(sql.UnresolvedDatabase("mydb"), "t1", "h"),
+	"HANDLER `my table` OPEN":   plan.NewHandlerOpen(sql.UnresolvedDatabase(""), "my table", ""),
+	"HANDLER h CLOSE;":          plan.NewHandlerClose("h"),
+	"HANDLER h READ NEXT": plan.NewLimit(
+		expression.NewLiteral(int8(1), sql.Int8),
+		plan.NewHandlerRead("h", "", plan.HandlerReadNext),
+	),
+	"HANDLER h READ idx >= (1, 'a') WHERE b > 2 LIMIT 1, 10": plan.NewLimit(
+		expression.NewLiteral(int8(10), sql.Int8),
+		plan.NewOffset(
+			expression.NewLiteral(int8(1), sql.Int8),
+			plan.NewFilter(
+				expression.NewGreaterThan(
+					expression.NewUnresolvedColumn("b"),
+					expression.NewLiteral(int8(2), sql.Int8),
+				),
+				plan.NewHandlerReadKey("h", "idx", ">=", []sql.Expression{
+					expression.NewLiteral(int8(1), sql.Int8),
+					expression.NewLiteral("a", sql.LongText),
+				}),
+			),
+		),
+	),
+	"OPTIMIZE TABLE t1, mydb.`t 2`": plan.NewTableMaintenance(plan.TableMaintenanceOptimize, []sql.Node{
+		plan.NewUnresolvedTable("t1", ""),
+		plan.NewUnresolvedTable("t 2", "mydb"),
+	}),
+	"REPAIR LOCAL TABLES t1 QUICK EXTENDED": plan.NewTableMaintenance(plan.TableMaintenanceRepair, []sql.Node{
+		plan.NewUnresolvedTable("t1", ""),
+	}),
+	"BACKUP DATABASE mydb TO '/backups/my''db'":      plan.NewBackup(plan.BackupOpBackup, sql.UnresolvedDatabase("mydb"), "/backups/my'db"),
+	"restore database `my db` from \"backups/mydb\"": plan.NewBackup(plan.BackupOpRestore, sql.UnresolvedDatabase("my db"), "backups/mydb"),
 	"SHOW COLLATION": showCollationProjection,
 	"SHOW COLLATION LIKE 'foo'": plan.NewFilter(
 		expression.NewLike(
@@ -2533,6 +2652,16 @@ CREATE TABLE t2
 		),
 		showCollationProjection,
 	),
+	"START TRANSACTION /*!40100 WITH CONSISTENT SNAPSHOT */": plan.NewStartTransaction("", sql.ReadWrite),
+	"start transaction read only, with consistent snapshot":  plan.NewStartTransaction("", sql.ReadOnly),
+	"FLUSH TABLES":                                 plan.NewFlushTables(false),
+	"FLUSH /*!40101 LOCAL */ TABLES":               plan.NewFlushTables(false),
+	"FLUSH NO_WRITE_TO_BINLOG TABLE foo, mydb.bar": plan.NewFlushTables(false),
+	"FLUSH TABLES WITH READ LOCK":                  plan.NewFlushTables(true),
+	"flush tables foo, `mydb`.bar with read lock": plan.NewLockTables([]*plan.TableLock{
+		{Table: plan.NewUnresolvedTable("foo", "")},
+		{Table: plan.NewUnresolvedTable("bar", "mydb")},
+	}),
 	"BEGIN":                                  plan.NewStartTransaction("", sql.ReadWrite),
 	"START TRANSACTION":                      plan.NewStartTransaction("", sql.ReadWrite),
 	"COMMIT":                                 plan.NewCommit(""),
@@ -2815,6 +2944,80 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT a, rank() over (partition by s order by x desc), dense_rank() over () FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("rank() over (partition by s order by x desc)",
+				expression.NewUnresolvedFunction("rank", false, sql.NewWindow(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("s"),
+					},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("x"),
+							Order:        sql.Descending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				)),
+			),
+			expression.NewAlias("dense_rank() over ()",
+				expression.NewUnresolvedFunction("dense_rank", false, sql.NewWindow(
+					[]sql.Expression{},
+					nil,
+				)),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	"SELECT a, lag(b, 1) IGNORE NULLS OVER (PARTITION BY c ORDER BY a), lead(b) respect nulls over (order by a) FROM foo": plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("lag(b, 1) IGNORE NULLS OVER (PARTITION BY c ORDER BY a)",
+				func() sql.Expression {
+					uf := expression.NewUnresolvedFunction("lag", false, sql.NewWindow(
+						[]sql.Expression{
+							expression.NewUnresolvedColumn("c"),
+						},
+						sql.SortFields{
+							{
+								Column:       expression.NewUnresolvedColumn("a"),
+								Order:        sql.Ascending,
+								NullOrdering: sql.NullsFirst,
+							},
+						},
+					), expression.NewUnresolvedColumn("b"), expression.NewLiteral(int8(1), sql.Int8))
+					uf.IgnoreNulls = true
+					return uf
+				}(),
+			),
+			expression.NewAlias("lead(b) respect nulls over (order by a)",
+				expression.NewUnresolvedFunction("lead", false, sql.NewWindow(
+					[]sql.Expression{},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("a"),
+							Order:        sql.Ascending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				), expression.NewUnresolvedColumn("b")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	"SELECT EXTRACT(DAY_MINUTE FROM d), extract( year from '2019-07-02'), `extract` FROM foo": plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("EXTRACT(DAY_MINUTE FROM d)",
+				mustExpression(function.NewExtract("DAY_MINUTE", expression.NewUnresolvedColumn("d"))),
+			),
+			expression.NewAlias("extract( year from '2019-07-02')",
+				mustExpression(function.NewExtract("YEAR", expression.NewLiteral("2019-07-02", sql.LongText))),
+			),
+			expression.NewUnresolvedColumn("extract"),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT a, count(i) over () FROM foo`: plan.NewWindow(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("a"),
@@ -2827,6 +3030,32 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT a, sum(b) over (partition by c order by a), count(*) over () FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("sum(b) over (partition by c order by a)",
+				expression.NewUnresolvedFunction("sum", true, sql.NewWindow(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("c"),
+					},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("a"),
+							Order:        sql.Ascending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				), expression.NewUnresolvedColumn("b")),
+			),
+			expression.NewAlias("count(*) over ()",
+				expression.NewUnresolvedFunction("count", true, sql.NewWindow(
+					[]sql.Expression{},
+					nil,
+				), expression.NewStar()),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT a, row_number() over (order by x), row_number() over (partition by y) FROM foo`: plan.NewWindow(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("a"),
@@ -3252,12 +3481,30 @@ CREATE TABLE t2
 			),
 		),
 	),
-	`CREATE DATABASE test`:               plan.NewCreateDatabase("test", false),
-	`CREATE DATABASE IF NOT EXISTS test`: plan.NewCreateDatabase("test", true),
-	`DROP DATABASE test`:                 plan.NewDropDatabase("test", false),
-	`DROP DATABASE IF EXISTS test`:       plan.NewDropDatabase("test", true),
-	`KILL QUERY 1`:                       plan.NewKill(plan.KillType_Query, 1),
-	`KILL CONNECTION 1`:                  plan.NewKill(plan.KillType_Connection, 1),
+	`DROP DATABASE test`:           plan.NewDropDatabase("test", false),
+	`DROP DATABASE IF EXISTS test`: plan.NewDropDatabase("test", true),
+	`KILL QUERY 1`:                 plan.NewKill(plan.KillType_Query, 1),
+	`KILL CONNECTION 1`:            plan.NewKill(plan.KillType_Connection, 1),
+}
+
+// mustExpression returns the expression given, panicking if there's an error.
+func mustExpression(expr sql.Expression, err error) sql.Expression {
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// mustOnUpdateValue returns the ON UPDATE value of a datetime column with the expression given.
+func mustOnUpdateValue(expr sql.Expression, err error) *sql.ColumnDefaultValue {
+	if err != nil {
+		panic(err)
+	}
+	onUpdate, err := sql.NewColumnDefaultValue(expr, sql.Datetime, true, true)
+	if err != nil {
+		panic(err)
+	}
+	return onUpdate
 }
 
 func TestParse(t *testing.T) {
@@ -3324,6 +3571,15 @@ func assertNodesEqualWithDiff(t *testing.T, expected, actual sql.Node) bool {
 
 var fixturesErrors = map[string]*errors.Kind{
 	`SHOW METHEMONEY`:                                           sql.ErrUnsupportedFeature,
+	`HANDLER h READ idx NOWHERE`:                                sql.ErrSyntaxError,
+	`HANDLER h DELETE`:                                          sql.ErrSyntaxError,
+	`OPTIMIZE TABLE t1 QUICK`:                                   sql.ErrSyntaxError,
+	`REPAIR t1`:                                                 sql.ErrSyntaxError,
+	`BACKUP DATABASE mydb FROM 'mydb'`:                          sql.ErrSyntaxError,
+	`RESTORE DATABASE mydb FROM mydb`:                           sql.ErrSyntaxError,
+	`FLUSH TABLES WITH READ`:                                    sql.ErrSyntaxError,
+	`FLUSH TABLES foo FOR EXPORT`:                               sql.ErrUnsupportedFeature,
+	`START TRANSACTION READ ONLY, CONSISTENT SNAPSHOT`:          sql.ErrSyntaxError,
 	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
 	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
 	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                      sql.ErrUnsupportedSyntax,
@@ -3335,15 +3591,20 @@ var fixturesErrors = map[string]*errors.Kind{
 	`CREATE TABLE test (pk int not null null primary key)`:      ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int null, primary key(pk))`:          ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int not null null, primary key(pk))`: ErrPrimaryKeyOnNullField,
-	`SELECT a, count(i) over (order by x) FROM foo`:             sql.ErrUnsupportedFeature,
-	`SELECT a, count(i) over (partition by y) FROM foo`:         sql.ErrUnsupportedFeature,
+	`SELECT a, count(distinct i) over (order by x) FROM foo`:    sql.ErrUnsupportedFeature,
+	`SELECT a, sum(i) over (partition by y), max(b) FROM foo`:   sql.ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a) group by 1`:       sql.ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a), max(b)`:          sql.ErrUnsupportedFeature,
+	`SELECT a, b FROM foo GROUP BY 3`:                           sql.ErrGroupByColumnIndex,
+	`SELECT a, b FROM foo GROUP BY 0`:                           sql.ErrGroupByColumnIndex,
+	`SELECT a, count(*) FROM foo GROUP BY 2`:                    sql.ErrGroupByAggregate,
+	`SELECT * FROM foo GROUP BY 1`:                              sql.ErrUnsupportedFeature,
 	`SHOW COUNT(*) WARNINGS`:                                    sql.ErrUnsupportedFeature,
 	`SHOW ERRORS`:                                               sql.ErrUnsupportedFeature,
 	`SHOW VARIABLES WHERE Variable_name = 'autocommit'`:         sql.ErrUnsupportedFeature,
 	`SHOW SESSION VARIABLES WHERE Variable_name IS NOT NULL`:    sql.ErrUnsupportedFeature,
 	`KILL CONNECTION 4294967296`:                                sql.ErrUnsupportedFeature,
+	`SELECT EXTRACT(DAY_YEAR FROM '2018-05-01')`:                sql.ErrSyntaxError,
 }
 
 func TestParseOne(t *testing.T) {
@@ -3381,6 +3642,18 @@ func TestParseOne(t *testing.T) {
 			"SELECT 1; -- empty statement with comment\n; SELECT 2",
 			[]string{"SELECT 1", "-- empty statement with comment\n", "SELECT 2"},
 		},
+		{
+			"SELECT EXTRACT(DAY FROM '2019-07-02'); SELECT extract(hour_minute FROM now())",
+			[]string{"SELECT EXTRACT(DAY FROM '2019-07-02')", "SELECT extract(hour_minute FROM now())"},
+		},
+		{
+			"SHOW TABLES LIKE :pattern; SHOW TABLES LIKE '?'",
+			[]string{"SHOW TABLES LIKE :pattern", "SHOW TABLES LIKE '?'"},
+		},
+		{
+			"SHOW TABLES; SHOW TABLES LIKE ?",
+			[]string{"SHOW TABLES", "SHOW TABLES LIKE ?"},
+		},
 		{
 			"SELECT 1; SELECT 2; -- empty statement with comment\n",
 			[]string{"SELECT 1", "SELECT 2", "-- empty statement with comment"},
@@ -3404,6 +3677,107 @@ func TestParseOne(t *testing.T) {
 	}
 }
 
+func TestParseCollationOptions(t *testing.T) {
+	// Collations can't be compared with the other nodes, so only their names are checked
+	databaseCases := []struct {
+		query     string
+		db        string
+		collation string
+	}{
+		{"CREATE DATABASE test", "test", "utf8mb4_0900_bin"},
+		{"CREATE DATABASE IF NOT EXISTS test", "test", "utf8mb4_0900_bin"},
+		{"CREATE DATABASE test CHARACTER SET latin1", "test", "latin1_swedish_ci"},
+		{"CREATE DATABASE test DEFAULT CHARSET = utf8mb4", "test", "utf8mb4_0900_ai_ci"},
+		{"CREATE SCHEMA IF NOT EXISTS test /* comment */ COLLATE 'utf8mb4_bin'", "test", "utf8mb4_bin"},
+		{"ALTER DATABASE test CHARACTER SET latin1", "test", "latin1_swedish_ci"},
+		{"ALTER SCHEMA DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_bin", "", "utf8mb4_bin"},
+	}
+	for _, tt := range databaseCases {
+		t.Run(tt.query, func(t *testing.T) {
+			p, err := Parse(sql.NewEmptyContext(), tt.query)
+			require.NoError(t, err)
+			switch n := p.(type) {
+			case *plan.CreateDB:
+				require.True(t, strings.HasSuffix(n.String(), " "+tt.db))
+				require.Equal(t, tt.collation, n.Collation.Name)
+			case *plan.AlterDB:
+				require.Equal(t, tt.db, n.Database().Name())
+				require.Equal(t, tt.collation, n.Collation.Name)
+			default:
+				require.Failf(t, "unexpected node", "%T", p)
+			}
+		})
+	}
+
+	tableCases := []struct {
+		query     string
+		collation string
+		explicit  []string
+	}{
+		{"CREATE TABLE t (a INT, b VARCHAR(10))", "", nil},
+		{"CREATE TABLE t (a INT, b VARCHAR(10) COLLATE utf8mb4_bin) ENGINE=InnoDB DEFAULT CHARSET=latin1", "latin1_swedish_ci", []string{"b"}},
+		{"CREATE TABLE t (a VARCHAR(10) CHARACTER SET latin1, b TEXT) COLLATE='utf8mb4_unicode_ci'", "utf8mb4_unicode_ci", []string{"a"}},
+	}
+	for _, tt := range tableCases {
+		t.Run(tt.query, func(t *testing.T) {
+			p, err := Parse(sql.NewEmptyContext(), tt.query)
+			require.NoError(t, err)
+			spec := p.(*plan.CreateTable).TableSpec()
+			if tt.collation == "" {
+				require.Nil(t, spec.Collation)
+			} else {
+				require.NotNil(t, spec.Collation)
+				require.Equal(t, tt.collation, spec.Collation.Name)
+			}
+			require.Equal(t, tt.explicit, spec.ExplicitCollationColumns)
+		})
+	}
+
+	_, err := Parse(sql.NewEmptyContext(), "ALTER DATABASE test READ ONLY = 1")
+	require.Error(t, err)
+}
+
+func TestRegisterStatement(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var statements []string
+	RegisterStatement("backup  DATABASE", func(ctx *sql.Context, statement string) (sql.Node, error) {
+		statements = append(statements, statement)
+		return plan.Nothing, nil
+	})
+	defer UnregisterStatement("BACKUP DATABASE")
+
+	node, err := Parse(ctx, "  Backup\tdatabase mydb TO 'x;y';")
+	require.NoError(err)
+	require.Equal(plan.Nothing, node)
+
+	node, parsed, remainder, err := ParseOne(ctx, "BACKUP DATABASE mydb; SELECT 1")
+	require.NoError(err)
+	require.Equal(plan.Nothing, node)
+	require.Equal("BACKUP DATABASE mydb", parsed)
+	require.Equal(" SELECT 1", remainder)
+
+	require.Equal([]string{"Backup\tdatabase mydb TO 'x;y'", "BACKUP DATABASE mydb"}, statements)
+
+	// Only whole keywords match
+	_, err = Parse(ctx, "BACKUP DATABASES")
+	require.Error(err)
+
+	// Custom statements take precedence over built-in ones
+	RegisterStatement("select 42", func(ctx *sql.Context, statement string) (sql.Node, error) {
+		return plan.Nothing, nil
+	})
+	node, err = Parse(ctx, "SELECT 42")
+	require.NoError(err)
+	require.Equal(plan.Nothing, node)
+
+	UnregisterStatement("SELECT 42")
+	node, err = Parse(ctx, "SELECT 42")
+	require.NoError(err)
+	require.NotEqual(plan.Nothing, node)
+}
+
 func TestParseErrors(t *testing.T) {
 	for query, expectedError := range fixturesErrors {
 		t.Run(query, func(t *testing.T) {
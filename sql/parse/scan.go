@@ -0,0 +1,211 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import "strings"
+
+// The functions in this file scan the parts of the statements the SQL parser doesn't support, such as HANDLER.
+
+// splitStatement returns the first statement of the query given and the rest of the query after it.
+func splitStatement(s string) (string, string) {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ';':
+			return strings.TrimSpace(s[:i]), s[i+1:]
+		}
+	}
+	return s, ""
+}
+
+// stripComments returns the statement given without its comments, except for the comments MySQL executes, such as
+// /*!40101 LOCAL */, which are replaced by their contents. Every comment is replaced by at least a space.
+func stripComments(s string) string {
+	var sb strings.Builder
+	var quote byte
+	executable := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' && i+1 < len(s) {
+				sb.WriteByte(c)
+				i++
+				c = s[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case executable && strings.HasPrefix(s[i:], "*/"):
+			executable = false
+			sb.WriteByte(' ')
+			i++
+			continue
+		case strings.HasPrefix(s[i:], "/*!"):
+			// The version the comment is executed from is ignored
+			executable = true
+			i += 3
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+				i++
+			}
+			i--
+			sb.WriteByte(' ')
+			continue
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				i = len(s)
+			} else {
+				i += end + 3
+			}
+			sb.WriteByte(' ')
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// scanTableName scans a table name, optionally qualified with its database, after any spaces from the position given.
+// It returns the database and table names and the position after them, or false if there is no table name there.
+func scanTableName(s string, pos int) (string, string, int, bool) {
+	name, pos, ok := scanIdent(s, pos)
+	if !ok {
+		return "", "", 0, false
+	}
+	if pos < len(s) && s[pos] == '.' {
+		qualifier := name
+		name, pos, ok = scanIdent(s, pos+1)
+		if !ok {
+			return "", "", 0, false
+		}
+		return qualifier, name, pos, true
+	}
+	return "", name, pos, true
+}
+
+// scanIdent scans an identifier, quoted with backticks or not, after any spaces from the position given. It returns
+// the identifier and the position after it, or false if there is no identifier there.
+func scanIdent(s string, pos int) (string, int, bool) {
+	pos = skipSpaces(s, pos)
+	if pos >= len(s) {
+		return "", 0, false
+	}
+
+	if s[pos] == '`' {
+		var sb strings.Builder
+		for i := pos + 1; i < len(s); i++ {
+			if s[i] != '`' {
+				sb.WriteByte(s[i])
+			} else if i+1 < len(s) && s[i+1] == '`' {
+				sb.WriteByte('`')
+				i++
+			} else {
+				return sb.String(), i + 1, true
+			}
+		}
+		return "", 0, false
+	}
+
+	end := pos
+	for end < len(s) && isIdentChar(s[end]) {
+		end++
+	}
+	if end == pos {
+		return "", 0, false
+	}
+	return s[pos:end], end, true
+}
+
+// scanKeywords scans the keywords given, case-insensitive, after any spaces from the position given. It returns the
+// position after them, or false if they aren't there.
+func scanKeywords(s string, pos int, keywords ...string) (int, bool) {
+	for _, keyword := range keywords {
+		word, next, ok := scanIdent(s, pos)
+		if !ok || !strings.EqualFold(word, keyword) {
+			return 0, false
+		}
+		pos = next
+	}
+	return pos, true
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t' || s[pos] == '\n' || s[pos] == '\r') {
+		pos++
+	}
+	return pos
+}
+
+// scanString scans a string literal, quoted with single or double quotes, after any spaces from the position given. It
+// returns the string and the position after it, or false if there is no string literal there.
+func scanString(s string, pos int) (string, int, bool) {
+	pos = skipSpaces(s, pos)
+	if pos >= len(s) || s[pos] != '\'' && s[pos] != '"' {
+		return "", 0, false
+	}
+
+	quote := s[pos]
+	var sb strings.Builder
+	for i := pos + 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			sb.WriteByte(unescapeStringChar(s[i]))
+		case s[i] != quote:
+			sb.WriteByte(s[i])
+		case i+1 < len(s) && s[i+1] == quote:
+			sb.WriteByte(quote)
+			i++
+		default:
+			return sb.String(), i + 1, true
+		}
+	}
+	return "", 0, false
+}
+
+// unescapeStringChar returns the character the escape sequence of a backslash and the character given stands for.
+func unescapeStringChar(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 26
+	default:
+		return c
+	}
+}
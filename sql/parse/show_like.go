@@ -0,0 +1,111 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// The SQL parser only accepts a string literal as the pattern of SHOW ... LIKE, not a placeholder. Before a SHOW
+// statement is parsed, the placeholder of its LIKE clause is replaced with likePlaceholderLiteral, and the statement is
+// converted with the bind variable the placeholder stands for, which takes the place of the pattern parsed.
+const likePlaceholderLiteral = "'?'"
+
+// likePlaceholder is the placeholder of the LIKE clause of a SHOW statement, replaced before the statement is parsed.
+type likePlaceholder struct {
+	// name is the name of the bind variable of the placeholder.
+	name string
+	// text is the placeholder as written in the statement.
+	text string
+	// start is the position of the placeholder in the statement.
+	start int
+}
+
+// rewriteShowLikePlaceholders returns the statement given with the placeholder of its LIKE clause replaced with a
+// string literal, and that placeholder, if it's a SHOW statement. Placeholders written as ? are named as the parser
+// names them, v1 for the first one of the statement, v2 for the second and so on.
+func rewriteShowLikePlaceholders(s string) (string, *likePlaceholder) {
+	if _, ok := scanKeywords(s, 0, "show"); !ok {
+		return s, nil
+	}
+
+	var quote byte
+	placeholders := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '?':
+			placeholders++
+		case isIdentChar(c) && (i == 0 || !isIdentChar(s[i-1])):
+			// Placeholders of a WHERE clause are parsed as such already
+			if _, ok := scanKeywords(s, i, "where"); ok {
+				return s, nil
+			}
+			next, ok := scanKeywords(s, i, "like")
+			if !ok {
+				continue
+			}
+			start := skipSpaces(s, next)
+			if start >= len(s) {
+				continue
+			}
+
+			var name string
+			end := start + 1
+			switch s[start] {
+			case '?':
+				placeholders++
+				name = "v" + strconv.Itoa(placeholders)
+			case ':':
+				for end < len(s) && isIdentChar(s[end]) {
+					end++
+				}
+				name = s[start+1 : end]
+			}
+			if name == "" {
+				continue
+			}
+
+			placeholder := &likePlaceholder{name: name, text: s[start:end], start: start}
+			return s[:start] + likePlaceholderLiteral + s[end:], placeholder
+		}
+	}
+	return s, nil
+}
+
+// restore returns the statement given, rewritten by rewriteShowLikePlaceholders, with the placeholder back in place.
+func (p *likePlaceholder) restore(s string) string {
+	return s[:p.start] + p.text + s[p.start+len(likePlaceholderLiteral):]
+}
+
+// likePattern returns the pattern of a SHOW ... LIKE clause as an expression: the bind variable of the placeholder
+// given if there's one, a string literal of the pattern otherwise.
+func likePattern(like string, placeholder *likePlaceholder) sql.Expression {
+	if placeholder != nil {
+		return expression.NewBindVar(placeholder.name)
+	}
+	return expression.NewLiteral(like, sql.LongText)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var consistentSnapshotRegex = regexp.MustCompile(`(?is)^start\s+transaction\s.*\bconsistent\s+snapshot\b`)
+
+// isConsistentSnapshotStatement returns whether the statement given is a START TRANSACTION WITH CONSISTENT SNAPSHOT
+// statement, which the SQL parser doesn't support. mysqldump writes WITH CONSISTENT SNAPSHOT in a comment, so comments
+// are ignored.
+func isConsistentSnapshotStatement(s string) bool {
+	return consistentSnapshotRegex.MatchString(strings.TrimSpace(stripComments(s)))
+}
+
+// convertConsistentSnapshot converts a START TRANSACTION statement with a consistent snapshot:
+//
+//	START TRANSACTION transaction_characteristic [, transaction_characteristic] ...
+//
+//	transaction_characteristic: {WITH CONSISTENT SNAPSHOT | READ WRITE | READ ONLY}
+//
+// Transactions see a snapshot of their databases from the moment they start, so a consistent snapshot is the same as
+// any other transaction.
+func convertConsistentSnapshot(ctx *sql.Context, s string) (sql.Node, error) {
+	s = stripComments(s)
+	pos, _ := scanKeywords(s, 0, "start", "transaction")
+
+	transChar := sql.ReadWrite
+	var readOnly, readWrite bool
+	for {
+		if next, ok := scanKeywords(s, pos, "with", "consistent", "snapshot"); ok {
+			pos = next
+		} else if next, ok := scanKeywords(s, pos, "read", "only"); ok && !readWrite {
+			transChar, readOnly, pos = sql.ReadOnly, true, next
+		} else if next, ok := scanKeywords(s, pos, "read", "write"); ok && !readOnly {
+			readWrite, pos = true, next
+		} else {
+			return nil, consistentSnapshotSyntaxError(s)
+		}
+
+		pos = skipSpaces(s, pos)
+		if pos >= len(s) || s[pos] != ',' {
+			break
+		}
+		pos++
+	}
+	if pos < len(s) {
+		return nil, consistentSnapshotSyntaxError(s)
+	}
+
+	return plan.NewStartTransaction("", transChar), nil
+}
+
+func consistentSnapshotSyntaxError(s string) error {
+	return sql.ErrSyntaxError.New("invalid START TRANSACTION statement: " + strings.TrimSpace(s))
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// StatementParser converts a custom statement, one the SQL parser doesn't support, into the node that runs it. The
+// statement given has no surrounding spaces nor trailing semicolon.
+type StatementParser func(ctx *sql.Context, statement string) (sql.Node, error)
+
+type customStatement struct {
+	keywords string
+	regex    *regexp.Regexp
+	parser   StatementParser
+}
+
+var (
+	customStatementsMu sync.RWMutex
+	customStatements   []customStatement
+)
+
+// RegisterStatement registers the parser given for the custom statements starting with the keywords given, such as
+// "BACKUP DATABASE", which are matched ignoring case and with any spaces between them. Custom statements are parsed
+// before the built-in ones, so they can also replace those. Registering the same keywords again replaces their parser.
+func RegisterStatement(keywords string, parser StatementParser) {
+	keywords = normalizeKeywords(keywords)
+	words := strings.Fields(keywords)
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+	}
+	regex := regexp.MustCompile(`(?is)^` + strings.Join(words, `\s+`) + `(\s|$)`)
+
+	customStatementsMu.Lock()
+	defer customStatementsMu.Unlock()
+	for i, cs := range customStatements {
+		if cs.keywords == keywords {
+			customStatements[i].parser = parser
+			return
+		}
+	}
+	customStatements = append(customStatements, customStatement{keywords: keywords, regex: regex, parser: parser})
+}
+
+// UnregisterStatement removes the parser of the custom statements starting with the keywords given.
+func UnregisterStatement(keywords string) {
+	keywords = normalizeKeywords(keywords)
+
+	customStatementsMu.Lock()
+	defer customStatementsMu.Unlock()
+	for i, cs := range customStatements {
+		if cs.keywords == keywords {
+			customStatements = append(customStatements[:i], customStatements[i+1:]...)
+			return
+		}
+	}
+}
+
+// customStatementParser returns the parser registered for the statement given, if any.
+func customStatementParser(s string) (StatementParser, bool) {
+	customStatementsMu.RLock()
+	defer customStatementsMu.RUnlock()
+	for _, cs := range customStatements {
+		if cs.regex.MatchString(s) {
+			return cs.parser, true
+		}
+	}
+	return nil, false
+}
+
+func normalizeKeywords(keywords string) string {
+	return strings.ToLower(strings.Join(strings.Fields(keywords), " "))
+}
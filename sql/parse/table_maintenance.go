@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var tableMaintenanceStatementRegex = regexp.MustCompile(`(?is)^(optimize|repair)\s`)
+
+// isTableMaintenanceStatement returns whether the statement given is an OPTIMIZE TABLE or REPAIR TABLE statement, which
+// the SQL parser doesn't return the tables of.
+func isTableMaintenanceStatement(s string) bool {
+	return tableMaintenanceStatementRegex.MatchString(s)
+}
+
+// convertTableMaintenance converts an OPTIMIZE TABLE or REPAIR TABLE statement:
+//
+//	OPTIMIZE [NO_WRITE_TO_BINLOG | LOCAL] {TABLE | TABLES} tbl_name [, tbl_name] ...
+//	REPAIR [NO_WRITE_TO_BINLOG | LOCAL] {TABLE | TABLES} tbl_name [, tbl_name] ... [QUICK] [EXTENDED] [USE_FRM]
+//
+// The binary log and the options of REPAIR TABLE don't apply to this engine, so they are ignored.
+func convertTableMaintenance(ctx *sql.Context, s string) (sql.Node, error) {
+	verb, pos, _ := scanIdent(s, 0)
+	op := plan.TableMaintenanceOp(strings.ToLower(verb))
+
+	word, pos, ok := scanIdent(s, pos)
+	if ok && (strings.EqualFold(word, "no_write_to_binlog") || strings.EqualFold(word, "local")) {
+		word, pos, ok = scanIdent(s, pos)
+	}
+	if !ok || !strings.EqualFold(word, "table") && !strings.EqualFold(word, "tables") {
+		return nil, tableMaintenanceSyntaxError(s)
+	}
+
+	var tables []sql.Node
+	for {
+		var db, name string
+		db, name, pos, ok = scanTableName(s, pos)
+		if !ok {
+			return nil, tableMaintenanceSyntaxError(s)
+		}
+		tables = append(tables, plan.NewUnresolvedTable(name, db))
+
+		pos = skipSpaces(s, pos)
+		if pos >= len(s) || s[pos] != ',' {
+			break
+		}
+		pos++
+	}
+
+	for pos = skipSpaces(s, pos); pos < len(s); pos = skipSpaces(s, pos) {
+		word, pos, ok = scanIdent(s, pos)
+		if !ok || op != plan.TableMaintenanceRepair || !isRepairTableOption(word) {
+			return nil, tableMaintenanceSyntaxError(s)
+		}
+	}
+
+	return plan.NewTableMaintenance(op, tables), nil
+}
+
+func isRepairTableOption(word string) bool {
+	switch strings.ToLower(word) {
+	case "quick", "extended", "use_frm":
+		return true
+	default:
+		return false
+	}
+}
+
+func tableMaintenanceSyntaxError(s string) error {
+	return sql.ErrSyntaxError.New("invalid table maintenance statement: " + s)
+}
@@ -87,6 +87,9 @@ type AddColumn struct {
 	UnaryNode
 	column *sql.Column
 	order  *sql.ColumnOrder
+	// ExplicitCollation is whether the column has a character set or collation of its own, rather than getting the
+	// default collation of its table.
+	ExplicitCollation bool
 }
 
 var _ sql.Node = (*AddColumn)(nil)
@@ -144,7 +147,12 @@ func (a *AddColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return nil, err
 	}
 
-	return sql.RowsToRowIter(), alterable.AddColumn(ctx, a.column, a.order)
+	column, err := inheritTableCollation(tbl, a.column, a.ExplicitCollation)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(), alterable.AddColumn(ctx, column, a.order)
 }
 
 func (a *AddColumn) Expressions() []sql.Expression {
@@ -378,6 +386,9 @@ type ModifyColumn struct {
 	columnName string
 	column     *sql.Column
 	order      *sql.ColumnOrder
+	// ExplicitCollation is whether the column has a character set or collation of its own, rather than getting the
+	// default collation of its table.
+	ExplicitCollation bool
 }
 
 var _ sql.Node = (*ModifyColumn)(nil)
@@ -450,7 +461,22 @@ func (m *ModifyColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 		return nil, err
 	}
 
-	return sql.RowsToRowIter(), alterable.ModifyColumn(ctx, m.columnName, m.column, m.order)
+	column, err := inheritTableCollation(tbl, m.column, m.ExplicitCollation)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(), alterable.ModifyColumn(ctx, m.columnName, column, m.order)
+}
+
+// inheritTableCollation returns the column given with the default collation of the table given, unless the column
+// has an explicit collation of its own or the table isn't a sql.CollatedTable.
+func inheritTableCollation(tbl sql.Table, col *sql.Column, explicit bool) (*sql.Column, error) {
+	collatedTable, ok := tbl.(sql.CollatedTable)
+	if explicit || !ok || collatedTable.Collation().Equals(sql.Collation_Default) {
+		return col, nil
+	}
+	return columnWithCollation(col, collatedTable.Collation())
 }
 
 func (m *ModifyColumn) Children() []sql.Node {
@@ -0,0 +1,153 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// BackupOp is the operation run by a Backup node.
+type BackupOp string
+
+const (
+	// BackupOpBackup is the operation of BACKUP DATABASE.
+	BackupOpBackup BackupOp = "backup"
+	// BackupOpRestore is the operation of RESTORE DATABASE.
+	BackupOpRestore BackupOp = "restore"
+)
+
+// Backup runs BACKUP DATABASE db TO 'path' or RESTORE DATABASE db FROM 'path'. The work is delegated to databases
+// implementing sql.BackupableDatabase. As with LOAD DATA, the path is relative to the secure_file_priv directory.
+type Backup struct {
+	Op   BackupOp
+	db   sql.Database
+	Path string
+}
+
+var _ sql.Node = (*Backup)(nil)
+var _ sql.Databaser = (*Backup)(nil)
+
+// NewBackup creates a new Backup node running the operation given on the database given, with the file given.
+func NewBackup(op BackupOp, db sql.Database, path string) *Backup {
+	return &Backup{Op: op, db: db, Path: path}
+}
+
+// Database implements the sql.Databaser interface.
+func (b *Backup) Database() sql.Database {
+	return b.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (b *Backup) WithDatabase(db sql.Database) (sql.Node, error) {
+	nb := *b
+	nb.db = db
+	return &nb, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (b *Backup) Resolved() bool {
+	_, ok := b.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// Schema implements the sql.Node interface.
+func (b *Backup) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// Children implements the sql.Node interface.
+func (b *Backup) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (b *Backup) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(b, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (b *Backup) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	db, ok := b.db.(sql.BackupableDatabase)
+	if !ok {
+		return nil, sql.ErrBackupNotSupported.New(b.db.Name())
+	}
+
+	_, dir, ok := sql.SystemVariables.GetGlobal("secure_file_priv")
+	if !ok {
+		return nil, fmt.Errorf("error: secure_file_priv variable was not found")
+	}
+	if dir == nil {
+		dir = ""
+	}
+	path := filepath.Join(dir.(string), b.Path)
+
+	var err error
+	switch b.Op {
+	case BackupOpBackup:
+		err = backupDatabase(ctx, db, path)
+	case BackupOpRestore:
+		err = restoreDatabase(ctx, db, path)
+	default:
+		return nil, sql.ErrUnsupportedFeature.New(string(b.Op))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// backupDatabase writes the backup of the database given to the path given. The backup is written to a temporary
+// file first, so that a backup that fails doesn't replace an older one.
+func backupDatabase(ctx *sql.Context, db sql.BackupableDatabase, path string) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return sql.ErrBackupCannotOpen.New(err.Error())
+	}
+	defer os.Remove(file.Name())
+
+	if err := db.Backup(ctx, file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func restoreDatabase(ctx *sql.Context, db sql.BackupableDatabase, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return sql.ErrBackupCannotOpen.New(err.Error())
+	}
+	defer file.Close()
+
+	return db.Restore(ctx, file)
+}
+
+func (b *Backup) String() string {
+	direction := "TO"
+	if b.Op == BackupOpRestore {
+		direction = "FROM"
+	}
+	return fmt.Sprintf("%s DATABASE %s %s '%s'", strings.ToUpper(string(b.Op)), b.db.Name(), direction, b.Path)
+}
@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -59,3 +61,101 @@ func ApplyBindings(ctx *sql.Context, n sql.Node, bindings map[string]sql.Express
 		return e, nil
 	})
 }
+
+// InferBindVarTypes returns the types of the |BindVar| expressions in the given sql.Node, by name, as inferred from
+// where they're used: a variable compared to another expression or assigned to a column takes the type of that
+// expression or column, and a variable used as a row count in LIMIT or OFFSET is an integer. Variables whose type
+// can't be inferred aren't in the result. When a variable is used in several places, the first inferred type wins.
+func InferBindVarTypes(n sql.Node) map[string]sql.Type {
+	types := make(map[string]sql.Type)
+	inferBindVarTypes(n, types)
+	return types
+}
+
+func inferBindVarTypes(n sql.Node, types map[string]sql.Type) {
+	infer := func(e sql.Expression, t sql.Type) {
+		bv, ok := e.(*expression.BindVar)
+		if !ok {
+			return
+		}
+		if _, ok := types[bv.Name]; !ok {
+			types[bv.Name] = t
+		}
+	}
+	// inferFrom infers the type of e from the expression it's used with, unless that's a variable as well or a tuple
+	inferFrom := func(e, other sql.Expression) {
+		if _, ok := other.(*expression.BindVar); ok {
+			return
+		}
+		if _, ok := other.Type().(sql.TupleType); ok {
+			return
+		}
+		infer(e, other.Type())
+	}
+
+	Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *InsertInto:
+			inferBindVarTypes(n.Source, types)
+			// The values are in the order of the column names, even once the analyzer projects them onto the
+			// columns of the table
+			source := n.Source
+			if project, ok := source.(*Project); ok {
+				source = project.Child
+			}
+			if values, ok := source.(*Values); ok {
+				schema := n.Destination.Schema()
+				for _, tuple := range values.ExpressionTuples {
+					for i, e := range tuple {
+						if i >= len(n.ColumnNames) {
+							break
+						}
+						for _, col := range schema {
+							if strings.EqualFold(col.Name, n.ColumnNames[i]) {
+								infer(e, col.Type)
+							}
+						}
+					}
+				}
+			}
+		case *Limit:
+			infer(n.Limit, sql.Int64)
+		case *Offset:
+			infer(n.Offset, sql.Int64)
+		}
+
+		expressioner, ok := n.(sql.Expressioner)
+		if !ok {
+			return true
+		}
+		for _, e := range expressioner.Expressions() {
+			sql.Inspect(e, func(e sql.Expression) bool {
+				switch e := e.(type) {
+				case *expression.InTuple:
+					if tuple, ok := e.Right().(expression.Tuple); ok {
+						for _, el := range tuple {
+							inferFrom(el, e.Left())
+						}
+					}
+				case expression.Comparer:
+					inferFrom(e.Left(), e.Right())
+					inferFrom(e.Right(), e.Left())
+				case *expression.SetField:
+					inferFrom(e.Right, e.Left)
+				case *expression.Like:
+					infer(e.Left, sql.LongText)
+					infer(e.Right, sql.LongText)
+				case *expression.Between:
+					inferFrom(e.Val, e.Lower)
+					inferFrom(e.Val, e.Upper)
+					inferFrom(e.Lower, e.Val)
+					inferFrom(e.Upper, e.Val)
+				case *Subquery:
+					inferBindVarTypes(e.Query, types)
+				}
+				return true
+			})
+		}
+		return true
+	})
+}
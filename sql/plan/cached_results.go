@@ -92,6 +92,25 @@ func (n *CachedResults) getCachedResults() []sql.Row {
 	return n.cache.Get()
 }
 
+func (n *CachedResults) hasCachedResults() bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.cache != nil
+}
+
+// setCachedResults caches the rows of the cache given, read from the child by another iterator than the node's own,
+// unless the node has cached its results already, in which case the cache given is disposed.
+func (n *CachedResults) setCachedResults(cache sql.RowsCache, dispose sql.DisposeFunc) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.cache != nil {
+		dispose()
+		return
+	}
+	n.cache = cache
+	n.dispose = dispose
+}
+
 type cachedResultsIter struct {
 	parent  *CachedResults
 	iter    sql.RowIter
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -149,6 +150,12 @@ func (c *CreateIndex) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		}
 	}
 
+	release, err := ctx.Workers.Acquire(ctx, sql.WorkerIndexBuild)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	index, err := driver.Create(
 		c.CurrentDatabase,
 		table.Name(),
@@ -181,26 +188,28 @@ func (c *CreateIndex) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		"driver": index.Driver(),
 	})
 
-	createIndex := func() {
-		c.createIndex(ctx, log, driver, index, iter, created, ready)
-	}
-
 	log.Info("starting to save the index")
 
-	createIndex()
+	err = c.createIndex(ctx, log, driver, index, table.Table, iter, created, ready)
+	if err != nil {
+		return nil, err
+	}
 
 	return sql.RowsToRowIter(), nil
 }
 
+// createIndex saves the index with the driver given. If saving fails, e.g. because the query was cancelled or timed
+// out, the index is removed from the registry and the driver is asked to delete whatever it already saved.
 func (c *CreateIndex) createIndex(
 	ctx *sql.Context,
 	log *logrus.Entry,
 	driver sql.IndexDriver,
 	index sql.DriverIndex,
+	table sql.Table,
 	iter sql.PartitionIndexKeyValueIter,
 	done chan<- struct{},
 	ready <-chan struct{},
-) {
+) error {
 	span, ctx := ctx.Span("plan.createIndex",
 		opentracing.Tags{
 			"index":  index.ID(),
@@ -225,21 +234,38 @@ func (c *CreateIndex) createIndex(
 			},
 		})
 
-		ctx.Error(0, "unable to save the index: %s", err)
 		logrus.WithField("err", err).Error("unable to save the index")
 
-		deleted, err := ctx.GetIndexRegistry().DeleteIndex(index.Database(), index.ID(), true)
-		if err != nil {
-			ctx.Error(0, "unable to delete index: %s", err)
-			logrus.WithField("err", err).Error("unable to delete the index")
+		deleted, deleteErr := ctx.GetIndexRegistry().DeleteIndex(index.Database(), index.ID(), true)
+		if deleteErr != nil {
+			ctx.Error(0, "unable to delete index: %s", deleteErr)
+			logrus.WithField("err", deleteErr).Error("unable to delete the index")
 		} else {
 			<-deleted
 		}
-	} else {
-		<-ready
-		span.Finish()
-		log.Info("index successfully created")
+
+		// The context may be the one that was cancelled, so the data is deleted with a context of its own
+		if deleteErr = deleteIndexData(ctx.WithContext(context.Background()), driver, index, table); deleteErr != nil {
+			ctx.Error(0, "unable to delete index data: %s", deleteErr)
+			logrus.WithField("err", deleteErr).Error("unable to delete the index data")
+		}
+
+		return err
+	}
+
+	<-ready
+	span.Finish()
+	log.Info("index successfully created")
+	return nil
+}
+
+// deleteIndexData deletes the data of the index given saved by the driver for all partitions of the table given.
+func deleteIndexData(ctx *sql.Context, driver sql.IndexDriver, index sql.DriverIndex, table sql.Table) error {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
 	}
+	return driver.Delete(index, partitions)
 }
 
 // Schema implements the Node interface.
@@ -412,6 +438,10 @@ func newLoggingPartitionKeyValueIter(
 }
 
 func (i *loggingPartitionKeyValueIter) Next(ctx *sql.Context) (sql.Partition, sql.IndexKeyValueIter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	p, iter, err := i.iter.Next(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -446,6 +476,11 @@ func newLoggingKeyValueIter(
 }
 
 func (i *loggingKeyValueIter) Next(ctx *sql.Context) ([]interface{}, []byte, error) {
+	// Drivers stop saving the index when the query is cancelled or times out as soon as they get this error.
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	if i.span == nil {
 		i.span, _ = ctx.Span("plan.createIndex.iterator",
 			opentracing.Tags{
@@ -362,6 +362,79 @@ func TestCreateIndexWithIter(t *testing.T) {
 	}, vals)
 }
 
+func TestCreateIndexCancelled(t *testing.T) {
+	require := require.New(t)
+
+	memTable := memory.NewPartitionedTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64},
+	}), 2)
+	require.NoError(memTable.Insert(sql.NewEmptyContext(), sql.NewRow(int64(1))))
+	table := &cancellableTable{memTable}
+
+	idxReg := sql.NewIndexRegistry()
+	driver := &iteratingDriver{mockDriver: new(mockDriver)}
+	idxReg.RegisterIndexDriver(driver)
+	db := memory.NewDatabase("foo")
+	db.AddTable("foo", table)
+
+	exprs := []sql.Expression{expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true)}
+	ci := NewCreateIndex("idx", NewResolvedTable(table, nil, nil), exprs, "mock", make(map[string]string))
+	ci.Catalog = test.NewCatalog(sql.NewDatabaseProvider(db))
+	ci.CurrentDatabase = "foo"
+
+	sess := sql.NewBaseSession()
+	sess.SetIndexRegistry(idxReg)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx := sql.NewContext(cancelled, sql.WithSession(sess))
+
+	_, err := ci.RowIter(ctx, nil)
+	require.Equal(context.Canceled, err)
+	require.Nil(idxReg.Index("foo", "idx"))
+	require.Equal([]string{"idx"}, driver.deleted)
+}
+
+// cancellableTable is a table whose partitions can't be listed once the context is cancelled.
+type cancellableTable struct {
+	*memory.Table
+}
+
+func (t *cancellableTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return t.Table.Partitions(ctx)
+}
+
+// iteratingDriver is a mockDriver that reads all the values of the indexes it saves.
+type iteratingDriver struct {
+	*mockDriver
+}
+
+func (d *iteratingDriver) Save(ctx *sql.Context, index sql.DriverIndex, iter sql.PartitionIndexKeyValueIter) error {
+	for {
+		_, kviter, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		for {
+			_, _, err := kviter.Next(ctx)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+		if err := kviter.Close(ctx); err != nil {
+			return err
+		}
+	}
+	return d.mockDriver.Save(ctx, index, iter)
+}
+
 type mockIndex struct {
 	db      string
 	table   string
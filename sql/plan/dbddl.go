@@ -28,6 +28,8 @@ type CreateDB struct {
 	Catalog     sql.Catalog
 	dbName      string
 	IfNotExists bool
+	// Collation is the default collation of the database, which is set if the database is a sql.CollatedDatabase.
+	Collation sql.Collation
 }
 
 func (c CreateDB) Resolved() bool {
@@ -73,6 +75,16 @@ func (c CreateDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
+	db, err := c.Catalog.Database(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+	if cdb, ok := db.(sql.CollatedDatabase); ok {
+		if err := cdb.SetCollation(ctx, c.Collation); err != nil {
+			return nil, err
+		}
+	}
+
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -80,10 +92,11 @@ func (c CreateDB) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return NillaryWithChildren(c, children...)
 }
 
-func NewCreateDatabase(dbName string, ifNotExists bool) *CreateDB {
+func NewCreateDatabase(dbName string, ifNotExists bool, collation sql.Collation) *CreateDB {
 	return &CreateDB{
 		dbName:      dbName,
 		IfNotExists: ifNotExists,
+		Collation:   collation,
 	}
 }
 
@@ -157,3 +170,69 @@ func NewDropDatabase(dbName string, ifExists bool) *DropDB {
 		IfExists: ifExists,
 	}
 }
+
+// AlterDB changes the default collation of a database, which must be a sql.CollatedDatabase. The tables of the
+// database keep their collation, only the tables created after it have the new one.
+type AlterDB struct {
+	db        sql.Database
+	Collation sql.Collation
+}
+
+var _ sql.Databaser = (*AlterDB)(nil)
+
+// NewAlterDatabase returns a new AlterDB node changing the default collation of the database given.
+func NewAlterDatabase(db sql.Database, collation sql.Collation) *AlterDB {
+	return &AlterDB{
+		db:        db,
+		Collation: collation,
+	}
+}
+
+// Database implements the sql.Databaser interface.
+func (a *AlterDB) Database() sql.Database {
+	return a.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (a *AlterDB) WithDatabase(db sql.Database) (sql.Node, error) {
+	na := *a
+	na.db = db
+	return &na, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (a *AlterDB) Resolved() bool {
+	_, ok := a.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+func (a *AlterDB) String() string {
+	return fmt.Sprintf("alter database %s collate %s", a.db.Name(), a.Collation)
+}
+
+// Schema implements the sql.Node interface.
+func (a *AlterDB) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// Children implements the sql.Node interface.
+func (a *AlterDB) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (a *AlterDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	cdb, ok := a.db.(sql.CollatedDatabase)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("changing the collation of database %s", a.db.Name()))
+	}
+	if err := cdb.SetCollation(ctx, a.Collation); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{sql.OkResult{RowsAffected: 1}}), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AlterDB) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(a, children...)
+}
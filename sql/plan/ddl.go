@@ -96,6 +96,12 @@ type TableSpec struct {
 	FkDefs  []*sql.ForeignKeyConstraint
 	ChDefs  []*sql.CheckConstraint
 	IdxDefs []*IndexDefinition
+	// Collation is the default collation given in the options of the table, or nil if the table inherits the default
+	// collation of its database.
+	Collation *sql.Collation
+	// ExplicitCollationColumns are the names of the columns with a character set or collation of their own. The other
+	// columns get the default collation of the table.
+	ExplicitCollationColumns []string
 }
 
 func (c *TableSpec) WithSchema(schema sql.PrimaryKeySchema) *TableSpec {
@@ -134,6 +140,8 @@ type CreateTable struct {
 	like         sql.Node
 	temporary    TempTableOption
 	selectNode   sql.Node
+	collation    *sql.Collation
+	explicit     []string
 }
 
 var _ sql.Databaser = (*CreateTable)(nil)
@@ -155,6 +163,8 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 		idxDefs:      tableSpec.IdxDefs,
 		ifNotExists:  ifn,
 		temporary:    temp,
+		collation:    tableSpec.Collation,
+		explicit:     tableSpec.ExplicitCollationColumns,
 	}
 }
 
@@ -185,6 +195,8 @@ func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tab
 		selectNode:   selectNode,
 		ifNotExists:  ifn,
 		temporary:    temp,
+		collation:    tableSpec.Collation,
+		explicit:     tableSpec.ExplicitCollationColumns,
 	}
 }
 
@@ -215,7 +227,11 @@ func (c *CreateTable) Resolved() bool {
 
 // RowIter implements the Node interface.
 func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	var err error
+	schema, collation, err := c.collatedSchema(ctx)
+	if err != nil {
+		return sql.RowsToRowIter(), err
+	}
+
 	if c.temporary == IsTempTable {
 		creatable, ok := c.db.(sql.TemporaryTableCreator)
 		if !ok {
@@ -226,7 +242,7 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 			return sql.RowsToRowIter(), err
 		}
 
-		err = creatable.CreateTemporaryTable(ctx, c.name, c.CreateSchema)
+		err = creatable.CreateTemporaryTable(ctx, c.name, schema)
 	} else {
 		creatable, ok := c.db.(sql.TableCreator)
 		if !ok {
@@ -237,12 +253,13 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 			return sql.RowsToRowIter(), err
 		}
 
-		err = creatable.CreateTable(ctx, c.name, c.CreateSchema)
+		err = creatable.CreateTable(ctx, c.name, schema)
 	}
 
 	if err != nil && !(sql.ErrTableAlreadyExists.Is(err) && (c.ifNotExists == IfNotExists)) {
 		return sql.RowsToRowIter(), err
 	}
+	created := err == nil
 
 	//TODO: in the event that foreign keys or indexes aren't supported, you'll be left with a created table and no foreign keys/indexes
 	//this also means that if a foreign key or index fails, you'll only have what was declared up to the failure
@@ -254,6 +271,12 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		return sql.RowsToRowIter(), ErrTableCreatedNotFound.New()
 	}
 
+	if collatedTable, ok := tableNode.(sql.CollatedTable); ok && created {
+		if err := collatedTable.SetCollation(ctx, collation); err != nil {
+			return sql.RowsToRowIter(), err
+		}
+	}
+
 	var nonPrimaryIdxes []*IndexDefinition
 	for _, def := range c.idxDefs {
 		if def.Constraint != sql.IndexConstraint_Primary {
@@ -285,6 +308,56 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	return sql.RowsToRowIter(), nil
 }
 
+// collatedSchema returns the schema of the table to create, with the columns that have no character set or collation
+// of their own in the default collation of the table, and that collation. Unless it's given in the options of the
+// table, it's the default collation of the database.
+func (c *CreateTable) collatedSchema(ctx *sql.Context) (sql.PrimaryKeySchema, sql.Collation, error) {
+	collation := sql.DatabaseCollation(ctx, c.db)
+	if c.collation != nil {
+		collation = *c.collation
+	}
+
+	// The columns are created in the default collation until they get the one of the table
+	if collation.Equals(sql.Collation_Default) {
+		return c.CreateSchema, collation, nil
+	}
+
+	schema := make(sql.Schema, len(c.CreateSchema.Schema))
+	for i, col := range c.CreateSchema.Schema {
+		schema[i] = col
+		if c.hasExplicitCollation(col.Name) {
+			continue
+		}
+		var err error
+		schema[i], err = columnWithCollation(col, collation)
+		if err != nil {
+			return sql.PrimaryKeySchema{}, sql.Collation_Default, err
+		}
+	}
+	return sql.NewPrimaryKeySchema(schema, c.CreateSchema.PkOrdinals...), collation, nil
+}
+
+// hasExplicitCollation returns whether the column named has a character set or collation of its own.
+func (c *CreateTable) hasExplicitCollation(name string) bool {
+	for _, explicit := range c.explicit {
+		if strings.EqualFold(explicit, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// columnWithCollation returns a copy of the column given with the collation given, if it's a textual column.
+func columnWithCollation(col *sql.Column, collation sql.Collation) (*sql.Column, error) {
+	typ, err := sql.TypeWithCollation(col.Type, collation)
+	if err != nil {
+		return nil, err
+	}
+	nc := *col
+	nc.Type = typ
+	return &nc, nil
+}
+
 func (c *CreateTable) createIndexes(ctx *sql.Context, tableNode sql.Table, idxes []*IndexDefinition) error {
 	idxAlterable, ok := tableNode.(sql.IndexAlterableTable)
 	if !ok {
@@ -502,6 +575,8 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	ret = ret.WithForeignKeys(c.fkDefs)
 	ret = ret.WithIndices(c.idxDefs)
 	ret = ret.WithCheckConstraints(c.chDefs)
+	ret.Collation = c.collation
+	ret.ExplicitCollationColumns = c.explicit
 
 	return ret
 }
@@ -106,6 +106,11 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return nil, err
 	}
 
+	timestamps, err := sql.NewTimestampConverter(ctx, deletable.Schema())
+	if err != nil {
+		return nil, err
+	}
+
 	iter, err := p.Child.RowIter(ctx, row)
 	if err != nil {
 		return nil, err
@@ -113,14 +118,15 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 
 	deleter := deletable.Deleter(ctx)
 
-	return newDeleteIter(iter, deleter, deletable.Schema()), nil
+	return newDeleteIter(iter, deleter, deletable.Schema(), timestamps), nil
 }
 
 type deleteIter struct {
-	deleter   sql.RowDeleter
-	schema    sql.Schema
-	childIter sql.RowIter
-	closed    bool
+	deleter    sql.RowDeleter
+	schema     sql.Schema
+	childIter  sql.RowIter
+	timestamps *sql.TimestampConverter
+	closed     bool
 }
 
 func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -136,7 +142,7 @@ func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
 		row = row[len(row)-len(d.schema):]
 	}
 
-	return row, d.deleter.Delete(ctx, row)
+	return row, d.deleter.Delete(ctx, d.timestamps.ToUTC(row))
 }
 
 func (d *deleteIter) Close(ctx *sql.Context) error {
@@ -150,11 +156,12 @@ func (d *deleteIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-func newDeleteIter(childIter sql.RowIter, deleter sql.RowDeleter, schema sql.Schema) sql.RowIter {
+func newDeleteIter(childIter sql.RowIter, deleter sql.RowDeleter, schema sql.Schema, timestamps *sql.TimestampConverter) sql.RowIter {
 	return NewTableEditorIter(deleter, &deleteIter{
-		deleter:   deleter,
-		childIter: childIter,
-		schema:    schema,
+		deleter:    deleter,
+		childIter:  childIter,
+		schema:     schema,
+		timestamps: timestamps,
 	})
 }
 
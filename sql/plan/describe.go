@@ -86,6 +86,12 @@ func (i *describeIter) Close(*sql.Context) error {
 type DescribeQuery struct {
 	child  sql.Node
 	Format string
+	// Analyze is true for EXPLAIN ANALYZE, which executes the query to report the number of rows each node returns.
+	Analyze bool
+	// Extended is true for EXPLAIN EXTENDED, which reports the indexes the analyzer couldn't use as warnings.
+	Extended bool
+	// notes are the warnings reported by EXPLAIN EXTENDED.
+	notes []string
 }
 
 func (d *DescribeQuery) Resolved() bool {
@@ -110,7 +116,7 @@ var DescribeSchema = sql.Schema{
 
 // NewDescribeQuery creates a new DescribeQuery node.
 func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
-	return &DescribeQuery{child, format}
+	return &DescribeQuery{child: child, Format: format}
 }
 
 // Schema implements the Node interface.
@@ -121,11 +127,30 @@ func (d *DescribeQuery) Schema() sql.Schema {
 // RowIter implements the Node interface.
 func (d *DescribeQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var rows []sql.Row
+	child, err := describeRows(ctx, d.child, d.Analyze)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Analyze {
+		iter, err := child.RowIter(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := sql.RowIterToRows(ctx, iter); err != nil {
+			return nil, err
+		}
+	}
+
 	var formatString string
 	if d.Format == "debug" {
-		formatString = sql.DebugString(d.child)
+		formatString = sql.DebugString(child)
 	} else {
-		formatString = d.child.String()
+		formatString = child.String()
+	}
+
+	for _, note := range d.notes {
+		ctx.Warn(1739, "%s", note)
 	}
 
 	for _, l := range strings.Split(formatString, "\n") {
@@ -159,7 +184,27 @@ func (d *DescribeQuery) Query() sql.Node {
 	return d.child
 }
 
+// WithNotes returns a copy of this node with the notes given, which EXPLAIN EXTENDED reports as warnings.
+func (d *DescribeQuery) WithNotes(notes []string) *DescribeQuery {
+	nd := *d
+	nd.notes = notes
+	return &nd
+}
+
 // WithQuery returns a copy of this node with the query node given
 func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
-	return NewDescribeQuery(d.Format, child)
+	nd := *d
+	nd.child = child
+	return &nd
+}
+
+func getTableStatisticsTable(t sql.Table) (sql.TableStatisticsTable, bool) {
+	switch t := t.(type) {
+	case sql.TableStatisticsTable:
+		return t, true
+	case sql.TableWrapper:
+		return getTableStatisticsTable(t.Underlying())
+	default:
+		return nil, false
+	}
 }
@@ -0,0 +1,413 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Default selectivities of filter predicates whose columns have no estimates, which are the ones MySQL uses.
+const (
+	equalitySelectivity = 0.1
+	rangeSelectivity    = 1.0 / 3
+	betweenSelectivity  = 1.0 / 9
+	defaultSelectivity  = 0.5
+)
+
+// rowsNode annotates a node of a described query plan with the number of rows it's estimated to return, and for
+// EXPLAIN ANALYZE, the number of rows it actually returned.
+type rowsNode struct {
+	UnaryNode
+	// estimate is nil if the node has no estimate.
+	estimate *uint64
+	// actual is nil unless the query is analyzed.
+	actual *uint64
+}
+
+var _ sql.Node = (*rowsNode)(nil)
+
+// RowIter implements the sql.Node interface.
+func (n *rowsNode) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	iter, err := n.Child.RowIter(ctx, row)
+	if err != nil || n.actual == nil {
+		return iter, err
+	}
+	return &countingRowIter{iter: iter, count: n.actual}, nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *rowsNode) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	return &rowsNode{UnaryNode{children[0]}, n.estimate, n.actual}, nil
+}
+
+func (n *rowsNode) String() string {
+	return n.annotate(n.Child.String())
+}
+
+func (n *rowsNode) DebugString() string {
+	return n.annotate(sql.DebugString(n.Child))
+}
+
+// annotate appends the row counts of this node to the first line of the description of its child.
+func (n *rowsNode) annotate(description string) string {
+	var counts []string
+	if n.estimate != nil {
+		counts = append(counts, fmt.Sprintf("estimated rows: %d", *n.estimate))
+	}
+	if n.actual != nil {
+		counts = append(counts, fmt.Sprintf("actual rows: %d", atomic.LoadUint64(n.actual)))
+	}
+
+	lines := strings.SplitN(description, "\n", 2)
+	lines[0] = fmt.Sprintf("%s (%s)", lines[0], strings.Join(counts, ", "))
+	return strings.Join(lines, "\n")
+}
+
+// countingRowIter counts the rows returned by the iterator it wraps. Nodes can be iterated concurrently, so the count
+// is updated atomically.
+type countingRowIter struct {
+	iter  sql.RowIter
+	count *uint64
+}
+
+func (i *countingRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err == nil {
+		atomic.AddUint64(i.count, 1)
+	}
+	return row, err
+}
+
+func (i *countingRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
+
+// describeRows annotates the nodes of the query plan given with the number of rows they're estimated to return, from
+// the tables that implement sql.TableStatisticsTable, for the output of EXPLAIN. If analyze is true, every node also
+// counts the rows it returns when the plan is executed, for the output of EXPLAIN ANALYZE.
+func describeRows(ctx *sql.Context, node sql.Node, analyze bool) (sql.Node, error) {
+	e := &rowEstimator{ctx: ctx, analyze: analyze, tables: make(map[string]*sql.TableStatistics)}
+	node, _, err := e.annotate(node)
+	return node, err
+}
+
+// rowEstimator estimates the number of rows returned by the nodes of a query plan.
+type rowEstimator struct {
+	ctx     *sql.Context
+	analyze bool
+	// tables are the estimates of the tables seen so far, keyed by their lowercase names and aliases.
+	tables map[string]*sql.TableStatistics
+}
+
+// annotate returns the node given with its subtree annotated, and the estimated number of rows of the node, or nil if
+// it's unknown.
+func (e *rowEstimator) annotate(node sql.Node) (sql.Node, *uint64, error) {
+	// Decorations aren't operators of their own
+	if d, ok := node.(*DecoratedNode); ok {
+		child, rows, err := e.annotate(d.Child)
+		if err != nil {
+			return nil, nil, err
+		}
+		node, err = d.WithChildren(child)
+		return node, rows, err
+	}
+
+	children := node.Children()
+	rows := make([]*uint64, len(children))
+	if len(children) > 0 {
+		newChildren := make([]sql.Node, len(children))
+		for i, child := range children {
+			var err error
+			newChildren[i], rows[i], err = e.annotate(child)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		var err error
+		node, err = node.WithChildren(newChildren...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	estimate, err := e.estimate(node, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	if estimate == nil && !e.analyze {
+		return node, nil, nil
+	}
+
+	n := &rowsNode{UnaryNode: UnaryNode{node}, estimate: estimate}
+	if e.analyze {
+		n.actual = new(uint64)
+	}
+	return n, estimate, nil
+}
+
+// estimate returns the estimated number of rows of the node given, whose children have the estimates given, or nil if
+// it's unknown.
+func (e *rowEstimator) estimate(node sql.Node, rows []*uint64) (*uint64, error) {
+	switch n := node.(type) {
+	case *ResolvedTable:
+		return e.tableRows(n.Name(), n.Table)
+	case *IndexedTableAccess:
+		return e.tableRows(n.Name(), n.ResolvedTable.Table)
+	case *TableAlias:
+		if child, ok := unwrapRowsNode(n.Child).(sql.Nameable); ok {
+			if stats, ok := e.tables[strings.ToLower(child.Name())]; ok {
+				e.tables[strings.ToLower(n.Name())] = stats
+			}
+		}
+		return rows[0], nil
+	case *Project, *Sort, *Distinct, *OrderedDistinct, *SubqueryAlias, *Window, *Exchange, *QueryProcess:
+		return rows[0], nil
+	case *Filter:
+		return e.scale(rows[0], e.selectivity(n.Expression)), nil
+	case *Having:
+		return e.scale(rows[0], e.selectivity(n.Cond)), nil
+	case *Limit:
+		return e.limit(rows[0], n.Limit), nil
+	case *TopN:
+		return e.limit(rows[0], n.Limit), nil
+	case *Offset:
+		if rows[0] == nil {
+			return nil, nil
+		}
+		offset, ok := literalCount(n.Offset)
+		if !ok {
+			return rows[0], nil
+		}
+		if offset > *rows[0] {
+			offset = *rows[0]
+		}
+		return uint64Ptr(*rows[0] - offset), nil
+	case *GroupBy:
+		return e.groups(rows[0], n.GroupByExprs), nil
+	case *Union:
+		if rows[0] == nil || rows[1] == nil {
+			return nil, nil
+		}
+		return uint64Ptr(*rows[0] + *rows[1]), nil
+	case *CrossJoin:
+		return e.join(rows[0], rows[1], nil, JoinTypeInner), nil
+	case *IndexedJoin:
+		return e.join(rows[0], rows[1], n.Cond, n.JoinType()), nil
+	case JoinNode:
+		return e.join(rows[0], rows[1], n.JoinCond(), n.JoinType()), nil
+	default:
+		return nil, nil
+	}
+}
+
+// tableRows returns the number of rows of the table given, if it implements sql.TableStatisticsTable, and remembers
+// its estimates to estimate the selectivity of the predicates on its columns.
+func (e *rowEstimator) tableRows(name string, table sql.Table) (*uint64, error) {
+	st, ok := getTableStatisticsTable(table)
+	if !ok {
+		return nil, nil
+	}
+	stats, err := st.Statistics(e.ctx)
+	if err != nil || stats == nil {
+		return nil, err
+	}
+
+	e.tables[strings.ToLower(name)] = stats
+	return uint64Ptr(stats.RowCount), nil
+}
+
+// join estimates the number of rows of a join of the inputs with the number of rows given.
+func (e *rowEstimator) join(left, right *uint64, cond sql.Expression, joinType JoinType) *uint64 {
+	if left == nil || right == nil {
+		return nil
+	}
+
+	rows := uint64Ptr(*left * *right)
+	if cond != nil {
+		rows = e.scale(rows, e.selectivity(cond))
+	}
+
+	// Outer joins return every row of their outer side at least once
+	switch {
+	case joinType == JoinTypeLeft && *rows < *left:
+		return left
+	case joinType == JoinTypeRight && *rows < *right:
+		return right
+	default:
+		return rows
+	}
+}
+
+// limit estimates the number of rows of the input with the number of rows given, limited by the expression given.
+func (e *rowEstimator) limit(rows *uint64, limit sql.Expression) *uint64 {
+	n, ok := literalCount(limit)
+	if !ok || rows != nil && *rows < n {
+		return rows
+	}
+	return uint64Ptr(n)
+}
+
+// groups estimates the number of groups of the input with the number of rows given, grouped by the expressions given.
+// The number of groups is known when the number of distinct values of every grouping column is.
+func (e *rowEstimator) groups(rows *uint64, groupBy []sql.Expression) *uint64 {
+	if len(groupBy) == 0 {
+		return uint64Ptr(1)
+	}
+	if rows == nil {
+		return nil
+	}
+
+	groups := uint64(1)
+	for _, expr := range groupBy {
+		distinct, ok := e.distinct(expr)
+		if !ok {
+			return rows
+		}
+		groups *= distinct
+		if groups >= *rows {
+			return rows
+		}
+	}
+	return uint64Ptr(groups)
+}
+
+// scale returns the number of rows given multiplied by the selectivity given. Inputs with rows are estimated to
+// return at least one row, unless the selectivity is zero.
+func (e *rowEstimator) scale(rows *uint64, selectivity float64) *uint64 {
+	if rows == nil {
+		return nil
+	}
+
+	scaled := uint64(math.Round(float64(*rows) * selectivity))
+	if scaled == 0 && *rows > 0 && selectivity > 0 {
+		scaled = 1
+	}
+	return &scaled
+}
+
+// selectivity estimates the fraction of rows for which the predicate given is true.
+func (e *rowEstimator) selectivity(expr sql.Expression) float64 {
+	switch expr := expr.(type) {
+	case *expression.And:
+		return e.selectivity(expr.Left) * e.selectivity(expr.Right)
+	case *expression.Or:
+		left, right := e.selectivity(expr.Left), e.selectivity(expr.Right)
+		return left + right - left*right
+	case *expression.Not:
+		return 1 - e.selectivity(expr.Child)
+	case *expression.Equals:
+		return e.equalitySelectivity(expr.Left(), expr.Right())
+	case *expression.NullSafeEquals:
+		return e.equalitySelectivity(expr.Left(), expr.Right())
+	case *expression.GreaterThan, *expression.LessThan, *expression.GreaterThanOrEqual, *expression.LessThanOrEqual:
+		return rangeSelectivity
+	case *expression.Between:
+		return betweenSelectivity
+	case *expression.IsNull:
+		return equalitySelectivity
+	default:
+		return defaultSelectivity
+	}
+}
+
+// equalitySelectivity estimates the fraction of rows for which the operands given are equal. Each value of a column
+// with an estimated number of distinct values is assumed to be equally likely.
+func (e *rowEstimator) equalitySelectivity(left, right sql.Expression) float64 {
+	leftDistinct, leftOk := e.distinct(left)
+	rightDistinct, rightOk := e.distinct(right)
+	switch {
+	case leftOk && rightOk:
+		if rightDistinct > leftDistinct {
+			leftDistinct = rightDistinct
+		}
+		return 1 / float64(leftDistinct)
+	case leftOk:
+		return 1 / float64(leftDistinct)
+	case rightOk:
+		return 1 / float64(rightDistinct)
+	}
+
+	// Columns compared to each other are assumed to be keys of the larger table, so each row of the smaller table
+	// matches a single one.
+	leftStats, leftOk := e.columnStatistics(left)
+	rightStats, rightOk := e.columnStatistics(right)
+	if leftOk && rightOk {
+		rows := leftStats.RowCount
+		if rightStats.RowCount > rows {
+			rows = rightStats.RowCount
+		}
+		if rows > 0 {
+			return 1 / float64(rows)
+		}
+	}
+
+	return equalitySelectivity
+}
+
+// distinct returns the estimated number of distinct values of the expression given, which is known for columns of
+// tables estimating it.
+func (e *rowEstimator) distinct(expr sql.Expression) (uint64, bool) {
+	stats, ok := e.columnStatistics(expr)
+	if !ok {
+		return 0, false
+	}
+	n, ok := stats.Distinct(expr.(*expression.GetField).Name())
+	return n, ok && n > 0
+}
+
+// columnStatistics returns the estimates of the table of the column given, if the expression is a column of a table
+// with estimates.
+func (e *rowEstimator) columnStatistics(expr sql.Expression) (*sql.TableStatistics, bool) {
+	gf, ok := expr.(*expression.GetField)
+	if !ok {
+		return nil, false
+	}
+	stats, ok := e.tables[strings.ToLower(gf.Table())]
+	return stats, ok
+}
+
+// literalCount returns the value of the LIMIT or OFFSET expression given, if it's a literal.
+func literalCount(expr sql.Expression) (uint64, bool) {
+	lit, ok := expr.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	n, err := sql.Uint64.Convert(lit.Value())
+	if err != nil {
+		return 0, false
+	}
+	return n.(uint64), true
+}
+
+func unwrapRowsNode(node sql.Node) sql.Node {
+	if n, ok := node.(*rowsNode); ok {
+		return n.Child
+	}
+	return node
+}
+
+func uint64Ptr(n uint64) *uint64 {
+	return &n
+}
@@ -104,3 +104,36 @@ func TestDescribeQuery(t *testing.T) {
 
 	require.Equal(expected, rows)
 }
+
+func TestDescribeQueryAnalyze(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "foo", Name: "a", Type: sql.Text},
+	}))
+	for _, a := range []string{"foo", "bar", "foo"} {
+		require.NoError(table.Insert(ctx, sql.NewRow(a)))
+	}
+
+	node := NewDescribeQuery("tree", NewFilter(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(0, sql.Text, "foo", "a", false),
+			expression.NewLiteral("foo", sql.LongText),
+		),
+		NewResolvedTable(table, nil, nil),
+	))
+	node.Analyze = true
+
+	iter, err := node.RowIter(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	// Tables without statistics have no estimates
+	expected := []sql.Row{
+		{"Filter(foo.a = \"foo\") (actual rows: 2)"},
+		{" └─ Table(foo) (actual rows: 3)"},
+	}
+	require.Equal(expected, rows)
+}
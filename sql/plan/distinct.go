@@ -47,7 +47,7 @@ func (d *Distinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, it, d.Child.Schema())), nil
 }
 
 // WithChildren implements the Node interface.
@@ -80,14 +80,16 @@ func (d Distinct) DebugString() string {
 // result sets.
 type distinctIter struct {
 	childIter sql.RowIter
+	schema    sql.Schema
 	seen      sql.KeyValueCache
 	dispose   sql.DisposeFunc
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
+func newDistinctIter(ctx *sql.Context, child sql.RowIter, schema sql.Schema) *distinctIter {
 	cache, dispose := ctx.Memory.NewHistoryCache()
 	return &distinctIter{
 		childIter: child,
+		schema:    schema,
 		seen:      cache,
 		dispose:   dispose,
 	}
@@ -103,7 +105,7 @@ func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		hash, err := sql.HashOfSchema(di.schema, row)
 		if err != nil {
 			return nil, err
 		}
@@ -85,11 +85,22 @@ func (e *Exchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	})
 
 	// Spawn |iterPartitionRows| goroutines in the dependent
-	// errgroup.
+	// errgroup. The first one always runs, every other one needs
+	// a worker from the pool, so that a full pool only reduces
+	// the parallelism of the scan instead of blocking it.
 	getRowIter := e.getRowIterFunc(row)
 	seg, segCtx := egCtx.NewErrgroup()
 	for i := 0; i < e.Parallelism; i++ {
+		release := func() {}
+		if i > 0 {
+			var ok bool
+			release, ok = ctx.Workers.TryAcquire(sql.WorkerParallelScan)
+			if !ok {
+				break
+			}
+		}
 		seg.Go(func() error {
+			defer release()
 			return iterPartitionRows(segCtx, getRowIter, partitionsCh, rowsCh)
 		})
 	}
@@ -195,7 +206,17 @@ func (exchangePartition) Children() []sql.Node { return nil }
 func (exchangePartition) Resolved() bool { return true }
 
 func (p *exchangePartition) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return p.table.PartitionRows(ctx, p.Partition)
+	timestamps, err := sql.NewTimestampConverter(ctx, p.table.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := p.table.PartitionRows(ctx, p.Partition)
+	if err != nil {
+		return nil, err
+	}
+
+	return timestamps.FromUTCIter(iter), nil
 }
 
 func (p *exchangePartition) Schema() sql.Schema {
@@ -75,6 +75,26 @@ func TestExchange(t *testing.T) {
 	}
 }
 
+func TestExchangeWorkerPool(t *testing.T) {
+	require := require.New(t)
+
+	pool := sql.NewWorkerPool(2)
+	exchange := NewExchange(4, &partitionable{nil, 3, 6})
+	ctx := sql.NewContext(context.Background(), sql.WithWorkerPool(pool))
+
+	iter, err := exchange.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, 18)
+
+	stats := pool.Stats(sql.WorkerParallelScan)
+	require.Equal(0, stats.Running)
+	require.Equal(uint64(2), stats.Completed)
+	require.Equal(uint64(1), stats.Rejected)
+}
+
 func TestExchangeCancelled(t *testing.T) {
 	children := NewProject(
 		[]sql.Expression{
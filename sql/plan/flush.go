@@ -0,0 +1,98 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// FlushTables is FLUSH TABLES, optionally WITH READ LOCK. The engine doesn't cache tables, so there is nothing to
+// flush, but with a read lock, every lockable table of every database is locked for read until UNLOCK TABLES, as
+// mysqldump expects before it starts a consistent snapshot.
+type FlushTables struct {
+	Catalog  sql.Catalog
+	ReadLock bool
+}
+
+var _ sql.Node = (*FlushTables)(nil)
+
+// NewFlushTables creates a new FlushTables node.
+func NewFlushTables(readLock bool) *FlushTables {
+	return &FlushTables{ReadLock: readLock}
+}
+
+// Children implements the sql.Node interface.
+func (f *FlushTables) Children() []sql.Node { return nil }
+
+// Resolved implements the sql.Node interface.
+func (f *FlushTables) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (f *FlushTables) Schema() sql.Schema { return nil }
+
+// RowIter implements the sql.Node interface.
+func (f *FlushTables) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.FlushTables")
+	defer span.Finish()
+
+	if !f.ReadLock {
+		return sql.RowsToRowIter(), nil
+	}
+
+	for _, db := range f.Catalog.AllDatabases() {
+		names, err := db.GetTableNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			table, ok, err := db.GetTableInsensitive(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			// As with LOCK TABLES, tables that can't be locked are skipped
+			lockable, err := getLockableTable(table)
+			if err != nil {
+				continue
+			}
+
+			if err := lockable.Lock(ctx, false); err != nil {
+				return nil, err
+			}
+			f.Catalog.LockTable(ctx, db.Name(), lockable.Name())
+		}
+	}
+
+	return sql.RowsToRowIter(), nil
+}
+
+func (f *FlushTables) String() string {
+	p := sql.NewTreePrinter()
+	if f.ReadLock {
+		_ = p.WriteNode("FlushTables(WITH READ LOCK)")
+	} else {
+		_ = p.WriteNode("FlushTables")
+	}
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (f *FlushTables) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(f, children...)
+}
@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
 
 	"github.com/cespare/xxhash"
@@ -100,6 +101,8 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var iter sql.RowIter
 	if len(g.GroupByExprs) == 0 {
 		iter = newGroupByIter(g.SelectedExprs, i)
+	} else if g.childSortedByGrouping() {
+		iter = newGroupBySortedIter(g.SelectedExprs, g.GroupByExprs, i)
 	} else {
 		iter = newGroupByGroupingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
 	}
@@ -184,6 +187,30 @@ func (g *GroupBy) Expressions() []sql.Expression {
 	return exprs
 }
 
+// childSortedByGrouping returns whether this node's child is a Sort whose sort fields are exactly the grouping
+// expressions, in any order and direction. Rows with the same grouping key are then adjacent in the input.
+func (g *GroupBy) childSortedByGrouping() bool {
+	s, ok := g.Child.(*Sort)
+	if !ok || len(s.SortFields) != len(g.GroupByExprs) {
+		return false
+	}
+
+	for _, e := range g.GroupByExprs {
+		found := false
+		for _, sf := range s.SortFields {
+			if reflect.DeepEqual(sf.Column, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 type groupByIter struct {
 	selectedExprs []sql.Expression
 	child         sql.RowIter
@@ -366,6 +393,119 @@ func (i *groupByGroupingIter) Dispose() {
 	}
 }
 
+// groupBySortedIter computes groups from a child iterator whose rows are ordered by the grouping expressions. Since
+// every row of a group is adjacent to the others, it only needs to hold the buffers for a single group at a time,
+// and it returns groups in the order of its input.
+type groupBySortedIter struct {
+	selectedExprs []sql.Expression
+	groupByExprs  []sql.Expression
+	child         sql.RowIter
+	key           sql.Row
+	buf           []sql.AggregationBuffer
+	done          bool
+}
+
+func newGroupBySortedIter(selectedExprs, groupByExprs []sql.Expression, child sql.RowIter) *groupBySortedIter {
+	return &groupBySortedIter{
+		selectedExprs: selectedExprs,
+		groupByExprs:  groupByExprs,
+		child:         child,
+	}
+}
+
+func (i *groupBySortedIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	for {
+		row, err := i.child.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			if i.buf == nil {
+				return nil, io.EOF
+			}
+			return i.flush(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := evalExprs(ctx, i.groupByExprs, row)
+		if err != nil {
+			return nil, err
+		}
+
+		var result sql.Row
+		if i.buf != nil {
+			same, err := i.sameGroup(key)
+			if err != nil {
+				return nil, err
+			}
+			if !same {
+				result, err = i.flush(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if i.buf == nil {
+			i.key = key
+			i.buf = make([]sql.AggregationBuffer, len(i.selectedExprs))
+			for j, a := range i.selectedExprs {
+				i.buf[j], err = newAggregationBuffer(a)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := updateBuffers(ctx, i.buf, row); err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+}
+
+// sameGroup returns whether the grouping key given belongs to the group currently being computed.
+func (i *groupBySortedIter) sameGroup(key sql.Row) (bool, error) {
+	for j, e := range i.groupByExprs {
+		cmp, err := e.Type().Compare(i.key[j], key[j])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// flush returns the result row for the current group and releases its buffers.
+func (i *groupBySortedIter) flush(ctx *sql.Context) (sql.Row, error) {
+	row, err := evalBuffers(ctx, i.buf)
+	i.Dispose()
+	i.buf = nil
+	i.key = nil
+	return row, err
+}
+
+func (i *groupBySortedIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.buf = nil
+	return i.child.Close(ctx)
+}
+
+func (i *groupBySortedIter) Dispose() {
+	for _, b := range i.buf {
+		b.Dispose()
+	}
+}
+
 func groupingKey(
 	ctx *sql.Context,
 	exprs []sql.Expression,
@@ -377,7 +517,7 @@ func groupingKey(
 		if err != nil {
 			return 0, err
 		}
-		_, err = hash.Write(([]byte)(fmt.Sprintf("%#v,", v)))
+		_, err = hash.Write(([]byte)(fmt.Sprintf("%#v,", sql.CollationKey(expr.Type(), v))))
 		if err != nil {
 			return 0, err
 		}
@@ -426,3 +566,15 @@ func evalBuffers(
 
 	return row, nil
 }
+
+func evalExprs(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, error) {
+	result := make(sql.Row, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		result[i], err = expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
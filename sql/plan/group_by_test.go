@@ -111,6 +111,58 @@ func TestGroupByRowIter(t *testing.T) {
 	require.Equal(sql.NewRow("col1_2", int64(4444)), rows[1])
 }
 
+func TestGroupBySortedRowIter(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	childSchema := sql.Schema{
+		{Name: "col1", Type: sql.LongText, Nullable: true},
+		{Name: "col2", Type: sql.Int64},
+	}
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(childSchema))
+
+	rows := []sql.Row{
+		sql.NewRow("col1_1", int64(1)),
+		sql.NewRow("col1_2", int64(2)),
+		sql.NewRow("col1_1", int64(3)),
+		sql.NewRow(nil, int64(4)),
+		sql.NewRow("col1_2", int64(5)),
+	}
+
+	for _, r := range rows {
+		require.NoError(child.Insert(sql.NewEmptyContext(), r))
+	}
+
+	p := NewGroupBy(
+		[]sql.Expression{
+			expression.NewGetField(0, sql.LongText, "col1", true),
+			aggregation.NewSum(expression.NewGetField(1, sql.Int64, "col2", true)),
+		},
+		[]sql.Expression{
+			expression.NewGetField(0, sql.LongText, "col1", true),
+		},
+		NewSort(
+			[]sql.SortField{
+				{
+					Column: expression.NewGetField(0, sql.LongText, "col1", true),
+					Order:  sql.Descending,
+				},
+			},
+			NewResolvedTable(child, nil, nil),
+		),
+	)
+
+	require.True(p.childSortedByGrouping())
+
+	rows, err := sql.NodeToRows(ctx, p)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		sql.NewRow("col1_2", float64(7)),
+		sql.NewRow("col1_1", float64(4)),
+		sql.NewRow(nil, float64(4)),
+	}, rows)
+}
+
 func TestGroupByEvalEmptyBuffer(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
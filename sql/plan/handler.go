@@ -0,0 +1,427 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// HandlerOpen opens a table for the session with HANDLER ... OPEN, so that its rows can be read with HANDLER ... READ.
+type HandlerOpen struct {
+	db    sql.Database
+	Table string
+	Alias string
+}
+
+var _ sql.Databaser = (*HandlerOpen)(nil)
+
+// NewHandlerOpen creates a new HandlerOpen node for the table named in the database given. The handler is named as the
+// alias given, or as the table if the alias is empty.
+func NewHandlerOpen(db sql.Database, table, alias string) *HandlerOpen {
+	return &HandlerOpen{db: db, Table: table, Alias: alias}
+}
+
+// Name returns the name of the handler opened.
+func (h *HandlerOpen) Name() string {
+	if h.Alias != "" {
+		return h.Alias
+	}
+	return h.Table
+}
+
+// Database implements the sql.Databaser interface.
+func (h *HandlerOpen) Database() sql.Database {
+	return h.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (h *HandlerOpen) WithDatabase(db sql.Database) (sql.Node, error) {
+	nh := *h
+	nh.db = db
+	return &nh, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerOpen) Resolved() bool {
+	_, ok := h.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerOpen) Schema() sql.Schema { return nil }
+
+// Children implements the sql.Node interface.
+func (h *HandlerOpen) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerOpen) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerOpen) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	session, err := handlerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := session.Handler(h.Name()); ok {
+		return nil, sql.ErrDuplicateAliasOrTable.New(h.Name())
+	}
+
+	table, ok, err := h.db.GetTableInsensitive(ctx, h.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrTableNotFound.New(h.Table)
+	}
+
+	session.SetHandler(&sql.TableHandler{
+		Name:     h.Name(),
+		Database: h.db.Name(),
+		Table:    table,
+	})
+	return sql.RowsToRowIter(), nil
+}
+
+func (h *HandlerOpen) String() string {
+	if h.Alias != "" {
+		return fmt.Sprintf("HANDLER %s OPEN AS %s", h.Table, h.Alias)
+	}
+	return fmt.Sprintf("HANDLER %s OPEN", h.Table)
+}
+
+// HandlerClose closes a handler opened with HANDLER ... OPEN.
+type HandlerClose struct {
+	Name string
+}
+
+// NewHandlerClose creates a new HandlerClose node for the handler named.
+func NewHandlerClose(name string) *HandlerClose {
+	return &HandlerClose{Name: name}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerClose) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (h *HandlerClose) Schema() sql.Schema { return nil }
+
+// Children implements the sql.Node interface.
+func (h *HandlerClose) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerClose) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerClose) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	session, err := handlerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, ok := session.Handler(h.Name)
+	if !ok {
+		return nil, sql.ErrUnknownHandler.New(h.Name)
+	}
+
+	session.RemoveHandler(h.Name)
+	if err := handler.ResetCursor(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+func (h *HandlerClose) String() string {
+	return fmt.Sprintf("HANDLER %s CLOSE", h.Name)
+}
+
+// HandlerReadPosition is the row a HANDLER ... READ statement starts reading from.
+type HandlerReadPosition byte
+
+const (
+	// HandlerReadFirst reads from the first row.
+	HandlerReadFirst HandlerReadPosition = iota
+	// HandlerReadNext reads from the row after the last one read, or from the first row if none was read.
+	HandlerReadNext
+	// HandlerReadPrev reads backwards from the row before the last one read. It's not supported.
+	HandlerReadPrev
+	// HandlerReadLast reads backwards from the last row. It's not supported.
+	HandlerReadLast
+	// HandlerReadKey reads the rows of an index that compare to a key as requested.
+	HandlerReadKey
+)
+
+func (p HandlerReadPosition) String() string {
+	switch p {
+	case HandlerReadFirst:
+		return "FIRST"
+	case HandlerReadNext:
+		return "NEXT"
+	case HandlerReadPrev:
+		return "PREV"
+	case HandlerReadLast:
+		return "LAST"
+	default:
+		return "KEY"
+	}
+}
+
+// HandlerRead reads rows from a handler opened with HANDLER ... OPEN. Rows are read either in the order the table
+// returns them, or in the order of one of its indexes, and the handler keeps its position between statements so that
+// NEXT continues where the previous read stopped. The number of rows read and any condition on them are given by a
+// Limit and a Filter on top of this node.
+type HandlerRead struct {
+	Name     string
+	Index    string
+	Position HandlerReadPosition
+	// Operator is the comparison of the index columns with the Key for HandlerReadKey reads: =, <=, >=, < or >.
+	Operator string
+	// Key is the value of the leading columns of the index for HandlerReadKey reads.
+	Key     []sql.Expression
+	handler *sql.TableHandler
+}
+
+var _ sql.Expressioner = (*HandlerRead)(nil)
+
+// NewHandlerRead creates a new HandlerRead node reading from the handler named, in table order if index is empty.
+func NewHandlerRead(name, index string, position HandlerReadPosition) *HandlerRead {
+	return &HandlerRead{Name: name, Index: index, Position: position}
+}
+
+// NewHandlerReadKey creates a new HandlerRead node reading from the handler named the rows of the index given that
+// compare to the key given with the operator given.
+func NewHandlerReadKey(name, index, operator string, key []sql.Expression) *HandlerRead {
+	return &HandlerRead{Name: name, Index: index, Position: HandlerReadKey, Operator: operator, Key: key}
+}
+
+// Handler returns the handler read, or nil if it hasn't been resolved yet.
+func (h *HandlerRead) Handler() *sql.TableHandler {
+	return h.handler
+}
+
+// WithHandler returns a copy of this node reading from the handler given.
+func (h *HandlerRead) WithHandler(handler *sql.TableHandler) *HandlerRead {
+	nh := *h
+	nh.handler = handler
+	return &nh
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerRead) Resolved() bool {
+	return h.handler != nil && expression.ExpressionsResolved(h.Key...)
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerRead) Schema() sql.Schema {
+	if h.handler == nil {
+		return nil
+	}
+
+	tableSchema := h.handler.Table.Schema()
+	schema := make(sql.Schema, len(tableSchema))
+	for i, col := range tableSchema {
+		c := *col
+		c.Source = h.Name
+		schema[i] = &c
+	}
+	return schema
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerRead) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerRead) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (h *HandlerRead) Expressions() []sql.Expression {
+	return h.Key
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (h *HandlerRead) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(h.Key) {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(exprs), len(h.Key))
+	}
+	nh := *h
+	nh.Key = exprs
+	return &nh, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerRead) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Position == HandlerReadPrev || h.Position == HandlerReadLast {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER ... READ " + h.Position.String())
+	}
+
+	handler := h.handler
+	if h.Position != HandlerReadNext || handler.Cursor == nil || !strings.EqualFold(handler.Index, h.Index) {
+		if err := handler.ResetCursor(ctx); err != nil {
+			return nil, err
+		}
+
+		cursor, err := h.openCursor(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		handler.Cursor = cursor
+		handler.Index = h.Index
+	}
+
+	return &handlerReadIter{cursor: handler.Cursor}, nil
+}
+
+// openCursor returns an iterator over the rows of the handler, starting at the position of this read.
+func (h *HandlerRead) openCursor(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	table := h.handler.Table
+	if h.Index != "" {
+		indexed, ok := table.(sql.IndexedTable)
+		if !ok {
+			return nil, ErrIndexNotFound.New(h.Index, table.Name(), h.handler.Database)
+		}
+
+		lookup, err := h.indexLookup(ctx, indexed, row)
+		if err != nil {
+			return nil, err
+		}
+		if lookup == nil {
+			return sql.RowsToRowIter(), nil
+		}
+		table = indexed.WithIndexLookup(lookup)
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewTableRowIter(ctx, table, partitions), nil
+}
+
+// indexLookup returns the lookup of the rows of the index of this read, or nil if no row can match. When the key has
+// several values, all but the last one are compared for equality, and the operator only applies to the last one.
+func (h *HandlerRead) indexLookup(ctx *sql.Context, table sql.IndexedTable, row sql.Row) (sql.IndexLookup, error) {
+	indexes, err := table.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var idx sql.Index
+	for _, i := range indexes {
+		if strings.EqualFold(i.ID(), h.Index) {
+			idx = i
+			break
+		}
+	}
+	if idx == nil {
+		return nil, ErrIndexNotFound.New(h.Index, table.Name(), h.handler.Database)
+	}
+
+	columns := idx.Expressions()
+	if len(h.Key) > len(columns) {
+		return nil, sql.ErrInvalidOperandColumns.New(len(columns), len(h.Key))
+	}
+
+	builder := sql.NewIndexBuilder(ctx, idx)
+	for i, e := range h.Key {
+		key, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		operator := h.Operator
+		if i < len(h.Key)-1 {
+			operator = "="
+		}
+		switch operator {
+		case "=":
+			builder = builder.Equals(ctx, columns[i], key)
+		case "<=":
+			builder = builder.LessOrEqual(ctx, columns[i], key)
+		case ">=":
+			builder = builder.GreaterOrEqual(ctx, columns[i], key)
+		case "<":
+			builder = builder.LessThan(ctx, columns[i], key)
+		case ">":
+			builder = builder.GreaterThan(ctx, columns[i], key)
+		default:
+			return nil, sql.ErrUnsupportedSyntax.New(h.Operator)
+		}
+	}
+
+	return builder.Build(ctx)
+}
+
+func (h *HandlerRead) String() string {
+	var sb strings.Builder
+	sb.WriteString("HANDLER ")
+	sb.WriteString(h.Name)
+	sb.WriteString(" READ ")
+	if h.Index != "" {
+		sb.WriteString(h.Index)
+		sb.WriteString(" ")
+	}
+	if h.Position != HandlerReadKey {
+		sb.WriteString(h.Position.String())
+		return sb.String()
+	}
+
+	key := make([]string, len(h.Key))
+	for i, e := range h.Key {
+		key[i] = e.String()
+	}
+	sb.WriteString(fmt.Sprintf("%s (%s)", h.Operator, strings.Join(key, ", ")))
+	return sb.String()
+}
+
+// handlerReadIter returns the rows of the cursor of a handler, leaving it open when closed so that the next read
+// continues from there.
+type handlerReadIter struct {
+	cursor sql.RowIter
+}
+
+func (i *handlerReadIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return i.cursor.Next(ctx)
+}
+
+func (i *handlerReadIter) Close(*sql.Context) error {
+	return nil
+}
+
+func handlerSession(ctx *sql.Context) (sql.HandlerSession, error) {
+	session, ok := ctx.Session.(sql.HandlerSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+	return session, nil
+}
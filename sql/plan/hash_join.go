@@ -0,0 +1,601 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// hashJoinSpillPartitions is the number of partitions the rows of a hash join are spilled to when the rows of its
+	// build side don't fit in memory.
+	hashJoinSpillPartitions = 16
+	// hashJoinMaxSpillDepth is the number of times a partition whose build rows still don't fit in memory is
+	// partitioned again. Past it, the rows of the partition are joined with a nested loop over its files.
+	hashJoinMaxSpillDepth = 3
+)
+
+// ErrSpillValue is returned when a value of a row that doesn't fit in memory can't be written to disk.
+var ErrSpillValue = errors.NewKind("unable to spill a value of type %T to disk: %v")
+
+// spilledTypes are the concrete types of the values of rows spilled to disk that are registered with gob, which needs
+// to know the concrete types of the values it encodes as interfaces. Rather than a fixed list, every type is registered
+// the first time a value of it is spilled, so that the values of the types of integrators can be spilled as well.
+var spilledTypes sync.Map
+
+// newJoinIter returns the iterator of the join given, which is a hashJoinIter if the secondary side of the join is
+// a HashLookup.
+func newJoinIter(ji *joinIter) sql.RowIter {
+	if hl, ok := ji.secondaryProvider.(*HashLookup); ok && ji.mode == multipassMode {
+		return &hashJoinIter{joinIter: ji, lookup: hl}
+	}
+	return ji
+}
+
+// hashJoinIter is the iterator of a join whose secondary side, its build side, is a HashLookup. Before joining any
+// rows, it reads the build rows into the cache of the HashLookup's CachedResults, and then joins the rows like a
+// joinIter does. If the build rows don't fit in memory, it performs a Grace hash join instead: the rows of both sides
+// are partitioned by the hash of their join keys into temporary files, and then the build rows of every partition are
+// read into memory to join them with the primary rows of the same partition. A partition whose build rows still don't
+// fit is partitioned again. The rows of a spilled join are returned in the order of their partitions.
+type hashJoinIter struct {
+	*joinIter
+	lookup  *HashLookup
+	built   bool
+	spilled bool
+
+	// pending are the partitions left to join, the last one being the next one
+	pending   []*hashJoinPartition
+	partition *hashJoinPartition
+	primaries *spillReader
+
+	// table are the build rows of the partition by join key, or nil if they didn't fit in memory, in which case the
+	// build rows are scanned for every primary row
+	table        map[interface{}][]sql.Row
+	disposeTable sql.DisposeFunc
+	primaryKey   interface{}
+	matches      []sql.Row
+	pos          int
+	scan         *spillReader
+}
+
+// hashJoinPartition is a partition of the rows of a spilled hash join. Either file is nil if it has no rows.
+type hashJoinPartition struct {
+	build   *spillFile
+	primary *spillFile
+	depth   int
+}
+
+func (i *hashJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !i.built {
+		// As with a joinIter, the build side isn't read if there are no primary rows
+		if err := i.loadPrimary(ctx); err != nil {
+			return nil, err
+		}
+		if err := i.build(ctx); err != nil {
+			return nil, err
+		}
+		i.built = true
+	}
+
+	if !i.spilled {
+		return i.joinIter.Next(ctx)
+	}
+	return i.nextSpilled(ctx)
+}
+
+// build reads the build rows into the cache of the HashLookup's CachedResults, unless they are cached already. If
+// they don't fit in memory, it partitions the rows of both sides of the join to disk instead.
+func (i *hashJoinIter) build(ctx *sql.Context) error {
+	cr := i.lookup.Child.(*CachedResults)
+	if cr.hasCachedResults() {
+		return nil
+	}
+
+	iter, err := cr.Child.RowIter(ctx, i.originalRow)
+	if err != nil {
+		return err
+	}
+
+	cache, dispose := ctx.Memory.NewRowsCache()
+	var partitions []*hashJoinPartition
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = iter.Close(ctx)
+			dispose()
+			removePartitions(partitions)
+			return err
+		}
+
+		if partitions == nil {
+			err = cache.Add(row)
+			if err == nil {
+				continue
+			}
+			if sql.ErrNoMemoryAvailable.Is(err) {
+				// The build rows don't fit in memory, so the ones read so far are spilled along with the rest
+				partitions = make([]*hashJoinPartition, hashJoinSpillPartitions)
+				err = nil
+				for _, r := range cache.Get() {
+					if err = i.spillBuildRow(ctx, partitions, 0, r); err != nil {
+						break
+					}
+				}
+				dispose()
+				dispose = func() {}
+			}
+		}
+		if err == nil {
+			err = i.spillBuildRow(ctx, partitions, 0, row)
+		}
+		if err != nil {
+			_ = iter.Close(ctx)
+			dispose()
+			removePartitions(partitions)
+			return err
+		}
+	}
+	if err := iter.Close(ctx); err != nil {
+		dispose()
+		removePartitions(partitions)
+		return err
+	}
+
+	if partitions == nil {
+		cr.setCachedResults(cache, dispose)
+		return nil
+	}
+
+	i.spilled = true
+	err = i.spillPrimaryRows(ctx, partitions)
+	i.pushPartitions(partitions)
+	return err
+}
+
+// spillPrimaryRows partitions the primary rows, starting with the one already loaded, to the partitions given.
+func (i *hashJoinIter) spillPrimaryRows(ctx *sql.Context, partitions []*hashJoinPartition) error {
+	for {
+		if err := i.spillPrimaryRow(ctx, partitions, 0, i.primaryRow[len(i.originalRow):]); err != nil {
+			return err
+		}
+
+		r, err := i.primary.Next(ctx)
+		if err == io.EOF {
+			i.primaryRow = nil
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		i.primaryRow = i.originalRow.Append(r)
+	}
+}
+
+func (i *hashJoinIter) spillBuildRow(ctx *sql.Context, partitions []*hashJoinPartition, depth int, row sql.Row) error {
+	key, err := i.lookup.getHashKey(ctx, i.lookup.childProjection, row)
+	if err != nil {
+		return err
+	}
+	p, err := partitionOf(partitions, depth, key)
+	if err != nil {
+		return err
+	}
+	if p.build == nil {
+		if p.build, err = newSpillFile(); err != nil {
+			return err
+		}
+	}
+	return p.build.write(row)
+}
+
+// spillPrimaryRow spills a primary row, without the row of the outer scope that the primary rows start with.
+func (i *hashJoinIter) spillPrimaryRow(ctx *sql.Context, partitions []*hashJoinPartition, depth int, row sql.Row) error {
+	key, err := i.lookup.getHashKey(ctx, i.lookup.lookupProjection, i.originalRow.Append(row))
+	if err != nil {
+		return err
+	}
+	p, err := partitionOf(partitions, depth, key)
+	if err != nil {
+		return err
+	}
+	if p.primary == nil {
+		if p.primary, err = newSpillFile(); err != nil {
+			return err
+		}
+	}
+	return p.primary.write(row)
+}
+
+// partitionOf returns the partition, among the ones of the depth given, of the rows with the join key given. The
+// hash of the key is salted with the depth, so that the rows of a partition are spread again when it's partitioned.
+func partitionOf(partitions []*hashJoinPartition, depth int, key interface{}) (*hashJoinPartition, error) {
+	hash, err := sql.HashOf(sql.Row{depth, key})
+	if err != nil {
+		return nil, err
+	}
+	n := hash % uint64(len(partitions))
+	if partitions[n] == nil {
+		partitions[n] = &hashJoinPartition{depth: depth}
+	}
+	return partitions[n], nil
+}
+
+// pushPartitions adds the partitions given to the ones left to join, to be joined in order before the others.
+func (i *hashJoinIter) pushPartitions(partitions []*hashJoinPartition) {
+	for j := len(partitions) - 1; j >= 0; j-- {
+		if partitions[j] != nil {
+			i.pending = append(i.pending, partitions[j])
+		}
+	}
+}
+
+func (i *hashJoinIter) nextSpilled(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.partition == nil {
+			if len(i.pending) == 0 {
+				return nil, io.EOF
+			}
+			if err := i.loadPartition(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if i.primaryRow == nil {
+			r, err := i.primaries.next()
+			if err == io.EOF {
+				i.closePartition()
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err = i.loadSpilledPrimary(ctx, r); err != nil {
+				return nil, err
+			}
+		}
+
+		primary := i.primaryRow
+		secondary, err := i.nextMatch(ctx)
+		if err == io.EOF {
+			i.primaryRow = nil
+			if !i.foundMatch && (i.typ == JoinTypeLeft || i.typ == JoinTypeRight) {
+				return i.buildRow(primary, nil), nil
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := i.buildRow(primary, secondary)
+		matches, err := conditionIsTrue(ctx, row, i.cond)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		i.foundMatch = true
+		return row, nil
+	}
+}
+
+// loadPartition starts joining the next partition left to join, reading its build rows into memory. If they don't
+// fit, the partition is partitioned again, or, past the maximum depth, its build rows are left on disk.
+func (i *hashJoinIter) loadPartition(ctx *sql.Context) error {
+	p := i.pending[len(i.pending)-1]
+	i.pending = i.pending[:len(i.pending)-1]
+	if p.primary == nil || p.build == nil && i.typ == JoinTypeInner {
+		// There are no rows to join in the partition
+		p.remove()
+		return nil
+	}
+
+	if p.build != nil {
+		table, dispose, err := i.loadBuildRows(ctx, p)
+		if sql.ErrNoMemoryAvailable.Is(err) && p.depth < hashJoinMaxSpillDepth {
+			return i.repartition(ctx, p)
+		}
+		if err != nil && !sql.ErrNoMemoryAvailable.Is(err) {
+			p.remove()
+			return err
+		}
+		i.table, i.disposeTable = table, dispose
+	}
+
+	primaries, err := p.primary.reader()
+	if err != nil {
+		p.remove()
+		return err
+	}
+	i.partition, i.primaries = p, primaries
+	return nil
+}
+
+// loadBuildRows reads the build rows of the partition given into memory, by join key. It returns an
+// ErrNoMemoryAvailable error if they don't fit.
+func (i *hashJoinIter) loadBuildRows(ctx *sql.Context, p *hashJoinPartition) (map[interface{}][]sql.Row, sql.DisposeFunc, error) {
+	rows, err := p.build.reader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cache, dispose := ctx.Memory.NewRowsCache()
+	table := make(map[interface{}][]sql.Row)
+	for {
+		row, err := rows.next()
+		if err == io.EOF {
+			return table, dispose, nil
+		}
+		if err == nil {
+			err = cache.Add(row)
+		}
+		if err != nil {
+			dispose()
+			return nil, nil, err
+		}
+
+		key, err := i.lookup.getHashKey(ctx, i.lookup.childProjection, row)
+		if err != nil {
+			dispose()
+			return nil, nil, err
+		}
+		table[key] = append(table[key], row)
+	}
+}
+
+// repartition partitions the rows of the partition given again, to be joined before the other partitions left.
+func (i *hashJoinIter) repartition(ctx *sql.Context, p *hashJoinPartition) error {
+	defer p.remove()
+
+	partitions := make([]*hashJoinPartition, hashJoinSpillPartitions)
+	err := p.build.forEach(func(row sql.Row) error {
+		return i.spillBuildRow(ctx, partitions, p.depth+1, row)
+	})
+	if err == nil {
+		err = p.primary.forEach(func(row sql.Row) error {
+			return i.spillPrimaryRow(ctx, partitions, p.depth+1, row)
+		})
+	}
+	if err != nil {
+		removePartitions(partitions)
+		return err
+	}
+
+	i.pushPartitions(partitions)
+	return nil
+}
+
+// loadSpilledPrimary makes the primary row given, read from the partition being joined, the one being joined.
+func (i *hashJoinIter) loadSpilledPrimary(ctx *sql.Context, r sql.Row) error {
+	i.primaryRow = i.originalRow.Append(r)
+	i.foundMatch = false
+
+	key, err := i.lookup.getHashKey(ctx, i.lookup.lookupProjection, i.primaryRow)
+	if err != nil {
+		return err
+	}
+	i.primaryKey = key
+
+	switch {
+	case i.table != nil:
+		i.matches, i.pos = i.table[key], 0
+	case i.partition.build != nil:
+		i.scan, err = i.partition.build.reader()
+	default:
+		i.matches, i.pos = nil, 0
+	}
+	return err
+}
+
+// nextMatch returns the next build row with the join key of the primary row being joined, or io.EOF if there are no
+// more.
+func (i *hashJoinIter) nextMatch(ctx *sql.Context) (sql.Row, error) {
+	if i.table != nil || i.partition.build == nil {
+		if i.pos >= len(i.matches) {
+			return nil, io.EOF
+		}
+		i.pos++
+		return i.matches[i.pos-1], nil
+	}
+
+	for {
+		row, err := i.scan.next()
+		if err != nil {
+			return nil, err
+		}
+		key, err := i.lookup.getHashKey(ctx, i.lookup.childProjection, row)
+		if err != nil {
+			return nil, err
+		}
+		if key == i.primaryKey {
+			return row, nil
+		}
+	}
+}
+
+func (i *hashJoinIter) closePartition() {
+	if i.disposeTable != nil {
+		i.disposeTable()
+		i.disposeTable = nil
+	}
+	i.table, i.matches, i.scan, i.primaries = nil, nil, nil, nil
+	if i.partition != nil {
+		i.partition.remove()
+		i.partition = nil
+	}
+}
+
+func (i *hashJoinIter) Close(ctx *sql.Context) error {
+	i.closePartition()
+	removePartitions(i.pending)
+	i.pending = nil
+	return i.joinIter.Close(ctx)
+}
+
+func (p *hashJoinPartition) remove() {
+	if p.build != nil {
+		p.build.remove()
+	}
+	if p.primary != nil {
+		p.primary.remove()
+	}
+}
+
+func removePartitions(partitions []*hashJoinPartition) {
+	for _, p := range partitions {
+		if p != nil {
+			p.remove()
+		}
+	}
+}
+
+// spillFile is a temporary file that rows that don't fit in memory are written to, to be read back later. The file
+// is created in the default directory for temporary files, which is given by the TMPDIR environment variable.
+type spillFile struct {
+	file *os.File
+	w    *bufio.Writer
+	enc  *gob.Encoder
+	rows int
+}
+
+func newSpillFile() (*spillFile, error) {
+	file, err := ioutil.TempFile("", "gms-hash-join-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(file)
+	return &spillFile{file: file, w: w, enc: gob.NewEncoder(w)}, nil
+}
+
+func (f *spillFile) write(row sql.Row) error {
+	spilled := make(sql.Row, len(row))
+	for i, v := range row {
+		var err error
+		if spilled[i], err = spilledValue(v); err != nil {
+			return err
+		}
+	}
+	f.rows++
+	return f.enc.Encode(spilled)
+}
+
+// spilledValue returns the value given as it's written to disk. Chunked values are read in full, as they're held in
+// memory anyway when they're read back.
+func spilledValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case sql.ChunkedValue:
+		b, err := sql.ReadChunkedValue(nil, v)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
+	t := reflect.TypeOf(v)
+	if _, ok := spilledTypes.Load(t); !ok {
+		if err := registerSpilledType(v); err != nil {
+			return nil, err
+		}
+		spilledTypes.Store(t, struct{}{})
+	}
+	return v, nil
+}
+
+// registerSpilledType registers the type of the value given with gob, which panics if the type can't be registered,
+// for instance if another type of the same name is registered already.
+func registerSpilledType(v interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrSpillValue.New(v, r)
+		}
+	}()
+	gob.Register(v)
+	return nil
+}
+
+// reader returns a reader of the rows of the file from the start. Rows can't be written to the file anymore once it's
+// read, and only the last reader returned can be read from.
+func (f *spillFile) reader() (*spillReader, error) {
+	if f.w != nil {
+		if err := f.w.Flush(); err != nil {
+			return nil, err
+		}
+		f.w, f.enc = nil, nil
+	}
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &spillReader{dec: gob.NewDecoder(bufio.NewReader(f.file)), remaining: f.rows}, nil
+}
+
+func (f *spillFile) forEach(fn func(sql.Row) error) error {
+	rows, err := f.reader()
+	if err != nil {
+		return err
+	}
+	for {
+		row, err := rows.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil {
+			err = fn(row)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (f *spillFile) remove() {
+	_ = f.file.Close()
+	_ = os.Remove(f.file.Name())
+}
+
+type spillReader struct {
+	dec       *gob.Decoder
+	remaining int
+}
+
+func (r *spillReader) next() (sql.Row, error) {
+	if r.remaining == 0 {
+		return nil, io.EOF
+	}
+	var row sql.Row
+	if err := r.dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	r.remaining--
+	return row, nil
+}
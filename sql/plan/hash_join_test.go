@@ -0,0 +1,200 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestHashJoinSpill(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "hash-join")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	defer os.Setenv("TMPDIR", os.Getenv("TMPDIR"))
+	require.NoError(t, os.Setenv("TMPDIR", tmpDir))
+
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64, Nullable: true},
+		{Name: "s", Type: sql.LongText},
+	}
+	primary := memory.NewTable("primary", sql.NewPrimaryKeySchema(schema))
+	build := memory.NewTable("build", sql.NewPrimaryKeySchema(schema))
+	for i := 0; i < 100; i++ {
+		require.NoError(t, primary.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i), fmt.Sprintf("primary %d", i))))
+	}
+	require.NoError(t, primary.Insert(sql.NewEmptyContext(), sql.NewRow(nil, "primary null")))
+	for i := 0; i < 200; i++ {
+		require.NoError(t, build.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i%50), fmt.Sprintf("build %d", i))))
+	}
+
+	hashLookup := func() *HashLookup {
+		return NewHashLookup(
+			NewCachedResults(NewResolvedTable(build, nil, nil)),
+			expression.NewTuple(expression.NewGetField(0, sql.Int64, "i", true)),
+			expression.NewTuple(expression.NewGetField(0, sql.Int64, "i", true)),
+		)
+	}
+	// Every primary row with a key below 50 matches 4 build rows, the others only show up in outer joins
+	joins := map[string]struct {
+		join func() (JoinNode, *HashLookup)
+		rows int
+	}{
+		"inner join": {func() (JoinNode, *HashLookup) {
+			hl := hashLookup()
+			return NewInnerJoin(NewResolvedTable(primary, nil, nil), hl, expression.NewEquals(
+				expression.NewGetField(0, sql.Int64, "i", true),
+				expression.NewGetField(2, sql.Int64, "i", true),
+			)), hl
+		}, 200},
+		"left join": {func() (JoinNode, *HashLookup) {
+			hl := hashLookup()
+			return NewLeftJoin(NewResolvedTable(primary, nil, nil), hl, expression.NewEquals(
+				expression.NewGetField(0, sql.Int64, "i", true),
+				expression.NewGetField(2, sql.Int64, "i", true),
+			)), hl
+		}, 251},
+		"right join": {func() (JoinNode, *HashLookup) {
+			hl := hashLookup()
+			return NewRightJoin(hl, NewResolvedTable(primary, nil, nil), expression.NewEquals(
+				expression.NewGetField(0, sql.Int64, "i", true),
+				expression.NewGetField(2, sql.Int64, "i", true),
+			)), hl
+		}, 251},
+	}
+
+	for name, tt := range joins {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			j, hl := tt.join()
+			expected, err := sql.NodeToRows(sql.NewEmptyContext(), j.WithMultipassMode())
+			require.NoError(err)
+			require.True(hl.Child.(*CachedResults).hasCachedResults())
+			require.Len(expected, tt.rows)
+
+			// The build rows don't fit in 2000 bytes, but the ones of every partition do. Nothing fits in 1 byte, which
+			// leaves the build rows of the partitions on disk.
+			for _, quota := range []uint64{2000, 1} {
+				ctx := sql.NewContext(context.Background(), sql.WithMemoryManager(
+					sql.NewMemoryManager(sql.NewMemoryQuota(nil, quota)),
+				))
+				j, hl := tt.join()
+				rows, err := sql.NodeToRows(ctx, j.WithMultipassMode())
+				require.NoError(err)
+				require.ElementsMatch(expected, rows)
+				require.False(hl.Child.(*CachedResults).hasCachedResults())
+
+				files, err := ioutil.ReadDir(tmpDir)
+				require.NoError(err)
+				require.Empty(files)
+			}
+		})
+	}
+}
+
+// spillChunkedValue is a sql.ChunkedValue of the chunks given.
+type spillChunkedValue [][]byte
+
+func (c spillChunkedValue) Size() int64 {
+	var size int64
+	for _, chunk := range c {
+		size += int64(len(chunk))
+	}
+	return size
+}
+
+func (c spillChunkedValue) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, chunk := range c {
+		written, err := w.Write(chunk)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// spillPoint is a value of a type that the engine doesn't know about, like the ones of integrators.
+type spillPoint struct {
+	X, Y int64
+}
+
+func TestHashJoinSpillValues(t *testing.T) {
+	require := require.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "hash-join")
+	require.NoError(err)
+	defer os.RemoveAll(tmpDir)
+	defer os.Setenv("TMPDIR", os.Getenv("TMPDIR"))
+	require.NoError(os.Setenv("TMPDIR", tmpDir))
+
+	large := strings.Repeat("0123456789", 1000)
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64},
+		{Name: "b", Type: sql.LongBlob},
+		{Name: "p", Type: sql.JSON},
+	}
+	primary := memory.NewTable("primary", sql.NewPrimaryKeySchema(schema))
+	build := memory.NewTable("build", sql.NewPrimaryKeySchema(schema))
+	for i := 0; i < 20; i++ {
+		row := sql.NewRow(int64(i), spillChunkedValue{[]byte(large[:5000]), []byte(large[5000:])}, spillPoint{int64(i), 1})
+		require.NoError(primary.Insert(sql.NewEmptyContext(), row))
+		require.NoError(build.Insert(sql.NewEmptyContext(), row))
+	}
+
+	hl := NewHashLookup(
+		NewCachedResults(NewResolvedTable(build, nil, nil)),
+		expression.NewTuple(expression.NewGetField(0, sql.Int64, "i", false)),
+		expression.NewTuple(expression.NewGetField(0, sql.Int64, "i", false)),
+	)
+	j := NewInnerJoin(NewResolvedTable(primary, nil, nil), hl, expression.NewEquals(
+		expression.NewGetField(0, sql.Int64, "i", false),
+		expression.NewGetField(3, sql.Int64, "i", false),
+	))
+
+	ctx := sql.NewContext(context.Background(), sql.WithMemoryManager(
+		sql.NewMemoryManager(sql.NewMemoryQuota(nil, 1)),
+	))
+	rows, err := sql.NodeToRows(ctx, j.WithMultipassMode())
+	require.NoError(err)
+	require.Len(rows, 20)
+	for _, row := range rows {
+		require.Equal(row[0], row[3])
+		require.Equal(spillPoint{row[0].(int64), 1}, row[2])
+		require.Equal(spillPoint{row[0].(int64), 1}, row[5])
+		for _, i := range []int{1, 4} {
+			b, err := sql.LongBlob.Convert(row[i])
+			require.NoError(err)
+			require.Equal(large, b)
+		}
+	}
+
+	files, err := ioutil.ReadDir(tmpDir)
+	require.NoError(err)
+	require.Empty(files)
+}
@@ -16,8 +16,12 @@ package plan
 
 import (
 	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // NewHashLookup returns a node that performs an indexed hash lookup
@@ -28,12 +32,16 @@ import (
 // performing a lookup. When RowIter is called, if cached results are
 // available, it fulfills the RowIter call by performing a hash lookup
 // on the projected results. If cached results are not available, it
-// simply delegates to the child.
+// simply delegates to the child. When the types of the projections
+// differ, their values are converted to the type they're compared as
+// before being hashed, so that values that compare as equal have the
+// same key.
 func NewHashLookup(n *CachedResults, childProjection sql.Expression, lookupProjection sql.Expression) *HashLookup {
 	return &HashLookup{
 		UnaryNode:        UnaryNode{n},
 		childProjection:  childProjection,
 		lookupProjection: lookupProjection,
+		keyTypes:         hashKeyTypes(childProjection, lookupProjection),
 		mutex:            new(sync.Mutex),
 	}
 }
@@ -42,8 +50,35 @@ type HashLookup struct {
 	UnaryNode
 	childProjection  sql.Expression
 	lookupProjection sql.Expression
-	mutex            *sync.Mutex
-	lookup           map[interface{}][]sql.Row
+	// keyTypes are the comparison types of the elements of the keys,
+	// or nil if both projections have the same types.
+	keyTypes []sql.Type
+	mutex    *sync.Mutex
+	lookup   map[interface{}][]sql.Row
+}
+
+// hashKeyTypes returns the types the elements of the keys of the
+// projections given are compared as, or nil if they have the same
+// types and can be hashed as they are.
+func hashKeyTypes(childProjection, lookupProjection sql.Expression) []sql.Type {
+	childExprs, lookupExprs := []sql.Expression{childProjection}, []sql.Expression{lookupProjection}
+	if ct, ok := childProjection.(expression.Tuple); ok && len(ct) > 1 {
+		if lt, ok := lookupProjection.(expression.Tuple); ok && len(lt) == len(ct) {
+			childExprs, lookupExprs = ct, lt
+		}
+	}
+
+	types := make([]sql.Type, len(childExprs))
+	equal := true
+	for i := range childExprs {
+		ct, lt := childExprs[i].Type(), lookupExprs[i].Type()
+		types[i] = sql.ComparisonType(ct, lt)
+		equal = equal && sql.TypesEqual(ct, lt)
+	}
+	if equal {
+		return nil
+	}
+	return types
 }
 
 func (n *HashLookup) String() string {
@@ -113,6 +148,10 @@ func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
+	key, err = n.convertKey(key)
+	if err != nil {
+		return nil, err
+	}
 	if s, ok := key.([]interface{}); ok {
 		switch len(s) {
 		case 0:
@@ -133,3 +172,47 @@ func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 	}
 	return key, nil
 }
+
+// convertKey converts the elements of the key given to their
+// comparison types, when the projections have different types.
+func (n *HashLookup) convertKey(key interface{}) (interface{}, error) {
+	if n.keyTypes == nil {
+		return key, nil
+	}
+	if len(n.keyTypes) == 1 {
+		return hashableComparisonValue(key, n.keyTypes[0])
+	}
+
+	s, ok := key.([]interface{})
+	if !ok || len(s) != len(n.keyTypes) {
+		return key, nil
+	}
+	converted := make([]interface{}, len(s))
+	for i, v := range s {
+		c, err := hashableComparisonValue(v, n.keyTypes[i])
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = c
+	}
+	return converted, nil
+}
+
+// hashableComparisonValue converts the value given to the comparison
+// type given, and then to a value that is equal to any other value
+// that compares as equal to it. Decimals and times aren't, as they
+// hold pointers.
+func hashableComparisonValue(v interface{}, t sql.Type) (interface{}, error) {
+	v, err := sql.ConvertForComparison(v, t)
+	if err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case decimal.Decimal:
+		return v.String(), nil
+	case time.Time:
+		return v.UnixNano(), nil
+	default:
+		return v, nil
+	}
+}
@@ -53,6 +53,11 @@ type IndexedInSubqueryFilter struct {
 	equals   bool
 }
 
+// Subquery returns the subquery whose results are looked up in the child.
+func (i *IndexedInSubqueryFilter) Subquery() *Subquery {
+	return i.subquery
+}
+
 func (i *IndexedInSubqueryFilter) Resolved() bool {
 	return i.subquery.Resolved() && i.child.Resolved()
 }
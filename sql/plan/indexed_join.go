@@ -17,6 +17,7 @@ package plan
 import (
 	"io"
 	"reflect"
+	"sync/atomic"
 
 	"github.com/opentracing/opentracing-go"
 
@@ -126,6 +127,25 @@ func indexedJoinRowIter(
 		span.Finish()
 		return nil, err
 	}
+
+	concurrency, err := indexedJoinLookupConcurrency(ctx)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	if concurrency > 1 && canLookupConcurrently(right) {
+		return sql.NewSpanIter(span, &concurrentIndexedJoinIter{
+			parentRow:         parentRow,
+			primary:           l,
+			secondaryProvider: right,
+			cond:              cond,
+			joinType:          joinType,
+			rowSize:           len(parentRow) + len(left.Schema()) + len(right.Schema()),
+			scopeLen:          scopeLen,
+			concurrency:       concurrency,
+		}), nil
+	}
+
 	return sql.NewSpanIter(span, &indexedJoinIter{
 		parentRow:         parentRow,
 		primary:           l,
@@ -271,3 +291,188 @@ func (i *indexedJoinIter) Close(ctx *sql.Context) (err error) {
 
 	return err
 }
+
+const (
+	indexedJoinLookupConcurrencySessionVar = "indexed_join_lookup_concurrency"
+	// indexedJoinLookupBatchFactor is the number of primary rows read in a batch for each concurrent lookup.
+	indexedJoinLookupBatchFactor = 4
+)
+
+// indexedJoinLookupConcurrency returns the number of lookups in the secondary table that an indexed join may run at
+// once, as set in the indexed_join_lookup_concurrency session variable.
+func indexedJoinLookupConcurrency(ctx *sql.Context) (int, error) {
+	val, err := ctx.GetSessionVariable(ctx, indexedJoinLookupConcurrencySessionVar)
+	if err != nil {
+		return 0, err
+	}
+	return int(val.(int64)), nil
+}
+
+// canLookupConcurrently returns whether rows of the secondary node of an indexed join given can be looked up by many
+// goroutines at once, which is the case of index lookups in tables.
+func canLookupConcurrently(n sql.Node) bool {
+	switch n := n.(type) {
+	case *IndexedTableAccess:
+		return true
+	case *TableAlias:
+		return canLookupConcurrently(n.Child)
+	default:
+		return false
+	}
+}
+
+// concurrentIndexedJoinIter is an indexedJoinIter that reads the rows of the primary table in batches and looks up
+// the secondary rows of every row of a batch concurrently, which hides the latency of the lookups for index drivers
+// with remote storage. The rows are returned in the same order as with an indexedJoinIter. The first lookup of a
+// batch always runs, every other one needs a worker from the pool of the context, so that a full pool only reduces
+// the concurrency of the lookups instead of blocking them.
+type concurrentIndexedJoinIter struct {
+	parentRow         sql.Row
+	primary           sql.RowIter
+	primaryDone       bool
+	secondaryProvider sql.Node
+	cond              sql.Expression
+	joinType          JoinType
+	rowSize           int
+	scopeLen          int
+	concurrency       int
+
+	// the current batch of primary rows, with their secondary rows
+	batch      []sql.Row
+	secondary  [][]sql.Row
+	primaryIdx int
+	// the next secondary row of the current primary row to join
+	secondaryIdx int
+	foundMatch   bool
+}
+
+func (i *concurrentIndexedJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.primaryIdx >= len(i.batch) {
+			if err := i.loadBatch(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		primary := i.batch[i.primaryIdx]
+		secondary := i.secondary[i.primaryIdx]
+		if i.secondaryIdx >= len(secondary) {
+			foundMatch := i.foundMatch
+			i.primaryIdx++
+			i.secondaryIdx = 0
+			i.foundMatch = false
+			if !foundMatch && (i.joinType == JoinTypeLeft || i.joinType == JoinTypeRight) {
+				return i.removeParentRow(i.buildRow(primary, nil)), nil
+			}
+			continue
+		}
+
+		row := i.buildRow(primary, secondary[i.secondaryIdx])
+		i.secondaryIdx++
+		matches, err := conditionIsTrue(ctx, row, i.cond)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			continue
+		}
+
+		i.foundMatch = true
+		return i.removeParentRow(row), nil
+	}
+}
+
+// loadBatch reads the next batch of primary rows and looks up their secondary rows.
+func (i *concurrentIndexedJoinIter) loadBatch(ctx *sql.Context) error {
+	i.batch = i.batch[:0]
+	i.secondary = nil
+	i.primaryIdx = 0
+	i.secondaryIdx = 0
+	i.foundMatch = false
+
+	batchSize := i.concurrency * indexedJoinLookupBatchFactor
+	for !i.primaryDone && len(i.batch) < batchSize {
+		r, err := i.primary.Next(ctx)
+		if err == io.EOF {
+			i.primaryDone = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+		i.batch = append(i.batch, i.parentRow.Append(r))
+	}
+	if len(i.batch) == 0 {
+		return io.EOF
+	}
+
+	secondary := make([][]sql.Row, len(i.batch))
+	next := int64(-1)
+	lookups := func(ctx *sql.Context) error {
+		for {
+			idx := int(atomic.AddInt64(&next, 1))
+			if idx >= len(i.batch) {
+				return nil
+			}
+			rows, err := i.lookup(ctx, i.batch[idx])
+			if err != nil {
+				return err
+			}
+			secondary[idx] = rows
+		}
+	}
+
+	eg, egCtx := ctx.NewErrgroup()
+	for w := 0; w < i.concurrency && w < len(i.batch); w++ {
+		release := func() {}
+		if w > 0 {
+			var ok bool
+			release, ok = ctx.Workers.TryAcquire(sql.WorkerIndexLookup)
+			if !ok {
+				break
+			}
+		}
+		eg.Go(func() error {
+			defer release()
+			return lookups(egCtx)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	i.secondary = secondary
+	return nil
+}
+
+// lookup returns the secondary rows of the primary row given.
+func (i *concurrentIndexedJoinIter) lookup(ctx *sql.Context, primaryRow sql.Row) ([]sql.Row, error) {
+	iter, err := i.secondaryProvider.RowIter(ctx, primaryRow)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func (i *concurrentIndexedJoinIter) removeParentRow(r sql.Row) sql.Row {
+	copy(r[i.scopeLen:], r[len(i.parentRow):])
+	r = r[:len(r)-len(i.parentRow)+i.scopeLen]
+	return r
+}
+
+// buildRow builds the result set row using the rows from the primary and secondary tables
+func (i *concurrentIndexedJoinIter) buildRow(primary, secondary sql.Row) sql.Row {
+	row := make(sql.Row, i.rowSize)
+
+	copy(row, primary)
+	copy(row[len(primary):], secondary)
+
+	return row
+}
+
+func (i *concurrentIndexedJoinIter) Close(ctx *sql.Context) error {
+	i.batch = nil
+	i.secondary = nil
+	return i.primary.Close(ctx)
+}
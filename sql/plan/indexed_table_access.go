@@ -189,3 +189,8 @@ func (i *IndexedTableAccess) WithExpressions(exprs ...sql.Expression) (sql.Node,
 func GetIndexLookup(ita *IndexedTableAccess) sql.IndexLookup {
 	return ita.lookup
 }
+
+// Index returns the index used by this table access.
+func (i *IndexedTableAccess) Index() sql.Index {
+	return i.index
+}
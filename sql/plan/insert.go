@@ -59,6 +59,16 @@ var IgnorableErrors = []*errors.Kind{sql.ErrInsertIntoNonNullableProvidedNull,
 	sql.ErrDuplicateEntry,
 	sql.ErrUniqueKeyViolation}
 
+// InsertInfo is the Info for OKResults returned by InsertInto nodes that insert more than a single row of values.
+type InsertInfo struct {
+	Records, Duplicates, Warnings int
+}
+
+// String implements fmt.Stringer
+func (ii InsertInfo) String() string {
+	return fmt.Sprintf("Records: %d  Duplicates: %d  Warnings: %d", ii.Records, ii.Duplicates, ii.Warnings)
+}
+
 // InsertInto is a node describing the insertion into some table.
 type InsertInto struct {
 	db          sql.Database
@@ -125,6 +135,10 @@ type insertIter struct {
 	tableNode           sql.Node
 	closed              bool
 	ignore              bool
+	// rowNumber is the number of the row being inserted, starting at 1, for warnings.
+	rowNumber int
+	// timestamps converts TIMESTAMP values between the session time zone and UTC, which they're stored in.
+	timestamps *sql.TimestampConverter
 }
 
 func GetInsertable(node sql.Node) (sql.InsertableTable, error) {
@@ -172,6 +186,11 @@ func newInsertIter(
 		return nil, err
 	}
 
+	timestamps, err := sql.NewTimestampConverter(ctx, dstSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	var inserter sql.RowInserter
 
 	var replacer sql.RowReplacer
@@ -204,6 +223,7 @@ func newInsertIter(
 		checks:      checks,
 		ctx:         ctx,
 		ignore:      ignore,
+		timestamps:  timestamps,
 	}
 
 	if replacer != nil {
@@ -235,6 +255,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	if err != nil {
 		return i.ignoreOrClose(ctx, row, err)
 	}
+	i.rowNumber++
 
 	// Prune the row down to the size of the schema. It can be larger in the case of running with an outer scope, in which
 	// case the additional scope variables are prepended to the row.
@@ -265,13 +286,22 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	}
 
 	// Do any necessary type conversions to the target schema
-	for i, col := range i.schema {
-		if row[i] != nil {
-			converted, err := col.Type.Convert(row[i]) // allows for better error handling
+	for idx, col := range i.schema {
+		if row[idx] != nil {
+			converted, err := col.Type.Convert(row[idx]) // allows for better error handling
+			if sql.ErrLengthBeyondLimit.Is(err) && (i.ignore || !ctx.IsStrictMode()) {
+				converted, err = i.truncateString(ctx, col, row[idx])
+			} else if sql.ErrOutOfRange.Is(err) && (i.ignore || !ctx.IsStrictMode()) {
+				converted, err = i.clampNumber(ctx, col, row[idx])
+			} else if sql.ErrInvalidDate.Is(err) {
+				converted, err = i.invalidDate(ctx, col, row[idx], err)
+			} else if err == nil && sql.IsZeroTime(converted) && ctx.IsSQLModeEnabled("NO_ZERO_DATE") {
+				converted, err = i.zeroDate(ctx, col, row[idx])
+			}
 			if err != nil {
 				return nil, sql.NewWrappedInsertError(row, err)
 			}
-			row[i] = converted
+			row[idx] = converted
 		}
 	}
 
@@ -283,7 +313,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
 		for {
-			if err := i.replacer.Insert(ctx, row); err != nil {
+			if err := i.replacer.Insert(ctx, i.timestamps.ToUTC(row)); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
 					i.rowSource.Close(ctx)
 					i.rowSource = nil
@@ -297,16 +327,14 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
 				// the row had to be deleted, write the values into the toReturn row
-				for i := 0; i < len(ue.Existing); i++ {
-					toReturn[i] = ue.Existing[i]
-				}
+				copy(toReturn, i.timestamps.FromUTC(ue.Existing))
 			} else {
 				break
 			}
 		}
 		return toReturn, nil
 	} else {
-		if err := i.inserter.Insert(ctx, row); err != nil {
+		if err := i.inserter.Insert(ctx, i.timestamps.ToUTC(row)); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return i.ignoreOrClose(ctx, row, err)
 			}
@@ -321,18 +349,26 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	return row, nil
 }
 
-func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
+func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, existing sql.Row) (returnRow sql.Row, returnErr error) {
 	err := i.resolveValues(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 
-	newRow, err := applyUpdateExpressions(ctx, i.updateExprs, rowToUpdate)
+	// The existing row is the one in the table, in storage form
+	rowToUpdate := i.timestamps.FromUTC(existing)
+
+	newRow, err := applyUpdateExpressions(ctx, i.updateExprs, rowToUpdate, i.rowNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	newRow, err = applyOnUpdateValues(ctx, i.schema, i.updateExprs, 0, rowToUpdate, newRow)
 	if err != nil {
 		return nil, err
 	}
 
-	err = i.updater.Update(ctx, rowToUpdate, newRow)
+	err = i.updater.Update(ctx, existing, i.timestamps.ToUTC(newRow))
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +468,76 @@ func (i *insertIter) ignoreOrClose(ctx *sql.Context, row sql.Row, err error) (sq
 	}
 }
 
+// truncateString converts the value given to the string column given, truncating it with a warning if it's too long.
+func (i *insertIter) truncateString(ctx *sql.Context, col *sql.Column, val interface{}) (interface{}, error) {
+	st, ok := col.Type.(sql.StringType)
+	if !ok {
+		return nil, sql.ErrLengthBeyondLimit.New()
+	}
+
+	converted, truncated, err := sql.TruncateString(st, val)
+	if err != nil {
+		return nil, err
+	}
+	if truncated {
+		ctx.Warn(1265, "Data truncated for column '%s' at row %d", col.Name, i.rowNumber) // TODO: Needs to be added to vitess
+	}
+	return converted, nil
+}
+
+// clampNumber converts the value given to the numeric column given, clamping it with a warning if it's out of range.
+func (i *insertIter) clampNumber(ctx *sql.Context, col *sql.Column, val interface{}) (interface{}, error) {
+	nt, ok := col.Type.(sql.NumberType)
+	if !ok {
+		return col.Type.Convert(val)
+	}
+
+	converted, clamped, err := sql.ClampNumber(nt, val)
+	if err != nil {
+		return nil, err
+	}
+	if clamped {
+		ctx.Warn(1264, "Out of range value for column '%s' at row %d", col.Name, i.rowNumber) // TODO: Needs to be added to vitess
+	}
+	return converted, nil
+}
+
+// invalidDate handles the value given, which isn't a valid date, for the date column given. It's kept if
+// ALLOW_INVALID_DATES is enabled, and it's an error in strict mode. Otherwise it's replaced with a warning by the zero
+// date, or by NULL if NO_ZERO_DATE doesn't allow that.
+func (i *insertIter) invalidDate(ctx *sql.Context, col *sql.Column, val interface{}, err error) (interface{}, error) {
+	dt, ok := col.Type.(sql.DatetimeType)
+	if !ok {
+		return nil, err
+	}
+
+	if ctx.IsSQLModeEnabled("ALLOW_INVALID_DATES") {
+		if converted, ok := sql.ConvertInvalidDate(dt, val); ok {
+			return converted, nil
+		}
+	}
+	if !i.ignore && ctx.IsStrictMode() {
+		return nil, err
+	}
+
+	ctx.Warn(1264, "Out of range value for column '%s' at row %d", col.Name, i.rowNumber) // TODO: Needs to be added to vitess
+	if ctx.IsSQLModeEnabled("NO_ZERO_DATE") && col.Nullable {
+		return nil, nil
+	}
+	return dt.Zero(), nil
+}
+
+// zeroDate handles the zero date given for the date column given when NO_ZERO_DATE is enabled. It's an error in strict
+// mode, and it's kept with a warning otherwise.
+func (i *insertIter) zeroDate(ctx *sql.Context, col *sql.Column, val interface{}) (interface{}, error) {
+	if !i.ignore && ctx.IsStrictMode() {
+		return nil, sql.ErrInvalidDate.New(val)
+	}
+
+	ctx.Warn(1264, "Out of range value for column '%s' at row %d", col.Name, i.rowNumber) // TODO: Needs to be added to vitess
+	return col.Type.Zero(), nil
+}
+
 func (i *insertIter) warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 	if !i.ignore {
 		return err
@@ -70,6 +70,12 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 		typ := right.Type()
 
+		// Numbers of different types, like an integer and a decimal, don't hash the same even when they're equal
+		if cmpType := sql.ComparisonType(in.Left.Type(), typ); !leftNull && isNumericComparison(cmpType) &&
+			!sql.TypesEqual(in.Left.Type().Promote(), typ.Promote()) {
+			return in.evalComparing(ctx, row, left, right, cmpType)
+		}
+
 		values, err := right.HashMultiple(ctx, row)
 		if err != nil {
 			return nil, err
@@ -93,6 +99,9 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			if _, nilValNotFoundErr := values.Get(nilKey); nilValNotFoundErr == nil {
 				return nil, nil
 			}
+			if sql.IsTuple(typ) {
+				return in.evalTuples(ctx, row, left.([]interface{}), right)
+			}
 			return false, nil
 		}
 
@@ -113,6 +122,80 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalTuples evaluates the expression for a row value without an identical row in the subquery's result, which may
+// still be equal to one of them, as defined by sql.EqualTuples, or make the result NULL by comparing NULL elements.
+func (in *InSubquery) evalTuples(ctx *sql.Context, row sql.Row, left []interface{}, right *Subquery) (interface{}, error) {
+	values, err := right.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	fns := sql.TupleCompareFuncs(in.Left.Type().(sql.TupleType), right.Type().(sql.TupleType))
+	hasNull := false
+	for _, val := range values {
+		if val == nil {
+			hasNull = true
+			continue
+		}
+		equal, isNull, err := sql.EqualTuples(left, val.([]interface{}), fns)
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			return true, nil
+		}
+		hasNull = hasNull || isNull
+	}
+
+	if hasNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
+// isNumericComparison returns whether values compared with the type given are compared as numbers.
+func isNumericComparison(cmpType sql.Type) bool {
+	return cmpType == sql.Int64 || cmpType == sql.Uint64 || cmpType == sql.Float64 || sql.IsDecimal(cmpType)
+}
+
+// evalComparing evaluates the expression for a non-NULL value by comparing it to each value of the subquery's result
+// with the comparison type given, for values of different types that can't be looked up by hash.
+func (in *InSubquery) evalComparing(ctx *sql.Context, row sql.Row, left interface{}, right *Subquery, cmpType sql.Type) (interface{}, error) {
+	values, err := right.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err = sql.ConvertForComparison(left, cmpType)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNull := false
+	for _, val := range values {
+		if val == nil {
+			hasNull = true
+			continue
+		}
+		val, err = sql.ConvertForComparison(val, cmpType)
+		if err != nil {
+			return nil, err
+		}
+		cmp, err := cmpType.Compare(left, val)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+
+	if hasNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InSubquery) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -412,7 +412,7 @@ func joinRowIter(ctx *sql.Context, typ JoinType, left, right sql.Node, cond sql.
 			span.Finish()
 			return nil, err
 		}
-		return sql.NewSpanIter(span, &joinIter{
+		return sql.NewSpanIter(span, newJoinIter(&joinIter{
 			typ:               typ,
 			primary:           r,
 			secondaryProvider: left,
@@ -423,7 +423,7 @@ func joinRowIter(ctx *sql.Context, typ JoinType, left, right sql.Node, cond sql.
 			dispose:           dispose,
 			originalRow:       row,
 			scopeLen:          scopeLen,
-		}), nil
+		})), nil
 	}
 
 	l, err := left.RowIter(ctx, row)
@@ -432,7 +432,7 @@ func joinRowIter(ctx *sql.Context, typ JoinType, left, right sql.Node, cond sql.
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, &joinIter{
+	return sql.NewSpanIter(span, newJoinIter(&joinIter{
 		typ:               typ,
 		primary:           l,
 		secondaryProvider: right,
@@ -443,7 +443,7 @@ func joinRowIter(ctx *sql.Context, typ JoinType, left, right sql.Node, cond sql.
 		dispose:           dispose,
 		originalRow:       row,
 		scopeLen:          scopeLen,
-	}), nil
+	})), nil
 }
 
 // joinMode defines the mode in which a join will be performed.
@@ -28,6 +28,16 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
+// LoadDataInfo is the Info for OKResults returned by LOAD DATA statements.
+type LoadDataInfo struct {
+	Records, Deleted, Skipped, Warnings int
+}
+
+// String implements fmt.Stringer
+func (ldi LoadDataInfo) String() string {
+	return fmt.Sprintf("Records: %d  Deleted: %d  Skipped: %d  Warnings: %d", ldi.Records, ldi.Deleted, ldi.Skipped, ldi.Warnings)
+}
+
 type LoadData struct {
 	Local                   bool
 	File                    string
@@ -66,8 +76,25 @@ func (l *LoadData) String() string {
 	return pr.String()
 }
 
+// Schema implements the sql.Node interface. The rows loaded have the columns of the column list, or all the columns
+// of the destination table if there is none, so that the rows go through the same projection as the rows of any other
+// insert, which fills in the default values of the other columns.
 func (l *LoadData) Schema() sql.Schema {
-	return l.Destination.Schema()
+	schema := l.Destination.Schema()
+	if len(l.ColumnNames) == 0 {
+		return schema
+	}
+
+	columns := make(sql.Schema, 0, len(l.ColumnNames))
+	for _, name := range l.ColumnNames {
+		for _, col := range schema {
+			if strings.EqualFold(col.Name, name) {
+				columns = append(columns, col)
+				break
+			}
+		}
+	}
+	return columns
 }
 
 func (l *LoadData) Children() []sql.Node {
@@ -197,7 +224,7 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}
 
 	return &loadDataIter{
-		destination:             l.Destination,
+		schema:                  l.Schema(),
 		reader:                  reader,
 		scanner:                 scanner,
 		fieldsTerminatedByDelim: l.fieldsTerminatedByDelim,
@@ -211,7 +238,7 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 type loadDataIter struct {
 	scanner                 *bufio.Scanner
-	destination             sql.Node
+	schema                  sql.Schema
 	reader                  io.ReadCloser
 	fieldsTerminatedByDelim string
 	fieldsEnclosedByDelim   string
@@ -327,21 +354,22 @@ func (l loadDataIter) parseFields(line string) ([]sql.Expression, error) {
 		}
 	}
 
-	exprs := make([]sql.Expression, len(l.destination.Schema()))
-
-	limit := len(exprs)
-	if len(fields) < limit {
-		limit = len(fields)
-	}
+	exprs := make([]sql.Expression, len(l.schema))
+	for i, col := range l.schema {
+		if i >= len(fields) {
+			// As in MySQL, the columns of the fields missing from the line get their default values
+			if col.Default != nil {
+				exprs[i] = col.Default
+			}
+			continue
+		}
 
-	for i := 0; i < limit; i++ {
 		field := fields[i]
-		dSchema := l.destination.Schema()[i]
-		// Replace the empty string with defaults
+		// Empty fields are the zero values of the types that aren't strings
 		if field == "" {
-			_, ok := dSchema.Type.(sql.StringType)
+			_, ok := col.Type.(sql.StringType)
 			if !ok {
-				exprs[i] = expression.NewLiteral(dSchema.Default, dSchema.Type)
+				exprs[i] = expression.NewLiteral(col.Type.Zero(), col.Type)
 			} else {
 				exprs[i] = expression.NewLiteral(field, sql.LongText)
 			}
@@ -78,7 +78,7 @@ func (t *LockTables) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		if err := lockable.Lock(ctx, l.Write); err != nil {
 			ctx.Error(0, "unable to lock table: %s", err)
 		} else {
-			t.Catalog.LockTable(ctx, lockable.Name())
+			t.Catalog.LockTable(ctx, lockedTableDatabase(ctx, l.Table), lockable.Name())
 		}
 	}
 
@@ -143,6 +143,15 @@ func getLockableTable(table sql.Table) (sql.Lockable, error) {
 	}
 }
 
+// lockedTableDatabase returns the name of the database of the table node given, or the current database if it isn't
+// known.
+func lockedTableDatabase(ctx *sql.Context, node sql.Node) string {
+	if rt, ok := node.(*ResolvedTable); ok && rt.Database != nil {
+		return rt.Database.Name()
+	}
+	return ctx.GetCurrentDatabase()
+}
+
 // UnlockTables will release all locks for the current session.
 type UnlockTables struct {
 	Catalog sql.Catalog
@@ -131,12 +131,7 @@ func (t *ProcedureResolvedTable) newestTable(ctx *sql.Context) (*ResolvedTable,
 		}
 		return t.ResolvedTable.WithTable(tbl)
 	} else {
-		versionedDb, ok := t.ResolvedTable.Database.(sql.VersionedDatabase)
-		if !ok {
-			return nil, sql.ErrAsOfNotSupported.New(t.ResolvedTable.Database.Name())
-		}
-
-		tbl, ok, err := versionedDb.GetTableInsensitiveAsOf(ctx, t.ResolvedTable.Table.Name(), t.ResolvedTable.AsOf)
+		tbl, ok, err := sql.GetTableInsensitiveAsOf(ctx, t.ResolvedTable.Database, t.ResolvedTable.Table.Name(), t.ResolvedTable.AsOf)
 		if err != nil {
 			return nil, err
 		} else if !ok {
@@ -425,7 +425,8 @@ func IsDDLNode(node sql.Node) bool {
 	switch node.(type) {
 	case *CreateTable, *DropTable, *Truncate,
 		*AddColumn, *ModifyColumn, *DropColumn,
-		*CreateDB, *DropDB,
+		*AlterDefaultSet, *AlterDefaultDrop, *AlterAutoIncrement, *DropConstraint,
+		*CreateDB, *DropDB, *AlterDB,
 		*RenameTable, *RenameColumn,
 		*CreateView, *DropView,
 		*CreateIndex, *AlterIndex, *DropIndex,
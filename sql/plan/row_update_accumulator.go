@@ -76,11 +76,74 @@ func (r RowUpdateAccumulator) DebugString() string {
 
 type accumulatorRowHandler interface {
 	handleRowUpdate(row sql.Row) error
-	okResult() sql.OkResult
+	// okResult returns the result of the statement, which raised the number of warnings given.
+	okResult(warnings int) sql.OkResult
+}
+
+// insertInfoType is the kind of info string in the OKResults of inserts.
+type insertInfoType byte
+
+const (
+	// noInsertInfo is for inserts of a single row of values, which only report an info string if they raised warnings.
+	noInsertInfo insertInfoType = iota
+	// insertInfo is for inserts of several rows of values or of the result of a query.
+	insertInfo
+	// loadDataInfo is for LOAD DATA statements.
+	loadDataInfo
+)
+
+// getInsertInfoType returns the kind of info string of the insert in the node given, based on where its rows come from.
+func getInsertInfoType(n sql.Node) insertInfoType {
+	var insert *InsertInto
+	Inspect(n, func(node sql.Node) bool {
+		if ii, ok := node.(*InsertInto); ok && insert == nil {
+			insert = ii
+		}
+		return insert == nil
+	})
+	if insert == nil {
+		return insertInfo
+	}
+
+	// The row source is wrapped in projections and BEFORE triggers, which keep it as their first child
+	source := insert.Source
+	for {
+		switch s := source.(type) {
+		case *LoadData:
+			return loadDataInfo
+		case *Values:
+			if len(s.ExpressionTuples) == 1 {
+				return noInsertInfo
+			}
+			return insertInfo
+		}
+
+		children := source.Children()
+		if len(children) == 0 {
+			return insertInfo
+		}
+		source = children[0]
+	}
+}
+
+// newInfo returns the info string of an insert of the records given. Duplicates are the records that replaced or
+// updated an existing row, or that were skipped in favor of it.
+func (t insertInfoType) newInfo(records, duplicates, warnings int) fmt.Stringer {
+	switch t {
+	case loadDataInfo:
+		return LoadDataInfo{Records: records, Skipped: duplicates, Warnings: warnings}
+	case noInsertInfo:
+		if warnings == 0 {
+			return nil
+		}
+	}
+	return InsertInfo{Records: records, Duplicates: duplicates, Warnings: warnings}
 }
 
 type insertRowHandler struct {
 	rowsAffected int
+	rowsIgnored  int
+	infoType     insertInfoType
 }
 
 func (i *insertRowHandler) handleRowUpdate(_ sql.Row) error {
@@ -88,14 +151,19 @@ func (i *insertRowHandler) handleRowUpdate(_ sql.Row) error {
 	return nil
 }
 
-func (i *insertRowHandler) okResult() sql.OkResult {
+func (i *insertRowHandler) okResult(warnings int) sql.OkResult {
 	// TODO: the auto inserted id should be in this result. Needs to be passed up by the insert iter, which is a larger
 	//  change.
-	return sql.NewOkResult(i.rowsAffected)
+	return sql.OkResult{
+		RowsAffected: uint64(i.rowsAffected),
+		Info:         i.infoType.newInfo(i.rowsAffected+i.rowsIgnored, i.rowsIgnored, warnings),
+	}
 }
 
 type replaceRowHandler struct {
 	rowsAffected int
+	rowsReplaced int
+	infoType     insertInfoType
 }
 
 func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
@@ -106,6 +174,7 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	for i := 0; i < len(row)/2; i++ {
 		if row[i] != nil {
 			r.rowsAffected++
+			r.rowsReplaced++
 			break
 		}
 	}
@@ -113,17 +182,25 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (r *replaceRowHandler) okResult() sql.OkResult {
-	return sql.NewOkResult(r.rowsAffected)
+func (r *replaceRowHandler) okResult(warnings int) sql.OkResult {
+	return sql.OkResult{
+		RowsAffected: uint64(r.rowsAffected),
+		Info:         r.infoType.newInfo(r.rowsAffected-r.rowsReplaced, r.rowsReplaced, warnings),
+	}
 }
 
 type onDuplicateUpdateHandler struct {
 	rowsAffected              int
+	rowsHandled               int
+	rowsUpdated               int
 	schema                    sql.Schema
 	clientFoundRowsCapability bool
+	infoType                  insertInfoType
 }
 
 func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
+	o.rowsHandled++
+
 	// See https://dev.mysql.com/doc/refman/8.0/en/insert-on-duplicate.html for row count semantics
 	// If a row was inserted, increment by 1
 	if len(row) == len(o.schema) {
@@ -139,25 +216,34 @@ func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
 			// Ig the CLIENT_FOUND_ROWS capabilities flag is set, increment by 1 if a row stays the same.
 			if o.clientFoundRowsCapability {
 				o.rowsAffected++
+				o.rowsUpdated++
 			}
 		} else {
 			o.rowsAffected += 2
+			o.rowsUpdated++
 		}
 	} else {
 		o.rowsAffected++
+		o.rowsUpdated++
 	}
 
 	return nil
 }
 
-func (o *onDuplicateUpdateHandler) okResult() sql.OkResult {
-	return sql.NewOkResult(o.rowsAffected)
+func (o *onDuplicateUpdateHandler) okResult(warnings int) sql.OkResult {
+	return sql.OkResult{
+		RowsAffected: uint64(o.rowsAffected),
+		Info:         o.infoType.newInfo(o.rowsHandled, o.rowsUpdated, warnings),
+	}
 }
 
+// updateRowHandler handles row update count for UPDATEs of a single table. When the CLIENT_FOUND_ROWS capabilities
+// flag is set, the rows matched are reported as affected, changed or not.
 type updateRowHandler struct {
-	rowsMatched  int
-	rowsAffected int
-	schema       sql.Schema
+	rowsMatched               int
+	rowsAffected              int
+	schema                    sql.Schema
+	clientFoundRowsCapability bool
 }
 
 func (u *updateRowHandler) handleRowUpdate(row sql.Row) error {
@@ -174,24 +260,29 @@ func (u *updateRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (u *updateRowHandler) okResult() sql.OkResult {
+func (u *updateRowHandler) okResult(warnings int) sql.OkResult {
+	rowsAffected := u.rowsAffected
+	if u.clientFoundRowsCapability {
+		rowsAffected = u.rowsMatched
+	}
 	return sql.OkResult{
-		RowsAffected: uint64(u.rowsAffected),
+		RowsAffected: uint64(rowsAffected),
 		Info: UpdateInfo{
 			Matched:  u.rowsMatched,
 			Updated:  u.rowsAffected,
-			Warnings: 0,
+			Warnings: warnings,
 		},
 	}
 }
 
 // updateJoinRowHandler handles row update count for all UPDATEs that use a JOIN.
 type updateJoinRowHandler struct {
-	rowsMatched  int
-	rowsAffected int
-	joinSchema   sql.Schema
-	tableMap     map[string]sql.Schema // Needs to only be the tables that can be updated.
-	updaterMap   map[string]sql.RowUpdater
+	rowsMatched               int
+	rowsAffected              int
+	joinSchema                sql.Schema
+	tableMap                  map[string]sql.Schema // Needs to only be the tables that can be updated.
+	updaterMap                map[string]sql.RowUpdater
+	clientFoundRowsCapability bool
 }
 
 func (u *updateJoinRowHandler) handleRowUpdate(row sql.Row) error {
@@ -216,13 +307,17 @@ func (u *updateJoinRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (u *updateJoinRowHandler) okResult() sql.OkResult {
+func (u *updateJoinRowHandler) okResult(warnings int) sql.OkResult {
+	rowsAffected := u.rowsAffected
+	if u.clientFoundRowsCapability {
+		rowsAffected = u.rowsMatched
+	}
 	return sql.OkResult{
-		RowsAffected: uint64(u.rowsAffected),
+		RowsAffected: uint64(rowsAffected),
 		Info: UpdateInfo{
 			Matched:  u.rowsMatched,
 			Updated:  u.rowsAffected,
-			Warnings: 0,
+			Warnings: warnings,
 		},
 	}
 }
@@ -252,7 +347,7 @@ func (u *deleteRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (u *deleteRowHandler) okResult() sql.OkResult {
+func (u *deleteRowHandler) okResult(_ int) sql.OkResult {
 	return sql.NewOkResult(u.rowsAffected)
 }
 
@@ -260,6 +355,8 @@ type accumulatorIter struct {
 	iter             sql.RowIter
 	once             sync.Once
 	updateRowHandler accumulatorRowHandler
+	// warningsBefore is the number of warnings of the session before the statement ran
+	warningsBefore int
 }
 
 func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
@@ -282,7 +379,7 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 		}
 
 		if err == nil {
-			result := a.updateRowHandler.okResult()
+			result := a.updateRowHandler.okResult(a.warnings(ctx))
 			ctx.SetLastQueryInfo(sql.RowCount, int64(result.RowsAffected))
 
 			// For UPDATE, the affected-rows value is the number of rows “found”; that is, matched by the WHERE clause for FOUND_ROWS
@@ -298,8 +395,11 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 		_, isIg := err.(sql.ErrInsertIgnore)
 
 		if err == io.EOF {
-			return sql.NewRow(a.updateRowHandler.okResult()), nil
+			return sql.NewRow(a.updateRowHandler.okResult(a.warnings(ctx))), nil
 		} else if isIg {
+			if ih, ok := a.updateRowHandler.(*insertRowHandler); ok {
+				ih.rowsIgnored++
+			}
 			continue
 		} else if err != nil {
 			return nil, err
@@ -312,6 +412,15 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 	}
 }
 
+// warnings returns the number of warnings raised by the statement so far.
+func (a *accumulatorIter) warnings(ctx *sql.Context) int {
+	warnings := int(ctx.WarningCount()) - a.warningsBefore
+	if warnings < 0 {
+		return 0
+	}
+	return warnings
+}
+
 func (a *accumulatorIter) Close(ctx *sql.Context) error {
 	return nil
 }
@@ -322,20 +431,21 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 		return nil, err
 	}
 
+	clientFoundRowsToggled := (ctx.Client().Capabilities & mysql.CapabilityClientFoundRows) == mysql.CapabilityClientFoundRows
+
 	var rowHandler accumulatorRowHandler
 	switch r.RowUpdateType {
 	case UpdateTypeInsert:
-		rowHandler = &insertRowHandler{}
+		rowHandler = &insertRowHandler{infoType: getInsertInfoType(r.Child)}
 	case UpdateTypeReplace:
-		rowHandler = &replaceRowHandler{}
+		rowHandler = &replaceRowHandler{infoType: getInsertInfoType(r.Child)}
 	case UpdateTypeDuplicateKeyUpdate:
-		clientFoundRowsToggled := (ctx.Client().Capabilities & mysql.CapabilityClientFoundRows) == mysql.CapabilityClientFoundRows
-		rowHandler = &onDuplicateUpdateHandler{schema: r.Child.Schema(), clientFoundRowsCapability: clientFoundRowsToggled}
+		rowHandler = &onDuplicateUpdateHandler{schema: r.Child.Schema(), clientFoundRowsCapability: clientFoundRowsToggled, infoType: getInsertInfoType(r.Child)}
 	case UpdateTypeUpdate:
 		schema := r.Child.Schema()
 		// the schema of the update node is a self-concatenation of the underlying table's, so split it in half for new /
 		// old row comparison purposes
-		rowHandler = &updateRowHandler{schema: schema[:len(schema)/2]}
+		rowHandler = &updateRowHandler{schema: schema[:len(schema)/2], clientFoundRowsCapability: clientFoundRowsToggled}
 	case UpdateTypeDelete:
 		rowHandler = &deleteRowHandler{}
 	case UpdateTypeJoinUpdate:
@@ -358,7 +468,7 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 			return nil, fmt.Errorf("error: No JoinNode found in query plan to go along with an UpdateTypeJoinUpdate")
 		}
 
-		rowHandler = &updateJoinRowHandler{joinSchema: schema, tableMap: recreateTableSchemaFromJoinSchema(schema), updaterMap: updaterMap}
+		rowHandler = &updateJoinRowHandler{joinSchema: schema, tableMap: recreateTableSchemaFromJoinSchema(schema), updaterMap: updaterMap, clientFoundRowsCapability: clientFoundRowsToggled}
 	default:
 		panic(fmt.Sprintf("Unrecognized RowUpdateType %d", r.RowUpdateType))
 	}
@@ -366,5 +476,6 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 	return &accumulatorIter{
 		iter:             rowIter,
 		updateRowHandler: rowHandler,
+		warningsBefore:   int(ctx.WarningCount()),
 	}, nil
 }
@@ -0,0 +1,163 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SerializedPlanVersion is the version of the format of serialized plans. It's increased every time the format
+// changes in a way that older readers can't handle.
+const SerializedPlanVersion = 1
+
+// ErrUnsupportedPlanVersion is returned when importing a serialized plan with an unknown version.
+var ErrUnsupportedPlanVersion = errors.NewKind("unsupported serialized plan version %d, expected %d")
+
+// SerializedPlan is the stable, serializable description of an execution plan. It's meant to be stored, diffed and
+// inspected by tools: it describes every node and expression of the plan, but it can't be executed, since the
+// tables, indexes and functions it refers to only exist in the catalog the plan was analyzed with.
+type SerializedPlan struct {
+	Version int            `json:"version"`
+	Root    SerializedNode `json:"root"`
+}
+
+// SerializedNode is the description of a node of a SerializedPlan.
+type SerializedNode struct {
+	// Type is the Go type of the node, such as "plan.Project".
+	Type string `json:"type"`
+	// Description is the node as shown by EXPLAIN, without its children.
+	Description string `json:"description"`
+	// Schema is only set for resolved nodes.
+	Schema      []SerializedColumn     `json:"schema,omitempty"`
+	Expressions []SerializedExpression `json:"expressions,omitempty"`
+	Children    []SerializedNode       `json:"children,omitempty"`
+}
+
+// SerializedColumn is the description of a column of the schema of a SerializedNode.
+type SerializedColumn struct {
+	Name     string `json:"name"`
+	Source   string `json:"source,omitempty"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable,omitempty"`
+}
+
+// SerializedExpression is the description of an expression of a SerializedNode.
+type SerializedExpression struct {
+	// Type is the Go type of the expression, such as "expression.GetField".
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	// ValueType is the SQL type of the values of the expression, which is only set for resolved expressions.
+	ValueType string                 `json:"valueType,omitempty"`
+	Children  []SerializedExpression `json:"children,omitempty"`
+	// Subquery is the plan of the query of subquery expressions.
+	Subquery *SerializedNode `json:"subquery,omitempty"`
+}
+
+// SerializePlan returns the description of the plan given.
+func SerializePlan(n sql.Node) *SerializedPlan {
+	return &SerializedPlan{
+		Version: SerializedPlanVersion,
+		Root:    serializeNode(n),
+	}
+}
+
+// ExportPlan returns the plan given serialized as indented JSON, which is stable for equal plans.
+func ExportPlan(n sql.Node) ([]byte, error) {
+	return json.MarshalIndent(SerializePlan(n), "", "  ")
+}
+
+// ImportPlan returns the serialized plan in the JSON given, as returned by ExportPlan.
+func ImportPlan(data []byte) (*SerializedPlan, error) {
+	var p SerializedPlan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	if p.Version != SerializedPlanVersion {
+		return nil, ErrUnsupportedPlanVersion.New(p.Version, SerializedPlanVersion)
+	}
+	return &p, nil
+}
+
+func serializeNode(n sql.Node) SerializedNode {
+	sn := SerializedNode{
+		Type:        typeName(n),
+		Description: firstLine(n.String()),
+	}
+
+	if n.Resolved() {
+		for _, c := range n.Schema() {
+			sn.Schema = append(sn.Schema, SerializedColumn{
+				Name:     c.Name,
+				Source:   c.Source,
+				Type:     c.Type.String(),
+				Nullable: c.Nullable,
+			})
+		}
+	}
+
+	if e, ok := n.(sql.Expressioner); ok {
+		for _, expr := range e.Expressions() {
+			sn.Expressions = append(sn.Expressions, serializeExpression(expr))
+		}
+	}
+
+	for _, child := range n.Children() {
+		sn.Children = append(sn.Children, serializeNode(child))
+	}
+
+	return sn
+}
+
+func serializeExpression(e sql.Expression) SerializedExpression {
+	se := SerializedExpression{
+		Type:        typeName(e),
+		Description: e.String(),
+	}
+
+	if e.Resolved() {
+		se.ValueType = e.Type().String()
+	}
+
+	for _, child := range e.Children() {
+		se.Children = append(se.Children, serializeExpression(child))
+	}
+
+	if s, ok := e.(*Subquery); ok && s.Query != nil {
+		query := serializeNode(s.Query)
+		se.Subquery = &query
+	}
+
+	return se
+}
+
+// typeName returns the name of the type of the value given, without the pointer indirection.
+func typeName(v interface{}) string {
+	return strings.TrimPrefix(reflect.TypeOf(v).String(), "*")
+}
+
+// firstLine returns the first line of the string given, which is the node itself in the tree representation of a
+// node.
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
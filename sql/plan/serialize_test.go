@@ -0,0 +1,99 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestExportPlan(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "test"},
+		{Name: "s", Type: sql.Text, Source: "test", Nullable: true},
+	}))
+	node := NewProject(
+		[]sql.Expression{expression.NewGetFieldWithTable(1, sql.Text, "test", "s", true)},
+		NewFilter(
+			expression.NewEquals(
+				expression.NewGetFieldWithTable(0, sql.Int64, "test", "i", false),
+				expression.NewLiteral(int8(1), sql.Int8),
+			),
+			NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	expected := &SerializedPlan{
+		Version: SerializedPlanVersion,
+		Root: SerializedNode{
+			Type:        "plan.Project",
+			Description: "Project(test.s)",
+			Schema:      []SerializedColumn{{Name: "s", Source: "test", Type: "TEXT", Nullable: true}},
+			Expressions: []SerializedExpression{
+				{Type: "expression.GetField", Description: "test.s", ValueType: "TEXT"},
+			},
+			Children: []SerializedNode{{
+				Type:        "plan.Filter",
+				Description: "Filter(test.i = 1)",
+				Schema: []SerializedColumn{
+					{Name: "i", Source: "test", Type: "BIGINT"},
+					{Name: "s", Source: "test", Type: "TEXT", Nullable: true},
+				},
+				Expressions: []SerializedExpression{{
+					Type:        "expression.Equals",
+					Description: "(test.i = 1)",
+					ValueType:   "TINYINT",
+					Children: []SerializedExpression{
+						{Type: "expression.GetField", Description: "test.i", ValueType: "BIGINT"},
+						{Type: "expression.Literal", Description: "1", ValueType: "TINYINT"},
+					},
+				}},
+				Children: []SerializedNode{{
+					Type:        "plan.ResolvedTable",
+					Description: "Table(test)",
+					Schema: []SerializedColumn{
+						{Name: "i", Source: "test", Type: "BIGINT"},
+						{Name: "s", Source: "test", Type: "TEXT", Nullable: true},
+					},
+				}},
+			}},
+		},
+	}
+	require.Equal(expected, SerializePlan(node))
+
+	data, err := ExportPlan(node)
+	require.NoError(err)
+	imported, err := ImportPlan(data)
+	require.NoError(err)
+	require.Equal(expected, imported)
+
+	// Unresolved nodes and expressions have no types
+	unresolved := SerializePlan(NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("a")},
+		NewUnresolvedTable("t", ""),
+	))
+	require.Nil(unresolved.Root.Schema)
+	require.Equal("", unresolved.Root.Expressions[0].ValueType)
+
+	_, err = ImportPlan([]byte(`{"version": 2, "root": {}}`))
+	require.True(ErrUnsupportedPlanVersion.Is(err))
+}
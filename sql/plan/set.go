@@ -109,7 +109,8 @@ func (s *Set) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 	var resultRow sql.Row
 	if len(updateExprs) > 0 {
-		newRow, err := applyUpdateExpressions(ctx, updateExprs, row)
+		// The fields set are the ones of the single row a trigger runs for
+		newRow, err := applyUpdateExpressions(ctx, updateExprs, row, 1)
 		if err != nil {
 			return nil, err
 		}
@@ -160,11 +160,11 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 	schema := table.Schema()
 	colStmts := make([]string, len(schema))
 	var primaryKeyCols []string
+	collation := sql.TableCollation(table)
 
 	// Statement creation parts for each column
-	// TODO: rather than lower-casing here, we should do it in the String() method of types
 	for i, col := range schema {
-		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(col.Type.String()))
+		stmt := fmt.Sprintf("  `%s` %s%s", col.Name, col.TypeDefinition(), col.TableCollationClause(collation))
 
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
@@ -179,8 +179,12 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, col.Default.String())
 		}
 
+		if col.OnUpdate != nil {
+			stmt = fmt.Sprintf("%s ON UPDATE %s", stmt, col.OnUpdate.String())
+		}
+
 		if col.Comment != "" {
-			stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, col.Comment)
+			stmt = fmt.Sprintf("%s COMMENT %s", stmt, quoteString(col.Comment))
 		}
 
 		if col.PrimaryKey {
@@ -218,7 +222,7 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 
 		key := fmt.Sprintf("  %sKEY `%s` (%s)", unique, index.ID(), strings.Join(indexCols, ","))
 		if index.Comment() != "" {
-			key = fmt.Sprintf("%s COMMENT '%s'", key, index.Comment())
+			key = fmt.Sprintf("%s COMMENT %s", key, quoteString(index.Comment()))
 		}
 
 		colStmts = append(colStmts, key)
@@ -257,10 +261,17 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
+	// The default collation isn't the default of its character set, but it's left out like it always has been
+	tableOptions := "DEFAULT CHARSET=" + collation.CharacterSet().String()
+	if !collation.Equals(sql.Collation_Default) && !collation.Equals(collation.CharacterSet().DefaultCollation()) {
+		tableOptions += " COLLATE=" + collation.String()
+	}
+
 	return fmt.Sprintf(
-		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB %s",
 		table.Name(),
 		strings.Join(colStmts, ",\n"),
+		tableOptions,
 	), nil
 }
 
@@ -284,6 +295,11 @@ func quoteIdentifiers(ids []string) []string {
 	return quoted
 }
 
+// quoteString returns the string given as a single-quoted string literal, escaping the quotes it contains.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 // isPrimaryKeyIndex returns whether the index given matches the table's primary key columns. Order is not considered.
 func isPrimaryKeyIndex(index sql.Index, table sql.Table) bool {
 	var pks []*sql.Column
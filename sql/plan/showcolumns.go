@@ -16,7 +16,6 @@ package plan
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -75,8 +74,8 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	for i, col := range schema {
 		var row sql.Row
 		var collation interface{}
-		if sql.IsTextOnly(col.Type) {
-			collation = sql.Collation_Default.String()
+		if coll, ok := col.Collation(); ok {
+			collation = coll.String()
 		}
 
 		var null = "NO"
@@ -109,11 +108,10 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 			defaultVal = col.Default.String()
 		}
 
-		// TODO: rather than lower-casing here, we should lower-case the String() method of types
 		if s.Full {
 			row = sql.Row{
 				col.Name,
-				strings.ToLower(col.Type.String()),
+				col.TypeDefinition(),
 				collation,
 				null,
 				key,
@@ -125,7 +123,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		} else {
 			row = sql.Row{
 				col.Name,
-				strings.ToLower(col.Type.String()),
+				col.TypeDefinition(),
 				null,
 				key,
 				defaultVal,
@@ -17,6 +17,7 @@ package plan_test
 import (
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -146,6 +147,8 @@ func TestShowColumnsFull(t *testing.T) {
 		{Name: "a", Type: sql.Text, PrimaryKey: true},
 		{Name: "b", Type: sql.Int64, Nullable: true},
 		{Name: "c", Type: sql.Int64, Default: parse.MustStringToColumnDefaultValue(ctx, "1", sql.Int64, false), Comment: "a comment"},
+		{Name: "d", Type: sql.MustCreateString(sqltypes.VarChar, 20, sql.Collation_latin1_swedish_ci), Nullable: true},
+		{Name: "e", Type: sql.MustCreateBinary(sqltypes.VarBinary, 20), Nullable: true},
 	})), nil, nil)
 
 	iter, err := NewShowColumns(true, table).RowIter(ctx, nil)
@@ -158,6 +161,8 @@ func TestShowColumnsFull(t *testing.T) {
 		{"a", "text", "utf8mb4_0900_bin", "NO", "PRI", "", "", "", ""},
 		{"b", "bigint", nil, "YES", "", "", "", "", ""},
 		{"c", "bigint", nil, "NO", "", "1", "", "", "a comment"},
+		{"d", "varchar(20)", "latin1_swedish_ci", "YES", "", "", "", "", ""},
+		{"e", "varbinary(20)", nil, "YES", "", "", "", "", ""},
 	}
 
 	require.Equal(expected, rows)
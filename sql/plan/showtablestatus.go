@@ -16,6 +16,7 @@ package plan
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -45,23 +46,23 @@ func (s *ShowTableStatus) WithDatabase(db sql.Database) (sql.Node, error) {
 
 var showTableStatusSchema = sql.Schema{
 	{Name: "Name", Type: sql.LongText},
-	{Name: "Engine", Type: sql.LongText},
-	{Name: "Version", Type: sql.LongText},
-	{Name: "Row_format", Type: sql.LongText},
-	{Name: "Rows", Type: sql.Uint64},
-	{Name: "Avg_row_length", Type: sql.Uint64},
-	{Name: "Data_length", Type: sql.Uint64},
-	{Name: "Max_data_length", Type: sql.Uint64},
-	{Name: "Index_length", Type: sql.Int64},
-	{Name: "Data_free", Type: sql.Int64},
-	{Name: "Auto_increment", Type: sql.Int64},
+	{Name: "Engine", Type: sql.LongText, Nullable: true},
+	{Name: "Version", Type: sql.LongText, Nullable: true},
+	{Name: "Row_format", Type: sql.LongText, Nullable: true},
+	{Name: "Rows", Type: sql.Uint64, Nullable: true},
+	{Name: "Avg_row_length", Type: sql.Uint64, Nullable: true},
+	{Name: "Data_length", Type: sql.Uint64, Nullable: true},
+	{Name: "Max_data_length", Type: sql.Uint64, Nullable: true},
+	{Name: "Index_length", Type: sql.Int64, Nullable: true},
+	{Name: "Data_free", Type: sql.Int64, Nullable: true},
+	{Name: "Auto_increment", Type: sql.Int64, Nullable: true},
 	{Name: "Create_time", Type: sql.Datetime, Nullable: true},
 	{Name: "Update_time", Type: sql.Datetime, Nullable: true},
 	{Name: "Check_time", Type: sql.Datetime, Nullable: true},
-	{Name: "Collation", Type: sql.LongText},
+	{Name: "Collation", Type: sql.LongText, Nullable: true},
 	{Name: "Checksum", Type: sql.LongText, Nullable: true},
 	{Name: "Create_options", Type: sql.LongText, Nullable: true},
-	{Name: "Comments", Type: sql.LongText, Nullable: true},
+	{Name: "Comment", Type: sql.LongText, Nullable: true},
 }
 
 // Children implements the sql.Node interface.
@@ -80,7 +81,7 @@ func (s *ShowTableStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 		return nil, err
 	}
 
-	var rows = make([]sql.Row, len(tables))
+	var rows = make([]sql.Row, len(tables), len(tables)+1)
 
 	for i, tName := range tables {
 		table, _, err := s.Catalog.Table(ctx, s.db.Name(), tName)
@@ -107,9 +108,27 @@ func (s *ShowTableStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			return nil, err
 		}
 
-		rows[i] = tableToStatusRow(tName, numRows, nextAIVal, dataLength)
+		rows[i] = tableToStatusRow(tName, numRows, nextAIVal, dataLength, sql.TableCollation(table))
 	}
 
+	if vdb, ok := s.db.(sql.ViewDatabase); ok {
+		views, err := vdb.AllViews(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, view := range views {
+			rows = append(rows, viewToStatusRow(view.Name))
+		}
+	}
+
+	for _, view := range ctx.GetViewRegistry().ViewsInDatabase(s.db.Name()) {
+		rows = append(rows, viewToStatusRow(view.Name()))
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -173,7 +192,7 @@ func sqlValToInt64(val interface{}) int64 {
 }
 
 // cc here: https://dev.mysql.com/doc/refman/8.0/en/show-table-status.html
-func tableToStatusRow(table string, numRows uint64, nextAIVal interface{}, dataLength uint64) sql.Row {
+func tableToStatusRow(table string, numRows uint64, nextAIVal interface{}, dataLength uint64, collation sql.Collation) sql.Row {
 	var avgLength uint64 = 0
 	if numRows > 0 {
 		avgLength = dataLength / numRows
@@ -184,21 +203,29 @@ func tableToStatusRow(table string, numRows uint64, nextAIVal interface{}, dataL
 		// This column is unused. With the removal of .frm files in MySQL 8.0, this
 		// column now reports a hardcoded value of 10, which is the last .frm file
 		// version used in MySQL 5.7.
-		"10",                           // Version
-		"Fixed",                        // Row_format
-		numRows,                        // Rows
-		avgLength,                      // Avg_row_length
-		dataLength,                     // Data_length
-		uint64(0),                      // Max_data_length (Unused for InnoDB)
-		int64(0),                       // Index_length
-		int64(0),                       // Data_free
-		nextAIVal,                      // Auto_increment
-		nil,                            // Create_time
-		nil,                            // Update_time
-		nil,                            // Check_time
-		sql.Collation_Default.String(), // Collation
-		nil,                            // Checksum
-		nil,                            // Create_options
-		nil,                            // Comments
+		"10",               // Version
+		"Fixed",            // Row_format
+		numRows,            // Rows
+		avgLength,          // Avg_row_length
+		dataLength,         // Data_length
+		uint64(0),          // Max_data_length (Unused for InnoDB)
+		int64(0),           // Index_length
+		int64(0),           // Data_free
+		nextAIVal,          // Auto_increment
+		nil,                // Create_time
+		nil,                // Update_time
+		nil,                // Check_time
+		collation.String(), // Collation
+		nil,                // Checksum
+		nil,                // Create_options
+		"",                 // Comment
 	)
 }
+
+// viewToStatusRow returns the status row of the view given. Like MySQL, views only report their name.
+func viewToStatusRow(view string) sql.Row {
+	row := make(sql.Row, len(showTableStatusSchema))
+	row[0] = view
+	row[len(row)-1] = "VIEW"
+	return row
+}
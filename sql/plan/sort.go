@@ -67,6 +67,14 @@ func (s *Sort) String() string {
 	var fields = make([]string, len(s.SortFields))
 	for i, f := range s.SortFields {
 		fields[i] = fmt.Sprintf("%s %s", f.Column, f.Order)
+		if f.NullOrdering == sql.NullsLast {
+			// NullOrdering applies to the ascending order, which descending sorts reverse.
+			if f.Order == sql.Descending {
+				fields[i] += " NULLS FIRST"
+			} else {
+				fields[i] += " NULLS LAST"
+			}
+		}
 	}
 	_ = pr.WriteNode("Sort(%s)", strings.Join(fields, ", "))
 	_ = pr.WriteChildren(s.Child.String())
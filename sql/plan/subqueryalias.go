@@ -24,15 +24,20 @@ type SubqueryAlias struct {
 	Columns        []string
 	name           string
 	TextDefinition string
+	// schema is the schema of the resolved child, computed once since it's asked for many times during analysis
+	// and execution. It is reset whenever the child, the name or the columns change.
+	schema sql.Schema
 }
 
 // NewSubqueryAlias creates a new SubqueryAlias node.
 func NewSubqueryAlias(name, textDefinition string, node sql.Node) *SubqueryAlias {
-	return &SubqueryAlias{
+	sq := &SubqueryAlias{
 		UnaryNode:      UnaryNode{Child: node},
 		name:           name,
 		TextDefinition: textDefinition,
 	}
+	sq.cacheSchema()
+	return sq
 }
 
 // Returns the view wrapper for this subquery
@@ -45,6 +50,22 @@ func (sq *SubqueryAlias) Name() string { return sq.name }
 
 // Schema implements the Node interface.
 func (sq *SubqueryAlias) Schema() sql.Schema {
+	if sq.schema != nil {
+		return sq.schema
+	}
+	return sq.computeSchema()
+}
+
+// cacheSchema computes the schema of this node once its child is resolved, so it can be reused by all the calls to
+// Schema. Nodes are never modified after they're created, so this must only be called on new nodes.
+func (sq *SubqueryAlias) cacheSchema() {
+	sq.schema = nil
+	if sq.Child != nil && sq.Child.Resolved() {
+		sq.schema = sq.computeSchema()
+	}
+}
+
+func (sq *SubqueryAlias) computeSchema() sql.Schema {
 	childSchema := sq.Child.Schema()
 	schema := make(sql.Schema, len(childSchema))
 	for i, col := range childSchema {
@@ -80,11 +101,13 @@ func (sq *SubqueryAlias) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 	nn := *sq
 	nn.Child = children[0]
+	nn.cacheSchema()
 	return &nn, nil
 }
 
 func (sq SubqueryAlias) WithName(name string) *SubqueryAlias {
 	sq.name = name
+	sq.cacheSchema()
 	return &sq
 }
 
@@ -109,5 +132,6 @@ func (sq SubqueryAlias) DebugString() string {
 
 func (sq SubqueryAlias) WithColumns(columns []string) *SubqueryAlias {
 	sq.Columns = columns
+	sq.cacheSchema()
 	return &sq
 }
@@ -52,3 +52,46 @@ func TestSubqueryAliasSchema(t *testing.T) {
 		NewSubqueryAlias("alias", "", subquery).Schema(),
 	)
 }
+
+func TestSubqueryAliasSchemaCache(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "foo", Type: sql.Text, Nullable: false, Source: "bar"},
+		{Name: "baz", Type: sql.Int64, Nullable: false, Source: "bar"},
+	}))
+
+	subquery := NewProject(
+		[]sql.Expression{
+			expression.NewGetField(0, sql.Text, "foo", false),
+		},
+		NewResolvedTable(table, nil, nil),
+	)
+
+	sq := NewSubqueryAlias("alias", "", subquery)
+	schema := sq.Schema()
+	require.Equal(sql.Schema{{Name: "foo", Type: sql.Text, Source: "alias"}}, schema)
+	require.Same(schema[0], sq.Schema()[0])
+
+	// The cached schema must not outlive the child, name or columns it was computed from
+	newChild := NewProject(
+		[]sql.Expression{
+			expression.NewGetField(1, sql.Int64, "baz", false),
+		},
+		NewResolvedTable(table, nil, nil),
+	)
+	n, err := sq.WithChildren(newChild)
+	require.NoError(err)
+	require.Equal(sql.Schema{{Name: "baz", Type: sql.Int64, Source: "alias"}}, n.Schema())
+	require.Equal(sql.Schema{{Name: "foo", Type: sql.Text, Source: "alias"}}, sq.Schema())
+
+	require.Equal(sql.Schema{{Name: "foo", Type: sql.Text, Source: "other"}}, sq.WithName("other").Schema())
+	require.Equal(sql.Schema{{Name: "x", Type: sql.Text, Source: "alias"}}, sq.WithColumns([]string{"x"}).Schema())
+
+	// Unresolved children aren't cached, since their schema isn't known yet
+	unresolved := NewSubqueryAlias("alias", "", NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+		NewResolvedTable(table, nil, nil),
+	))
+	require.Nil(unresolved.schema)
+}
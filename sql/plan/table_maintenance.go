@@ -0,0 +1,163 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableMaintenanceOp is the operation run by a TableMaintenance node.
+type TableMaintenanceOp string
+
+const (
+	// TableMaintenanceOptimize is the operation of OPTIMIZE TABLE.
+	TableMaintenanceOptimize TableMaintenanceOp = "optimize"
+	// TableMaintenanceRepair is the operation of REPAIR TABLE.
+	TableMaintenanceRepair TableMaintenanceOp = "repair"
+)
+
+// TableMaintenance runs OPTIMIZE TABLE or REPAIR TABLE on a list of tables. The operation is delegated to the tables
+// implementing sql.MaintainableTable, and the outcome for each table is returned as rows in the format of MySQL.
+type TableMaintenance struct {
+	Op     TableMaintenanceOp
+	Tables []sql.Node
+}
+
+var _ sql.Node = (*TableMaintenance)(nil)
+
+var tableMaintenanceSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Op", Type: sql.LongText},
+	{Name: "Msg_type", Type: sql.LongText},
+	{Name: "Msg_text", Type: sql.LongText},
+}
+
+// NewTableMaintenance creates a new TableMaintenance node running the operation given on the tables given.
+func NewTableMaintenance(op TableMaintenanceOp, tables []sql.Node) *TableMaintenance {
+	return &TableMaintenance{Op: op, Tables: tables}
+}
+
+// Resolved implements the sql.Node interface.
+func (t *TableMaintenance) Resolved() bool {
+	for _, table := range t.Tables {
+		if !table.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema implements the sql.Node interface.
+func (t *TableMaintenance) Schema() sql.Schema {
+	return tableMaintenanceSchema
+}
+
+// Children implements the sql.Node interface.
+func (t *TableMaintenance) Children() []sql.Node {
+	return t.Tables
+}
+
+// WithChildren implements the sql.Node interface.
+func (t *TableMaintenance) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(t.Tables) {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), len(t.Tables))
+	}
+	nt := *t
+	nt.Tables = children
+	return &nt, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (t *TableMaintenance) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	for _, node := range t.Tables {
+		rt := maintenanceResolvedTable(node)
+		if rt == nil {
+			return nil, sql.ErrInvalidChildType.New(t, node, rt)
+		}
+
+		name := rt.Name()
+		if rt.Database != nil {
+			name = rt.Database.Name() + "." + name
+		}
+
+		table, ok := getMaintainableTable(rt.Table)
+		if !ok {
+			rows = append(rows, t.row(name, "note", fmt.Sprintf("The storage engine for the table doesn't support %s", t.Op)))
+			continue
+		}
+
+		var err error
+		switch t.Op {
+		case TableMaintenanceOptimize:
+			err = table.Optimize(ctx)
+		case TableMaintenanceRepair:
+			err = table.Repair(ctx)
+		default:
+			return nil, sql.ErrUnsupportedFeature.New(string(t.Op))
+		}
+
+		if err != nil {
+			rows = append(rows, t.row(name, "error", err.Error()), t.row(name, "status", "Operation failed"))
+		} else {
+			rows = append(rows, t.row(name, "status", "OK"))
+		}
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (t *TableMaintenance) row(table, msgType, msgText string) sql.Row {
+	return sql.NewRow(table, string(t.Op), msgType, msgText)
+}
+
+func (t *TableMaintenance) String() string {
+	tables := make([]string, len(t.Tables))
+	for i, table := range t.Tables {
+		tables[i] = table.String()
+	}
+
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%s TABLE", strings.ToUpper(string(t.Op)))
+	_ = pr.WriteChildren(tables...)
+	return pr.String()
+}
+
+// maintenanceResolvedTable returns the table of the node given, which may have been wrapped during analysis.
+func maintenanceResolvedTable(node sql.Node) *ResolvedTable {
+	var rt *ResolvedTable
+	Inspect(node, func(node sql.Node) bool {
+		if t, ok := node.(*ResolvedTable); ok {
+			rt = t
+			return false
+		}
+		return rt == nil
+	})
+	return rt
+}
+
+func getMaintainableTable(t sql.Table) (sql.MaintainableTable, bool) {
+	switch t := t.(type) {
+	case sql.MaintainableTable:
+		return t, true
+	case sql.TableWrapper:
+		return getMaintainableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
@@ -137,11 +137,12 @@ func (ui UpdateInfo) String() string {
 }
 
 type updateIter struct {
-	childIter sql.RowIter
-	schema    sql.Schema
-	updater   sql.RowUpdater
-	checks    sql.CheckConstraints
-	closed    bool
+	childIter  sql.RowIter
+	schema     sql.Schema
+	updater    sql.RowUpdater
+	checks     sql.CheckConstraints
+	timestamps *sql.TimestampConverter
+	closed     bool
 }
 
 func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -170,7 +171,7 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				}
 			}
 
-			err = u.updater.Update(ctx, oldRow, newRow)
+			err = u.updater.Update(ctx, u.timestamps.ToUTC(oldRow), u.timestamps.ToUTC(newRow))
 			if err != nil {
 				return nil, err
 			}
@@ -182,12 +183,26 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return oldAndNewRow, nil
 }
 
-// Applies the update expressions given to the row given, returning the new resultant row.
+// Applies the update expressions given to the row given, returning the new resultant row. Outside strict mode, numbers
+// out of the range of the columns they're assigned to are clamped with a warning, as for inserts. The row number is the
+// one of the row being updated, starting at 1, for warnings.
 // TODO: a set of update expressions should probably be its own expression type with an Eval method that does this
-func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row sql.Row) (sql.Row, error) {
+func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row sql.Row, rowNumber int) (sql.Row, error) {
 	var ok bool
 	prev := row
 	for _, updateExpr := range updateExprs {
+		if setField, isSetField := updateExpr.(*expression.SetField); isSetField && !ctx.IsStrictMode() {
+			updated, clamped, err := setField.EvalClamping(ctx, prev)
+			if err != nil {
+				return nil, err
+			}
+			if clamped {
+				ctx.Warn(1264, "Out of range value for column '%s' at row %d", setField.Left.(*expression.GetField).Name(), rowNumber) // TODO: Needs to be added to vitess
+			}
+			prev = updated
+			continue
+		}
+
 		val, err := updateExpr.Eval(ctx, prev)
 		if err != nil {
 			return nil, err
@@ -200,6 +215,59 @@ func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row
 	return prev, nil
 }
 
+// applyOnUpdateValues sets the columns of the new row that have an ON UPDATE value to that value, if any other column
+// of the same table changed from the old row and the update expressions given don't assign the column. The update
+// expressions index into rows that hold |offset| values before the ones of the schema given.
+func applyOnUpdateValues(ctx *sql.Context, schema sql.Schema, updateExprs []sql.Expression, offset int, oldRow, newRow sql.Row) (sql.Row, error) {
+	assigned := make(map[int]bool)
+	for _, updateExpr := range updateExprs {
+		if setField, ok := updateExpr.(*expression.SetField); ok {
+			if gf, ok := setField.Left.(*expression.GetField); ok {
+				assigned[gf.Index()-offset] = true
+			}
+		}
+	}
+
+	var updated sql.Row
+	for i, col := range schema {
+		if col.OnUpdate == nil || assigned[i] {
+			continue
+		}
+
+		changed := false
+		for j, other := range schema {
+			if j == i || other.Source != col.Source {
+				continue
+			}
+			cmp, err := other.Type.Compare(oldRow[j], newRow[j])
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		val, err := col.OnUpdate.Eval(ctx, newRow)
+		if err != nil {
+			return nil, err
+		}
+		if updated == nil {
+			updated = newRow.Copy()
+		}
+		updated[i] = val
+	}
+
+	if updated == nil {
+		return newRow, nil
+	}
+	return updated, nil
+}
+
 func (u *updateIter) Close(ctx *sql.Context) error {
 	if !u.closed {
 		u.closed = true
@@ -216,12 +284,14 @@ func newUpdateIter(
 	schema sql.Schema,
 	updater sql.RowUpdater,
 	checks sql.CheckConstraints,
+	timestamps *sql.TimestampConverter,
 ) sql.RowIter {
 	return NewTableEditorIter(updater, &updateIter{
-		childIter: childIter,
-		updater:   updater,
-		schema:    schema,
-		checks:    checks,
+		childIter:  childIter,
+		updater:    updater,
+		schema:     schema,
+		checks:     checks,
+		timestamps: timestamps,
 	})
 }
 
@@ -231,6 +301,11 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
+	timestamps, err := sql.NewTimestampConverter(ctx, updatable.Schema())
+	if err != nil {
+		return nil, err
+	}
+
 	updater := updatable.Updater(ctx)
 
 	iter, err := u.Child.RowIter(ctx, row)
@@ -238,7 +313,7 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return newUpdateIter(iter, updatable.Schema(), updater, u.Checks), nil
+	return newUpdateIter(iter, updatable.Schema(), updater, u.Checks, timestamps), nil
 }
 
 // WithChildren implements the Node interface.
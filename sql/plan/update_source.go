@@ -92,6 +92,8 @@ type updateSourceIter struct {
 	childIter   sql.RowIter
 	updateExprs []sql.Expression
 	tableSchema sql.Schema
+	// rowNumber is the number of the row being updated, starting at 1, for warnings.
+	rowNumber int
 }
 
 func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -100,7 +102,8 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, err
 	}
 
-	newRow, err := applyUpdateExpressions(ctx, u.updateExprs, oldRow)
+	u.rowNumber++
+	newRow, err := applyUpdateExpressions(ctx, u.updateExprs, oldRow, u.rowNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +112,16 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 	// scope, which will be the first N values in the row.
 	// TODO: handle this in the analyzer instead?
 	expectedSchemaLen := len(u.tableSchema)
+	offset := 0
 	if expectedSchemaLen < len(oldRow) {
-		oldRow = oldRow[len(oldRow)-expectedSchemaLen:]
-		newRow = newRow[len(newRow)-expectedSchemaLen:]
+		offset = len(oldRow) - expectedSchemaLen
+		oldRow = oldRow[offset:]
+		newRow = newRow[offset:]
+	}
+
+	newRow, err = applyOnUpdateValues(ctx, u.tableSchema, u.updateExprs, offset, oldRow, newRow)
+	if err != nil {
+		return nil, err
 	}
 
 	return oldRow.Append(newRow), nil
@@ -162,6 +162,7 @@ func (i *windowIter) compute(ctx *sql.Context) error {
 	// case. This needs some work to look better.
 
 	var err error
+	var input []sql.Row
 	for j, expr := range i.selectExprs {
 		i.buffers[j], err = newBuffer(expr)
 		if err != nil {
@@ -204,12 +205,43 @@ func (i *windowIter) compute(ctx *sql.Context) error {
 		}
 
 		i.rows = append(i.rows, outRow)
+		input = append(input, row)
 	}
 
+	return i.finish(ctx, input)
+}
+
+// finish finishes every window aggregation in this iterator. Aggregations that can accept an ordering of their input
+// computed elsewhere are grouped by window, and the input is sorted only once for each distinct window.
+func (i *windowIter) finish(ctx *sql.Context, input []sql.Row) error {
+	orders := make(map[string][]int)
 	for j, expr := range i.selectExprs {
-		if wa, ok := expr.(sql.WindowAggregation); ok {
-			err := wa.Finish(ctx, i.buffers[j])
-			if err != nil {
+		switch wa := expr.(type) {
+		case sql.OrderedWindowAggregation:
+			w := wa.Window()
+			if len(input) == 0 || w == nil || w.OrderBy == nil {
+				if err := wa.Finish(ctx, i.buffers[j]); err != nil {
+					return err
+				}
+				continue
+			}
+
+			key := sql.DebugString(w)
+			order, ok := orders[key]
+			if !ok {
+				var err error
+				order, err = expression.SortedIndexes(ctx, w.PartitionSortFields(), input)
+				if err != nil {
+					return err
+				}
+				orders[key] = order
+			}
+
+			if err := wa.FinishOrdered(ctx, i.buffers[j], order); err != nil {
+				return err
+			}
+		case sql.WindowAggregation:
+			if err := wa.Finish(ctx, i.buffers[j]); err != nil {
 				return err
 			}
 		}
@@ -61,6 +61,43 @@ func TestWindow(t *testing.T) {
 
 		require.Equal(t, res, []sql.Row{sql.NewRow(1, "a"), sql.NewRow(1, "b"), sql.NewRow(1, "c")})
 	})
+
+	t.Run("shared and distinct windows", func(t *testing.T) {
+		byA := sql.NewWindow(nil, sql.SortFields{
+			{Column: expression.NewGetField(0, sql.Int32, "a", false)},
+		})
+		byADesc := sql.NewWindow(nil, sql.SortFields{
+			{Column: expression.NewGetField(0, sql.Int32, "a", false), Order: sql.Descending},
+		})
+		wIter := &windowIter{
+			selectExprs: []sql.Expression{
+				mustExpr(window.NewRowNumber().(*window.RowNumber).WithWindow(byA)),
+				mustExpr(window.NewFirstValue(
+					expression.NewGetField(1, sql.TinyText, "b", false),
+				).(*window.FirstValue).WithWindow(byA)),
+				mustExpr(window.NewRowNumber().(*window.RowNumber).WithWindow(byADesc)),
+			},
+			childIter: newDummyIter(),
+		}
+
+		ctx := sql.NewEmptyContext()
+
+		res := make([]sql.Row, 0)
+		for {
+			r, err := wIter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			res = append(res, r)
+		}
+
+		require.Equal(t, []sql.Row{
+			sql.NewRow(2, "b", 2),
+			sql.NewRow(1, "b", 3),
+			sql.NewRow(3, "b", 1),
+		}, res)
+	})
 }
 
 type dummyIter struct {
@@ -56,14 +56,20 @@ type ProcessList interface {
 	// RemovePartitionProgress removes an existing partition tracking progress from the
 	// process with the given pid, if it exists.
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
+
+	// UpdateTableRowsEstimate sets the estimated number of rows to be read from the table
+	// with the given name for the process with the given pid. If the pid or the table does
+	// not exist, it will do nothing.
+	UpdateTableRowsEstimate(pid uint64, name string, rows int64)
 }
 
-// Process represents a process in the SQL server.
+// Process represents a process in the SQL server. Its tags are the tags of its query, as parsed by ParseQueryTags.
 type Process struct {
 	Pid        uint64
 	Connection uint32
 	User       string
 	Query      string
+	Tags       map[string]string
 	Progress   map[string]TableProgress
 	StartedAt  time.Time
 	Kill       context.CancelFunc
@@ -96,6 +102,9 @@ func (p Progress) totalString() string {
 type TableProgress struct {
 	Progress
 	PartitionsProgress map[string]PartitionProgress
+	// Rows is the number of rows read from all partitions of the table, out of the
+	// estimated number of rows of the table.
+	Rows Progress
 }
 
 func NewTableProgress(name string, total int64) TableProgress {
@@ -105,11 +114,28 @@ func NewTableProgress(name string, total int64) TableProgress {
 			Total: total,
 		},
 		PartitionsProgress: make(map[string]PartitionProgress),
+		Rows: Progress{
+			Name:  name,
+			Total: -1,
+		},
 	}
 }
 
 func (p TableProgress) String() string {
-	return fmt.Sprintf("%s (%d/%s partitions)", p.Name, p.Done, p.totalString())
+	if p.Rows.Done == 0 && p.Rows.Total <= 0 {
+		return fmt.Sprintf("%s (%d/%s partitions)", p.Name, p.Done, p.totalString())
+	}
+	return fmt.Sprintf("%s (%d/%s partitions, %d/%s rows)", p.Name, p.Done, p.totalString(), p.Rows.Done, p.Rows.totalString())
+}
+
+// Copy returns a copy of the progress that shares no state with it.
+func (p TableProgress) Copy() TableProgress {
+	partitions := make(map[string]PartitionProgress, len(p.PartitionsProgress))
+	for name, partition := range p.PartitionsProgress {
+		partitions[name] = partition
+	}
+	p.PartitionsProgress = partitions
+	return p
 }
 
 // PartitionProgress keeps track of a partition progress
@@ -145,3 +171,4 @@ func (e EmptyProcessList) AddPartitionProgress(pid uint64, tableName, partitionN
 }
 func (e EmptyProcessList) RemoveTableProgress(pid uint64, name string)                         {}
 func (e EmptyProcessList) RemovePartitionProgress(pid uint64, tableName, partitionName string) {}
+func (e EmptyProcessList) UpdateTableRowsEstimate(pid uint64, name string, rows int64)         {}
@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+// ParseQueryTags returns the tags of the query given, which clients attach to their queries so that the traffic of
+// different services can be told apart. Tags are given in comments made of comma-separated key:value pairs, such as
+// /* service:billing, route:invoices */. Other comments, including optimizer hints and the comments executed by
+// MySQL, aren't tags, and later tags override earlier ones with the same key. It returns nil if there are no tags.
+func ParseQueryTags(query string) map[string]string {
+	var tags map[string]string
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(query, i)
+		case c == '#' || strings.HasPrefix(query[i:], "-- "):
+			if end := strings.IndexByte(query[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(query)
+			}
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return tags
+			}
+			comment := query[i+2 : i+2+end]
+			i += end + 3
+			if strings.HasPrefix(comment, "!") || strings.HasPrefix(comment, "+") {
+				continue
+			}
+			if pairs, ok := parseQueryTagPairs(comment); ok {
+				if tags == nil {
+					tags = make(map[string]string, len(pairs))
+				}
+				for k, v := range pairs {
+					tags[k] = v
+				}
+			}
+		}
+	}
+	return tags
+}
+
+// skipQuoted returns the position of the quote ending the string or identifier starting at the position given.
+func skipQuoted(query string, start int) int {
+	quote := query[start]
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	return len(query)
+}
+
+// parseQueryTagPairs parses the key:value pairs of a comment, returning false if it isn't made of pairs only.
+func parseQueryTagPairs(comment string) (map[string]string, bool) {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(comment, ",") {
+		sep := strings.IndexByte(pair, ':')
+		if sep < 0 {
+			return nil, false
+		}
+		key, value := strings.TrimSpace(pair[:sep]), strings.TrimSpace(pair[sep+1:])
+		if key == "" || value == "" || strings.ContainsAny(key, " \t\r\n") {
+			return nil, false
+		}
+		pairs[key] = value
+	}
+	return pairs, true
+}
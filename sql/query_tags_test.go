@@ -0,0 +1,46 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryTags(t *testing.T) {
+	tests := []struct {
+		query string
+		tags  map[string]string
+	}{
+		{`SELECT 1`, nil},
+		{`/* service:billing */ SELECT 1`, map[string]string{"service": "billing"}},
+		{`SELECT 1 /*service:billing,route: /invoices/:id */`, map[string]string{"service": "billing", "route": "/invoices/:id"}},
+		{`/* service:a */ SELECT /* service:b, user:c */ 1`, map[string]string{"service": "b", "user": "c"}},
+		{`SELECT '/* service:billing */', "/* a:b */", ` + "`/* c:d */`" + ` FROM t`, nil},
+		{`SELECT 'it\'s /* a:b */' /* service:billing */`, map[string]string{"service": "billing"}},
+		{`SELECT 1 -- /* service:billing */` + "\n" + `/* route:x */`, map[string]string{"route": "x"}},
+		{`SELECT 1 # /* service:billing */`, nil},
+		{`SELECT /*+ SET_VAR(sort_buffer_size = 16M) */ /*!80000 a:b */ 1`, nil},
+		{`/* just a comment */ /* a:b, not a tag */ /* a: */ /* two words:x */ SELECT 1`, nil},
+		{`SELECT 1 /* service:billing`, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.tags, ParseQueryTags(test.query))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+	"math/rand"
+	"sync"
+)
+
+// Random is a source of random numbers for functions such as RAND() and UUID(). Implementations must be safe for
+// concurrent use, since the rows of a query can be evaluated concurrently.
+type Random interface {
+	io.Reader
+	// Float64 returns a number in [0.0, 1.0).
+	Float64() float64
+}
+
+// NewRandom returns a Random that returns the same sequence of numbers every time it's created with the same seed.
+func NewRandom(seed int64) Random {
+	return &seededRandom{r: rand.New(rand.NewSource(seed))}
+}
+
+type seededRandom struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+var _ Random = (*seededRandom)(nil)
+
+// Read implements the io.Reader interface.
+func (s *seededRandom) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.r.Read(p)
+}
+
+// Float64 implements the Random interface.
+func (s *seededRandom) Float64() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.r.Float64()
+}
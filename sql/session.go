@@ -135,6 +135,31 @@ type PersistableSession interface {
 	GetPersistedValue(k string) (interface{}, error)
 }
 
+// TableOverrideSession is a session that can replace tables of the catalog with session-local implementations, e.g.
+// to stub specific tables with fixtures in tests while the rest of the catalog stays real. Overrides only apply to the
+// queries of the session that sets them.
+type TableOverrideSession interface {
+	Session
+	// SetTableOverride makes the session use the table given in place of the table with the same name in the database
+	// named, whether that table exists or not.
+	SetTableOverride(dbName string, table Table)
+	// RemoveTableOverride removes the override of the table named in the database named, if any.
+	RemoveTableOverride(dbName, tableName string)
+	// TableOverride returns the table overriding the table named in the database named, if any.
+	TableOverride(dbName, tableName string) (Table, bool)
+}
+
+// HandlerSession is a session that keeps the tables opened with HANDLER ... OPEN until they are closed.
+type HandlerSession interface {
+	Session
+	// SetHandler registers the handler given under its name, replacing any previous one with the same name.
+	SetHandler(handler *TableHandler)
+	// RemoveHandler removes the handler named, if any.
+	RemoveHandler(name string)
+	// Handler returns the handler named, if any.
+	Handler(name string) (*TableHandler, bool)
+}
+
 // BaseSession is the basic session type.
 type BaseSession struct {
 	id     uint32
@@ -160,8 +185,21 @@ type BaseSession struct {
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	tableOverrides   map[string]map[string]Table
+	handlers         map[string]*TableHandler
+	initializedVars  map[string]bool
+}
+
+// SessionVariableInitializer is implemented by sessions whose system variables can have session values that differ
+// from the global ones whatever their scope, e.g. the version of the server or engine the session is used with.
+type SessionVariableInitializer interface {
+	// InitSessionVariable sets the session value of the system variable given, unless it was initialized already.
+	// Unlike SetSessionVariable, it also sets global and read-only variables.
+	InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error
 }
 
+var _ SessionVariableInitializer = (*BaseSession)(nil)
+
 func (s *BaseSession) GetLogger() *logrus.Entry {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -239,6 +277,29 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 	return nil
 }
 
+// InitSessionVariable implements the SessionVariableInitializer interface.
+func (s *BaseSession) InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error {
+	sysVar, _, ok := SystemVariables.GetGlobal(sysVarName)
+	if !ok {
+		return ErrUnknownSystemVariable.New(sysVarName)
+	}
+	convertedVal, err := sysVar.Type.Convert(value)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.initializedVars[sysVar.Name] {
+		return nil
+	}
+	if s.initializedVars == nil {
+		s.initializedVars = make(map[string]bool)
+	}
+	s.initializedVars[sysVar.Name] = true
+	s.systemVars[sysVar.Name] = convertedVal
+	return nil
+}
+
 // SetUserVariable implements the Session interface.
 func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interface{}) error {
 	s.mu.Lock()
@@ -408,6 +469,74 @@ func (s *BaseSession) SetViewRegistry(reg *ViewRegistry) {
 	s.viewReg = reg
 }
 
+var _ TableOverrideSession = (*BaseSession)(nil)
+
+// SetTableOverride implements the TableOverrideSession interface.
+func (s *BaseSession) SetTableOverride(dbName string, table Table) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dbName = strings.ToLower(dbName)
+	if s.tableOverrides == nil {
+		s.tableOverrides = make(map[string]map[string]Table)
+	}
+	if _, ok := s.tableOverrides[dbName]; !ok {
+		s.tableOverrides[dbName] = make(map[string]Table)
+	}
+	s.tableOverrides[dbName][strings.ToLower(table.Name())] = table
+}
+
+// RemoveTableOverride implements the TableOverrideSession interface.
+func (s *BaseSession) RemoveTableOverride(dbName, tableName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dbName = strings.ToLower(dbName)
+	delete(s.tableOverrides[dbName], strings.ToLower(tableName))
+	if len(s.tableOverrides[dbName]) == 0 {
+		delete(s.tableOverrides, dbName)
+	}
+}
+
+// TableOverride implements the TableOverrideSession interface.
+func (s *BaseSession) TableOverride(dbName, tableName string) (Table, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	table, ok := s.tableOverrides[strings.ToLower(dbName)][strings.ToLower(tableName)]
+	return table, ok
+}
+
+var _ HandlerSession = (*BaseSession)(nil)
+
+// SetHandler implements the HandlerSession interface.
+func (s *BaseSession) SetHandler(handler *TableHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.handlers == nil {
+		s.handlers = make(map[string]*TableHandler)
+	}
+	s.handlers[strings.ToLower(handler.Name)] = handler
+}
+
+// RemoveHandler implements the HandlerSession interface.
+func (s *BaseSession) RemoveHandler(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.handlers, strings.ToLower(name))
+}
+
+// Handler implements the HandlerSession interface.
+func (s *BaseSession) Handler(name string) (*TableHandler, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	handler, ok := s.handlers[strings.ToLower(name)]
+	return handler, ok
+}
+
 type (
 	// TypedValue is a value along with its type.
 	TypedValue struct {
@@ -532,12 +661,19 @@ type Context struct {
 	Session
 	Memory      *MemoryManager
 	ProcessList ProcessList
+	Workers     *WorkerPool
+	Account     string
 	services    Services
 	pid         uint64
 	query       string
+	queryTags   map[string]string
 	queryTime   time.Time
+	clock       func() time.Time
+	random      Random
 	tracer      opentracing.Tracer
 	rootSpan    opentracing.Span
+	metrics     *QueryMetrics
+	operator    *operatorMetrics
 }
 
 // ContextOption is a function to configure the context.
@@ -564,10 +700,11 @@ func WithPid(pid uint64) ContextOption {
 	}
 }
 
-// WithQuery adds the given query to the context.
+// WithQuery adds the given query to the context, along with its tags.
 func WithQuery(q string) ContextOption {
 	return func(ctx *Context) {
 		ctx.query = q
+		ctx.queryTags = ParseQueryTags(q)
 	}
 }
 
@@ -591,6 +728,22 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithWorkerPool sets the worker pool used to run background work for the
+// context. Without one, the work is not limited.
+func WithWorkerPool(p *WorkerPool) ContextOption {
+	return func(ctx *Context) {
+		ctx.Workers = p
+	}
+}
+
+// WithAccount sets the account the client was authenticated as, in the user@host form returned by CURRENT_USER().
+// Without one, the user and address of the client are used instead.
+func WithAccount(account string) ContextOption {
+	return func(ctx *Context) {
+		ctx.Account = account
+	}
+}
+
 // WithServices sets the services for the Context
 func WithServices(services Services) ContextOption {
 	return func(ctx *Context) {
@@ -598,6 +751,23 @@ func WithServices(services Services) ContextOption {
 	}
 }
 
+// WithClock sets the clock the context reads the current time from, instead of the system clock, and resets the
+// query time to the clock's current time.
+func WithClock(clock func() time.Time) ContextOption {
+	return func(ctx *Context) {
+		ctx.clock = clock
+		ctx.queryTime = clock()
+	}
+}
+
+// WithRandom sets the source of the random numbers returned by RAND() and UUID() for the context. Without one, they
+// aren't reproducible.
+func WithRandom(r Random) ContextOption {
+	return func(ctx *Context) {
+		ctx.random = r
+	}
+}
+
 var ctxNowFunc = time.Now
 var ctxNowFuncMutex = &sync.Mutex{}
 
@@ -646,6 +816,14 @@ func NewContext(
 	return c
 }
 
+// WithQueryMetrics collects the execution metrics of the plan operators of the queries run with the context in the
+// metrics given, as well as in the logs of their tracing spans.
+func WithQueryMetrics(m *QueryMetrics) ContextOption {
+	return func(ctx *Context) {
+		ctx.metrics = m
+	}
+}
+
 // Applys the options given to the context. Mostly for tests, not safe for use after construction of the context.
 func (c *Context) ApplyOpts(opts ...ContextOption) {
 	for _, opt := range opts {
@@ -664,14 +842,38 @@ func (c *Context) Query() string { return c.query }
 
 func (c Context) WithQuery(q string) *Context {
 	c.query = q
+	c.queryTags = ParseQueryTags(q)
 	return &c
 }
 
+// QueryTags returns the tags of the query associated with this context, as parsed by ParseQueryTags. They must not
+// be modified.
+func (c *Context) QueryTags() map[string]string { return c.queryTags }
+
 // QueryTime returns the time.Time when the context associated with this query was created
 func (c *Context) QueryTime() time.Time {
 	return c.queryTime
 }
 
+// Now returns the current time according to the clock of the context, which is the system clock unless one was set
+// with WithClock.
+func (c *Context) Now() time.Time {
+	if c.clock != nil {
+		return c.clock()
+	}
+	return ctxNowFunc()
+}
+
+// QueryMetrics returns the metrics set with WithQueryMetrics, or nil if there are none.
+func (c *Context) QueryMetrics() *QueryMetrics {
+	return c.metrics
+}
+
+// Random returns the source of random numbers set with WithRandom, or nil if there's none.
+func (c *Context) Random() Random {
+	return c.random
+}
+
 // Span creates a new tracing span with the given context.
 // It will return the span and a new context that should be passed to all
 // children of this span.
@@ -686,9 +888,24 @@ func (c *Context) Span(
 	span := c.tracer.StartSpan(opName, opts...)
 	ctx := opentracing.ContextWithSpan(c.Context, span)
 
+	if c.metrics != nil {
+		op := &operatorMetrics{name: opName, parent: c.operator}
+		nc := c.withOperator(op)
+		nc.Context = ctx
+		return &operatorSpan{Span: span, op: op, metrics: c.metrics}, nc
+	}
+
 	return span, c.WithContext(ctx)
 }
 
+// withOperator returns a new context for running the plan operator given, which accounts for the memory of its caches.
+func (c *Context) withOperator(op *operatorMetrics) *Context {
+	nc := *c
+	nc.operator = op
+	nc.Memory = c.Memory.forOperator(op)
+	return &nc
+}
+
 // NewSubContext creates a new sub-context with the current context as parent. Returns the resulting context.CancelFunc
 // as well as the new *sql.Context, which be used to cancel the new context before the parent is finished.
 func (c *Context) NewSubContext() (*Context, context.CancelFunc) {
@@ -732,6 +949,39 @@ func (c *Context) Warn(code int, msg string, args ...interface{}) {
 	})
 }
 
+// IsStrictMode returns whether the sql_mode of the session enables STRICT_TRANS_TABLES or STRICT_ALL_TABLES, in which
+// case invalid values given to INSERT statements are errors instead of being adjusted with a warning.
+func (c *Context) IsStrictMode() bool {
+	modes, ok := c.sqlModes()
+	if !ok {
+		return true
+	}
+	return modes["STRICT_TRANS_TABLES"] || modes["STRICT_ALL_TABLES"]
+}
+
+// IsSQLModeEnabled returns whether the sql_mode of the session enables the mode given, such as NO_ZERO_DATE.
+func (c *Context) IsSQLModeEnabled(mode string) bool {
+	modes, _ := c.sqlModes()
+	return modes[strings.ToUpper(mode)]
+}
+
+// sqlModes returns the set of modes enabled by the sql_mode of the session, and whether it could be read.
+func (c *Context) sqlModes() (map[string]bool, bool) {
+	val, err := c.GetSessionVariable(c, "sql_mode")
+	if err != nil {
+		return nil, false
+	}
+	mode, ok := val.(string)
+	if !ok {
+		return nil, false
+	}
+	modes := make(map[string]bool)
+	for _, m := range strings.Split(strings.ToUpper(mode), ",") {
+		modes[m] = true
+	}
+	return modes, true
+}
+
 // Terminate the connection associated with |connID|.
 func (c *Context) KillConnection(connID uint32) error {
 	if c.services.KillConnection != nil {
@@ -767,17 +1017,24 @@ type Services struct {
 	LoadInfile     func(filename string) (io.ReadCloser, error)
 }
 
-// NewSpanIter creates a RowIter executed in the given span.
-// Currently inactive, returns the iter returned unaltered.
+// NewSpanIter creates a RowIter executed in the given span. If the span was started with a context with query
+// metrics, the iterator is registered as a plan operator and its metrics are collected.
 func NewSpanIter(span opentracing.Span, iter RowIter) RowIter {
+	var op *operatorMetrics
+	if os, ok := span.(*operatorSpan); ok {
+		op = os.op
+		os.metrics.register(op)
+	}
+
 	// In the default, non traced case, we should not bother with
 	// collecting the timings below.
-	if (span.Tracer() == opentracing.NoopTracer{}) {
+	if (span.Tracer() == opentracing.NoopTracer{}) && op == nil {
 		return iter
 	} else {
 		return &spanIter{
 			span: span,
 			iter: iter,
+			op:   op,
 		}
 	}
 }
@@ -790,9 +1047,15 @@ type spanIter struct {
 	min   time.Duration
 	total time.Duration
 	done  bool
+	// the metrics of the operator, if collected, along with those of its parent operator and the last context it was
+	// run with, which are looked up on the first call to Next
+	op       *operatorMetrics
+	parentOp *operatorMetrics
+	ctx      *Context
+	opCtx    *Context
 }
 
-func (i *spanIter) updateTimings(start time.Time) {
+func (i *spanIter) updateTimings(start time.Time) time.Duration {
 	elapsed := time.Since(start)
 	if i.max < elapsed {
 		i.max = elapsed
@@ -803,24 +1066,45 @@ func (i *spanIter) updateTimings(start time.Time) {
 	}
 
 	i.total += elapsed
+	return elapsed
+}
+
+// operatorContext returns the context to run the operator with, given the one its Next method was called with.
+func (i *spanIter) operatorContext(ctx *Context) *Context {
+	if i.ctx != ctx {
+		if i.ctx == nil {
+			i.parentOp = i.op.parentOperator()
+		}
+		i.ctx = ctx
+		i.opCtx = ctx.withOperator(i.op)
+	}
+	return i.opCtx
 }
 
 func (i *spanIter) Next(ctx *Context) (Row, error) {
+	if i.op != nil {
+		ctx = i.operatorContext(ctx)
+	}
 	start := time.Now()
 
 	row, err := i.iter.Next(ctx)
-	if err == io.EOF {
-		i.finish()
-		return nil, err
-	}
-
 	if err != nil {
-		i.finishWithError(err)
+		if i.op != nil {
+			i.op.elapsed(i.parentOp, time.Since(start))
+		}
+		if err == io.EOF {
+			i.finish()
+		} else {
+			i.finishWithError(err)
+		}
 		return nil, err
 	}
 
 	i.count++
-	i.updateTimings(start)
+	elapsed := i.updateTimings(start)
+	if i.op != nil {
+		i.op.rowOut(i.parentOp, elapsed)
+	}
 	return row, nil
 }
 
@@ -830,17 +1114,27 @@ func (i *spanIter) finish() {
 		avg = i.total / time.Duration(i.count)
 	}
 
+	fields := []log.Field{
+		log.Int("rows", i.count),
+		log.String("total_time", i.total.String()),
+		log.String("max_time", i.max.String()),
+		log.String("min_time", i.min.String()),
+		log.String("avg_time", avg.String()),
+	}
+	if i.op != nil {
+		metrics := i.op.snapshot()
+		fields = append(fields,
+			log.Int64("rows_in", metrics.RowsIn),
+			log.String("operator_time", metrics.Time.String()),
+			log.Uint64("memory_high_water", metrics.MemoryHighWater),
+		)
+	}
+
 	i.span.FinishWithOptions(opentracing.FinishOptions{
 		LogRecords: []opentracing.LogRecord{
 			{
 				Timestamp: time.Now(),
-				Fields: []log.Field{
-					log.Int("rows", i.count),
-					log.String("total_time", i.total.String()),
-					log.String("max_time", i.max.String()),
-					log.String("min_time", i.min.String()),
-					log.String("avg_time", avg.String()),
-				},
+				Fields:    fields,
 			},
 		},
 	})
@@ -53,6 +53,24 @@ func TestSessionConfig(t *testing.T) {
 	require.Equal(1, sess.Warnings()[2].Code)
 }
 
+func TestInitSessionVariable(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+	sess := NewBaseSessionWithClientServer("foo", Client{Address: "baz", User: "bar"}, 1)
+	_, global, _ := SystemVariables.GetGlobal("version")
+
+	require.True(ErrSystemVariableGlobalOnly.Is(sess.SetSessionVariable(ctx, "version", "1.0")))
+	require.NoError(sess.InitSessionVariable(ctx, "version", "1.0"))
+	require.NoError(sess.InitSessionVariable(ctx, "version", "2.0"))
+	v, err := sess.GetSessionVariable(ctx, "version")
+	require.NoError(err)
+	require.Equal("1.0", v)
+
+	_, v, _ = SystemVariables.GetGlobal("version")
+	require.Equal(global, v)
+	require.True(ErrUnknownSystemVariable.Is(sess.InitSessionVariable(ctx, "nope", "1.0")))
+}
+
 func TestHasDefaultValue(t *testing.T) {
 	require := require.New(t)
 	ctx := NewEmptyContext()
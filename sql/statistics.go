@@ -0,0 +1,80 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TableStatistics are the estimates about the data of a table used to plan queries.
+type TableStatistics struct {
+	// RowCount is the number of rows of the table.
+	RowCount uint64
+	// AvgRowSize is the average size of a row in bytes, or 0 if unknown.
+	AvgRowSize uint64
+	// DistinctCount is the number of distinct values of each column, keyed by lowercase column name. Columns without
+	// an estimate are missing.
+	DistinctCount map[string]uint64
+}
+
+// Distinct returns the number of distinct values of the column given, and whether it's known.
+func (s *TableStatistics) Distinct(column string) (uint64, bool) {
+	n, ok := s.DistinctCount[strings.ToLower(column)]
+	return n, ok
+}
+
+func (s *TableStatistics) String() string {
+	return fmt.Sprintf("Estimated rows: %d, average row size: %d", s.RowCount, s.AvgRowSize)
+}
+
+// TableStatisticsTable is a StatisticsTable that can provide detailed estimates about its data, including the number
+// of distinct values of its columns.
+type TableStatisticsTable interface {
+	StatisticsTable
+	// Statistics returns the current estimates of the table.
+	Statistics(ctx *Context) (*TableStatistics, error)
+}
+
+// GetTableStatistics returns the estimates of the table given, either from a TableStatisticsTable or derived from the
+// row count and data length of a StatisticsTable. Returns false if the table provides no statistics.
+func GetTableStatistics(ctx *Context, t Table) (*TableStatistics, bool, error) {
+	switch t := t.(type) {
+	case TableStatisticsTable:
+		stats, err := t.Statistics(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return stats, stats != nil, nil
+	case StatisticsTable:
+		rows, err := t.NumRows(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		length, err := t.DataLength(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		stats := &TableStatistics{RowCount: rows}
+		if rows > 0 {
+			stats.AvgRowSize = length / rows
+		}
+		return stats, true, nil
+	case TableWrapper:
+		return GetTableStatistics(ctx, t.Underlying())
+	default:
+		return nil, false, nil
+	}
+}
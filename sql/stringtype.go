@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -213,12 +214,7 @@ func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 		bs = bi.(string)
 	}
 
-	// TODO: should be comparing based on the collation for many cases, but the way this function is used throughout the
-	// codebase causes problems if made case insensitive.  Need to revisit usings strings.Compare for now.
-	//
-	// return Collations[t.collationName].Compare(as, bs), nil
-
-	return strings.Compare(as, bs), nil
+	return t.Collation().Compare(as, bs), nil
 }
 
 // Convert implements Type interface.
@@ -278,13 +274,19 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+	case ChunkedValue:
+		b, err := ReadChunkedValue(nil, s)
+		if err != nil {
+			return nil, err
+		}
+		val = string(b)
 	default:
 		return nil, ErrConvertToSQL.New(t)
 	}
 
 	if t.baseType == sqltypes.Text {
-		// for TEXT types, we use the byte length instead of the character length
-		if int64(len(val)) > t.MaxByteLength() {
+		// for TEXT types, we use the byte length of the size class instead of the character length
+		if int64(len(val)) > MaxClassByteLength(t) {
 			return nil, ErrLengthBeyondLimit.New()
 		}
 	} else {
@@ -294,8 +296,7 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 				return nil, ErrLengthBeyondLimit.New()
 			}
 		} else {
-			//TODO: this should count the string's length properly according to the character set
-			if int64(len(val)) > t.charLength {
+			if int64(utf8.RuneCountInString(val)) > t.charLength {
 				return nil, ErrLengthBeyondLimit.New()
 			}
 		}
@@ -308,6 +309,50 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 	return val, nil
 }
 
+// TruncateString converts the value given to the string type given like Convert, but values too long for the type
+// are truncated to its maximum length instead of failing. Returns whether the value was truncated.
+func TruncateString(t StringType, v interface{}) (interface{}, bool, error) {
+	converted, err := t.Convert(v)
+	if err == nil || !ErrLengthBeyondLimit.Is(err) {
+		return converted, false, err
+	}
+
+	val, err := LongText.Convert(v)
+	if err != nil {
+		return nil, false, err
+	}
+	str := val.(string)
+
+	if t.Type() == sqltypes.Text || t.CharacterSet().MaxLength() == 1 {
+		maxLength := t.MaxCharacterLength()
+		if t.Type() == sqltypes.Text {
+			maxLength = MaxClassByteLength(t)
+		}
+		str = str[:maxLength]
+		if t.CharacterSet().MaxLength() > 1 {
+			// don't leave a partial character behind
+			for len(str) > 0 {
+				if r, size := utf8.DecodeLastRuneInString(str); r != utf8.RuneError || size != 1 {
+					break
+				}
+				str = str[:len(str)-1]
+			}
+		}
+	} else {
+		var chars int64
+		for i := range str {
+			if chars == t.MaxCharacterLength() {
+				str = str[:i]
+				break
+			}
+			chars++
+		}
+	}
+
+	converted, err = t.Convert(str)
+	return converted, true, err
+}
+
 // MustConvert implements the Type interface.
 func (t stringType) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
@@ -330,11 +375,23 @@ func (t stringType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t stringType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t stringType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
 
+	// Chunked values of TEXT and BLOB columns are read straight into the value, rather than converted to a string
+	if cv, ok := v.(ChunkedValue); ok && IsTextBlob(t) {
+		if cv.Size() > MaxClassByteLength(t) {
+			return sqltypes.Value{}, ErrLengthBeyondLimit.New()
+		}
+		b, err := ReadChunkedValue(dest[:0], cv)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		return sqltypes.MakeTrusted(t.baseType, b), nil
+	}
+
 	v, err := t.Convert(v)
 	if err != nil {
 		return sqltypes.Value{}, err
@@ -419,6 +476,26 @@ func (t stringType) MaxByteLength() int64 {
 	return t.charLength * t.CharacterSet().MaxLength()
 }
 
+// MaxClassByteLength returns the maximum number of bytes of the size class of the TEXT or BLOB type given, e.g. 255
+// for TINYTEXT and TINYBLOB regardless of the character set, or the maximum byte length of any other string type.
+func MaxClassByteLength(t StringType) int64 {
+	byteLength := t.MaxByteLength()
+	if !IsTextBlob(t) {
+		return byteLength
+	}
+
+	switch {
+	case byteLength <= tinyTextBlobMax:
+		return tinyTextBlobMax
+	case byteLength <= textBlobMax:
+		return textBlobMax
+	case byteLength <= mediumTextBlobMax:
+		return mediumTextBlobMax
+	default:
+		return longTextBlobMax
+	}
+}
+
 func (t stringType) CreateMatcher(likeStr string) (regex.DisposableMatcher, error) {
 	c := t.Collation()
 	return c.LikeMatcher(likeStr)
@@ -16,6 +16,7 @@ package sql
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 	"time"
@@ -74,6 +75,15 @@ func TestStringCompare(t *testing.T) {
 		{MustCreateBinary(sqltypes.VarBinary, 10), false, 1, 1},
 		{MustCreateBinary(sqltypes.VarBinary, 10), 0, 1, -1},
 		{MustCreateBinary(sqltypes.VarBinary, 10), []byte("254"), 254, 0},
+
+		// Collations
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), "abc", "ABC", 1},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_general_ci), "abc", "ABC", 0},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_0900_ai_ci), "abc", "ABD", -1},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_0900_ai_ci), "B", "a", 1},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_0900_ai_ci), "Ab", "a", 1},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_0900_ai_ci), "ÉTÉ", "été", 0},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_bin), "B", "a", -1},
 	}
 
 	for _, test := range tests {
@@ -309,6 +319,7 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 7), float64(11583.5), "11583.5", false},
 		{MustCreateStringWithDefaults(sqltypes.Char, 4), []byte("abcd"), "abcd", false},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 40), time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), "2019-12-12 12:12:12", false},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "äöü", "äöü", false},
 
 		{MustCreateBinary(sqltypes.Binary, 3), "abcd", nil, true},
 		{MustCreateBinary(sqltypes.Blob, 3), strings.Repeat("0", tinyTextBlobMax+1), nil, true},
@@ -316,6 +327,7 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.Text, 3), strings.Repeat("𒁏", int(tinyTextBlobMax/Collation_Default.CharacterSet().MaxLength())+1), nil, true},
 		{MustCreateBinary(sqltypes.VarBinary, 3), []byte{01, 02, 03, 04}, nil, true},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), []byte("abcd"), nil, true},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "äöüß", nil, true},
 		{MustCreateStringWithDefaults(sqltypes.Char, 20), JSONDocument{Val: nil}, "null", false},
 	}
 
@@ -332,6 +344,91 @@ func TestStringConvert(t *testing.T) {
 	}
 }
 
+// chunkedValue is a ChunkedValue of the chunks given.
+type chunkedValue [][]byte
+
+func (c chunkedValue) Size() int64 {
+	var size int64
+	for _, chunk := range c {
+		size += int64(len(chunk))
+	}
+	return size
+}
+
+func (c chunkedValue) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, chunk := range c {
+		written, err := w.Write(chunk)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func TestStringChunkedValue(t *testing.T) {
+	value := chunkedValue{[]byte("abc"), []byte("def"), []byte(strings.Repeat("g", 300))}
+	expected := "abcdef" + strings.Repeat("g", 300)
+
+	t.Run("convert", func(t *testing.T) {
+		val, err := LongBlob.Convert(value)
+		require.NoError(t, err)
+		assert.Equal(t, expected, val)
+
+		_, err = TinyText.Convert(value)
+		assert.True(t, ErrLengthBeyondLimit.Is(err))
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		dest := make([]byte, 0, 1024)
+		val, err := LongText.SQL(dest, value)
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.Text, val.Type())
+		assert.Equal(t, expected, val.ToString())
+		// the value is read straight into the free space of the buffer given
+		assert.Equal(t, &dest[:1][0], &val.Raw()[0])
+
+		val, err = Blob.SQL(nil, value)
+		require.NoError(t, err)
+		assert.Equal(t, expected, val.ToString())
+
+		_, err = TinyBlob.SQL(nil, value)
+		assert.True(t, ErrLengthBeyondLimit.Is(err))
+
+		// other types convert the value
+		val, err = MustCreateStringWithDefaults(sqltypes.VarChar, 400).SQL(nil, value)
+		require.NoError(t, err)
+		assert.Equal(t, expected, val.ToString())
+	})
+}
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		typ         StringType
+		val         interface{}
+		expectedVal interface{}
+		truncated   bool
+	}{
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "abc", "abc", false},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "abcdef", "abc", true},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "äöüß", "äöü", true},
+		{MustCreateStringWithDefaults(sqltypes.Char, 2), int64(12345), "12", true},
+		{MustCreateBinary(sqltypes.VarBinary, 2), "äb", "\xc3\xa4", true},
+		{MustCreateBinary(sqltypes.Binary, 4), "abcdef", "abcd", true},
+		{MustCreateStringWithDefaults(sqltypes.Text, 3), strings.Repeat("ä", int(tinyTextBlobMax/2)+1), strings.Repeat("ä", int(tinyTextBlobMax/2)), true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			val, truncated, err := TruncateString(test.typ, test.val)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedVal, val)
+			assert.Equal(t, test.truncated, truncated)
+		})
+	}
+}
+
 func TestStringString(t *testing.T) {
 	tests := []struct {
 		typ         Type
@@ -381,3 +478,28 @@ func TestStringString(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxClassByteLength(t *testing.T) {
+	tests := []struct {
+		typ      StringType
+		expected int64
+	}{
+		{TinyText, tinyTextBlobMax},
+		{Text, textBlobMax},
+		{MediumText, mediumTextBlobMax},
+		{LongText, longTextBlobMax},
+		{TinyBlob, tinyTextBlobMax},
+		{Blob, textBlobMax},
+		{MediumBlob, mediumTextBlobMax},
+		{LongBlob, longTextBlobMax},
+		{MustCreateString(sqltypes.Text, tinyTextBlobMax, Collation_latin1_swedish_ci), tinyTextBlobMax},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), 40},
+		{MustCreateBinary(sqltypes.Binary, 3), 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.typ.String(), func(t *testing.T) {
+			assert.Equal(t, test.expected, MaxClassByteLength(test.typ))
+		})
+	}
+}
@@ -107,7 +107,7 @@ func (t systemDoubleType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t systemDoubleType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t systemDoubleType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -122,7 +122,7 @@ func (t systemEnumType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t systemEnumType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t systemEnumType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
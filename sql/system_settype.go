@@ -109,7 +109,7 @@ func (t systemSetType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t systemSetType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t systemSetType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -80,7 +80,7 @@ func (t systemStringType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t systemStringType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t systemStringType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
@@ -954,6 +954,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("immediate_server_version", -9223372036854775808, 9223372036854775807, false),
 		Default:           int64(80017),
 	},
+	"indexed_join_lookup_concurrency": {
+		Name:              "indexed_join_lookup_concurrency",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemIntType("indexed_join_lookup_concurrency", 1, 256, false),
+		Default:           int64(1),
+	},
 	"init_connect": {
 		Name:              "init_connect",
 		Scope:             SystemVariableScope_Global,
@@ -1957,6 +1965,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("read_rnd_buffer_size", 1, 2147483647, false),
 		Default:           int64(262144),
 	},
+	"regexp_engine": {
+		Name:              "regexp_engine",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemEnumType("regexp_engine", "go", "icu"),
+		Default:           "go",
+	},
 	"regexp_stack_limit": {
 		Name:              "regexp_stack_limit",
 		Scope:             SystemVariableScope_Global,
@@ -2429,6 +2445,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("syseventlog.tag"),
 		Default:           "",
 	},
+	"sysdate_is_now": {
+		Name:              "sysdate_is_now",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("sysdate_is_now"),
+		Default:           int8(0),
+	},
 	"system_time_zone": {
 		Name:              "system_time_zone",
 		Scope:             SystemVariableScope_Global,
@@ -2587,7 +2611,7 @@ var systemVars = map[string]SystemVariable{
 		Scope:             SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              NewSystemStringType("time_zone"),
+		Type:              systemTimeZoneType{systemStringType{"time_zone"}},
 		Default:           "SYSTEM",
 	},
 	//TODO: this needs to utilize a function as the value is not static
@@ -2718,7 +2742,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              NewSystemStringType("version"),
-		Default:           "",
+		Default:           "8.0.11",
 	},
 	"version_comment": {
 		Name:              "version_comment",
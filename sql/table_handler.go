@@ -0,0 +1,42 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// TableHandler is a table opened by a session with HANDLER ... OPEN. It keeps the cursor of the last HANDLER ... READ
+// statement, so that the next one continues reading where it stopped.
+type TableHandler struct {
+	// Name is the name the handler is referred to by, either the alias it was opened with or the table name.
+	Name string
+	// Database is the name of the database of the table.
+	Database string
+	// Table is the table opened.
+	Table Table
+	// Index is the name of the index the cursor reads from, or empty when it reads the rows of the table in the order
+	// the table returns them.
+	Index string
+	// Cursor is the iterator of the last read, or nil before the first read.
+	Cursor RowIter
+}
+
+// ResetCursor closes the cursor of the handler, if any, so that the next read starts over.
+func (h *TableHandler) ResetCursor(ctx *Context) error {
+	if h.Cursor == nil {
+		return nil
+	}
+	cursor := h.Cursor
+	h.Cursor = nil
+	h.Index = ""
+	return cursor.Close(ctx)
+}
@@ -24,17 +24,24 @@ type TableRowIter struct {
 	partitions PartitionIter
 	partition  Partition
 	rows       RowIter
+	timestamps *TimestampConverter
+	err        error
 }
 
-// NewTableRowIter returns a new iterator over the rows in the partitions of the table given.
+// NewTableRowIter returns a new iterator over the rows in the partitions of the table given. TIMESTAMP values are
+// returned in the time zone of the session of the context given.
 func NewTableRowIter(ctx *Context, table Table, partitions PartitionIter) *TableRowIter {
-	return &TableRowIter{table: table, partitions: partitions}
+	timestamps, err := NewTimestampConverter(ctx, table.Schema())
+	return &TableRowIter{table: table, partitions: partitions, timestamps: timestamps, err: err}
 }
 
 func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
+	if i.err != nil {
+		return nil, i.err
+	}
 
 	if i.partition == nil {
 		partition, err := i.partitions.Next(ctx)
@@ -70,8 +77,11 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 		i.rows = nil
 		return i.Next(ctx)
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return row, err
+	return i.timestamps.FromUTC(row), nil
 }
 
 func (i *TableRowIter) Close(ctx *Context) error {
@@ -32,7 +32,7 @@ var (
 
 	ErrConvertingToTimeType = errors.NewKind("value %v is not a valid Time")
 
-	timespanRegex                   = regexp.MustCompile(`^-?(\d{1,3}):(\d{1,2})(:(\d{1,2})(\.(\d{1,9}))?)?$`)
+	timespanRegex                   = regexp.MustCompile(`^-?(?:(\d+)\s+)?(\d+)(?::(\d{1,2})(?::(\d{1,2})(?:\.(\d+))?)?)?$`)
 	timespanMinimum           int64 = -3020399000000
 	timespanMaximum           int64 = 3020399000000
 	microsecondsPerSecond     int64 = 1000000
@@ -228,7 +228,11 @@ func (t timespanType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t timespanType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t timespanType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
 	ti, err := t.ConvertToTimespanImpl(v)
 	if err != nil {
 		return sqltypes.Value{}, err
@@ -272,67 +276,87 @@ func int64Abs(v int64) int64 {
 }
 
 func stringToTimespan(s string) (timespanImpl, error) {
-	matches := timespanRegex.FindStringSubmatch(s)
-	if len(matches) == 7 {
-		hours, _ := strconv.Atoi(matches[1])
-		minutes, _ := strconv.Atoi(matches[2])
-		if minutes > 59 {
-			return timespanImpl{}, ErrConvertingToTimeType.New(s)
-		}
-		seconds, _ := strconv.Atoi(matches[4])
-		if seconds > 59 {
-			return timespanImpl{}, ErrConvertingToTimeType.New(s)
-		}
-		microseconds, _ := strconv.Atoi(matches[6])
-		if int64(microseconds) >= microsecondsPerSecond {
-			for i := microsecondsPerSecond * 1000; i >= microsecondsPerSecond*10; i /= 10 {
-				if int64(microseconds) > i {
-					microseconds /= 10
-				}
-			}
-			if microseconds%10 >= 5 {
-				microseconds += 10
-			}
-			microseconds /= 10
-			if int64(microseconds) == microsecondsPerSecond {
-				microseconds = 0
-				seconds++
-			}
-			if seconds == 60 {
-				seconds = 0
-				minutes++
-			}
-			if minutes == 60 {
-				minutes = 0
-				hours++
-			}
-		} else {
-			for i := 10; i < int(microsecondsPerSecond); i *= 10 {
-				if microseconds < i {
-					microseconds *= 10
-				}
-			}
-		}
-		if hours > 838 {
-			hours = 838
-			minutes = 59
-			seconds = 59
+	matches := timespanRegex.FindStringSubmatch(strings.TrimSpace(s))
+	// Hours without minutes are only a time when they follow days, otherwise the string is a number like 1112
+	if len(matches) != 6 || matches[1] == "" && matches[3] == "" {
+		return timespanImpl{}, ErrConvertingToTimeType.New(s)
+	}
+
+	days, ok := parseTimespanPart(matches[1])
+	if !ok {
+		return timespanImpl{}, ErrConvertingToTimeType.New(s)
+	}
+	hours, ok := parseTimespanPart(matches[2])
+	if !ok {
+		return timespanImpl{}, ErrConvertingToTimeType.New(s)
+	}
+	minutes, ok := parseTimespanPart(matches[3])
+	if !ok || minutes > 59 {
+		return timespanImpl{}, ErrConvertingToTimeType.New(s)
+	}
+	seconds, ok := parseTimespanPart(matches[4])
+	if !ok || seconds > 59 {
+		return timespanImpl{}, ErrConvertingToTimeType.New(s)
+	}
+
+	// Fractions are rounded to microseconds, which may carry over to the seconds and beyond
+	var microseconds int64
+	if fraction := matches[5]; fraction != "" {
+		fraction += strings.Repeat("0", 7)
+		microseconds, _ = strconv.ParseInt(fraction[:6], 10, 64)
+		if fraction[6] >= '5' {
+			microseconds++
 		}
-		if hours == 838 && minutes == 59 && seconds == 59 {
+		if microseconds == microsecondsPerSecond {
 			microseconds = 0
+			seconds++
 		}
-		impl := timespanImpl{
-			hours:        int16(hours),
-			minutes:      int8(minutes),
-			seconds:      int8(seconds),
-			microseconds: int32(microseconds),
+		if seconds == 60 {
+			seconds = 0
+			minutes++
 		}
-		if s[0] == '-' {
-			impl.negative = true
+		if minutes == 60 {
+			minutes = 0
+			hours++
 		}
-		return impl, nil
 	}
-	return timespanImpl{}, ErrConvertingToTimeType.New(s)
+
+	if days > 0 {
+		if days > 838 {
+			days = 838
+		}
+		hours += days * 24
+	}
+	if hours > 838 {
+		hours = 838
+		minutes = 59
+		seconds = 59
+	}
+	if hours == 838 && minutes == 59 && seconds == 59 {
+		microseconds = 0
+	}
+
+	impl := timespanImpl{
+		hours:        int16(hours),
+		minutes:      int8(minutes),
+		seconds:      int8(seconds),
+		microseconds: int32(microseconds),
+	}
+	impl.negative = strings.HasPrefix(strings.TrimSpace(s), "-") && impl.AsMicroseconds() != 0
+	return impl, nil
+}
+
+// parseTimespanPart returns the value of a part of a time string, or false if it's larger than an int32, which keeps
+// the hours of the days and the hours added together from overflowing.
+func parseTimespanPart(s string) (int64, bool) {
+	if s == "" {
+		return 0, true
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
 }
 
 func microsecondsToTimespan(v int64) timespanImpl {
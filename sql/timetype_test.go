@@ -130,9 +130,18 @@ func TestTimeConvert(t *testing.T) {
 		{"850:00:00", "838:59:59", false},
 		{"-838:59:59.1", "-838:59:59", false},
 		{"838:59:59.1", "838:59:59", false},
+		{"1 02:03:04", "26:03:04", false},
+		{"-1 02", "-26:00:00", false},
+		{"34 22:59:59", "838:59:59", false},
+		{"2147483647:00:00", "838:59:59", false},
+		{"-00:00:00", "00:00:00", false},
+		{"00:00:00.05", "00:00:00.050000", false},
 
 		{1060, nil, true},
 		{60, nil, true},
+		{"9223372036854775807:00:00", nil, true},
+		{"838 9223372036854775807:00:00", nil, true},
+		{"99999999999 01:00:00", nil, true},
 		{6040, nil, true},
 		{104060, nil, true},
 		{106040, nil, true},
@@ -0,0 +1,219 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// Named time zones are looked up in the time zone database embedded in the binary when the system has none
+	_ "time/tzdata"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnknownTimeZone is returned for time zones that are neither a UTC offset nor a named time zone.
+var ErrUnknownTimeZone = errors.NewKind("Unknown or incorrect time zone: '%s'")
+
+var timeZoneOffsetRegex = regexp.MustCompile(`^([+-])(\d{1,2}):(\d{2})$`)
+
+// TimeZone returns the location of the time zone given as a value of the time_zone system variable: SYSTEM, an offset
+// from UTC such as '+05:30', or a named time zone such as 'Europe/Madrid'. SYSTEM is UTC, the time zone the engine
+// reports in system_time_zone.
+func TimeZone(tz string) (*time.Location, error) {
+	if strings.EqualFold(tz, "SYSTEM") || strings.EqualFold(tz, "UTC") {
+		return time.UTC, nil
+	}
+
+	if match := timeZoneOffsetRegex.FindStringSubmatch(tz); match != nil {
+		hours, _ := strconv.Atoi(match[2])
+		minutes, _ := strconv.Atoi(match[3])
+		offset := hours*60 + minutes
+		// MySQL accepts offsets from -13:59 to +14:00
+		if minutes > 59 || offset > 14*60 || (match[1] == "-" && offset > 13*60+59) {
+			return nil, ErrUnknownTimeZone.New(tz)
+		}
+		if match[1] == "-" {
+			offset = -offset
+		}
+		return time.FixedZone(tz, offset*60), nil
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "" || strings.EqualFold(tz, "Local") {
+		return nil, ErrUnknownTimeZone.New(tz)
+	}
+	return loc, nil
+}
+
+// systemTimeZoneType is the type of the time_zone system variable, a string that must be a time zone TimeZone accepts.
+type systemTimeZoneType struct {
+	systemStringType
+}
+
+var _ SystemVariableType = systemTimeZoneType{}
+
+// Convert implements Type interface.
+func (t systemTimeZoneType) Convert(v interface{}) (interface{}, error) {
+	value, err := t.systemStringType.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := TimeZone(value.(string)); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// SessionTimeZone returns the location of the time_zone of the session of the context given.
+func SessionTimeZone(ctx *Context) (*time.Location, error) {
+	tz, err := ctx.GetSessionVariable(ctx, "time_zone")
+	if err != nil {
+		return nil, err
+	}
+	s, _ := tz.(string)
+	return TimeZone(s)
+}
+
+// ToSessionTime returns the instant given as a zone-naive time in the session time zone, which is how functions such
+// as NOW() return the current time.
+func ToSessionTime(ctx *Context, t time.Time) (time.Time, error) {
+	loc, err := SessionTimeZone(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fromUTC(t, loc), nil
+}
+
+// FromSessionTime returns the instant of the zone-naive time given in the session time zone, which is how functions
+// such as UNIX_TIMESTAMP() interpret their arguments.
+func FromSessionTime(ctx *Context, t time.Time) (time.Time, error) {
+	loc, err := SessionTimeZone(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return toUTC(t, loc), nil
+}
+
+// ConvertTimeZone returns the zone-naive time given in the location from as a zone-naive time in the location to, as
+// CONVERT_TZ() does. Like in MySQL, times whose instant is out of the range of TIMESTAMP values aren't converted.
+func ConvertTimeZone(t time.Time, from, to *time.Location) time.Time {
+	utc := toUTC(t, from)
+	if utc.Before(datetimeTypeMinTimestamp) || utc.After(datetimeTypeMaxTimestamp) {
+		return t
+	}
+	return fromUTC(utc, to)
+}
+
+// toUTC returns the instant of the zone-naive time given in the location given.
+func toUTC(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+}
+
+// fromUTC returns the instant given as a zone-naive time in the location given.
+func fromUTC(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// TimestampConverter converts the TIMESTAMP values of rows between UTC, which they're stored in, and the time zone of
+// the session, which they're in everywhere else. Unlike TIMESTAMP values, DATETIME values are never converted. A nil
+// TimestampConverter leaves rows as they are.
+type TimestampConverter struct {
+	loc     *time.Location
+	columns []int
+}
+
+// NewTimestampConverter returns the TimestampConverter for the rows of the schema given in the session of the context
+// given, which is nil if the schema has no TIMESTAMP columns or the session time zone is UTC.
+func NewTimestampConverter(ctx *Context, sch Schema) (*TimestampConverter, error) {
+	var columns []int
+	for i, col := range sch {
+		if col.Type.Type() == sqltypes.Timestamp {
+			columns = append(columns, i)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	loc, err := SessionTimeZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if loc == time.UTC {
+		return nil, nil
+	}
+
+	return &TimestampConverter{loc: loc, columns: columns}, nil
+}
+
+// ToUTC returns the row given, with TIMESTAMP values in the session time zone, with the values converted to UTC for
+// storage. The row given isn't modified.
+func (c *TimestampConverter) ToUTC(row Row) Row {
+	return c.convert(row, toUTC)
+}
+
+// FromUTC returns the row given, with TIMESTAMP values as stored in UTC, with the values converted to the session time
+// zone. The row given isn't modified.
+func (c *TimestampConverter) FromUTC(row Row) Row {
+	return c.convert(row, fromUTC)
+}
+
+func (c *TimestampConverter) convert(row Row, fn func(time.Time, *time.Location) time.Time) Row {
+	if c == nil || row == nil {
+		return row
+	}
+
+	converted := row.Copy()
+	for _, i := range c.columns {
+		if i >= len(converted) {
+			continue
+		}
+		// The zero date has no time zone
+		if t, ok := converted[i].(time.Time); ok && !IsZeroTime(t) {
+			converted[i] = fn(t, c.loc)
+		}
+	}
+	return converted
+}
+
+// FromUTCIter returns an iterator over the rows of the one given, with TIMESTAMP values as stored in UTC, that
+// returns them with the values converted to the session time zone.
+func (c *TimestampConverter) FromUTCIter(iter RowIter) RowIter {
+	if c == nil {
+		return iter
+	}
+	return &timestampRowIter{iter: iter, timestamps: c}
+}
+
+type timestampRowIter struct {
+	iter       RowIter
+	timestamps *TimestampConverter
+}
+
+func (i *timestampRowIter) Next(ctx *Context) (Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return i.timestamps.FromUTC(row), nil
+}
+
+func (i *timestampRowIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeZone(t *testing.T) {
+	instant := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		tz     string
+		offset int
+		err    bool
+	}{
+		{"SYSTEM", 0, false},
+		{"UTC", 0, false},
+		{"+00:00", 0, false},
+		{"+05:30", 5*3600 + 30*60, false},
+		{"-08:00", -8 * 3600, false},
+		{"+14:00", 14 * 3600, false},
+		{"+14:01", 0, true},
+		{"-14:00", 0, true},
+		{"+01:60", 0, true},
+		{"Europe/Madrid", 2 * 3600, false},
+		{"Local", 0, true},
+		{"", 0, true},
+		{"Nowhere/Special", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tz, func(t *testing.T) {
+			loc, err := TimeZone(test.tz)
+			if test.err {
+				require.True(t, ErrUnknownTimeZone.Is(err))
+				return
+			}
+			require.NoError(t, err)
+			_, offset := instant.In(loc).Zone()
+			assert.Equal(t, test.offset, offset)
+		})
+	}
+}
+
+func TestTimeZoneSystemVariable(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	require.NoError(ctx.SetSessionVariable(ctx, "time_zone", "+05:30"))
+	loc, err := SessionTimeZone(ctx)
+	require.NoError(err)
+	require.Equal("+05:30", loc.String())
+
+	err = ctx.SetSessionVariable(ctx, "time_zone", "Nowhere/Special")
+	require.True(ErrUnknownTimeZone.Is(err))
+	val, err := ctx.GetSessionVariable(ctx, "time_zone")
+	require.NoError(err)
+	require.Equal("+05:30", val)
+}
+
+func TestTimestampConverter(t *testing.T) {
+	require := require.New(t)
+
+	sch := Schema{
+		{Name: "i", Type: Int64},
+		{Name: "ts", Type: Timestamp},
+		{Name: "dt", Type: Datetime},
+	}
+
+	ctx := NewEmptyContext()
+	c, err := NewTimestampConverter(ctx, sch)
+	require.NoError(err)
+	require.Nil(c, "no conversion is needed in UTC")
+	row := Row{int64(1), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), nil}
+	require.Equal(row, c.ToUTC(row))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "time_zone", "-03:00"))
+	c, err = NewTimestampConverter(ctx, sch)
+	require.NoError(err)
+
+	local := Row{int64(1), time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC)}
+	stored := Row{int64(1), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC)}
+	require.Equal(stored, c.ToUTC(local))
+	require.Equal(local, c.FromUTC(stored))
+	require.Equal(time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC), local[1], "rows given aren't modified")
+
+	zero := Row{int64(1), zeroTime, nil}
+	require.Equal(zero, c.ToUTC(zero))
+
+	c, err = NewTimestampConverter(ctx, Schema{{Name: "dt", Type: Datetime}})
+	require.NoError(err)
+	require.Nil(c)
+}
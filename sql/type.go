@@ -57,8 +57,10 @@ type Type interface {
 	Convert(interface{}) (interface{}, error)
 	// Promote will promote the current type to the largest representing type of the same kind, such as Int8 to Int64.
 	Promote() Type
-	// SQL returns the sqltypes.Value for the given value.
-	SQL(interface{}) (sqltypes.Value, error)
+	// SQL returns the sqltypes.Value for the given value. Implementations may append the bytes of the value to dest,
+	// which may be nil, and return a Value referencing them to avoid allocating; callers must not overwrite them while
+	// the Value is in use.
+	SQL(dest []byte, v interface{}) (sqltypes.Value, error)
 	// Type returns the query.Type for the given Type.
 	Type() query.Type
 	// Zero returns the golang zero value for this type
@@ -225,8 +227,13 @@ func AreComparable(types ...Type) bool {
 	return true
 }
 
-// ColumnTypeToType gets the column type using the column definition.
+// ColumnTypeToType gets the column type using the column definition. Types registered with RegisterType take
+// precedence over the built-in ones.
 func ColumnTypeToType(ct *sqlparser.ColumnType) (Type, error) {
+	if t, ok := registeredTypeForKeyword(ct.Type); ok {
+		return t.New(ct)
+	}
+
 	switch strings.ToLower(ct.Type) {
 	case "boolean", "bool":
 		return Int8, nil
@@ -616,6 +623,81 @@ func IsUnsigned(t Type) bool {
 	return t == Uint8 || t == Uint16 || t == Uint32 || t == Uint64
 }
 
+// NumericCoercionType returns the type values of the types given are converted to when they are the operands of the
+// +, - and * operators, or are compared with each other in a numeric context, following MySQL's rules:
+//   - integers with integers are BIGINT, or BIGINT UNSIGNED if either of them is unsigned
+//   - decimals with integers or decimals are DECIMAL, with the largest scale of the two
+//   - anything else, such as floats or strings used as numbers, is DOUBLE
+//
+// Comparisons of signed and unsigned integers are an exception, see IsMixedSignInteger.
+func NumericCoercionType(left, right Type) Type {
+	if IsInteger(left) && IsInteger(right) {
+		if IsUnsigned(left) || IsUnsigned(right) {
+			return Uint64
+		}
+		return Int64
+	}
+
+	if (IsInteger(left) || IsDecimal(left)) && (IsInteger(right) || IsDecimal(right)) {
+		scale := uint8(0)
+		if dt, ok := left.(DecimalType); ok {
+			scale = dt.Scale()
+		}
+		if dt, ok := right.(DecimalType); ok && dt.Scale() > scale {
+			scale = dt.Scale()
+		}
+		return MustCreateDecimalType(DecimalTypeMaxPrecision, scale)
+	}
+
+	return Float64
+}
+
+// NumericPrefix returns the longest leading part of the string given that's a number, which is what MySQL reads of a
+// string used as a number: leading and trailing spaces are skipped, and so is anything after the number. It's "0" if
+// the string doesn't start with a number. Returns whether anything besides spaces was skipped, which MySQL warns about.
+func NumericPrefix(s string) (string, bool) {
+	trimmed := strings.TrimSpace(s)
+	i := 0
+	if i < len(trimmed) && (trimmed[i] == '+' || trimmed[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(trimmed) && isDigit(trimmed[i]); i++ {
+		digits++
+	}
+	if i < len(trimmed) && trimmed[i] == '.' {
+		for i++; i < len(trimmed) && isDigit(trimmed[i]); i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return "0", len(trimmed) > 0
+	}
+	// An exponent is only part of the number if it has digits
+	if i < len(trimmed) && (trimmed[i] == 'e' || trimmed[i] == 'E') {
+		j := i + 1
+		if j < len(trimmed) && (trimmed[j] == '+' || trimmed[j] == '-') {
+			j++
+		}
+		if j < len(trimmed) && isDigit(trimmed[j]) {
+			for i = j; i < len(trimmed) && isDigit(trimmed[i]); i++ {
+			}
+		}
+	}
+	return trimmed[:i], i < len(trimmed)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// IsMixedSignInteger returns whether one of the types given is a signed integer and the other an unsigned one. Values
+// of such types can't all be held by either BIGINT or BIGINT UNSIGNED, so they're compared as exact decimals, and
+// arithmetic on them is checked against the range of BIGINT UNSIGNED.
+func IsMixedSignInteger(left, right Type) bool {
+	return (IsSigned(left) && IsUnsigned(right)) || (IsUnsigned(left) && IsSigned(right))
+}
+
 // NumColumns returns the number of columns in a type. This is one for all
 // types, except tuples.
 func NumColumns(t Type) int {
@@ -0,0 +1,225 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrDuplicateTypeRegistration is returned when registering a type with the ID, a keyword or the wire type of a type
+// that is already registered.
+var ErrDuplicateTypeRegistration = errors.NewKind("type %s is already registered with the %s %s")
+
+// ErrInvalidTypeRegistration is returned when registering a type with missing fields.
+var ErrInvalidTypeRegistration = errors.NewKind("invalid type registration %q: %s")
+
+// ErrUnsupportedWireType is returned by MysqlTypeToType for wire types that have no built-in nor registered type.
+var ErrUnsupportedWireType = errors.NewKind("unsupported wire type %v")
+
+// TypeRegistration describes a Type implemented outside of the engine, such as by a storage integrator, so that it
+// can be used in column definitions and mapped from its wire type like the built-in types.
+type TypeRegistration struct {
+	// ID is the stable identifier of the type, such as "mydb.uuid". Unlike the keywords, it must never change, so
+	// integrators can persist it to refer to the type.
+	ID string
+	// Keywords are the names of the type in column definitions, such as "point", which are matched ignoring case.
+	// They can only be names the SQL parser accepts as types, and they take precedence over the built-in types with
+	// the same names, so registering a type can also replace a built-in one.
+	Keywords []string
+	// WireType is the type the values of the type are sent to clients as, which should be the one returned by the
+	// Type method of the types created by New. Wire types that have a built-in type keep mapping to it, so
+	// MysqlTypeToType only returns registered types for the wire types no built-in type uses, such as
+	// query.Type_GEOMETRY.
+	WireType query.Type
+	// New returns the type of the column definition given, which has one of the keywords as its type.
+	New func(ct *sqlparser.ColumnType) (Type, error)
+}
+
+var (
+	typeRegistryMu  sync.RWMutex
+	typesByID       = make(map[string]*TypeRegistration)
+	typesByKeyword  = make(map[string]*TypeRegistration)
+	typesByWireType = make(map[query.Type]*TypeRegistration)
+)
+
+// RegisterType registers the type given. It fails if the ID, a keyword or the wire type of the type is already used by
+// another registered type. Wire types with a built-in type, such as VARCHAR, can be shared by any number of types.
+func RegisterType(t TypeRegistration) error {
+	if t.ID == "" {
+		return ErrInvalidTypeRegistration.New(t.ID, "missing ID")
+	}
+	if t.New == nil {
+		return ErrInvalidTypeRegistration.New(t.ID, "missing constructor")
+	}
+
+	keywords := make([]string, len(t.Keywords))
+	for i, kw := range t.Keywords {
+		keywords[i] = normalizeTypeKeyword(kw)
+	}
+	t.Keywords = keywords
+
+	typeRegistryMu.Lock()
+	defer typeRegistryMu.Unlock()
+
+	if _, ok := typesByID[t.ID]; ok {
+		return ErrDuplicateTypeRegistration.New(t.ID, "ID", t.ID)
+	}
+	for _, kw := range keywords {
+		if other, ok := typesByKeyword[kw]; ok {
+			return ErrDuplicateTypeRegistration.New(other.ID, "keyword", kw)
+		}
+	}
+	_, builtinWireType := builtinTypeForWireType(t.WireType)
+	if other, ok := typesByWireType[t.WireType]; ok && !builtinWireType {
+		return ErrDuplicateTypeRegistration.New(other.ID, "wire type", t.WireType.String())
+	}
+
+	typesByID[t.ID] = &t
+	for _, kw := range keywords {
+		typesByKeyword[kw] = &t
+	}
+	if !builtinWireType {
+		typesByWireType[t.WireType] = &t
+	}
+	return nil
+}
+
+// UnregisterType removes the registered type with the ID given.
+func UnregisterType(id string) {
+	typeRegistryMu.Lock()
+	defer typeRegistryMu.Unlock()
+
+	t, ok := typesByID[id]
+	if !ok {
+		return
+	}
+	delete(typesByID, id)
+	for _, kw := range t.Keywords {
+		delete(typesByKeyword, kw)
+	}
+	if typesByWireType[t.WireType] == t {
+		delete(typesByWireType, t.WireType)
+	}
+}
+
+// RegisteredType returns the registered type with the ID given, if any.
+func RegisteredType(id string) (TypeRegistration, bool) {
+	typeRegistryMu.RLock()
+	defer typeRegistryMu.RUnlock()
+	t, ok := typesByID[id]
+	if !ok {
+		return TypeRegistration{}, false
+	}
+	return *t, true
+}
+
+// registeredTypeForKeyword returns the registered type with the keyword given, if any.
+func registeredTypeForKeyword(keyword string) (*TypeRegistration, bool) {
+	typeRegistryMu.RLock()
+	defer typeRegistryMu.RUnlock()
+	t, ok := typesByKeyword[normalizeTypeKeyword(keyword)]
+	return t, ok
+}
+
+// registeredTypeForWireType returns the registered type with the wire type given, if any.
+func registeredTypeForWireType(wireType query.Type) (*TypeRegistration, bool) {
+	typeRegistryMu.RLock()
+	defer typeRegistryMu.RUnlock()
+	t, ok := typesByWireType[wireType]
+	return t, ok
+}
+
+func normalizeTypeKeyword(keyword string) string {
+	return strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+}
+
+// MysqlTypeToType returns the type values of the wire type given are represented with, using the widest built-in type
+// for it, such as LONGTEXT for VARCHAR, or the registered type with that wire type if there is no built-in one.
+func MysqlTypeToType(wireType query.Type) (Type, error) {
+	if t, ok := builtinTypeForWireType(wireType); ok {
+		return t, nil
+	}
+
+	if t, ok := registeredTypeForWireType(wireType); ok {
+		// There's no column definition for wire types, so the type is created as if it was declared with just its
+		// first keyword
+		ct := &sqlparser.ColumnType{}
+		if len(t.Keywords) > 0 {
+			ct.Type = t.Keywords[0]
+		}
+		return t.New(ct)
+	}
+	return nil, ErrUnsupportedWireType.New(wireType)
+}
+
+// builtinTypeForWireType returns the widest built-in type with the wire type given, if any.
+func builtinTypeForWireType(wireType query.Type) (Type, bool) {
+	switch wireType {
+	case sqltypes.Null:
+		return Null, true
+	case sqltypes.Int8:
+		return Int8, true
+	case sqltypes.Uint8:
+		return Uint8, true
+	case sqltypes.Int16:
+		return Int16, true
+	case sqltypes.Uint16:
+		return Uint16, true
+	case sqltypes.Int24:
+		return Int24, true
+	case sqltypes.Uint24:
+		return Uint24, true
+	case sqltypes.Int32:
+		return Int32, true
+	case sqltypes.Uint32:
+		return Uint32, true
+	case sqltypes.Int64:
+		return Int64, true
+	case sqltypes.Uint64:
+		return Uint64, true
+	case sqltypes.Float32:
+		return Float32, true
+	case sqltypes.Float64:
+		return Float64, true
+	case sqltypes.Decimal:
+		return MustCreateDecimalType(DecimalTypeMaxPrecision, DecimalTypeMaxScale), true
+	case sqltypes.Bit:
+		return MustCreateBitType(BitTypeMaxBits), true
+	case sqltypes.Year:
+		return Year, true
+	case sqltypes.Date:
+		return Date, true
+	case sqltypes.Time:
+		return Time, true
+	case sqltypes.Datetime:
+		return Datetime, true
+	case sqltypes.Timestamp:
+		return Timestamp, true
+	case sqltypes.Char, sqltypes.VarChar, sqltypes.Text:
+		return LongText, true
+	case sqltypes.Binary, sqltypes.VarBinary, sqltypes.Blob:
+		return LongBlob, true
+	case sqltypes.TypeJSON:
+		return JSON, true
+	default:
+		return nil, false
+	}
+}
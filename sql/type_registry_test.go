@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+)
+
+// pointType is a custom type storing points as text, sent to clients as geometries.
+type pointType struct {
+	stringType
+}
+
+func (pointType) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+func (pointType) String() string {
+	return "POINT"
+}
+
+func TestRegisterType(t *testing.T) {
+	require := require.New(t)
+
+	point := pointType{LongText.(stringType)}
+	registration := TypeRegistration{
+		ID:       "test.point",
+		Keywords: []string{"Point"},
+		WireType: sqltypes.Geometry,
+		New: func(ct *sqlparser.ColumnType) (Type, error) {
+			return point, nil
+		},
+	}
+
+	_, err := ColumnTypeToType(&sqlparser.ColumnType{Type: "point"})
+	require.Error(err)
+	_, err = MysqlTypeToType(sqltypes.Geometry)
+	require.True(ErrUnsupportedWireType.Is(err))
+
+	require.NoError(RegisterType(registration))
+	defer UnregisterType(registration.ID)
+
+	typ, err := ColumnTypeToType(&sqlparser.ColumnType{Type: "POINT"})
+	require.NoError(err)
+	require.Equal(point, typ)
+	typ, err = MysqlTypeToType(sqltypes.Geometry)
+	require.NoError(err)
+	require.Equal(point, typ)
+
+	r, ok := RegisteredType("test.point")
+	require.True(ok)
+	require.Equal([]string{"point"}, r.Keywords)
+
+	// Built-in wire types keep mapping to built-in types, and can be shared by registered types
+	typ, err = MysqlTypeToType(sqltypes.VarChar)
+	require.NoError(err)
+	require.Equal(LongText, typ)
+	uuid := TypeRegistration{ID: "test.uuid", Keywords: []string{"geometry"}, WireType: sqltypes.VarChar, New: registration.New}
+	require.NoError(RegisterType(uuid))
+	defer UnregisterType(uuid.ID)
+	typ, err = MysqlTypeToType(sqltypes.VarChar)
+	require.NoError(err)
+	require.Equal(LongText, typ)
+
+	err = RegisterType(TypeRegistration{ID: "test.point", WireType: sqltypes.VarChar, New: registration.New})
+	require.True(ErrDuplicateTypeRegistration.Is(err))
+	err = RegisterType(TypeRegistration{ID: "test.other", Keywords: []string{"POINT"}, WireType: sqltypes.VarChar, New: registration.New})
+	require.True(ErrDuplicateTypeRegistration.Is(err))
+	err = RegisterType(TypeRegistration{ID: "test.other", WireType: sqltypes.Geometry, New: registration.New})
+	require.True(ErrDuplicateTypeRegistration.Is(err))
+	err = RegisterType(TypeRegistration{ID: "test.other", WireType: sqltypes.VarChar})
+	require.True(ErrInvalidTypeRegistration.Is(err))
+
+	// Registered types can replace built-in ones
+	bigint := TypeRegistration{ID: "test.bigint", Keywords: []string{"bigint"}, WireType: sqltypes.Int64, New: registration.New}
+	require.NoError(RegisterType(bigint))
+	typ, err = ColumnTypeToType(&sqlparser.ColumnType{Type: "bigint"})
+	require.NoError(err)
+	require.Equal(point, typ)
+	UnregisterType(bigint.ID)
+	typ, err = ColumnTypeToType(&sqlparser.ColumnType{Type: "bigint"})
+	require.NoError(err)
+	require.Equal(Int64, typ)
+
+	UnregisterType(registration.ID)
+	_, err = MysqlTypeToType(sqltypes.Geometry)
+	require.True(ErrUnsupportedWireType.Is(err))
+}
@@ -72,3 +72,60 @@ func TestFloatCovert(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericCoercionType(t *testing.T) {
+	tests := []struct {
+		left, right Type
+		expected    Type
+	}{
+		{Int8, Int64, Int64},
+		{Uint8, Uint32, Uint64},
+		{Int32, Uint64, Uint64},
+		{Int64, MustCreateDecimalType(10, 2), MustCreateDecimalType(DecimalTypeMaxPrecision, 2)},
+		{MustCreateDecimalType(10, 2), MustCreateDecimalType(20, 5), MustCreateDecimalType(DecimalTypeMaxPrecision, 5)},
+		{MustCreateDecimalType(10, 2), Float32, Float64},
+		{Int64, Float64, Float64},
+		{Int64, LongText, Float64},
+		{LongText, LongText, Float64},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.left, test.right), func(t *testing.T) {
+			assert.Equal(t, test.expected, NumericCoercionType(test.left, test.right))
+			assert.Equal(t, test.expected, NumericCoercionType(test.right, test.left))
+		})
+	}
+
+	assert.True(t, IsMixedSignInteger(Int8, Uint64))
+	assert.True(t, IsMixedSignInteger(Uint8, Int64))
+	assert.False(t, IsMixedSignInteger(Int8, Int64))
+	assert.False(t, IsMixedSignInteger(Uint64, Float64))
+}
+
+func TestNumericPrefix(t *testing.T) {
+	tests := []struct {
+		s         string
+		prefix    string
+		truncated bool
+	}{
+		{"12", "12", false},
+		{"  -1.5  ", "-1.5", false},
+		{"1a", "1", true},
+		{"abc", "0", true},
+		{"", "0", false},
+		{".5x", ".5", true},
+		{"1e3", "1e3", false},
+		{"1e", "1", true},
+		{"2.5e-1 apples", "2.5e-1", true},
+		{"+7", "+7", false},
+		{"-", "0", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			prefix, truncated := NumericPrefix(test.s)
+			assert.Equal(t, test.prefix, prefix)
+			assert.Equal(t, test.truncated, truncated)
+		})
+	}
+}
@@ -55,6 +55,22 @@ func (w *Window) FromExpressions(children []Expression) (*Window, error) {
 	return &nw, nil
 }
 
+// PartitionSortFields returns the sort fields that order rows by this window's partition by expressions, and then by
+// its order by fields within each partition.
+func (w *Window) PartitionSortFields() SortFields {
+	if w == nil {
+		return nil
+	}
+	sfs := make(SortFields, 0, len(w.PartitionBy)+len(w.OrderBy))
+	for _, expr := range w.PartitionBy {
+		sfs = append(sfs, SortField{
+			Column: expr,
+			Order:  Ascending,
+		})
+	}
+	return append(sfs, w.OrderBy...)
+}
+
 func (w *Window) String() string {
 	if w == nil {
 		return ""
@@ -66,8 +82,8 @@ func (w *Window) String() string {
 		for i, expression := range w.PartitionBy {
 			if i > 0 {
 				sb.WriteString(", ")
-				sb.WriteString(expression.String())
 			}
+			sb.WriteString(expression.String())
 		}
 	}
 	if len(w.OrderBy) > 0 {
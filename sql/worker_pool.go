@@ -0,0 +1,246 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrWorkerPoolExhausted is returned when a worker cannot be started without waiting because the pool or the
+// subsystem quota is full.
+var ErrWorkerPoolExhausted = errors.NewKind("no workers available for %s")
+
+// WorkerSubsystem identifies the part of the engine that requests a worker from a WorkerPool.
+type WorkerSubsystem string
+
+const (
+	// WorkerParallelScan is used by the additional goroutines of a parallel table scan.
+	WorkerParallelScan WorkerSubsystem = "parallel_scan"
+	// WorkerIndexBuild is used while an index is being built.
+	WorkerIndexBuild WorkerSubsystem = "index_build"
+	// WorkerBackground is used by the threads started through BackgroundThreads.
+	WorkerBackground WorkerSubsystem = "background"
+	// WorkerAnalysis is used by the additional goroutines analyzing independent subtrees of a query plan.
+	WorkerAnalysis WorkerSubsystem = "analysis"
+	// WorkerIndexLookup is used by the additional goroutines looking up the rows of the secondary table of an indexed
+	// join.
+	WorkerIndexLookup WorkerSubsystem = "index_lookup"
+)
+
+// WorkerPoolStats are the metrics kept by a WorkerPool for a subsystem.
+type WorkerPoolStats struct {
+	// Running is the number of workers currently running.
+	Running int
+	// Waiting is the number of callers currently waiting for a worker.
+	Waiting int
+	// Completed is the number of workers that have finished.
+	Completed uint64
+	// Rejected is the number of workers that could not be started.
+	Rejected uint64
+}
+
+// WorkerPool limits the number of goroutines the engine runs for background work, both in total and per
+// subsystem. A size or quota of zero means unlimited. A nil *WorkerPool is valid and never limits anything.
+type WorkerPool struct {
+	mu      sync.Mutex
+	size    int
+	running int
+	quotas  map[WorkerSubsystem]int
+	stats   map[WorkerSubsystem]*WorkerPoolStats
+	// released is closed and replaced every time a worker finishes, to wake up waiters.
+	released chan struct{}
+}
+
+// NewWorkerPool creates a new WorkerPool running at most size workers at once. A size of zero means unlimited.
+func NewWorkerPool(size int) *WorkerPool {
+	if size < 0 {
+		size = 0
+	}
+	return &WorkerPool{
+		size:     size,
+		quotas:   make(map[WorkerSubsystem]int),
+		stats:    make(map[WorkerSubsystem]*WorkerPoolStats),
+		released: make(chan struct{}),
+	}
+}
+
+// Size returns the maximum number of workers of the pool, or zero if it's unlimited.
+func (p *WorkerPool) Size() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.size
+}
+
+// SetQuota limits the number of workers the subsystem given can run at once. A quota of zero removes the limit.
+func (p *WorkerPool) SetQuota(subsystem WorkerSubsystem, quota int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if quota <= 0 {
+		delete(p.quotas, subsystem)
+	} else {
+		p.quotas[subsystem] = quota
+	}
+	p.notify()
+}
+
+// Quota returns the quota of the subsystem given, or zero if it has none.
+func (p *WorkerPool) Quota(subsystem WorkerSubsystem) int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.quotas[subsystem]
+}
+
+// Stats returns the current metrics of the subsystem given.
+func (p *WorkerPool) Stats(subsystem WorkerSubsystem) WorkerPoolStats {
+	if p == nil {
+		return WorkerPoolStats{}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if s, ok := p.stats[subsystem]; ok {
+		return *s
+	}
+	return WorkerPoolStats{}
+}
+
+// Acquire reserves a worker for the subsystem given, waiting until one is available or the context is done. The
+// returned function must be called once the work is finished.
+func (p *WorkerPool) Acquire(ctx context.Context, subsystem WorkerSubsystem) (func(), error) {
+	if p == nil {
+		return func() {}, nil
+	}
+
+	p.mu.Lock()
+	waiting := false
+	for !p.available(subsystem) {
+		s := p.statsFor(subsystem)
+		if !waiting {
+			s.Waiting++
+			waiting = true
+		}
+		released := p.released
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			p.mu.Lock()
+			s.Waiting--
+			s.Rejected++
+			p.mu.Unlock()
+			return nil, ctx.Err()
+		case <-released:
+		}
+
+		p.mu.Lock()
+	}
+	if waiting {
+		p.statsFor(subsystem).Waiting--
+	}
+	release := p.start(subsystem)
+	p.mu.Unlock()
+
+	return release, nil
+}
+
+// TryAcquire reserves a worker for the subsystem given if one is available right away. It returns false otherwise.
+// The returned function must be called once the work is finished.
+func (p *WorkerPool) TryAcquire(subsystem WorkerSubsystem) (func(), bool) {
+	if p == nil {
+		return func() {}, true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.available(subsystem) {
+		p.statsFor(subsystem).Rejected++
+		return nil, false
+	}
+	return p.start(subsystem), true
+}
+
+// Go runs f in a new goroutine once a worker is available for the subsystem given. It returns an error without
+// running f if the context is done before that.
+func (p *WorkerPool) Go(ctx context.Context, subsystem WorkerSubsystem, f func()) error {
+	release, err := p.Acquire(ctx, subsystem)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer release()
+		f()
+	}()
+
+	return nil
+}
+
+// available returns whether a new worker can be started for the subsystem given. Must be called with the lock held.
+func (p *WorkerPool) available(subsystem WorkerSubsystem) bool {
+	if p.size > 0 && p.running >= p.size {
+		return false
+	}
+	if quota, ok := p.quotas[subsystem]; ok && p.statsFor(subsystem).Running >= quota {
+		return false
+	}
+	return true
+}
+
+// start accounts for a new worker of the subsystem given and returns the function releasing it. Must be called
+// with the lock held.
+func (p *WorkerPool) start(subsystem WorkerSubsystem) func() {
+	p.running++
+	p.statsFor(subsystem).Running++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.running--
+			s := p.statsFor(subsystem)
+			s.Running--
+			s.Completed++
+			p.notify()
+		})
+	}
+}
+
+// statsFor returns the stats of the subsystem given, creating them if needed. Must be called with the lock held.
+func (p *WorkerPool) statsFor(subsystem WorkerSubsystem) *WorkerPoolStats {
+	s, ok := p.stats[subsystem]
+	if !ok {
+		s = &WorkerPoolStats{}
+		p.stats[subsystem] = s
+	}
+	return s
+}
+
+// notify wakes up every waiter. Must be called with the lock held.
+func (p *WorkerPool) notify() {
+	close(p.released)
+	p.released = make(chan struct{})
+}
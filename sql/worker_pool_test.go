@@ -0,0 +1,130 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerPool(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		require := require.New(t)
+		p := NewWorkerPool(2)
+
+		r1, ok := p.TryAcquire(WorkerParallelScan)
+		require.True(ok)
+		r2, ok := p.TryAcquire(WorkerIndexBuild)
+		require.True(ok)
+		_, ok = p.TryAcquire(WorkerParallelScan)
+		require.False(ok)
+
+		r1()
+		r1()
+		r3, ok := p.TryAcquire(WorkerParallelScan)
+		require.True(ok)
+		r2()
+		r3()
+
+		require.Equal(WorkerPoolStats{Completed: 2, Rejected: 1}, p.Stats(WorkerParallelScan))
+		require.Equal(WorkerPoolStats{Completed: 1}, p.Stats(WorkerIndexBuild))
+	})
+
+	t.Run("quota", func(t *testing.T) {
+		require := require.New(t)
+		p := NewWorkerPool(0)
+		p.SetQuota(WorkerIndexBuild, 1)
+
+		release, ok := p.TryAcquire(WorkerIndexBuild)
+		require.True(ok)
+		_, ok = p.TryAcquire(WorkerIndexBuild)
+		require.False(ok)
+		other, ok := p.TryAcquire(WorkerParallelScan)
+		require.True(ok)
+		other()
+
+		p.SetQuota(WorkerIndexBuild, 0)
+		unlimited, ok := p.TryAcquire(WorkerIndexBuild)
+		require.True(ok)
+		unlimited()
+		release()
+	})
+
+	t.Run("acquire waits for a release", func(t *testing.T) {
+		require := require.New(t)
+		p := NewWorkerPool(1)
+
+		release, err := p.Acquire(context.Background(), WorkerIndexBuild)
+		require.NoError(err)
+
+		acquired := make(chan func())
+		go func() {
+			r, err := p.Acquire(context.Background(), WorkerIndexBuild)
+			require.NoError(err)
+			acquired <- r
+		}()
+
+		require.Eventually(func() bool {
+			return p.Stats(WorkerIndexBuild).Waiting == 1
+		}, time.Second, time.Millisecond)
+
+		release()
+		(<-acquired)()
+		require.Equal(WorkerPoolStats{Completed: 2}, p.Stats(WorkerIndexBuild))
+	})
+
+	t.Run("acquire is cancelled", func(t *testing.T) {
+		require := require.New(t)
+		p := NewWorkerPool(1)
+
+		release, ok := p.TryAcquire(WorkerBackground)
+		require.True(ok)
+		defer release()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := p.Acquire(ctx, WorkerIndexBuild)
+		require.Equal(context.Canceled, err)
+		require.Equal(WorkerPoolStats{Rejected: 1}, p.Stats(WorkerIndexBuild))
+	})
+
+	t.Run("go", func(t *testing.T) {
+		require := require.New(t)
+		p := NewWorkerPool(1)
+
+		done := make(chan struct{})
+		require.NoError(p.Go(context.Background(), WorkerBackground, func() { close(done) }))
+		<-done
+		require.Eventually(func() bool {
+			return p.Stats(WorkerBackground).Completed == 1
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("nil pool is unlimited", func(t *testing.T) {
+		require := require.New(t)
+		var p *WorkerPool
+
+		release, ok := p.TryAcquire(WorkerParallelScan)
+		require.True(ok)
+		release()
+		release, err := p.Acquire(context.Background(), WorkerIndexBuild)
+		require.NoError(err)
+		release()
+		require.Equal(WorkerPoolStats{}, p.Stats(WorkerParallelScan))
+	})
+}
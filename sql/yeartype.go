@@ -142,7 +142,7 @@ func (t yearType) Promote() Type {
 }
 
 // SQL implements Type interface.
-func (t yearType) SQL(v interface{}) (sqltypes.Value, error) {
+func (t yearType) SQL(dest []byte, v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
 	}
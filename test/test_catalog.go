@@ -111,8 +111,14 @@ func (c *Catalog) Function(name string) (sql.Function, error) {
 	return nil, sql.ErrFunctionNotFound.New(name)
 }
 
-func (c *Catalog) LockTable(ctx *sql.Context, table string) {}
+func (c *Catalog) LockTable(ctx *sql.Context, db, table string) {}
 
 func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	return nil
 }
+
+func (c *Catalog) SchemaVersion() uint64 {
+	return 0
+}
+
+func (c *Catalog) SchemaChanged() {}
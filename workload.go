@@ -0,0 +1,123 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrUnknownWorkloadClass is returned for queries of sessions assigned to a workload class the engine doesn't have.
+var ErrUnknownWorkloadClass = errors.NewKind("unknown workload class: %s")
+
+// WorkloadClass is a class of sessions whose queries share limits, like the resource groups of MySQL.
+type WorkloadClass struct {
+	// Name of the class, as returned by the WorkloadClassifier of the engine.
+	Name string
+	// MaxConcurrentQueries is the maximum number of queries of the class running at once. Queries beyond it wait for
+	// one of the running ones to finish, or for their context to be cancelled. Zero means no limit.
+	MaxConcurrentQueries int
+	// MaxMemory is the maximum number of bytes held by the caches of the queries of the class running at once, such
+	// as the ones used for joins, DISTINCT and GROUP BY. Queries needing more fail. Zero means no limit.
+	MaxMemory uint64
+}
+
+// WorkloadClassifier returns the name of the workload class of the session of the context given, or an empty name for
+// sessions that don't belong to any class and aren't limited.
+type WorkloadClassifier func(ctx *sql.Context) string
+
+// Workloads assigns the queries run by an engine to the workload classes of their sessions and enforces the limits of
+// those.
+type Workloads struct {
+	classifier WorkloadClassifier
+	classes    map[string]*workload
+}
+
+type workload struct {
+	WorkloadClass
+	slots  chan struct{}
+	memory *sql.MemoryManager
+}
+
+// NewWorkloads creates the workloads for the classes given, using the classifier given to assign sessions to them.
+func NewWorkloads(classifier WorkloadClassifier, classes ...WorkloadClass) *Workloads {
+	w := &Workloads{classifier: classifier, classes: make(map[string]*workload)}
+	for _, class := range classes {
+		wl := &workload{WorkloadClass: class}
+		if class.MaxConcurrentQueries > 0 {
+			wl.slots = make(chan struct{}, class.MaxConcurrentQueries)
+		}
+		if class.MaxMemory > 0 {
+			wl.memory = sql.NewMemoryManager(sql.NewMemoryQuota(sql.ProcessMemory, class.MaxMemory))
+		}
+		w.classes[class.Name] = wl
+	}
+	return w
+}
+
+// Admit waits until the session of the context given can run a query in its workload class, and returns the function
+// to call once the query is done. The memory manager of the context is replaced by the one of the class until then.
+func (w *Workloads) Admit(ctx *sql.Context) (func(), error) {
+	name := w.classifier(ctx)
+	if name == "" {
+		return func() {}, nil
+	}
+
+	wl, ok := w.classes[name]
+	if !ok {
+		return nil, ErrUnknownWorkloadClass.New(name)
+	}
+
+	if wl.slots != nil {
+		select {
+		case wl.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	memory := ctx.Memory
+	if wl.memory != nil {
+		ctx.Memory = wl.memory
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ctx.Memory = memory
+			if wl.slots != nil {
+				<-wl.slots
+			}
+		})
+	}, nil
+}
+
+// workloadIter is a RowIter wrapper that lets other queries of its workload class run once it's closed.
+type workloadIter struct {
+	childIter sql.RowIter
+	done      func()
+}
+
+func (i *workloadIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return i.childIter.Next(ctx)
+}
+
+func (i *workloadIter) Close(ctx *sql.Context) error {
+	defer i.done()
+	return i.childIter.Close(ctx)
+}